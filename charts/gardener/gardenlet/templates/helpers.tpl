@@ -238,6 +238,7 @@ controllers:
 {{ toYaml .Values.config.controllers.shootCare.conditionThresholds | indent 4 }}
     {{- end }}
     webhookRemediatorEnabled: {{ required ".Values.config.controllers.shootCare.webhookRemediatorEnabled is required" .Values.config.controllers.shootCare.webhookRemediatorEnabled }}
+    usageObservationEnabled: {{ required ".Values.config.controllers.shootCare.usageObservationEnabled is required" .Values.config.controllers.shootCare.usageObservationEnabled }}
   seedCare:
     syncPeriod: {{ required ".Values.config.controllers.seedCare.syncPeriod is required" .Values.config.controllers.seedCare.syncPeriod }}
     conditionThresholds:
@@ -333,6 +334,10 @@ debugging:
   enableProfiling: {{ .Values.config.debugging.enableProfiling | default false }}
   enableContentionProfiling: {{ .Values.config.debugging.enableContentionProfiling | default false }}
 {{- end }}
+{{- if .Values.config.tracing }}
+tracing:
+{{ toYaml .Values.config.tracing | indent 2 }}
+{{- end }}
 {{- if .Values.config.featureGates }}
 featureGates:
 {{ toYaml .Values.config.featureGates | indent 2 }}
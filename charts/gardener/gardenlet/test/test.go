@@ -751,6 +751,7 @@ func ComputeExpectedGardenletConfiguration(
 					},
 				},
 				WebhookRemediatorEnabled: ptr.To(false),
+				UsageObservationEnabled:  ptr.To(false),
 			},
 			SeedCare: &gardenletconfigv1alpha1.SeedCareControllerConfiguration{
 				SyncPeriod: &metav1.Duration{
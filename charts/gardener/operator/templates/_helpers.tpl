@@ -94,6 +94,10 @@ config.yaml: |
       etcdConfig:
 {{ toYaml .Values.config.controllers.garden.etcdConfig | indent 8 }}
       {{- end }}
+      {{- if .Values.config.controllers.garden.keyStore }}
+      keyStore:
+{{ toYaml .Values.config.controllers.garden.keyStore | indent 8 }}
+      {{- end }}
     {{- if .Values.config.controllers.gardenCare }}
     gardenCare:
       {{- if .Values.config.controllers.gardenCare.syncPeriod }}
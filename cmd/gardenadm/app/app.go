@@ -16,9 +16,13 @@ import (
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/bootstrap"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/connect"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/discover"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/etcd"
 	initcmd "github.com/gardener/gardener/pkg/gardenadm/cmd/init"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/join"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/preflight"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/reset"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/token"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/version"
 )
 
@@ -79,10 +83,14 @@ func prepareClusterBootstrapGroup(cmd *cobra.Command, opts *cmd.Options) {
 	cmd.AddGroup(group)
 
 	for _, subcommand := range []*cobra.Command{
+		preflight.NewCommand(opts),
 		initcmd.NewCommand(opts),
 		join.NewCommand(opts),
 		bootstrap.NewCommand(opts),
 		token.NewCommand(opts),
+		upgrade.NewCommand(opts),
+		reset.NewCommand(opts),
+		etcd.NewCommand(opts),
 	} {
 		subcommand.GroupID = group.ID
 		cmd.AddCommand(subcommand)
@@ -33,6 +33,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils/routes"
 	"github.com/gardener/gardener/pkg/features"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // Name is a const for the name of this component.
@@ -75,6 +76,21 @@ func run(ctx context.Context, log logr.Logger, cfg *controllermanagerconfigv1alp
 		return err
 	}
 
+	if cfg.Tracing != nil {
+		log.Info("Setting up tracing", "endpoint", cfg.Tracing.Endpoint)
+		shutdownTracing, err := tracing.Setup(ctx, Name, cfg.Tracing.Endpoint, ptr.Deref(cfg.Tracing.Insecure, false))
+		if err != nil {
+			return err
+		}
+		defer func() {
+			shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer shutdownCancel()
+			if err := shutdownTracing(shutdownCtx); err != nil {
+				log.Error(err, "Failed flushing remaining spans")
+			}
+		}()
+	}
+
 	var extraHandlers map[string]http.Handler
 	if cfg.Debugging != nil && ptr.Deref(cfg.Debugging.EnableProfiling, false) {
 		extraHandlers = routes.ProfilingHandlers
@@ -19,8 +19,10 @@ import (
 	"k8s.io/component-base/version/verflag"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	controllerwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
@@ -38,6 +40,8 @@ import (
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
 	"github.com/gardener/gardener/pkg/operator/controller"
 	"github.com/gardener/gardener/pkg/operator/webhook"
+	"github.com/gardener/gardener/pkg/utils/secrets/manager/inventory"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // Name is a const for the name of this component.
@@ -81,6 +85,21 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *o
 		return err
 	}
 
+	if cfg.Tracing != nil {
+		log.Info("Setting up tracing", "endpoint", cfg.Tracing.Endpoint)
+		shutdownTracing, err := tracing.Setup(ctx, Name, cfg.Tracing.Endpoint, ptr.Deref(cfg.Tracing.Insecure, false))
+		if err != nil {
+			return err
+		}
+		defer func() {
+			shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer shutdownCancel()
+			if err := shutdownTracing(shutdownCtx); err != nil {
+				log.Error(err, "Failed flushing remaining spans")
+			}
+		}()
+	}
+
 	var extraHandlers map[string]http.Handler
 	if cfg.Debugging != nil && ptr.Deref(cfg.Debugging.EnableProfiling, false) {
 		extraHandlers = routes.ProfilingHandlers
@@ -194,6 +213,11 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *o
 		return err
 	}
 
+	log.Info("Registering metrics collector for certificates managed by secrets managers")
+	if err := runtimemetrics.Registry.Register(inventory.NewCollector(log.WithName("certificate-inventory"), mgr.GetClient(), "gardener_operator", client.InNamespace(v1beta1constants.GardenNamespace))); err != nil {
+		return fmt.Errorf("failed registering certificate inventory metrics collector: %w", err)
+	}
+
 	log.Info("Adding controllers to manager")
 	if err := controller.AddToManager(cancel, mgr, cfg, gardenClientMap); err != nil {
 		return fmt.Errorf("failed adding controllers to manager: %w", err)
@@ -40,6 +40,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"github.com/gardener/gardener/cmd/utils/initrun"
@@ -66,12 +67,15 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/bootstrap/certificate"
 	"github.com/gardener/gardener/pkg/gardenlet/bootstrappers"
 	"github.com/gardener/gardener/pkg/gardenlet/controller"
+	gardenletmetrics "github.com/gardener/gardener/pkg/gardenlet/metrics"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
 	"github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/pkg/utils/secrets/manager/inventory"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // Name is a const for the name of this component.
@@ -118,6 +122,21 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *g
 		return err
 	}
 
+	if cfg.Tracing != nil {
+		log.Info("Setting up tracing", "endpoint", cfg.Tracing.Endpoint)
+		shutdownTracing, err := tracing.Setup(ctx, Name, cfg.Tracing.Endpoint, ptr.Deref(cfg.Tracing.Insecure, false))
+		if err != nil {
+			return err
+		}
+		defer func() {
+			shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer shutdownCancel()
+			if err := shutdownTracing(shutdownCtx); err != nil {
+				log.Error(err, "Failed flushing remaining spans")
+			}
+		}()
+	}
+
 	var extraHandlers map[string]http.Handler
 	if cfg.Debugging != nil && ptr.Deref(cfg.Debugging.EnableProfiling, false) {
 		extraHandlers = routes.ProfilingHandlers
@@ -566,6 +585,11 @@ func (g *garden) Start(ctx context.Context) error {
 		log.Info("Successfully fetched Shoot resource for self-hosted shoot", "shoot", g.selfHostedShootInfo)
 	}
 
+	log.Info("Registering metrics collector for certificates managed by secrets managers")
+	if err := runtimemetrics.Registry.Register(inventory.NewCollector(log.WithName("certificate-inventory"), g.mgr.GetClient(), gardenletmetrics.Namespace)); err != nil {
+		return fmt.Errorf("failed registering certificate inventory metrics collector: %w", err)
+	}
+
 	log.Info("Adding controllers to manager")
 	if err := controller.AddToManager(
 		ctx,
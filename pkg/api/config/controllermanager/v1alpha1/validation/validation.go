@@ -5,12 +5,18 @@
 package validation
 
 import (
+	"fmt"
+
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	schedulerconfigvalidation "github.com/gardener/gardener/pkg/api/config/scheduler/v1alpha1/validation"
+	gardencorevalidation "github.com/gardener/gardener/pkg/api/core/validation"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/logger"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 )
@@ -34,6 +40,10 @@ func ValidateControllerManagerConfiguration(conf *controllermanagerconfigv1alpha
 		}
 	}
 
+	if conf.Tracing != nil {
+		allErrs = append(allErrs, validationutils.ValidateTracingEndpoint(conf.Tracing.Endpoint, field.NewPath("tracing", "endpoint"))...)
+	}
+
 	allErrs = append(allErrs, validateControllerManagerControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 	return allErrs
 }
@@ -46,6 +56,28 @@ func validateControllerManagerControllerConfiguration(conf controllermanagerconf
 		allErrs = append(allErrs, validateProjectControllerConfiguration(conf.Project, projectFldPath)...)
 	}
 
+	seedDrainFldPath := fldPath.Child("seedDrain")
+	if conf.SeedDrain != nil {
+		allErrs = append(allErrs, validateSeedDrainControllerConfiguration(conf.SeedDrain, seedDrainFldPath)...)
+	}
+
+	seedRebalancerFldPath := fldPath.Child("seedRebalancer")
+	if conf.SeedRebalancer != nil {
+		allErrs = append(allErrs, validateSeedRebalancerControllerConfiguration(conf.SeedRebalancer, seedRebalancerFldPath)...)
+	}
+
+	if conf.ShootScheduler != nil {
+		allErrs = append(allErrs, schedulerconfigvalidation.ValidateShootSchedulerConfiguration(conf.ShootScheduler, fldPath.Child("shootScheduler"))...)
+	}
+
+	shootMaintenanceFldPath := fldPath.Child("shootMaintenance")
+	allErrs = append(allErrs, validateShootMaintenanceControllerConfiguration(conf.ShootMaintenance, shootMaintenanceFldPath)...)
+
+	shootQuotaFldPath := fldPath.Child("shootQuota")
+	if conf.ShootQuota != nil {
+		allErrs = append(allErrs, validateShootQuotaControllerConfiguration(conf.ShootQuota, shootQuotaFldPath)...)
+	}
+
 	shootStateFldPath := fldPath.Child("shootState")
 	if conf.ShootState != nil {
 		allErrs = append(allErrs, validateShootStateControllerConfiguration(conf.ShootState, shootStateFldPath)...)
@@ -59,6 +91,15 @@ func validateProjectControllerConfiguration(conf *controllermanagerconfigv1alpha
 	for i, quotaConfig := range conf.Quotas {
 		allErrs = append(allErrs, validateProjectQuotaConfiguration(quotaConfig, fldPath.Child("quotas").Index(i))...)
 	}
+
+	if conf.SoftQuotaSyncPeriod != nil && conf.SoftQuotaSyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("softQuotaSyncPeriod"), conf.SoftQuotaSyncPeriod.Duration, "softQuotaSyncPeriod must be larger than 0"))
+	}
+
+	if conf.Usage != nil && conf.Usage.SyncPeriod != nil && conf.Usage.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("usage", "syncPeriod"), conf.Usage.SyncPeriod.Duration, "syncPeriod must be larger than 0"))
+	}
+
 	return allErrs
 }
 
@@ -70,6 +111,117 @@ func validateProjectQuotaConfiguration(conf controllermanagerconfigv1alpha1.Quot
 	return allErrs
 }
 
+func validateSeedDrainControllerConfiguration(conf *controllermanagerconfigv1alpha1.SeedDrainControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.SyncPeriod != nil && conf.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), conf.SyncPeriod.Duration, "syncPeriod must be larger than 0"))
+	}
+
+	if conf.MaxConcurrentMigrations != nil && *conf.MaxConcurrentMigrations <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentMigrations"), *conf.MaxConcurrentMigrations, "maxConcurrentMigrations must be larger than 0"))
+	}
+
+	return allErrs
+}
+
+func validateSeedRebalancerControllerConfiguration(conf *controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.SyncPeriod != nil && conf.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), conf.SyncPeriod.Duration, "syncPeriod must be larger than 0"))
+	}
+
+	if conf.MaxShootDifference != nil && *conf.MaxShootDifference < 2 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxShootDifference"), *conf.MaxShootDifference, "maxShootDifference must be at least 2"))
+	}
+
+	if conf.MaxConcurrentMigrations != nil && *conf.MaxConcurrentMigrations <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentMigrations"), *conf.MaxConcurrentMigrations, "maxConcurrentMigrations must be larger than 0"))
+	}
+
+	return allErrs
+}
+
+func validateShootQuotaControllerConfiguration(conf *controllermanagerconfigv1alpha1.ShootQuotaControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, threshold := range conf.ExpirationWarningThresholds {
+		if threshold.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("expirationWarningThresholds").Index(i), threshold.Duration, "threshold must be larger than 0"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateShootMaintenanceControllerConfiguration(conf controllermanagerconfigv1alpha1.ShootMaintenanceControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	freezes := make([]gardencore.MaintenanceFreeze, len(conf.MaintenanceFreezes))
+	for i := range conf.MaintenanceFreezes {
+		if err := gardencorev1beta1.Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(&conf.MaintenanceFreezes[i], &freezes[i], nil); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maintenanceFreezes").Index(i), conf.MaintenanceFreezes[i], fmt.Sprintf("could not convert maintenance freeze: %v", err)))
+			return allErrs
+		}
+	}
+	allErrs = append(allErrs, gardencorevalidation.ValidateMaintenanceFreezes(freezes, fldPath.Child("maintenanceFreezes"))...)
+
+	if conf.StagedRollout != nil {
+		allErrs = append(allErrs, validateStagedRolloutConfiguration(conf.StagedRollout, fldPath.Child("stagedRollout"))...)
+	}
+
+	return allErrs
+}
+
+var availableShootPurposes = sets.New(
+	gardencorev1beta1.ShootPurposeEvaluation,
+	gardencorev1beta1.ShootPurposeTesting,
+	gardencorev1beta1.ShootPurposeDevelopment,
+	gardencorev1beta1.ShootPurposeProduction,
+	gardencorev1beta1.ShootPurposeInfrastructure,
+)
+
+func validateStagedRolloutConfiguration(conf *controllermanagerconfigv1alpha1.StagedRolloutConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(conf.Waves) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("waves"), "at least one rollout wave must be configured"))
+	}
+
+	names := sets.New[string]()
+	for i, wave := range conf.Waves {
+		idxPath := fldPath.Child("waves").Index(i)
+
+		if wave.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name must be provided"))
+		} else if names.Has(wave.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), wave.Name))
+		}
+		names.Insert(wave.Name)
+
+		for j, purpose := range wave.Purposes {
+			if !availableShootPurposes.Has(purpose) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("purposes").Index(j), purpose, sets.List(availableShootPurposes)))
+			}
+		}
+
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(wave.ProjectSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("projectSelector"))...)
+	}
+
+	if conf.SoakDuration != nil && conf.SoakDuration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("soakDuration"), conf.SoakDuration.Duration, "soakDuration must be larger than 0"))
+	}
+	if conf.MaxUnhealthyPercentage != nil && (*conf.MaxUnhealthyPercentage < 0 || *conf.MaxUnhealthyPercentage > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnhealthyPercentage"), *conf.MaxUnhealthyPercentage, "maxUnhealthyPercentage must be between 0 and 100"))
+	}
+	if conf.SyncPeriod != nil && conf.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), conf.SyncPeriod.Duration, "syncPeriod must be larger than 0"))
+	}
+
+	return allErrs
+}
+
 func validateShootStateControllerConfiguration(conf *controllermanagerconfigv1alpha1.ShootStateControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if conf.ConcurrentSyncs != nil {
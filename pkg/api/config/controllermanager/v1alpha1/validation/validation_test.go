@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -16,6 +18,8 @@ import (
 
 	. "github.com/gardener/gardener/pkg/api/config/controllermanager/v1alpha1/validation"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
 var _ = Describe("#ValidateControllerManagerConfiguration", func() {
@@ -95,6 +99,29 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 		})
 	})
 
+	Context("tracing configuration", func() {
+		BeforeEach(func() {
+			controllermanagerconfigv1alpha1.SetObjectDefaults_ControllerManagerConfiguration(conf)
+		})
+
+		It("should allow a valid endpoint", func() {
+			conf.Tracing = &controllermanagerconfigv1alpha1.TracingConfiguration{Endpoint: "otel-collector:4317", Insecure: ptr.To(true)}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should reject an invalid endpoint", func() {
+			conf.Tracing = &controllermanagerconfigv1alpha1.TracingConfiguration{Endpoint: "otel-collector:0"}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("tracing.endpoint"),
+				})),
+			))
+		})
+	})
+
 	Context("ProjectControllerConfiguration", func() {
 		Context("ProjectQuotaConfiguration", func() {
 			BeforeEach(func() {
@@ -155,6 +182,253 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 				))
 			})
 		})
+
+		Context("ProjectUsageConfiguration", func() {
+			BeforeEach(func() {
+				conf.Controllers.Project = &controllermanagerconfigv1alpha1.ProjectControllerConfiguration{
+					Usage: &controllermanagerconfigv1alpha1.ProjectUsageConfiguration{},
+				}
+			})
+
+			It("should allow a positive sync period", func() {
+				conf.Controllers.Project.Usage.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should forbid a non-positive sync period", func() {
+				conf.Controllers.Project.Usage.SyncPeriod = &metav1.Duration{}
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.usage.syncPeriod"),
+					})),
+				))
+			})
+		})
+
+		It("should forbid a non-positive soft quota sync period", func() {
+			conf.Controllers.Project = &controllermanagerconfigv1alpha1.ProjectControllerConfiguration{
+				SoftQuotaSyncPeriod: &metav1.Duration{},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.project.softQuotaSyncPeriod"),
+				})),
+			))
+		})
+	})
+
+	Context("SeedDrainControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.SeedDrain = &controllermanagerconfigv1alpha1.SeedDrainControllerConfiguration{}
+		})
+
+		It("should allow valid configurations", func() {
+			conf.Controllers.SeedDrain.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+			conf.Controllers.SeedDrain.MaxConcurrentMigrations = ptr.To[int32](3)
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid non-positive sync periods and max concurrent migrations", func() {
+			conf.Controllers.SeedDrain.SyncPeriod = &metav1.Duration{}
+			conf.Controllers.SeedDrain.MaxConcurrentMigrations = ptr.To[int32](0)
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedDrain.syncPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedDrain.maxConcurrentMigrations"),
+				})),
+			))
+		})
+	})
+
+	Context("ShootScheduler", func() {
+		It("should allow valid configurations", func() {
+			conf.Controllers.ShootScheduler = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Strategy: schedulerconfigv1alpha1.MinimalDistance,
+				Filters:  []schedulerconfigv1alpha1.SeedFilterRule{{Name: "foo", Expression: "true"}},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid invalid configurations", func() {
+			conf.Controllers.ShootScheduler = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Strategy: "foo",
+				Filters:  []schedulerconfigv1alpha1.SeedFilterRule{{Expression: "true"}},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.shootScheduler.strategy"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootScheduler.filters[0].name"),
+				})),
+			))
+		})
+	})
+
+	Context("SeedRebalancerControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.SeedRebalancer = &controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration{}
+		})
+
+		It("should allow valid configurations", func() {
+			conf.Controllers.SeedRebalancer.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+			conf.Controllers.SeedRebalancer.MaxShootDifference = ptr.To[int32](2)
+			conf.Controllers.SeedRebalancer.MaxConcurrentMigrations = ptr.To[int32](1)
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid invalid configurations", func() {
+			conf.Controllers.SeedRebalancer.SyncPeriod = &metav1.Duration{}
+			conf.Controllers.SeedRebalancer.MaxShootDifference = ptr.To[int32](1)
+			conf.Controllers.SeedRebalancer.MaxConcurrentMigrations = ptr.To[int32](0)
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedRebalancer.syncPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedRebalancer.maxShootDifference"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedRebalancer.maxConcurrentMigrations"),
+				})),
+			))
+		})
+	})
+
+	Context("ShootQuotaControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.ShootQuota = &controllermanagerconfigv1alpha1.ShootQuotaControllerConfiguration{}
+		})
+
+		It("should allow positive expiration warning thresholds", func() {
+			conf.Controllers.ShootQuota.ExpirationWarningThresholds = []metav1.Duration{{Duration: 24 * time.Hour}}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid non-positive expiration warning thresholds", func() {
+			conf.Controllers.ShootQuota.ExpirationWarningThresholds = []metav1.Duration{{Duration: 24 * time.Hour}, {}}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootQuota.expirationWarningThresholds[1]"),
+				})),
+			))
+		})
+	})
+
+	Context("ShootMaintenanceControllerConfiguration", func() {
+		It("should allow valid maintenance freezes", func() {
+			conf.Controllers.ShootMaintenance.MaintenanceFreezes = []gardencorev1beta1.MaintenanceFreeze{
+				{Name: "holidays", Schedule: ptr.To("0 0 20 12 *"), Duration: &metav1.Duration{Duration: 17 * 24 * time.Hour}},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid invalid maintenance freezes", func() {
+			conf.Controllers.ShootMaintenance.MaintenanceFreezes = []gardencorev1beta1.MaintenanceFreeze{
+				{Name: "holidays", Schedule: ptr.To("0 0 20 12 *")},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootMaintenance.maintenanceFreezes[0].duration"),
+				})),
+			))
+		})
+
+		It("should allow a valid staged rollout configuration", func() {
+			conf.Controllers.ShootMaintenance.StagedRollout = &controllermanagerconfigv1alpha1.StagedRolloutConfiguration{
+				Waves: []controllermanagerconfigv1alpha1.RolloutWave{
+					{Name: "canary", Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeEvaluation}},
+					{Name: "early", ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"rollout": "early"}}},
+					{Name: "rest"},
+				},
+				SoakDuration:           &metav1.Duration{Duration: 24 * time.Hour},
+				MaxUnhealthyPercentage: ptr.To[int32](10),
+				SyncPeriod:             &metav1.Duration{Duration: 10 * time.Minute},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid staged rollout configuration", func() {
+			conf.Controllers.ShootMaintenance.StagedRollout = &controllermanagerconfigv1alpha1.StagedRolloutConfiguration{
+				Waves: []controllermanagerconfigv1alpha1.RolloutWave{
+					{Name: "canary", Purposes: []gardencorev1beta1.ShootPurpose{"foo"}},
+					{Name: "canary"},
+					{ProjectSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "rollout", Operator: "foo"}}}},
+				},
+				SoakDuration:           &metav1.Duration{},
+				MaxUnhealthyPercentage: ptr.To[int32](101),
+				SyncPeriod:             &metav1.Duration{Duration: -time.Minute},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.shootMaintenance.stagedRollout.waves[0].purposes[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("controllers.shootMaintenance.stagedRollout.waves[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootMaintenance.stagedRollout.waves[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootMaintenance.stagedRollout.waves[2].projectSelector.matchExpressions[0].operator"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootMaintenance.stagedRollout.soakDuration"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootMaintenance.stagedRollout.maxUnhealthyPercentage"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootMaintenance.stagedRollout.syncPeriod"),
+				})),
+			))
+		})
+
+		It("should require at least one rollout wave", func() {
+			conf.Controllers.ShootMaintenance.StagedRollout = &controllermanagerconfigv1alpha1.StagedRolloutConfiguration{}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootMaintenance.stagedRollout.waves"),
+				})),
+			))
+		})
 	})
 
 	Context("ShootStateControllerConfiguration", func() {
@@ -79,6 +79,10 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		}
 	}
 
+	if cfg.Tracing != nil {
+		allErrs = append(allErrs, validationutils.ValidateTracingEndpoint(cfg.Tracing.Endpoint, field.NewPath("tracing", "endpoint"))...)
+	}
+
 	if cfg.SeedConfig != nil {
 		seedTemplate, err := gardencorehelper.ConvertSeedTemplate(&cfg.SeedConfig.SeedTemplate)
 		if err != nil {
@@ -5,6 +5,7 @@
 package validation
 
 import (
+	"path"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -35,6 +36,10 @@ func ValidateOperatorConfiguration(conf *operatorconfigv1alpha1.OperatorConfigur
 		allErrs = append(allErrs, field.NotSupported(field.NewPath("logFormat"), conf.LogFormat, logger.AllLogFormats))
 	}
 
+	if conf.Tracing != nil {
+		allErrs = append(allErrs, validationutils.ValidateTracingEndpoint(conf.Tracing.Endpoint, field.NewPath("tracing", "endpoint"))...)
+	}
+
 	allErrs = append(allErrs, validateControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 	allErrs = append(allErrs, validateNodeTolerationConfiguration(conf.NodeToleration, field.NewPath("nodeToleration"))...)
 
@@ -58,6 +63,16 @@ func validateGardenControllerConfiguration(conf operatorconfigv1alpha1.GardenCon
 	allErrs = append(allErrs, validateConcurrentSyncs(conf.ConcurrentSyncs, fldPath)...)
 	allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
 
+	if conf.KeyStore != nil {
+		keyStorePath := fldPath.Child("keyStore")
+
+		if conf.KeyStore.Directory == "" {
+			allErrs = append(allErrs, field.Required(keyStorePath.Child("directory"), "must specify the directory of the key store"))
+		} else if !path.IsAbs(conf.KeyStore.Directory) {
+			allErrs = append(allErrs, field.Invalid(keyStorePath.Child("directory"), conf.KeyStore.Directory, "must be an absolute path"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -156,6 +156,22 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 		),
 	)
 
+	DescribeTable("tracing configuration",
+		func(endpoint string, matcher gomegatypes.GomegaMatcher) {
+			conf.Tracing = &operatorconfigv1alpha1.TracingConfiguration{Endpoint: endpoint}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(matcher)
+		},
+
+		Entry("should be a valid tracing configuration", "otel-collector:4317", BeEmpty()),
+		Entry("should be an invalid tracing configuration without endpoint", "",
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("tracing.endpoint")}))),
+		),
+		Entry("should be an invalid tracing configuration without port", "otel-collector",
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("tracing.endpoint")}))),
+		),
+	)
+
 	Context("controller configuration", func() {
 		Context("garden", func() {
 			It("should return errors because concurrent syncs are <= 0", func() {
@@ -193,6 +209,34 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 					})),
 				))
 			})
+
+			It("should allow a key store with an absolute directory", func() {
+				conf.Controllers.Garden.KeyStore = &operatorconfigv1alpha1.KeyStoreConfig{Directory: "/var/run/secrets/ca-keys"}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should return errors because the directory of the key store is missing", func() {
+				conf.Controllers.Garden.KeyStore = &operatorconfigv1alpha1.KeyStoreConfig{}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.garden.keyStore.directory"),
+					})),
+				))
+			})
+
+			It("should return errors because the directory of the key store is relative", func() {
+				conf.Controllers.Garden.KeyStore = &operatorconfigv1alpha1.KeyStoreConfig{Directory: "ca-keys"}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.garden.keyStore.directory"),
+					})),
+				))
+			})
 		})
 
 		Context("GardenCare", func() {
@@ -7,14 +7,17 @@ package validation
 import (
 	"time"
 
+	"github.com/google/cel-go/cel"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
 	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/resourcemanager/customhealthcheck"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
@@ -39,6 +42,10 @@ func ValidateResourceManagerConfiguration(conf *resourcemanagerconfigv1alpha1.Re
 		allErrs = append(allErrs, field.NotSupported(field.NewPath("logFormat"), conf.LogFormat, logger.AllLogFormats))
 	}
 
+	if conf.Tracing != nil {
+		allErrs = append(allErrs, validationutils.ValidateTracingEndpoint(conf.Tracing.Endpoint, field.NewPath("tracing", "endpoint"))...)
+	}
+
 	allErrs = append(allErrs, validateResourceManagerControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 	allErrs = append(allErrs, validateResourceManagerWebhookConfiguration(conf.Webhooks, field.NewPath("webhooks"))...)
 
@@ -100,6 +107,11 @@ func validateResourceManagerControllerConfiguration(conf resourcemanagerconfigv1
 
 	allErrs = append(allErrs, validateConcurrentSyncs(conf.Health.ConcurrentSyncs, fldPath.Child("health"))...)
 	allErrs = append(allErrs, validateSyncPeriod(conf.Health.SyncPeriod, fldPath.Child("health"))...)
+	allErrs = append(allErrs, validateCustomHealthChecks(conf.Health.CustomHealthChecks, fldPath.Child("health", "customHealthChecks"))...)
+
+	if conf.Drift.Enabled {
+		allErrs = append(allErrs, validateConcurrentSyncs(conf.Drift.ConcurrentSyncs, fldPath.Child("drift"))...)
+	}
 
 	allErrs = append(allErrs, validateManagedResourceControllerConfiguration(conf.ManagedResource, fldPath.Child("managedResources"))...)
 
@@ -127,6 +139,60 @@ func validateManagedResourceControllerConfiguration(conf resourcemanagerconfigv1
 	return allErrs
 }
 
+func validateCustomHealthChecks(checks []resourcemanagerconfigv1alpha1.CustomHealthCheck, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	groupKinds := sets.New[schema.GroupKind]()
+
+	for i, check := range checks {
+		idxPath := fldPath.Index(i)
+
+		if len(check.Kind) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("kind"), "must provide the kind of resources"))
+		}
+
+		groupKind := schema.GroupKind{Group: check.Group, Kind: check.Kind}
+		if groupKinds.Has(groupKind) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, groupKind.String()))
+		}
+		groupKinds.Insert(groupKind)
+
+		switch {
+		case check.Conditions != nil && check.CEL != nil:
+			allErrs = append(allErrs, field.Forbidden(idxPath, "must not specify both conditions and cel"))
+		case check.Conditions == nil && check.CEL == nil:
+			allErrs = append(allErrs, field.Required(idxPath, "must specify either conditions or cel"))
+		case check.CEL != nil:
+			allErrs = append(allErrs, validateCELHealthCheck(*check.CEL, idxPath.Child("cel"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateCELHealthCheck(check resourcemanagerconfigv1alpha1.CELHealthCheck, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(check.Healthy) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("healthy"), "must provide an expression"))
+	} else if _, err := customhealthcheck.Compile(check.Healthy, cel.BoolType); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("healthy"), check.Healthy, err.Error()))
+	}
+
+	if check.Progressing != nil {
+		if _, err := customhealthcheck.Compile(*check.Progressing, cel.BoolType); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("progressing"), *check.Progressing, err.Error()))
+		}
+	}
+
+	if check.Message != nil {
+		if _, err := customhealthcheck.Compile(*check.Message, cel.StringType); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("message"), *check.Message, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
 func validateNodeAgentReconciliationDelayControllerConfiguration(conf resourcemanagerconfigv1alpha1.NodeAgentReconciliationDelayControllerConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
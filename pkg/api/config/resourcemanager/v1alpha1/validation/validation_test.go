@@ -171,6 +171,25 @@ var _ = Describe("Validation", func() {
 			})
 		})
 
+		Context("tracing configuration", func() {
+			It("should allow a valid endpoint", func() {
+				conf.Tracing = &resourcemanagerconfigv1alpha1.TracingConfiguration{Endpoint: "otel-collector:4317"}
+
+				Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should return errors because the endpoint is invalid", func() {
+				conf.Tracing = &resourcemanagerconfigv1alpha1.TracingConfiguration{Endpoint: "otel-collector"}
+
+				Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("tracing.endpoint"),
+					})),
+				))
+			})
+		})
+
 		Context("server configuration", func() {
 			It("should return errors because configuration is nil", func() {
 				conf.Server.HealthProbes = nil
@@ -323,6 +342,108 @@ var _ = Describe("Validation", func() {
 						})),
 					))
 				})
+
+				Context("custom health checks", func() {
+					It("should allow valid custom health checks", func() {
+						conf.Controllers.Health.CustomHealthChecks = []resourcemanagerconfigv1alpha1.CustomHealthCheck{
+							{Group: "example.com", Kind: "Foo", Conditions: &resourcemanagerconfigv1alpha1.ConditionsHealthCheck{HealthyConditions: []string{"Ready"}}},
+							{Group: "example.com", Kind: "Bar", CEL: &resourcemanagerconfigv1alpha1.CELHealthCheck{
+								Healthy:     "object.status.phase == 'Running'",
+								Progressing: ptr.To("object.status.phase == 'Pending'"),
+								Message:     ptr.To("'phase is ' + object.status.phase"),
+							}},
+						}
+
+						Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+					})
+
+					It("should forbid custom health checks without kind or duplicate kinds", func() {
+						conf.Controllers.Health.CustomHealthChecks = []resourcemanagerconfigv1alpha1.CustomHealthCheck{
+							{Group: "example.com", Conditions: &resourcemanagerconfigv1alpha1.ConditionsHealthCheck{}},
+							{Group: "example.com", Kind: "Foo", Conditions: &resourcemanagerconfigv1alpha1.ConditionsHealthCheck{}},
+							{Group: "example.com", Kind: "Foo", Conditions: &resourcemanagerconfigv1alpha1.ConditionsHealthCheck{}},
+						}
+
+						Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeRequired),
+								"Field": Equal("controllers.health.customHealthChecks[0].kind"),
+							})),
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeDuplicate),
+								"Field": Equal("controllers.health.customHealthChecks[2]"),
+							})),
+						))
+					})
+
+					It("should require exactly one of conditions and cel", func() {
+						conf.Controllers.Health.CustomHealthChecks = []resourcemanagerconfigv1alpha1.CustomHealthCheck{
+							{Kind: "Foo"},
+							{Kind: "Bar", Conditions: &resourcemanagerconfigv1alpha1.ConditionsHealthCheck{}, CEL: &resourcemanagerconfigv1alpha1.CELHealthCheck{Healthy: "true"}},
+						}
+
+						Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeRequired),
+								"Field": Equal("controllers.health.customHealthChecks[0]"),
+							})),
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeForbidden),
+								"Field": Equal("controllers.health.customHealthChecks[1]"),
+							})),
+						))
+					})
+
+					It("should forbid invalid CEL expressions", func() {
+						conf.Controllers.Health.CustomHealthChecks = []resourcemanagerconfigv1alpha1.CustomHealthCheck{
+							{Kind: "Foo", CEL: &resourcemanagerconfigv1alpha1.CELHealthCheck{}},
+							{Kind: "Bar", CEL: &resourcemanagerconfigv1alpha1.CELHealthCheck{
+								Healthy:     "object.status.phase ==",
+								Progressing: ptr.To("'foo'"),
+								Message:     ptr.To("true"),
+							}},
+						}
+
+						Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeRequired),
+								"Field": Equal("controllers.health.customHealthChecks[0].cel.healthy"),
+							})),
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("controllers.health.customHealthChecks[1].cel.healthy"),
+							})),
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("controllers.health.customHealthChecks[1].cel.progressing"),
+							})),
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("controllers.health.customHealthChecks[1].cel.message"),
+							})),
+						))
+					})
+				})
+			})
+
+			Context("drift", func() {
+				It("should return errors because concurrent syncs are <= 0", func() {
+					conf.Controllers.Drift.Enabled = true
+					conf.Controllers.Drift.ConcurrentSyncs = ptr.To(0)
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.drift.concurrentSyncs"),
+						})),
+					))
+				})
+
+				It("should not validate the configuration if the controller is disabled", func() {
+					conf.Controllers.Drift.ConcurrentSyncs = ptr.To(0)
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
 			})
 
 			Context("managed resources", func() {
@@ -7,10 +7,12 @@ package validation
 import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/scheduler/seedfilter"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 )
 
@@ -49,8 +51,44 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfigv1alpha1
 	}
 
 	if schedulers.Shoot != nil {
-		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
-		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+		allErrs = append(allErrs, ValidateShootSchedulerConfiguration(schedulers.Shoot, fldPath.Child("shoot"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateShootSchedulerConfiguration validates the shoot scheduler configuration.
+func ValidateShootSchedulerConfiguration(config *schedulerconfigv1alpha1.ShootSchedulerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(config.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	allErrs = append(allErrs, validateStrategy(config.Strategy, fldPath.Child("strategy"))...)
+
+	if config.CapacityAware != nil {
+		allErrs = append(allErrs, validateCapacityAwareConfiguration(config.CapacityAware, fldPath.Child("capacityAware"))...)
+	}
+
+	if config.SchedulingPreview != nil && config.SchedulingPreview.Enabled {
+		allErrs = append(allErrs, validateSchedulingPreviewServer(config.SchedulingPreview.Server, fldPath.Child("schedulingPreview", "server"))...)
+	}
+
+	allErrs = append(allErrs, validateSeedFilterRules(config.Filters, fldPath.Child("filters"))...)
+
+	return allErrs
+}
+
+func validateSchedulingPreviewServer(server *schedulerconfigv1alpha1.HTTPSServer, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if server == nil {
+		return append(allErrs, field.Required(fldPath, "must provide the HTTPS server configuration if the scheduling preview is enabled"))
+	}
+
+	for _, msg := range validation.IsValidPortNum(server.Port) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), server.Port, msg))
+	}
+	if server.TLS.ServerCertDir == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("tls", "serverCertDir"), "must provide the directory containing the server certificate"))
 	}
 
 	return allErrs
@@ -74,3 +112,71 @@ func validateStrategy(strategy schedulerconfigv1alpha1.CandidateDeterminationStr
 
 	return allErrs
 }
+
+func validateCapacityAwareConfiguration(config *schedulerconfigv1alpha1.CapacityAwareConfiguration, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs          = field.ErrorList{}
+		supportedScorers = sets.New(schedulerconfigv1alpha1.SeedScorers...)
+		scorerNames      = sets.New[schedulerconfigv1alpha1.SeedScorerName]()
+	)
+
+	for i, scorer := range config.Scorers {
+		idxPath := fldPath.Child("scorers").Index(i)
+
+		if !supportedScorers.Has(scorer.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), scorer.Name, sets.List(supportedScorers)))
+		} else if scorerNames.Has(scorer.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), scorer.Name))
+		}
+		scorerNames.Insert(scorer.Name)
+
+		if scorer.Weight != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*scorer.Weight), idxPath.Child("weight"))...)
+		}
+	}
+
+	if config.ShootCost != nil {
+		costPath := fldPath.Child("shootCost")
+
+		for purpose, cost := range config.ShootCost.Purposes {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cost), costPath.Child("purposes").Key(purpose))...)
+		}
+		if config.ShootCost.HighAvailabilityNode != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*config.ShootCost.HighAvailabilityNode), costPath.Child("highAvailabilityNode"))...)
+		}
+		if config.ShootCost.HighAvailabilityZone != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*config.ShootCost.HighAvailabilityZone), costPath.Child("highAvailabilityZone"))...)
+		}
+		if config.ShootCost.WorkerPool != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*config.ShootCost.WorkerPool), costPath.Child("workerPool"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateSeedFilterRules(rules []schedulerconfigv1alpha1.SeedFilterRule, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, rule := range rules {
+		idxPath := fldPath.Index(i)
+
+		if rule.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(rule.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), rule.Name))
+		}
+		names.Insert(rule.Name)
+
+		if rule.Expression == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("expression"), "must provide an expression"))
+		} else if _, err := seedfilter.Compile(rule.Expression); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("expression"), rule.Expression, err.Error()))
+		}
+	}
+
+	return allErrs
+}
@@ -162,4 +162,170 @@ var _ = Describe("#ValidateConfiguration", func() {
 			}))))
 		})
 	})
+
+	Context("capacity aware strategy configuration", func() {
+		BeforeEach(func() {
+			conf.Schedulers.Shoot.Strategy = schedulerconfigv1alpha1.CapacityAware
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(conf)
+		})
+
+		It("should pass because the default configuration of the 'Capacity Aware' Strategy is valid", func() {
+			Expect(ValidateConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should pass because multiple scorers and shoot costs are configured", func() {
+			conf.Schedulers.Shoot.CapacityAware.Scorers = []schedulerconfigv1alpha1.SeedScorer{
+				{Name: schedulerconfigv1alpha1.SeedScorerLeastAllocated, Weight: ptr.To[int32](2)},
+				{Name: schedulerconfigv1alpha1.SeedScorerLeastShoots, Weight: ptr.To[int32](1)},
+			}
+			conf.Schedulers.Shoot.CapacityAware.ShootCost.Purposes = map[string]int32{"production": 200, "development": 50}
+
+			Expect(ValidateConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because of unknown, duplicate and negatively weighted scorers", func() {
+			conf.Schedulers.Shoot.CapacityAware.Scorers = []schedulerconfigv1alpha1.SeedScorer{
+				{Name: "foo"},
+				{Name: schedulerconfigv1alpha1.SeedScorerLeastShoots, Weight: ptr.To[int32](-1)},
+				{Name: schedulerconfigv1alpha1.SeedScorerLeastShoots},
+			}
+
+			Expect(ValidateConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.capacityAware.scorers[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.capacityAware.scorers[1].weight"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("schedulers.shoot.capacityAware.scorers[2].name"),
+				})),
+			))
+		})
+
+		It("should fail because of negative shoot costs", func() {
+			conf.Schedulers.Shoot.CapacityAware.ShootCost = &schedulerconfigv1alpha1.ShootCostConfiguration{
+				Purposes:             map[string]int32{"production": -1},
+				HighAvailabilityNode: ptr.To[int32](-1),
+				HighAvailabilityZone: ptr.To[int32](-1),
+				WorkerPool:           ptr.To[int32](-1),
+			}
+
+			Expect(ValidateConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.capacityAware.shootCost.purposes[production]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.capacityAware.shootCost.highAvailabilityNode"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.capacityAware.shootCost.highAvailabilityZone"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.capacityAware.shootCost.workerPool"),
+				})),
+			))
+		})
+	})
+
+	Context("scheduling preview configuration", func() {
+		It("should pass because the scheduling preview is disabled", func() {
+			conf.Schedulers.Shoot.SchedulingPreview = &schedulerconfigv1alpha1.SchedulingPreviewConfiguration{}
+
+			Expect(ValidateConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should pass because the HTTPS server of the scheduling preview is configured", func() {
+			conf.Schedulers.Shoot.SchedulingPreview = &schedulerconfigv1alpha1.SchedulingPreviewConfiguration{
+				Enabled: true,
+				Server: &schedulerconfigv1alpha1.HTTPSServer{
+					Server: schedulerconfigv1alpha1.Server{Port: 10443},
+					TLS:    schedulerconfigv1alpha1.TLSServer{ServerCertDir: "/etc/gardener-scheduler/srv"},
+				},
+			}
+
+			Expect(ValidateConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because the HTTPS server of the scheduling preview is not configured", func() {
+			conf.Schedulers.Shoot.SchedulingPreview = &schedulerconfigv1alpha1.SchedulingPreviewConfiguration{Enabled: true}
+
+			Expect(ValidateConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("schedulers.shoot.schedulingPreview.server"),
+				})),
+			))
+		})
+
+		It("should fail because the HTTPS server of the scheduling preview is invalid", func() {
+			conf.Schedulers.Shoot.SchedulingPreview = &schedulerconfigv1alpha1.SchedulingPreviewConfiguration{
+				Enabled: true,
+				Server:  &schedulerconfigv1alpha1.HTTPSServer{},
+			}
+
+			Expect(ValidateConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.schedulingPreview.server.port"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("schedulers.shoot.schedulingPreview.server.tls.serverCertDir"),
+				})),
+			))
+		})
+	})
+
+	Context("seed filter rules", func() {
+		It("should pass because the filter rules are valid", func() {
+			conf.Schedulers.Shoot.Filters = []schedulerconfigv1alpha1.SeedFilterRule{
+				{Name: "gold-tier", Expression: `project.metadata.name != "foo" || seed.metadata.?labels.tier.orValue("") == "gold"`},
+				{Name: "max-production-shoots", Expression: `usage.shootsByPurpose.?production.orValue(0) < 10`, Message: ptr.To("seed hosts too many production shoots")},
+			}
+
+			Expect(ValidateConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because of missing, duplicate and invalid fields", func() {
+			conf.Schedulers.Shoot.Filters = []schedulerconfigv1alpha1.SeedFilterRule{
+				{Name: "", Expression: "true"},
+				{Name: "foo"},
+				{Name: "foo", Expression: "seed.metadata.name +"},
+				{Name: "bar", Expression: `"not a bool"`},
+			}
+
+			Expect(ValidateConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("schedulers.shoot.filters[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("schedulers.shoot.filters[1].expression"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("schedulers.shoot.filters[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("schedulers.shoot.filters[2].expression"),
+					"Detail": ContainSubstring("failed compiling expression"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("schedulers.shoot.filters[3].expression"),
+					"Detail": ContainSubstring("expression must evaluate to bool but evaluates to string"),
+				})),
+			))
+		})
+	})
 })
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// maxMaintenanceFreezeActivations is the maximum number of activations of a recurring maintenance freeze which are
+// considered when checking whether it is active. It is not reached by freezes passing the validation (at most one
+// activation per hour and a duration of at most 30 days) and only guards against freezes which were never validated.
+const maxMaintenanceFreezeActivations = 1000
+
+// ActiveMaintenanceFreeze returns the maintenance freeze of the given list which is active at the given time together
+// with the time when it ends. If multiple freezes are active, the one ending last is returned. If no freeze is active,
+// nil is returned.
+func ActiveMaintenanceFreeze(freezes []gardencorev1beta1.MaintenanceFreeze, now time.Time) (*gardencorev1beta1.MaintenanceFreeze, time.Time, error) {
+	var (
+		active *gardencorev1beta1.MaintenanceFreeze
+		end    time.Time
+	)
+
+	for i, freeze := range freezes {
+		freezeEnd, err := maintenanceFreezeEnd(freeze, now)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("failed checking maintenance freeze %q: %w", freeze.Name, err)
+		}
+
+		if freezeEnd != nil && freezeEnd.After(end) {
+			active, end = &freezes[i], *freezeEnd
+		}
+	}
+
+	return active, end, nil
+}
+
+// maintenanceFreezeEnd returns the end of the given maintenance freeze if it is active at the given time, or nil
+// otherwise.
+func maintenanceFreezeEnd(freeze gardencorev1beta1.MaintenanceFreeze, now time.Time) (*time.Time, error) {
+	if freeze.Start != nil && freeze.End != nil {
+		if !now.Before(freeze.Start.Time) && now.Before(freeze.End.Time) {
+			return &freeze.End.Time, nil
+		}
+		return nil, nil
+	}
+
+	if freeze.Schedule == nil || freeze.Duration == nil {
+		return nil, nil
+	}
+
+	location := time.UTC
+	if freeze.Location != nil {
+		var err error
+		if location, err = time.LoadLocation(*freeze.Location); err != nil {
+			return nil, err
+		}
+	}
+
+	schedule, err := cron.ParseStandard(*freeze.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	// The freeze is active if it was started by an activation in (now-duration, now]. The latest such activation
+	// determines when the freeze ends.
+	var (
+		lastActivation time.Time
+		activations    int
+	)
+	for activation := schedule.Next(now.In(location).Add(-freeze.Duration.Duration)); !activation.IsZero() && !activation.After(now); activation = schedule.Next(activation) {
+		if activations++; activations > maxMaintenanceFreezeActivations {
+			return nil, fmt.Errorf("schedule fires more than %d times within the duration of the freeze", maxMaintenanceFreezeActivations)
+		}
+		lastActivation = activation
+	}
+
+	if lastActivation.IsZero() {
+		return nil, nil
+	}
+
+	end := lastActivation.Add(freeze.Duration.Duration)
+	return &end, nil
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("Helper", func() {
+	Describe("#ActiveMaintenanceFreeze", func() {
+		var (
+			now = time.Date(2025, 12, 24, 10, 0, 0, 0, time.UTC)
+
+			oneTime, recurring gardencorev1beta1.MaintenanceFreeze
+		)
+
+		BeforeEach(func() {
+			oneTime = gardencorev1beta1.MaintenanceFreeze{
+				Name:  "one-time",
+				Start: ptr.To(metav1.NewTime(time.Date(2025, 12, 20, 0, 0, 0, 0, time.UTC))),
+				End:   ptr.To(metav1.NewTime(time.Date(2025, 12, 27, 0, 0, 0, 0, time.UTC))),
+			}
+			recurring = gardencorev1beta1.MaintenanceFreeze{
+				Name:     "recurring",
+				Schedule: ptr.To("0 0 24 12 *"),
+				Duration: &metav1.Duration{Duration: 72 * time.Hour},
+			}
+		})
+
+		It("should return nil if no freezes are configured", func() {
+			freeze, _, err := ActiveMaintenanceFreeze(nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(BeNil())
+		})
+
+		It("should return an active one-time freeze", func() {
+			freeze, end, err := ActiveMaintenanceFreeze([]gardencorev1beta1.MaintenanceFreeze{oneTime}, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(PointTo(Equal(oneTime)))
+			Expect(end).To(Equal(oneTime.End.Time))
+		})
+
+		It("should not return a one-time freeze which has ended", func() {
+			freeze, _, err := ActiveMaintenanceFreeze([]gardencorev1beta1.MaintenanceFreeze{oneTime}, oneTime.End.Time)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(BeNil())
+		})
+
+		It("should return an active recurring freeze", func() {
+			freeze, end, err := ActiveMaintenanceFreeze([]gardencorev1beta1.MaintenanceFreeze{recurring}, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(PointTo(Equal(recurring)))
+			Expect(end).To(BeTemporally("==", time.Date(2025, 12, 27, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should not return a recurring freeze which is not active", func() {
+			freeze, _, err := ActiveMaintenanceFreeze([]gardencorev1beta1.MaintenanceFreeze{recurring}, time.Date(2025, 12, 23, 23, 0, 0, 0, time.UTC))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(BeNil())
+		})
+
+		It("should evaluate the schedule in the configured location", func() {
+			recurring.Location = ptr.To("Asia/Tokyo")
+
+			freeze, end, err := ActiveMaintenanceFreeze([]gardencorev1beta1.MaintenanceFreeze{recurring}, time.Date(2025, 12, 23, 16, 0, 0, 0, time.UTC))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(PointTo(Equal(recurring)))
+			Expect(end).To(BeTemporally("==", time.Date(2025, 12, 26, 15, 0, 0, 0, time.UTC)))
+		})
+
+		It("should return the freeze ending last if multiple freezes are active", func() {
+			daily := gardencorev1beta1.MaintenanceFreeze{Name: "daily", Schedule: ptr.To("0 8 * * *"), Duration: &metav1.Duration{Duration: 4 * time.Hour}}
+
+			freeze, end, err := ActiveMaintenanceFreeze([]gardencorev1beta1.MaintenanceFreeze{daily, oneTime}, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(PointTo(Equal(oneTime)))
+			Expect(end).To(Equal(oneTime.End.Time))
+		})
+
+		It("should return an error for invalid schedules", func() {
+			recurring.Schedule = ptr.To("invalid")
+
+			_, _, err := ActiveMaintenanceFreeze([]gardencorev1beta1.MaintenanceFreeze{recurring}, now)
+			Expect(err).To(MatchError(ContainSubstring(`failed checking maintenance freeze "recurring"`)))
+		})
+
+		It("should return an error for schedules firing too often within the duration", func() {
+			recurring.Schedule = ptr.To("* * * * *")
+			recurring.Duration = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+
+			_, _, err := ActiveMaintenanceFreeze([]gardencorev1beta1.MaintenanceFreeze{recurring}, now)
+			Expect(err).To(MatchError(ContainSubstring("schedule fires more than 1000 times within the duration of the freeze")))
+		})
+	})
+})
@@ -629,6 +629,23 @@ func ShouldPrepareShootForMigration(shoot *gardencorev1beta1.Shoot) bool {
 	return shoot.Status.SeedName != nil && shoot.Spec.SeedName != nil && *shoot.Spec.SeedName != *shoot.Status.SeedName
 }
 
+// IsShootControlPlaneMigrating returns true if the control plane of the shoot is being migrated to another seed, i.e.,
+// if the shoot was already bound to another seed, if its last operation is `Migrate` (the `Restore` operation follows
+// once it has succeeded), or if its last operation is a `Restore` which has not succeeded yet.
+func IsShootControlPlaneMigrating(shoot *gardencorev1beta1.Shoot) bool {
+	if ShouldPrepareShootForMigration(shoot) {
+		return true
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	if lastOperation == nil {
+		return false
+	}
+
+	return lastOperation.Type == gardencorev1beta1.LastOperationTypeMigrate ||
+		(lastOperation.Type == gardencorev1beta1.LastOperationTypeRestore && lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded)
+}
+
 // LastInitiationTimeForWorkerPool returns the last initiation time for the worker pool when found in the given list of
 // pending workers rollouts. If the worker pool is not found in the list, the global last initiation time is returned.
 func LastInitiationTimeForWorkerPool(name string, pendingWorkersRollout []gardencorev1beta1.PendingWorkersRollout, globalLastInitiationTime *metav1.Time) *metav1.Time {
@@ -1623,6 +1623,26 @@ var _ = Describe("Helper", func() {
 		})
 	})
 
+	DescribeTable("#IsShootControlPlaneMigrating",
+		func(specSeedName, statusSeedName *string, lastOperation *gardencorev1beta1.LastOperation, expected bool) {
+			shoot := &gardencorev1beta1.Shoot{
+				Spec:   gardencorev1beta1.ShootSpec{SeedName: specSeedName},
+				Status: gardencorev1beta1.ShootStatus{SeedName: statusSeedName, LastOperation: lastOperation},
+			}
+			Expect(IsShootControlPlaneMigrating(shoot)).To(Equal(expected))
+		},
+
+		Entry("not scheduled", nil, nil, nil, false),
+		Entry("no last operation", ptr.To("seed"), ptr.To("seed"), nil, false),
+		Entry("reconciled", ptr.To("seed"), ptr.To("seed"), &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: gardencorev1beta1.LastOperationStateProcessing}, false),
+		Entry("bound to another seed", ptr.To("other"), ptr.To("seed"), &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: gardencorev1beta1.LastOperationStateSucceeded}, true),
+		Entry("migrate processing", ptr.To("other"), ptr.To("seed"), &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeMigrate, State: gardencorev1beta1.LastOperationStateProcessing}, true),
+		Entry("migrate succeeded", ptr.To("other"), nil, &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeMigrate, State: gardencorev1beta1.LastOperationStateSucceeded}, true),
+		Entry("restore processing", ptr.To("other"), ptr.To("other"), &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore, State: gardencorev1beta1.LastOperationStateProcessing}, true),
+		Entry("restore failed", ptr.To("other"), ptr.To("other"), &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore, State: gardencorev1beta1.LastOperationStateFailed}, true),
+		Entry("restore succeeded", ptr.To("other"), ptr.To("other"), &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore, State: gardencorev1beta1.LastOperationStateSucceeded}, false),
+	)
+
 	Describe("#LastInitiationTimeForWorkerPool", func() {
 		var (
 			poolName                 = "pool"
@@ -57,6 +57,7 @@ var (
 			MinSize: &negativeQuantity,
 		},
 		Architecture: ptr.To("amd64"),
+		HourlyPrice:  &negativeQuantity,
 	}
 	invalidMachineType2 = core.MachineType{
 		Name:   "negative-storage-size",
@@ -1655,6 +1656,9 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 					})), PointTo(MatchFields(IgnoreExtras, Fields{
 						"Type":  Equal(field.ErrorTypeInvalid),
 						"Field": Equal("spec.machineTypes[0].storage.minSize"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.machineTypes[0].hourlyPrice"),
 					})), PointTo(MatchFields(IgnoreExtras, Fields{
 						"Type":  Equal(field.ErrorTypeInvalid),
 						"Field": Equal("spec.machineTypes[1].storage.size"),
@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
+	"github.com/robfig/cron"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -154,6 +156,7 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, opts projectValidationOp
 	}
 
 	allErrs = append(allErrs, validateDualApprovalForDeletion(projectSpec.DualApprovalForDeletion, fldPath.Child("dualApprovalForDeletion"))...)
+	allErrs = append(allErrs, ValidateMaintenanceFreezes(projectSpec.MaintenanceFreezes, fldPath.Child("maintenanceFreezes"))...)
 
 	return allErrs
 }
@@ -357,6 +360,106 @@ func validateDualApprovalForDeletion(dualApproval []core.DualApprovalForDeletion
 	return allErrs
 }
 
+const (
+	// maxMaintenanceFreezeDuration is the maximum duration of a maintenance freeze. Longer freezes would hold back
+	// maintenance operations, including security updates, for too long.
+	maxMaintenanceFreezeDuration = 30 * 24 * time.Hour
+	// minMaintenanceFreezeScheduleInterval is the minimum interval between two activations of a recurring maintenance
+	// freeze.
+	minMaintenanceFreezeScheduleInterval = time.Hour
+)
+
+// ValidateMaintenanceFreezes validates the given maintenance freezes.
+func ValidateMaintenanceFreezes(freezes []core.MaintenanceFreeze, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		names   = sets.New[string]()
+	)
+
+	for i, freeze := range freezes {
+		idxPath := fldPath.Index(i)
+
+		if len(freeze.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "cannot be empty"))
+		} else {
+			if names.Has(freeze.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), freeze.Name))
+			}
+			names.Insert(freeze.Name)
+		}
+
+		switch {
+		case freeze.Start != nil && freeze.Schedule != nil:
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("schedule"), "must not be set together with start"))
+		case freeze.Start == nil && freeze.Schedule == nil:
+			allErrs = append(allErrs, field.Required(idxPath, "either start and end or schedule and duration must be set"))
+		}
+
+		if freeze.Start != nil {
+			if freeze.End == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("end"), "must be set if start is set"))
+			} else if !freeze.End.After(freeze.Start.Time) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), freeze.End, "must be after start"))
+			} else if freeze.End.Sub(freeze.Start.Time) > maxMaintenanceFreezeDuration {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), freeze.End, fmt.Sprintf("must not be more than %s after start", maxMaintenanceFreezeDuration)))
+			}
+		} else if freeze.End != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("end"), "must only be set if start is set"))
+		}
+
+		if freeze.Schedule != nil {
+			if schedule, err := cron.ParseStandard(*freeze.Schedule); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("schedule"), *freeze.Schedule, fmt.Sprintf("not a valid cron spec: %v", err)))
+			} else if scheduleFiresTooFrequently(schedule) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("schedule"), *freeze.Schedule, fmt.Sprintf("must not fire more often than every %s", minMaintenanceFreezeScheduleInterval)))
+			}
+			if freeze.Duration == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("duration"), "must be set if schedule is set"))
+			} else if freeze.Duration.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("duration"), freeze.Duration.Duration.String(), "must be positive"))
+			} else if freeze.Duration.Duration > maxMaintenanceFreezeDuration {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("duration"), freeze.Duration.Duration.String(), fmt.Sprintf("must not be longer than %s", maxMaintenanceFreezeDuration)))
+			}
+		} else {
+			if freeze.Duration != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("duration"), "must only be set if schedule is set"))
+			}
+			if freeze.Location != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("location"), "must only be set if schedule is set"))
+			}
+		}
+
+		if freeze.Location != nil {
+			if _, err := time.LoadLocation(*freeze.Location); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("location"), *freeze.Location, fmt.Sprintf("not a valid location: %v", err)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// scheduleFiresTooFrequently returns true if two consecutive activations of the given schedule are closer than
+// minMaintenanceFreezeScheduleInterval. A schedule firing multiple times per hour does so in every hour it fires in,
+// hence it is sufficient to check the first activations after the beginning of an hour.
+func scheduleFiresTooFrequently(schedule cron.Schedule) bool {
+	previous := schedule.Next(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	for range 24 {
+		if previous.IsZero() {
+			return false
+		}
+		next := schedule.Next(previous)
+		if next.IsZero() {
+			return false
+		}
+		if next.Sub(previous) < minMaintenanceFreezeScheduleInterval {
+			return true
+		}
+		previous = next
+	}
+	return false
+}
+
 // ValidateProjectStatusUpdate validates the status field of a Project object.
 func ValidateProjectStatusUpdate(newProject, oldProject *core.Project) field.ErrorList {
 	allErrs := field.ErrorList{}
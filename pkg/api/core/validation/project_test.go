@@ -7,6 +7,7 @@ package validation_test
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -625,6 +626,141 @@ var _ = Describe("Project Validation Tests", func() {
 			})
 		})
 
+		Context("maintenance freezes", func() {
+			var (
+				start = metav1.Date(2025, 12, 20, 0, 0, 0, 0, time.UTC)
+				end   = metav1.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)
+			)
+
+			It("should allow valid one-time and recurring freezes", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{
+					{Name: "holidays", Start: &start, End: &end},
+					{Name: "month-end", Schedule: ptr.To("0 0 28 * *"), Duration: &metav1.Duration{Duration: 96 * time.Hour}, Location: ptr.To("Europe/Berlin")},
+				}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid empty and duplicate names", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{
+					{Start: &start, End: &end},
+					{Name: "foo", Start: &start, End: &end},
+					{Name: "foo", Start: &start, End: &end},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.maintenanceFreezes[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.maintenanceFreezes[2].name"),
+					})),
+				))
+			})
+
+			It("should require either a period or a schedule", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{
+					{Name: "foo"},
+					{Name: "bar", Start: &start, End: &end, Schedule: ptr.To("0 0 * * *"), Duration: &metav1.Duration{Duration: time.Hour}},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.maintenanceFreezes[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.maintenanceFreezes[1].schedule"),
+					})),
+				))
+			})
+
+			It("should forbid invalid one-time freezes", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{
+					{Name: "foo", Start: &start},
+					{Name: "bar", Start: &end, End: &start},
+					{Name: "baz", Start: &start, End: &end, Duration: &metav1.Duration{Duration: time.Hour}, Location: ptr.To("UTC")},
+					{Name: "qux", Start: &start, End: ptr.To(metav1.NewTime(start.Add(31 * 24 * time.Hour)))},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.maintenanceFreezes[0].end"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenanceFreezes[1].end"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.maintenanceFreezes[2].duration"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.maintenanceFreezes[2].location"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenanceFreezes[3].end"),
+					})),
+				))
+			})
+
+			It("should forbid invalid recurring freezes", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{
+					{Name: "foo", Schedule: ptr.To("invalid"), Duration: &metav1.Duration{Duration: time.Hour}},
+					{Name: "bar", Schedule: ptr.To("0 0 * * *")},
+					{Name: "baz", Schedule: ptr.To("0 0 * * *"), Duration: &metav1.Duration{Duration: -time.Hour}, Location: ptr.To("Foo/Bar")},
+					{Name: "qux", Schedule: ptr.To("0 0 * * *"), Duration: &metav1.Duration{Duration: time.Hour}, End: &end},
+					{Name: "quux", Schedule: ptr.To("*/30 8 * * *"), Duration: &metav1.Duration{Duration: time.Hour}},
+					{Name: "corge", Schedule: ptr.To("0 0 1 * *"), Duration: &metav1.Duration{Duration: 31 * 24 * time.Hour}},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenanceFreezes[0].schedule"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.maintenanceFreezes[1].duration"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenanceFreezes[2].duration"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenanceFreezes[2].location"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.maintenanceFreezes[3].end"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenanceFreezes[4].schedule"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenanceFreezes[5].duration"),
+					})),
+				))
+			})
+
+			It("should allow recurring freezes firing once per hour", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{
+					{Name: "foo", Schedule: ptr.To("0 * * * *"), Duration: &metav1.Duration{Duration: 30 * time.Minute}},
+				}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+		})
+
 		DescribeTable("namespace immutability",
 			func(old, new *string, matcher gomegatypes.GomegaMatcher) {
 				project.Spec.Namespace = old
@@ -47,9 +47,33 @@ func ValidateQuotaSpec(quotaSpec *core.QuotaSpec, fldPath *field.Path) field.Err
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("scope"), scopeRef.GroupVersionKind().String(), []string{helper.ProjectGVK.String(), helper.SecretGVK.String(), helper.WorkloadIdentityGVK.String()}))
 	}
 
-	metricsFldPath := fldPath.Child("metrics")
-	for k, v := range quotaSpec.Metrics {
-		keyPath := metricsFldPath.Key(string(k))
+	allErrs = append(allErrs, validateQuotaMetrics(quotaSpec.Metrics, fldPath.Child("metrics"))...)
+
+	softMetricsFldPath := fldPath.Child("softMetrics")
+	allErrs = append(allErrs, validateQuotaMetrics(quotaSpec.SoftMetrics, softMetricsFldPath)...)
+	for k, v := range quotaSpec.SoftMetrics {
+		if limit, ok := quotaSpec.Metrics[k]; ok && v.Cmp(limit) > 0 {
+			allErrs = append(allErrs, field.Invalid(softMetricsFldPath.Key(string(k)), v.String(), fmt.Sprintf("must not be greater than the hard limit %s", limit.String())))
+		}
+	}
+
+	if quotaSpec.SoftLimitGracePeriod != nil {
+		gracePeriodFldPath := fldPath.Child("softLimitGracePeriod")
+		if len(quotaSpec.SoftMetrics) == 0 {
+			allErrs = append(allErrs, field.Forbidden(gracePeriodFldPath, "must not be set if no soft metrics are configured"))
+		} else if quotaSpec.SoftLimitGracePeriod.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(gracePeriodFldPath, quotaSpec.SoftLimitGracePeriod.Duration.String(), "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateQuotaMetrics(metrics corev1.ResourceList, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for k, v := range metrics {
+		keyPath := fldPath.Key(string(k))
 		if !isValidQuotaMetric(k) {
 			allErrs = append(allErrs, field.Invalid(keyPath, v.String(), fmt.Sprintf("%s is no supported quota metric", string(k))))
 		}
@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -142,6 +144,67 @@ var _ = Describe("Quota Validation Tests ", func() {
 			Entry("should forbid zero cluster lifetime days", ptr.To[int32](0)),
 		)
 
+		Context("soft limits", func() {
+			BeforeEach(func() {
+				quota.Spec.SoftMetrics = corev1.ResourceList{
+					"cpu":    resource.MustParse("150"),
+					"memory": resource.MustParse("4000Gi"),
+				}
+				quota.Spec.SoftLimitGracePeriod = &metav1.Duration{Duration: 72 * time.Hour}
+			})
+
+			It("should allow valid soft limits", func() {
+				Expect(ValidateQuota(quota)).To(BeEmpty())
+			})
+
+			It("should allow soft limits for metrics without hard limit", func() {
+				quota.Spec.SoftMetrics["gpu"] = resource.MustParse("4")
+
+				Expect(ValidateQuota(quota)).To(BeEmpty())
+			})
+
+			It("should forbid invalid soft limits", func() {
+				quota.Spec.SoftMetrics["key"] = resource.MustParse("-100")
+				quota.Spec.SoftMetrics["cpu"] = resource.MustParse("201")
+
+				Expect(ValidateQuota(quota)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.softMetrics[key]"),
+						"Detail": ContainSubstring("is no supported quota metric"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.softMetrics[key]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.softMetrics[cpu]"),
+						"Detail": Equal("must not be greater than the hard limit 200"),
+					})),
+				))
+			})
+
+			It("should forbid a non-positive grace period", func() {
+				quota.Spec.SoftLimitGracePeriod = &metav1.Duration{}
+
+				Expect(ValidateQuota(quota)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.softLimitGracePeriod"),
+					"Detail": Equal("must be greater than 0"),
+				}))))
+			})
+
+			It("should forbid a grace period without soft limits", func() {
+				quota.Spec.SoftMetrics = nil
+
+				Expect(ValidateQuota(quota)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.softLimitGracePeriod"),
+				}))))
+			})
+		})
+
 		It("should allow quota scope referencing WorkloadIdentity", func() {
 			quota.Spec.Scope = corev1.ObjectReference{
 				Kind:       "WorkloadIdentity",
@@ -68,6 +68,7 @@ var (
 		v1beta1constants.ShootOperationMaintain,
 		v1beta1constants.ShootOperationRetry,
 		v1beta1constants.ShootOperationForceInPlaceUpdate,
+		v1beta1constants.ShootOperationWakeUp,
 	).Union(availableShootMaintenanceOperations)
 	availableShootMaintenanceOperations = sets.New(
 		v1beta1constants.GardenerOperationReconcile,
@@ -157,6 +158,15 @@ var (
 		v1beta1constants.ReferenceProtectionFinalizerName,
 	)
 	availableUpdateStrategies = sets.New(core.AutoRollingUpdate, core.AutoInPlaceUpdate, core.ManualInPlaceUpdate)
+	availableWeekdays         = sets.New(
+		time.Monday.String(),
+		time.Tuesday.String(),
+		time.Wednesday.String(),
+		time.Thursday.String(),
+		time.Friday.String(),
+		time.Saturday.String(),
+		time.Sunday.String(),
+	)
 
 	// asymmetric algorithms from https://datatracker.ietf.org/doc/html/rfc7518#section-3.1
 	availableOIDCSigningAlgs = sets.New(
@@ -2154,20 +2164,52 @@ func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, wor
 	}
 
 	if maintenance.TimeWindow != nil {
-		maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End)
-		if err != nil {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow", "begin/end"), maintenance.TimeWindow, err.Error()))
-		} else {
-			duration := maintenanceTimeWindow.Duration()
-			if duration > core.MaintenanceTimeWindowDurationMaximum {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow"), duration, fmt.Sprintf("time window must not be greater than %s", core.MaintenanceTimeWindowDurationMaximum)))
-				return allErrs
-			}
-			if duration < core.MaintenanceTimeWindowDurationMinimum {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow"), duration, fmt.Sprintf("time window must not be smaller than %s", core.MaintenanceTimeWindowDurationMinimum)))
-				return allErrs
-			}
+		allErrs = append(allErrs, validateMaintenanceTimeWindow(maintenance.TimeWindow, fldPath.Child("timeWindow"))...)
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceTimeWindow(timeWindow *core.MaintenanceTimeWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(timeWindow.Begin, timeWindow.End)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("begin/end"), timeWindow, err.Error()))
+		return allErrs
+	}
+
+	duration := maintenanceTimeWindow.Duration()
+	if duration > core.MaintenanceTimeWindowDurationMaximum {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration, fmt.Sprintf("time window must not be greater than %s", core.MaintenanceTimeWindowDurationMaximum)))
+	} else if duration < core.MaintenanceTimeWindowDurationMinimum {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration, fmt.Sprintf("time window must not be smaller than %s", core.MaintenanceTimeWindowDurationMinimum)))
+	}
+
+	return allErrs
+}
+
+func validateWorkerMaintenance(maintenance *core.WorkerMaintenance, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if maintenance.TimeWindow == nil && len(maintenance.Weekdays) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "either timeWindow or weekdays must be specified"))
+	}
+
+	if maintenance.TimeWindow != nil {
+		allErrs = append(allErrs, validateMaintenanceTimeWindow(maintenance.TimeWindow, fldPath.Child("timeWindow"))...)
+	}
+
+	weekdays := sets.New[string]()
+	for i, weekday := range maintenance.Weekdays {
+		idxPath := fldPath.Child("weekdays").Index(i)
+
+		if !availableWeekdays.Has(weekday) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, weekday, sets.List(availableWeekdays)))
+		} else if weekdays.Has(weekday) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, weekday))
 		}
+		weekdays.Insert(weekday)
 	}
 
 	return allErrs
@@ -2395,6 +2437,10 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, shootNamespa
 		allErrs = append(allErrs, ValidateSysctls(worker.Sysctls, fldPath.Child("sysctls"))...)
 	}
 
+	if worker.Maintenance != nil {
+		allErrs = append(allErrs, validateWorkerMaintenance(worker.Maintenance, fldPath.Child("maintenance"))...)
+	}
+
 	return allErrs
 }
 
@@ -2897,6 +2943,25 @@ func ValidateHibernation(annotations map[string]string, hibernation *core.Hibern
 
 	allErrs = append(allErrs, ValidateHibernationSchedules(hibernation.Schedules, fldPath.Child("schedules"))...)
 
+	if hibernation.Inactivity != nil {
+		allErrs = append(allErrs, ValidateHibernationInactivity(hibernation.Inactivity, fldPath.Child("inactivity"))...)
+	}
+
+	return allErrs
+}
+
+// minimumHibernationInactivityTimeout is the minimum duration without user activity after which a Shoot may be
+// hibernated.
+const minimumHibernationInactivityTimeout = time.Hour
+
+// ValidateHibernationInactivity validates a HibernationInactivity object.
+func ValidateHibernationInactivity(inactivity *core.HibernationInactivity, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if inactivity.Timeout.Duration < minimumHibernationInactivityTimeout {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), inactivity.Timeout.Duration.String(), fmt.Sprintf("must be at least %s", minimumHibernationInactivityTimeout)))
+	}
+
 	return allErrs
 }
 
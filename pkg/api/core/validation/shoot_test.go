@@ -7400,6 +7400,7 @@ var _ = Describe("Shoot Validation Tests", func() {
 
 					Entry("retry with other operation", "retry;rotate-ssh-keypair", "retry"),
 					Entry("maintain with other operation", "rotate-ssh-keypair; maintain", "maintain"),
+					Entry("wake-up with other operation", "rotate-ssh-keypair;wake-up", "wake-up"),
 				)
 
 				It("should return an error on first not allowed to be run in parallel operation", func() {
@@ -9135,6 +9136,71 @@ var _ = Describe("Shoot Validation Tests", func() {
 			}))))
 		})
 
+		Context("maintenance", func() {
+			var worker core.Worker
+
+			BeforeEach(func() {
+				worker = core.Worker{
+					Name: "worker",
+					Machine: core.Machine{
+						Type: "xlarge",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+					},
+					MaxUnavailable: ptr.To(intstr.FromInt(1)),
+				}
+			})
+
+			It("should allow a valid maintenance configuration", func() {
+				worker.Maintenance = &core.WorkerMaintenance{
+					TimeWindow: &core.MaintenanceTimeWindow{Begin: "020000+0000", End: "040000+0000"},
+					Weekdays:   []string{"Saturday", "Sunday"},
+				}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, shootNamespace, providerType, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid an empty maintenance configuration", func() {
+				worker.Maintenance = &core.WorkerMaintenance{}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, shootNamespace, providerType, nil, false)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("maintenance"),
+				}))))
+			})
+
+			It("should forbid invalid time windows", func() {
+				worker.Maintenance = &core.WorkerMaintenance{
+					TimeWindow: &core.MaintenanceTimeWindow{Begin: "020000+0000", End: "021000+0000"},
+				}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, shootNamespace, providerType, nil, false)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("maintenance.timeWindow"),
+					"Detail": Equal("time window must not be smaller than 30m0s"),
+				}))))
+			})
+
+			It("should forbid unknown and duplicate weekdays", func() {
+				worker.Maintenance = &core.WorkerMaintenance{
+					Weekdays: []string{"Sunday", "sunday", "Sunday"},
+				}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, shootNamespace, providerType, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("maintenance.weekdays[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("maintenance.weekdays[2]"),
+					})),
+				))
+			})
+		})
+
 		DescribeTable("sysctl setting validation", func(sysctls map[string]string, matcher gomegatypes.GomegaMatcher) {
 			errList := ValidateSysctls(sysctls, field.NewPath("sysctls"))
 			Expect(errList).To(matcher)
@@ -10078,6 +10144,25 @@ var _ = Describe("Shoot Validation Tests", func() {
 		)
 	})
 
+	Describe("#ValidateHibernationInactivity", func() {
+		DescribeTable("validate inactivity",
+			func(timeout time.Duration, matcher gomegatypes.GomegaMatcher) {
+				Expect(ValidateHibernationInactivity(&core.HibernationInactivity{Timeout: metav1.Duration{Duration: timeout}}, field.NewPath("inactivity"))).To(matcher)
+			},
+
+			Entry("valid timeout", 8*time.Hour, BeEmpty()),
+			Entry("minimum timeout", time.Hour, BeEmpty()),
+			Entry("too short timeout", 30*time.Minute, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("inactivity.timeout"),
+			})))),
+			Entry("negative timeout", -time.Hour, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("inactivity.timeout"),
+			})))),
+		)
+	})
+
 	Describe("#ValidateFinalizersOnCreation", func() {
 		It("should return error if the finalizers contain forbidden finalizers", func() {
 			finalizers := []string{
@@ -466,6 +466,10 @@ func ValidateMachineType(machineType core.MachineType, names sets.Set[string], c
 	if machineType.Storage != nil {
 		allErrs = append(allErrs, validateMachineTypeStorage(*machineType.Storage, idxPath.Child("storage"))...)
 	}
+
+	if machineType.HourlyPrice != nil {
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("hourlyPrice", *machineType.HourlyPrice, idxPath.Child("hourlyPrice"))...)
+	}
 	return allErrs
 }
 
@@ -12,6 +12,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/config"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 )
 
 // SetDefaults_ControllerManagerConfiguration sets defaults for the configuration of the Gardener controller manager.
@@ -104,6 +105,11 @@ func SetDefaults_ProjectControllerConfiguration(obj *ProjectControllerConfigurat
 			Duration: 12 * time.Hour,
 		}
 	}
+	if obj.SoftQuotaSyncPeriod == nil {
+		obj.SoftQuotaSyncPeriod = &metav1.Duration{
+			Duration: 5 * time.Minute,
+		}
+	}
 
 	for i, quota := range obj.Quotas {
 		if quota.ProjectSelector == nil {
@@ -112,6 +118,13 @@ func SetDefaults_ProjectControllerConfiguration(obj *ProjectControllerConfigurat
 	}
 }
 
+// SetDefaults_ProjectUsageConfiguration sets defaults for the ProjectUsageConfiguration.
+func SetDefaults_ProjectUsageConfiguration(obj *ProjectUsageConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
 // SetDefaults_ServerConfiguration sets defaults for the ServerConfiguration.
 func SetDefaults_ServerConfiguration(obj *ServerConfiguration) {
 	if obj.HealthProbes == nil {
@@ -229,6 +242,41 @@ func SetDefaults_SeedReferenceControllerConfiguration(obj *SeedReferenceControll
 	}
 }
 
+// SetDefaults_SeedDrainControllerConfiguration sets defaults for the SeedDrainControllerConfiguration.
+func SetDefaults_SeedDrainControllerConfiguration(obj *SeedDrainControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+	if obj.MaxConcurrentMigrations == nil {
+		obj.MaxConcurrentMigrations = ptr.To[int32](3)
+	}
+	if obj.RespectMaintenanceTimeWindow == nil {
+		obj.RespectMaintenanceTimeWindow = ptr.To(false)
+	}
+}
+
+// SetDefaults_SeedRebalancerControllerConfiguration sets defaults for the SeedRebalancerControllerConfiguration.
+func SetDefaults_SeedRebalancerControllerConfiguration(obj *SeedRebalancerControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.DryRun == nil {
+		obj.DryRun = ptr.To(true)
+	}
+	if obj.MaxShootDifference == nil {
+		obj.MaxShootDifference = ptr.To[int32](10)
+	}
+	if obj.MaxConcurrentMigrations == nil {
+		obj.MaxConcurrentMigrations = ptr.To[int32](1)
+	}
+	if obj.RespectMaintenanceTimeWindow == nil {
+		obj.RespectMaintenanceTimeWindow = ptr.To(true)
+	}
+}
+
 // SetDefaults_ShootHibernationControllerConfiguration sets defaults for the ShootHibernationControllerConfiguration.
 func SetDefaults_ShootHibernationControllerConfiguration(obj *ShootHibernationControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -249,6 +297,19 @@ func SetDefaults_ShootMaintenanceControllerConfiguration(obj *ShootMaintenanceCo
 	}
 }
 
+// SetDefaults_StagedRolloutConfiguration sets defaults for the StagedRolloutConfiguration.
+func SetDefaults_StagedRolloutConfiguration(obj *StagedRolloutConfiguration) {
+	if obj.SoakDuration == nil {
+		obj.SoakDuration = &metav1.Duration{Duration: 24 * time.Hour}
+	}
+	if obj.MaxUnhealthyPercentage == nil {
+		obj.MaxUnhealthyPercentage = ptr.To[int32](10)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
 // SetDefaults_ShootQuotaControllerConfiguration sets defaults for the ShootQuotaControllerConfiguration.
 func SetDefaults_ShootQuotaControllerConfiguration(obj *ShootQuotaControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -259,6 +320,12 @@ func SetDefaults_ShootQuotaControllerConfiguration(obj *ShootQuotaControllerConf
 			Duration: 60 * time.Minute,
 		}
 	}
+	if obj.ExpirationWarningThresholds == nil {
+		obj.ExpirationWarningThresholds = []metav1.Duration{
+			{Duration: 7 * 24 * time.Hour},
+			{Duration: 24 * time.Hour},
+		}
+	}
 }
 
 // SetDefaults_ShootReferenceControllerConfiguration sets defaults for the ShootReferenceControllerConfiguration.
@@ -363,6 +430,22 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.SeedReference == nil {
 		obj.SeedReference = &SeedReferenceControllerConfiguration{}
 	}
+	if obj.SeedDrain == nil {
+		obj.SeedDrain = &SeedDrainControllerConfiguration{}
+	}
+	if obj.ShootScheduler == nil {
+		obj.ShootScheduler = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{}
+	}
+	if len(obj.ShootScheduler.Strategy) == 0 {
+		obj.ShootScheduler.Strategy = schedulerconfigv1alpha1.Default
+	}
+	if obj.ShootScheduler.Strategy == schedulerconfigv1alpha1.CapacityAware && obj.ShootScheduler.CapacityAware == nil {
+		obj.ShootScheduler.CapacityAware = &schedulerconfigv1alpha1.CapacityAwareConfiguration{}
+	}
+	if obj.ShootScheduler.CapacityAware != nil {
+		schedulerconfigv1alpha1.SetDefaults_CapacityAwareConfiguration(obj.ShootScheduler.CapacityAware)
+		schedulerconfigv1alpha1.SetDefaults_ShootCostConfiguration(obj.ShootScheduler.CapacityAware.ShootCost)
+	}
 	if obj.ShootQuota == nil {
 		obj.ShootQuota = &ShootQuotaControllerConfiguration{}
 	}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/apis/config"
 	. "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 )
 
 var _ = Describe("Defaults", func() {
@@ -183,6 +184,9 @@ var _ = Describe("Defaults", func() {
 				StaleSyncPeriod: &metav1.Duration{
 					Duration: 12 * time.Hour,
 				},
+				SoftQuotaSyncPeriod: &metav1.Duration{
+					Duration: 5 * time.Minute,
+				},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -213,6 +217,15 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.Project.Quotas).To(Equal(expected.Quotas))
 		})
 
+		It("should default the usage configuration", func() {
+			obj.Controllers.Project = &ProjectControllerConfiguration{Usage: &ProjectUsageConfiguration{}}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Project.Usage).To(Equal(&ProjectUsageConfiguration{
+				SyncPeriod: &metav1.Duration{Duration: 10 * time.Minute},
+			}))
+		})
+
 		It("should not default fields that are set", func() {
 			obj = &ControllerManagerConfiguration{
 				Controllers: ControllerManagerControllerConfiguration{
@@ -224,6 +237,9 @@ var _ = Describe("Defaults", func() {
 						StaleSyncPeriod: &metav1.Duration{
 							Duration: 12 * time.Hour,
 						},
+						SoftQuotaSyncPeriod: &metav1.Duration{
+							Duration: time.Minute,
+						},
 					},
 				},
 			}
@@ -575,6 +591,114 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("SeedDrainControllerConfiguration defaulting", func() {
+		It("should default SeedDrainControllerConfiguration correctly", func() {
+			expected := &SeedDrainControllerConfiguration{
+				ConcurrentSyncs:              ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:                   &metav1.Duration{Duration: time.Minute},
+				MaxConcurrentMigrations:      ptr.To[int32](3),
+				RespectMaintenanceTimeWindow: ptr.To(false),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedDrain).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedDrain: &SeedDrainControllerConfiguration{
+						ConcurrentSyncs:              ptr.To(10),
+						SyncPeriod:                   &metav1.Duration{Duration: 5 * time.Minute},
+						MaxConcurrentMigrations:      ptr.To[int32](1),
+						RespectMaintenanceTimeWindow: ptr.To(true),
+					},
+				},
+			}
+			expected := obj.Controllers.SeedDrain.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedDrain).To(Equal(expected))
+		})
+	})
+
+	Describe("ShootScheduler defaulting", func() {
+		It("should default the shoot scheduler configuration correctly", func() {
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootScheduler).To(Equal(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Strategy: schedulerconfigv1alpha1.SameRegion,
+			}))
+		})
+
+		It("should default the configuration of the CapacityAware strategy", func() {
+			obj.Controllers.ShootScheduler = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Strategy: schedulerconfigv1alpha1.CapacityAware,
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootScheduler.CapacityAware).To(Equal(&schedulerconfigv1alpha1.CapacityAwareConfiguration{
+				Scorers: []schedulerconfigv1alpha1.SeedScorer{{Name: schedulerconfigv1alpha1.SeedScorerLeastAllocated, Weight: ptr.To[int32](1)}},
+				ShootCost: &schedulerconfigv1alpha1.ShootCostConfiguration{
+					HighAvailabilityNode: ptr.To[int32](50),
+					HighAvailabilityZone: ptr.To[int32](100),
+					WorkerPool:           ptr.To[int32](5),
+				},
+			}))
+		})
+
+		It("should not default fields that are set", func() {
+			obj.Controllers.ShootScheduler = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Strategy: schedulerconfigv1alpha1.MinimalDistance,
+				Filters:  []schedulerconfigv1alpha1.SeedFilterRule{{Name: "foo", Expression: "true"}},
+			}
+			expected := obj.Controllers.ShootScheduler.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootScheduler).To(Equal(expected))
+		})
+	})
+
+	Describe("SeedRebalancerControllerConfiguration defaulting", func() {
+		It("should not default SeedRebalancerControllerConfiguration", func() {
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedRebalancer).To(BeNil())
+		})
+
+		It("should default SeedRebalancerControllerConfiguration correctly if it is set", func() {
+			obj.Controllers.SeedRebalancer = &SeedRebalancerControllerConfiguration{}
+			expected := &SeedRebalancerControllerConfiguration{
+				SyncPeriod:                   &metav1.Duration{Duration: time.Hour},
+				DryRun:                       ptr.To(true),
+				MaxShootDifference:           ptr.To[int32](10),
+				MaxConcurrentMigrations:      ptr.To[int32](1),
+				RespectMaintenanceTimeWindow: ptr.To(true),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedRebalancer).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedRebalancer: &SeedRebalancerControllerConfiguration{
+						SyncPeriod:                   &metav1.Duration{Duration: 5 * time.Minute},
+						DryRun:                       ptr.To(false),
+						MaxShootDifference:           ptr.To[int32](2),
+						MaxConcurrentMigrations:      ptr.To[int32](5),
+						RespectMaintenanceTimeWindow: ptr.To(false),
+					},
+				},
+			}
+			expected := obj.Controllers.SeedRebalancer.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedRebalancer).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootHibernationControllerConfiguration defaulting", func() {
 		It("should default ShootHibernationControllerConfiguration correctly", func() {
 			expected := &ShootHibernationControllerConfiguration{
@@ -627,6 +751,29 @@ var _ = Describe("Defaults", func() {
 
 			Expect(&obj.Controllers.ShootMaintenance).To(Equal(expected))
 		})
+
+		It("should default the staged rollout configuration", func() {
+			obj.Controllers.ShootMaintenance.StagedRollout = &StagedRolloutConfiguration{}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootMaintenance.StagedRollout).To(Equal(&StagedRolloutConfiguration{
+				SoakDuration:           &metav1.Duration{Duration: 24 * time.Hour},
+				MaxUnhealthyPercentage: ptr.To[int32](10),
+				SyncPeriod:             &metav1.Duration{Duration: 10 * time.Minute},
+			}))
+		})
+
+		It("should not default staged rollout fields that are set", func() {
+			obj.Controllers.ShootMaintenance.StagedRollout = &StagedRolloutConfiguration{
+				SoakDuration:           &metav1.Duration{Duration: time.Hour},
+				MaxUnhealthyPercentage: ptr.To[int32](0),
+				SyncPeriod:             &metav1.Duration{Duration: time.Minute},
+			}
+			expected := obj.Controllers.ShootMaintenance.StagedRollout.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootMaintenance.StagedRollout).To(Equal(expected))
+		})
 	})
 
 	Describe("ShootQuotaControllerConfiguration defaulting", func() {
@@ -636,6 +783,10 @@ var _ = Describe("Defaults", func() {
 				SyncPeriod: &metav1.Duration{
 					Duration: 60 * time.Minute,
 				},
+				ExpirationWarningThresholds: []metav1.Duration{
+					{Duration: 168 * time.Hour},
+					{Duration: 24 * time.Hour},
+				},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -650,6 +801,7 @@ var _ = Describe("Defaults", func() {
 						SyncPeriod: &metav1.Duration{
 							Duration: 120 * time.Minute,
 						},
+						ExpirationWarningThresholds: []metav1.Duration{},
 					},
 				},
 			}
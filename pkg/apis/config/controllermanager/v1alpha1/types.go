@@ -8,6 +8,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -33,6 +36,10 @@ type ControllerManagerConfiguration struct {
 	// Debugging holds configuration for Debugging related features.
 	// +optional
 	Debugging *componentbaseconfigv1alpha1.DebuggingConfiguration `json:"debugging,omitempty"`
+	// Tracing contains configuration for exporting OpenTelemetry traces of the reconciliations. Tracing is disabled if
+	// it is not set.
+	// +optional
+	Tracing *TracingConfiguration `json:"tracing,omitempty"`
 	// FeatureGates is a map of feature names to bools that enable or disable alpha/experimental
 	// features. This field modifies piecemeal the built-in default values from
 	// "github.com/gardener/gardener/pkg/controllermanager/features/features.go".
@@ -91,6 +98,19 @@ type ControllerManagerControllerConfiguration struct {
 	// SeedReference defines the configuration of the SeedReference controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	SeedReference *SeedReferenceControllerConfiguration `json:"seedReference,omitempty"`
+	// SeedDrain defines the configuration of the SeedDrain controller.
+	// +optional
+	SeedDrain *SeedDrainControllerConfiguration `json:"seedDrain,omitempty"`
+	// SeedRebalancer defines the configuration of the SeedRebalancer controller. If unset, the seed rebalancer will be
+	// disabled.
+	// +optional
+	SeedRebalancer *SeedRebalancerControllerConfiguration `json:"seedRebalancer,omitempty"`
+	// ShootScheduler is the configuration of the shoot scheduler which determines the destination seeds of shoots whose
+	// control planes are migrated by the SeedDrain and SeedRebalancer controllers. It should be the same as the shoot
+	// scheduler configuration of gardener-scheduler. If unspecified, it is defaulted with
+	// `candidateDeterminationStrategy=SameRegion`.
+	// +optional
+	ShootScheduler *schedulerconfigv1alpha1.ShootSchedulerConfiguration `json:"shootScheduler,omitempty"`
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration `json:"shootMaintenance"`
 	// ShootQuota defines the configuration of the ShootQuota controller.
@@ -224,6 +244,21 @@ type ProjectControllerConfiguration struct {
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	// +optional
 	StaleSyncPeriod *metav1.Duration `json:"staleSyncPeriod,omitempty"`
+	// SoftQuotaSyncPeriod is the duration how often it is checked whether Projects exceed the soft limits of the
+	// Quotas they use.
+	// +optional
+	SoftQuotaSyncPeriod *metav1.Duration `json:"softQuotaSyncPeriod,omitempty"`
+	// Usage configures the accounting of the resources consumed by the shoots of the projects. If it is not set, the
+	// usage of the projects is not accounted.
+	// +optional
+	Usage *ProjectUsageConfiguration `json:"usage,omitempty"`
+}
+
+// ProjectUsageConfiguration defines the configuration of the accounting of the resources consumed by projects.
+type ProjectUsageConfiguration struct {
+	// SyncPeriod is the duration how often the usage of the projects is accounted.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
 // QuotaConfiguration defines quota configurations.
@@ -283,6 +318,26 @@ type SeedControllerConfiguration struct {
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
+// SeedDrainControllerConfiguration defines the configuration of the SeedDrain controller.
+type SeedDrainControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often draining seeds are reconciled, i.e., how often the controller checks
+	// whether further shoots can be migrated away from the seed.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// MaxConcurrentMigrations is the maximum number of shoots which are migrated away from a draining seed at the
+	// same time.
+	// +optional
+	MaxConcurrentMigrations *int32 `json:"maxConcurrentMigrations,omitempty"`
+	// RespectMaintenanceTimeWindow specifies whether shoots are only migrated away from a draining seed during their
+	// maintenance time window.
+	// +optional
+	RespectMaintenanceTimeWindow *bool `json:"respectMaintenanceTimeWindow,omitempty"`
+}
+
 // SeedExtensionsCheckControllerConfiguration defines the configuration of the SeedExtensionsCheck
 // controller.
 type SeedExtensionsCheckControllerConfiguration struct {
@@ -315,6 +370,29 @@ type SeedBackupBucketsCheckControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
 }
 
+// SeedRebalancerControllerConfiguration defines the configuration of the SeedRebalancer controller.
+type SeedRebalancerControllerConfiguration struct {
+	// SyncPeriod is the duration how often the utilization of the seeds is compared.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// DryRun specifies whether the rebalancer only emits its migration plan as events instead of migrating shoots.
+	// Defaults to true, i.e., shoots are only migrated if it is explicitly set to false.
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+	// MaxShootDifference is the difference in the number of shoots scheduled to a seed and the number of shoots
+	// scheduled to the destination seed which must be exceeded before a shoot is migrated. It must be at least 2 to
+	// prevent shoots from being migrated back and forth.
+	// +optional
+	MaxShootDifference *int32 `json:"maxShootDifference,omitempty"`
+	// MaxConcurrentMigrations is the maximum number of control plane migrations in the whole landscape. The rebalancer
+	// does not trigger further migrations while this number is reached.
+	// +optional
+	MaxConcurrentMigrations *int32 `json:"maxConcurrentMigrations,omitempty"`
+	// RespectMaintenanceTimeWindow specifies whether shoots are only migrated during their maintenance time window.
+	// +optional
+	RespectMaintenanceTimeWindow *bool `json:"respectMaintenanceTimeWindow,omitempty"`
+}
+
 // SeedReferenceControllerConfiguration defines the configuration of the
 // SeedReference controller.
 type SeedReferenceControllerConfiguration struct {
@@ -337,6 +415,46 @@ type ShootMaintenanceControllerConfiguration struct {
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	// +optional
 	EnableShootCoreAddonRestarter *bool `json:"enableShootCoreAddonRestarter"`
+	// MaintenanceFreezes contains periods during which the automatic maintenance of all shoots is not performed. They
+	// apply in addition to the maintenance freezes configured in the projects.
+	// +optional
+	MaintenanceFreezes []gardencorev1beta1.MaintenanceFreeze `json:"maintenanceFreezes,omitempty"`
+	// StagedRollout configures the staged rollout of Kubernetes and machine image versions across the fleet of
+	// shoots. If it is not set, new versions are eligible for automatic updates of all shoots immediately.
+	// +optional
+	StagedRollout *StagedRolloutConfiguration `json:"stagedRollout,omitempty"`
+}
+
+// StagedRolloutConfiguration defines the configuration of the staged rollout of Kubernetes and machine image versions.
+type StagedRolloutConfiguration struct {
+	// Waves is the ordered list of rollout waves. A version becomes eligible for automatic updates of the shoots in the
+	// next wave only after the shoots in the previous waves stayed healthy for the soak duration.
+	Waves []RolloutWave `json:"waves"`
+	// SoakDuration is the duration for which the shoots of a wave running a version must stay healthy before the
+	// version becomes eligible for the next wave.
+	// +optional
+	SoakDuration *metav1.Duration `json:"soakDuration,omitempty"`
+	// MaxUnhealthyPercentage is the maximum percentage of the shoots updated to a version within the soak duration
+	// which may be unhealthy before the rollout of the version is halted.
+	// +optional
+	MaxUnhealthyPercentage *int32 `json:"maxUnhealthyPercentage,omitempty"`
+	// SyncPeriod is the duration how often the rollout state of the versions in the CloudProfiles is reconciled.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
+// RolloutWave defines a group of shoots which receive new versions at the same time. A shoot belongs to the first wave
+// whose criteria it matches. Shoots explicitly assigned via the 'maintenance.gardener.cloud/rollout-wave' label belong
+// to the referenced wave, shoots not matching any wave belong to the last wave.
+type RolloutWave struct {
+	// Name is the name of the wave.
+	Name string `json:"name"`
+	// Purposes is the list of shoot purposes belonging to this wave.
+	// +optional
+	Purposes []gardencorev1beta1.ShootPurpose `json:"purposes,omitempty"`
+	// ProjectSelector selects the projects whose shoots belong to this wave.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -350,6 +468,10 @@ type ShootQuotaControllerConfiguration struct {
 	// (how often Shoots referenced Quota is checked).
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// ExpirationWarningThresholds are the durations before the expiration of a Shoot's lifetime at which warning events
+	// are emitted for the Shoot, e.g. `168h` and `24h` for warnings one week and one day before the expiration.
+	// +optional
+	ExpirationWarningThresholds []metav1.Duration `json:"expirationWarningThresholds,omitempty"`
 }
 
 // ShootHibernationControllerConfiguration defines the configuration of the
@@ -468,6 +590,15 @@ type Server struct {
 	Port int `json:"port"`
 }
 
+// TracingConfiguration contains configuration for exporting OpenTelemetry traces.
+type TracingConfiguration struct {
+	// Endpoint is the address of the OTLP gRPC endpoint to which the spans are exported, e.g. `otel-collector:4317`.
+	Endpoint string `json:"endpoint"`
+	// Insecure disables TLS for the connection to the endpoint.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+}
+
 const (
 	// ControllerManagerDefaultLockObjectNamespace is the default lock namespace for leader election.
 	ControllerManagerDefaultLockObjectNamespace = "garden"
@@ -10,6 +10,8 @@
 package v1alpha1
 
 import (
+	schedulerv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -138,6 +140,11 @@ func (in *ControllerManagerConfiguration) DeepCopyInto(out *ControllerManagerCon
 		*out = new(configv1alpha1.DebuggingConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(TracingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FeatureGates != nil {
 		in, out := &in.FeatureGates, &out.FeatureGates
 		*out = make(map[string]bool, len(*in))
@@ -249,6 +256,21 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(SeedReferenceControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeedDrain != nil {
+		in, out := &in.SeedDrain, &out.SeedDrain
+		*out = new(SeedDrainControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeedRebalancer != nil {
+		in, out := &in.SeedRebalancer, &out.SeedRebalancer
+		*out = new(SeedRebalancerControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootScheduler != nil {
+		in, out := &in.ShootScheduler, &out.ShootScheduler
+		*out = new(schedulerv1alpha1.ShootSchedulerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ShootMaintenance.DeepCopyInto(&out.ShootMaintenance)
 	if in.ShootQuota != nil {
 		in, out := &in.ShootQuota, &out.ShootQuota
@@ -476,6 +498,16 @@ func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerCon
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.SoftQuotaSyncPeriod != nil {
+		in, out := &in.SoftQuotaSyncPeriod, &out.SoftQuotaSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(ProjectUsageConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -489,6 +521,27 @@ func (in *ProjectControllerConfiguration) DeepCopy() *ProjectControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectUsageConfiguration) DeepCopyInto(out *ProjectUsageConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectUsageConfiguration.
+func (in *ProjectUsageConfiguration) DeepCopy() *ProjectUsageConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectUsageConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuotaConfiguration) DeepCopyInto(out *QuotaConfiguration) {
 	*out = *in
@@ -532,6 +585,32 @@ func (in *QuotaControllerConfiguration) DeepCopy() *QuotaControllerConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutWave) DeepCopyInto(out *RolloutWave) {
+	*out = *in
+	if in.Purposes != nil {
+		in, out := &in.Purposes, &out.Purposes
+		*out = make([]v1beta1.ShootPurpose, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutWave.
+func (in *RolloutWave) DeepCopy() *RolloutWave {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutWave)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretBindingControllerConfiguration) DeepCopyInto(out *SecretBindingControllerConfiguration) {
 	*out = *in
@@ -620,6 +699,42 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedDrainControllerConfiguration) DeepCopyInto(out *SeedDrainControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConcurrentMigrations != nil {
+		in, out := &in.MaxConcurrentMigrations, &out.MaxConcurrentMigrations
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RespectMaintenanceTimeWindow != nil {
+		in, out := &in.RespectMaintenanceTimeWindow, &out.RespectMaintenanceTimeWindow
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDrainControllerConfiguration.
+func (in *SeedDrainControllerConfiguration) DeepCopy() *SeedDrainControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedDrainControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedExtensionsCheckControllerConfiguration) DeepCopyInto(out *SeedExtensionsCheckControllerConfiguration) {
 	*out = *in
@@ -651,6 +766,47 @@ func (in *SeedExtensionsCheckControllerConfiguration) DeepCopy() *SeedExtensions
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedRebalancerControllerConfiguration) DeepCopyInto(out *SeedRebalancerControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxShootDifference != nil {
+		in, out := &in.MaxShootDifference, &out.MaxShootDifference
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrentMigrations != nil {
+		in, out := &in.MaxConcurrentMigrations, &out.MaxConcurrentMigrations
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RespectMaintenanceTimeWindow != nil {
+		in, out := &in.RespectMaintenanceTimeWindow, &out.RespectMaintenanceTimeWindow
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedRebalancerControllerConfiguration.
+func (in *SeedRebalancerControllerConfiguration) DeepCopy() *SeedRebalancerControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedRebalancerControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedReferenceControllerConfiguration) DeepCopyInto(out *SeedReferenceControllerConfiguration) {
 	*out = *in
@@ -779,6 +935,18 @@ func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMainte
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MaintenanceFreezes != nil {
+		in, out := &in.MaintenanceFreezes, &out.MaintenanceFreezes
+		*out = make([]v1beta1.MaintenanceFreeze, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StagedRollout != nil {
+		in, out := &in.StagedRollout, &out.StagedRollout
+		*out = new(StagedRolloutConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -826,6 +994,11 @@ func (in *ShootQuotaControllerConfiguration) DeepCopyInto(out *ShootQuotaControl
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.ExpirationWarningThresholds != nil {
+		in, out := &in.ExpirationWarningThresholds, &out.ExpirationWarningThresholds
+		*out = make([]v1.Duration, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -932,3 +1105,62 @@ func (in *ShootStatusLabelControllerConfiguration) DeepCopy() *ShootStatusLabelC
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StagedRolloutConfiguration) DeepCopyInto(out *StagedRolloutConfiguration) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]RolloutWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SoakDuration != nil {
+		in, out := &in.SoakDuration, &out.SoakDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxUnhealthyPercentage != nil {
+		in, out := &in.MaxUnhealthyPercentage, &out.MaxUnhealthyPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StagedRolloutConfiguration.
+func (in *StagedRolloutConfiguration) DeepCopy() *StagedRolloutConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(StagedRolloutConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingConfiguration) DeepCopyInto(out *TracingConfiguration) {
+	*out = *in
+	if in.Insecure != nil {
+		in, out := &in.Insecure, &out.Insecure
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingConfiguration.
+func (in *TracingConfiguration) DeepCopy() *TracingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
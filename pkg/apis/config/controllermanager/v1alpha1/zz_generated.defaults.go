@@ -53,6 +53,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	}
 	if in.Controllers.Project != nil {
 		SetDefaults_ProjectControllerConfiguration(in.Controllers.Project)
+		if in.Controllers.Project.Usage != nil {
+			SetDefaults_ProjectUsageConfiguration(in.Controllers.Project.Usage)
+		}
 	}
 	if in.Controllers.Quota != nil {
 		SetDefaults_QuotaControllerConfiguration(in.Controllers.Quota)
@@ -75,7 +78,16 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.SeedReference != nil {
 		SetDefaults_SeedReferenceControllerConfiguration(in.Controllers.SeedReference)
 	}
+	if in.Controllers.SeedDrain != nil {
+		SetDefaults_SeedDrainControllerConfiguration(in.Controllers.SeedDrain)
+	}
+	if in.Controllers.SeedRebalancer != nil {
+		SetDefaults_SeedRebalancerControllerConfiguration(in.Controllers.SeedRebalancer)
+	}
 	SetDefaults_ShootMaintenanceControllerConfiguration(&in.Controllers.ShootMaintenance)
+	if in.Controllers.ShootMaintenance.StagedRollout != nil {
+		SetDefaults_StagedRolloutConfiguration(in.Controllers.ShootMaintenance.StagedRollout)
+	}
 	if in.Controllers.ShootQuota != nil {
 		SetDefaults_ShootQuotaControllerConfiguration(in.Controllers.ShootQuota)
 	}
@@ -62,6 +62,10 @@ type GardenletConfiguration struct {
 	// Debugging holds configuration for Debugging related features.
 	// +optional
 	Debugging *componentbaseconfigv1alpha1.DebuggingConfiguration `json:"debugging,omitempty"`
+	// Tracing contains configuration for exporting OpenTelemetry traces of the reconciliations. Tracing is disabled if
+	// it is not set.
+	// +optional
+	Tracing *TracingConfiguration `json:"tracing,omitempty"`
 	// FeatureGates is a map of feature names to bools that enable or disable alpha/experimental
 	// features. This field modifies piecemeal the built-in default values from
 	// "github.com/gardener/gardener/pkg/gardenlet/features/features.go".
@@ -358,6 +362,10 @@ type ShootCareControllerConfiguration struct {
 	// is enabled.
 	// +optional
 	WebhookRemediatorEnabled *bool `json:"webhookRemediatorEnabled,omitempty"`
+	// UsageObservationEnabled specifies whether the resources consumed by the Shoots (number of nodes per worker pool
+	// and resources requested by the control plane) are observed and recorded in their status.
+	// +optional
+	UsageObservationEnabled *bool `json:"usageObservationEnabled,omitempty"`
 }
 
 // SeedCareControllerConfiguration defines the configuration of the SeedCare
@@ -778,3 +786,12 @@ type NodeToleration struct {
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
+
+// TracingConfiguration contains configuration for exporting OpenTelemetry traces.
+type TracingConfiguration struct {
+	// Endpoint is the address of the OTLP gRPC endpoint to which the spans are exported, e.g. `otel-collector:4317`.
+	Endpoint string `json:"endpoint"`
+	// Insecure disables TLS for the connection to the endpoint.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+}
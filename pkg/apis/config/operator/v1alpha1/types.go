@@ -34,6 +34,10 @@ type OperatorConfiguration struct {
 	// Debugging holds configuration for Debugging related features.
 	// +optional
 	Debugging *componentbaseconfigv1alpha1.DebuggingConfiguration `json:"debugging,omitempty"`
+	// Tracing contains configuration for exporting OpenTelemetry traces of the reconciliations. Tracing is disabled if
+	// it is not set.
+	// +optional
+	Tracing *TracingConfiguration `json:"tracing,omitempty"`
 	// FeatureGates is a map of feature names to bools that enable or disable alpha/experimental features. This field
 	// modifies piecemeal the built-in default values from "github.com/gardener/gardener/pkg/operator/features/features.go".
 	// Default: nil
@@ -99,6 +103,19 @@ type GardenControllerConfig struct {
 	// backup compaction feature of ETCD backup-restore functionality.
 	// +optional
 	ETCDConfig *gardenletconfigv1alpha1.ETCDConfig `json:"etcdConfig,omitempty"`
+	// KeyStore configures a key store for the private keys of the certificate authorities of the garden. If set, the
+	// private keys of new certificate authorities are not stored in secrets of the runtime cluster.
+	// +optional
+	KeyStore *KeyStoreConfig `json:"keyStore,omitempty"`
+}
+
+// KeyStoreConfig is the configuration of a key store for the private keys of certificate authorities.
+type KeyStoreConfig struct {
+	// Directory is the path of the directory in which the private keys are kept as unencrypted files. It must be a
+	// writable and persistent volume which is shared by all replicas of gardener-operator and only mounted into it,
+	// e.g., a ReadWriteMany PersistentVolume on an encrypted disk. Read-only volumes (e.g., provided by the CSI driver
+	// of a secret store) are not suitable since private keys are created and deleted when CAs are rotated.
+	Directory string `json:"directory"`
 }
 
 // GardenletDeployerControllerConfig is the configuration for the gardenlet deployer controller.
@@ -193,6 +210,15 @@ type NodeTolerationConfiguration struct {
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
 
+// TracingConfiguration contains configuration for exporting OpenTelemetry traces.
+type TracingConfiguration struct {
+	// Endpoint is the address of the OTLP gRPC endpoint to which the spans are exported, e.g. `otel-collector:4317`.
+	Endpoint string `json:"endpoint"`
+	// Insecure disables TLS for the connection to the endpoint.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+}
+
 const (
 	// DefaultLockObjectNamespace is the default lock namespace for leader election.
 	DefaultLockObjectNamespace = "garden"
@@ -122,6 +122,26 @@ func SetDefaults_HealthControllerConfig(obj *HealthControllerConfig) {
 	}
 }
 
+// SetDefaults_ConditionsHealthCheck sets defaults for the ConditionsHealthCheck object.
+func SetDefaults_ConditionsHealthCheck(obj *ConditionsHealthCheck) {
+	if obj.HealthyConditions == nil {
+		obj.HealthyConditions = []string{"Ready"}
+	}
+	if obj.UnhealthyConditions == nil {
+		obj.UnhealthyConditions = []string{"Stalled"}
+	}
+	if obj.ProgressingConditions == nil {
+		obj.ProgressingConditions = []string{"Reconciling"}
+	}
+}
+
+// SetDefaults_DriftControllerConfig sets defaults for the DriftControllerConfig object.
+func SetDefaults_DriftControllerConfig(obj *DriftControllerConfig) {
+	if obj.Enabled && obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+}
+
 // SetDefaults_ManagedResourceControllerConfig sets defaults for the ManagedResourceControllerConfig object.
 func SetDefaults_ManagedResourceControllerConfig(obj *ManagedResourceControllerConfig) {
 	if obj.ConcurrentSyncs == nil {
@@ -317,6 +317,67 @@ var _ = Describe("ResourceManager defaulting", func() {
 		})
 	})
 
+	Describe("DriftControllerConfig defaulting", func() {
+		It("should not default the DriftControllerConfig because it is disabled", func() {
+			obj.Controllers.Drift = DriftControllerConfig{}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Drift.ConcurrentSyncs).To(BeNil())
+		})
+
+		It("should default the DriftControllerConfig because it is enabled", func() {
+			obj.Controllers.Drift = DriftControllerConfig{
+				Enabled: true,
+			}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Drift.ConcurrentSyncs).To(PointTo(Equal(5)))
+		})
+
+		It("should not overwrite already set values for DriftControllerConfig", func() {
+			obj.Controllers.Drift = DriftControllerConfig{
+				Enabled:         true,
+				ConcurrentSyncs: ptr.To(6),
+			}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Drift.ConcurrentSyncs).To(PointTo(Equal(6)))
+		})
+	})
+
+	Describe("ConditionsHealthCheck defaulting", func() {
+		It("should default the condition types", func() {
+			obj.Controllers.Health.CustomHealthChecks = []CustomHealthCheck{{Kind: "Foo", Conditions: &ConditionsHealthCheck{}}}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Health.CustomHealthChecks[0].Conditions).To(Equal(&ConditionsHealthCheck{
+				HealthyConditions:     []string{"Ready"},
+				UnhealthyConditions:   []string{"Stalled"},
+				ProgressingConditions: []string{"Reconciling"},
+			}))
+		})
+
+		It("should not overwrite already set condition types", func() {
+			obj.Controllers.Health.CustomHealthChecks = []CustomHealthCheck{{Kind: "Foo", Conditions: &ConditionsHealthCheck{
+				HealthyConditions:     []string{"Available"},
+				UnhealthyConditions:   []string{"Failed"},
+				ProgressingConditions: []string{"Progressing"},
+			}}}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Health.CustomHealthChecks[0].Conditions).To(Equal(&ConditionsHealthCheck{
+				HealthyConditions:     []string{"Available"},
+				UnhealthyConditions:   []string{"Failed"},
+				ProgressingConditions: []string{"Progressing"},
+			}))
+		})
+	})
+
 	Describe("ManagedResourceControllerConfig defaulting", func() {
 		It("should default the ManagedResourceControllerConfig", func() {
 			obj.Controllers.ManagedResource = ManagedResourceControllerConfig{}
@@ -31,6 +31,10 @@ type ResourceManagerConfiguration struct {
 	// Debugging holds configuration for Debugging related features.
 	// +optional
 	Debugging *componentbaseconfigv1alpha1.DebuggingConfiguration `json:"debugging,omitempty"`
+	// Tracing contains configuration for exporting OpenTelemetry traces of the reconciliations. Tracing is disabled if
+	// it is not set.
+	// +optional
+	Tracing *TracingConfiguration `json:"tracing,omitempty"`
 	// LogLevel is the level/severity for the logs. Must be one of [info,debug,error].
 	LogLevel string `json:"logLevel"`
 	// LogFormat is the output format for the logs. Must be one of [text,json].
@@ -102,6 +106,8 @@ type ResourceManagerControllerConfiguration struct {
 	GarbageCollector GarbageCollectorControllerConfig `json:"garbageCollector"`
 	// Health is the configuration for the health controller.
 	Health HealthControllerConfig `json:"health"`
+	// Drift is the configuration for the drift controller.
+	Drift DriftControllerConfig `json:"drift"`
 	// CSRApprover is the configuration for the csr-approver controller.
 	CSRApprover CSRApproverControllerConfig `json:"csrApprover"`
 	// ManagedResource is the configuration for the managed resource controller.
@@ -145,6 +151,69 @@ type HealthControllerConfig struct {
 	// SyncPeriod is the duration how often the controller performs its reconciliation.
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// CustomHealthChecks configures health checks for kinds of resources without a built-in health check, e.g. custom
+	// resources deployed by extensions. They are not considered for kinds of resources with a built-in health check.
+	// +optional
+	CustomHealthChecks []CustomHealthCheck `json:"customHealthChecks,omitempty"`
+}
+
+// CustomHealthCheck configures the health check for resources of a specific kind. Exactly one of Conditions and CEL
+// must be set.
+type CustomHealthCheck struct {
+	// Group is the API group of the resources. It is empty for the core API group.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the resources.
+	Kind string `json:"kind"`
+	// Conditions configures a generic health check based on the conditions in the status of the resources.
+	// +optional
+	Conditions *ConditionsHealthCheck `json:"conditions,omitempty"`
+	// CEL configures a health check based on CEL expressions.
+	// +optional
+	CEL *CELHealthCheck `json:"cel,omitempty"`
+}
+
+// ConditionsHealthCheck configures a generic health check based on the `.status.conditions` of resources following the
+// Kubernetes API conventions (similar to kstatus). A resource is progressing if its `.status.observedGeneration` is
+// outdated or if one of the progressing conditions has status `True`. It is unhealthy if its observed generation is
+// outdated, if one of the unhealthy conditions has status `True`, or if one of the healthy conditions is missing or does
+// not have status `True`.
+type ConditionsHealthCheck struct {
+	// HealthyConditions are the types of conditions which must have status `True`. Defaults to `Ready`.
+	// +optional
+	HealthyConditions []string `json:"healthyConditions,omitempty"`
+	// UnhealthyConditions are the types of conditions which indicate that the resource is unhealthy if they have status
+	// `True`. Defaults to `Stalled`.
+	// +optional
+	UnhealthyConditions []string `json:"unhealthyConditions,omitempty"`
+	// ProgressingConditions are the types of conditions which indicate that the resource is progressing if they have
+	// status `True`. Defaults to `Reconciling`.
+	// +optional
+	ProgressingConditions []string `json:"progressingConditions,omitempty"`
+}
+
+// CELHealthCheck configures a health check based on CEL expressions. The resource is available as `object` in the
+// expressions.
+type CELHealthCheck struct {
+	// Healthy is a CEL expression which must evaluate to true if the resource is healthy.
+	Healthy string `json:"healthy"`
+	// Progressing is a CEL expression which must evaluate to true if the resource is progressing. If it is not set, the
+	// resource is never considered as progressing.
+	// +optional
+	Progressing *string `json:"progressing,omitempty"`
+	// Message is a CEL expression which must evaluate to a string describing the state of the resource. It is reported
+	// if the resource is unhealthy or progressing.
+	// +optional
+	Message *string `json:"message,omitempty"`
+}
+
+// DriftControllerConfig is the configuration for the drift controller.
+type DriftControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool `json:"enabled"`
+	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
 // ManagedResourceControllerConfig is the configuration for the managed resource controller.
@@ -364,6 +433,15 @@ type VPAInPlaceUpdatesConfig struct {
 	Enabled bool `json:"enabled"`
 }
 
+// TracingConfiguration contains configuration for exporting OpenTelemetry traces.
+type TracingConfiguration struct {
+	// Endpoint is the address of the OTLP gRPC endpoint to which the spans are exported, e.g. `otel-collector:4317`.
+	Endpoint string `json:"endpoint"`
+	// Insecure disables TLS for the connection to the endpoint.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+}
+
 const (
 	// DefaultResourceClass is used as resource class if no class is specified on the command line.
 	DefaultResourceClass = "resources"
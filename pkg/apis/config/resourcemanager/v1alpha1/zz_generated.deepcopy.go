@@ -16,6 +16,32 @@ import (
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CELHealthCheck) DeepCopyInto(out *CELHealthCheck) {
+	*out = *in
+	if in.Progressing != nil {
+		in, out := &in.Progressing, &out.Progressing
+		*out = new(string)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CELHealthCheck.
+func (in *CELHealthCheck) DeepCopy() *CELHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(CELHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CRDDeletionProtection) DeepCopyInto(out *CRDDeletionProtection) {
 	*out = *in
@@ -85,6 +111,84 @@ func (in *ClientConnection) DeepCopy() *ClientConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionsHealthCheck) DeepCopyInto(out *ConditionsHealthCheck) {
+	*out = *in
+	if in.HealthyConditions != nil {
+		in, out := &in.HealthyConditions, &out.HealthyConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnhealthyConditions != nil {
+		in, out := &in.UnhealthyConditions, &out.UnhealthyConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProgressingConditions != nil {
+		in, out := &in.ProgressingConditions, &out.ProgressingConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionsHealthCheck.
+func (in *ConditionsHealthCheck) DeepCopy() *ConditionsHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionsHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomHealthCheck) DeepCopyInto(out *CustomHealthCheck) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = new(ConditionsHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CEL != nil {
+		in, out := &in.CEL, &out.CEL
+		*out = new(CELHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomHealthCheck.
+func (in *CustomHealthCheck) DeepCopy() *CustomHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftControllerConfig) DeepCopyInto(out *DriftControllerConfig) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftControllerConfig.
+func (in *DriftControllerConfig) DeepCopy() *DriftControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointSliceHintsWebhookConfig) DeepCopyInto(out *EndpointSliceHintsWebhookConfig) {
 	*out = *in
@@ -169,6 +273,13 @@ func (in *HealthControllerConfig) DeepCopyInto(out *HealthControllerConfig) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.CustomHealthChecks != nil {
+		in, out := &in.CustomHealthChecks, &out.CustomHealthChecks
+		*out = make([]CustomHealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -479,6 +590,11 @@ func (in *ResourceManagerConfiguration) DeepCopyInto(out *ResourceManagerConfigu
 		*out = new(configv1alpha1.DebuggingConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(TracingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Controllers.DeepCopyInto(&out.Controllers)
 	in.Webhooks.DeepCopyInto(&out.Webhooks)
 	return
@@ -517,6 +633,7 @@ func (in *ResourceManagerControllerConfiguration) DeepCopyInto(out *ResourceMana
 	}
 	in.GarbageCollector.DeepCopyInto(&out.GarbageCollector)
 	in.Health.DeepCopyInto(&out.Health)
+	in.Drift.DeepCopyInto(&out.Drift)
 	in.CSRApprover.DeepCopyInto(&out.CSRApprover)
 	in.ManagedResource.DeepCopyInto(&out.ManagedResource)
 	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
@@ -698,6 +815,27 @@ func (in *TokenRequestorControllerConfig) DeepCopy() *TokenRequestorControllerCo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingConfiguration) DeepCopyInto(out *TracingConfiguration) {
+	*out = *in
+	if in.Insecure != nil {
+		in, out := &in.Insecure, &out.Insecure
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingConfiguration.
+func (in *TracingConfiguration) DeepCopy() *TracingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPAInPlaceUpdatesConfig) DeepCopyInto(out *VPAInPlaceUpdatesConfig) {
 	*out = *in
@@ -36,6 +36,13 @@ func SetObjectDefaults_ResourceManagerConfiguration(in *ResourceManagerConfigura
 	SetDefaults_ResourceManagerControllerConfiguration(&in.Controllers)
 	SetDefaults_GarbageCollectorControllerConfig(&in.Controllers.GarbageCollector)
 	SetDefaults_HealthControllerConfig(&in.Controllers.Health)
+	for i := range in.Controllers.Health.CustomHealthChecks {
+		a := &in.Controllers.Health.CustomHealthChecks[i]
+		if a.Conditions != nil {
+			SetDefaults_ConditionsHealthCheck(a.Conditions)
+		}
+	}
+	SetDefaults_DriftControllerConfig(&in.Controllers.Drift)
 	SetDefaults_CSRApproverControllerConfig(&in.Controllers.CSRApprover)
 	SetDefaults_ManagedResourceControllerConfig(&in.Controllers.ManagedResource)
 	SetDefaults_NetworkPolicyControllerConfig(&in.Controllers.NetworkPolicy)
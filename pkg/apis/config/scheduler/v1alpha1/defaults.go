@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/config"
 )
@@ -46,6 +47,42 @@ func SetDefaults_SchedulerControllerConfiguration(obj *SchedulerControllerConfig
 	if obj.Shoot.ConcurrentSyncs == 0 {
 		obj.Shoot.ConcurrentSyncs = 5
 	}
+
+	if obj.Shoot.Strategy == CapacityAware && obj.Shoot.CapacityAware == nil {
+		obj.Shoot.CapacityAware = &CapacityAwareConfiguration{}
+	}
+}
+
+// SetDefaults_CapacityAwareConfiguration sets defaults for the configuration of the CapacityAware strategy.
+func SetDefaults_CapacityAwareConfiguration(obj *CapacityAwareConfiguration) {
+	if len(obj.Scorers) == 0 {
+		obj.Scorers = []SeedScorer{{Name: SeedScorerLeastAllocated}}
+	}
+
+	for i := range obj.Scorers {
+		if obj.Scorers[i].Weight == nil {
+			obj.Scorers[i].Weight = ptr.To[int32](1)
+		}
+	}
+
+	if obj.ShootCost == nil {
+		obj.ShootCost = &ShootCostConfiguration{}
+	}
+}
+
+// SetDefaults_ShootCostConfiguration sets defaults for the shoot cost configuration of the CapacityAware strategy.
+func SetDefaults_ShootCostConfiguration(obj *ShootCostConfiguration) {
+	if obj.HighAvailabilityNode == nil {
+		obj.HighAvailabilityNode = ptr.To[int32](50)
+	}
+
+	if obj.HighAvailabilityZone == nil {
+		obj.HighAvailabilityZone = ptr.To[int32](100)
+	}
+
+	if obj.WorkerPool == nil {
+		obj.WorkerPool = ptr.To[int32](5)
+	}
 }
 
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
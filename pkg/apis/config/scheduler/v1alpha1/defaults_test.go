@@ -90,6 +90,56 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("CapacityAwareConfiguration defaulting", func() {
+		It("should default the configuration of the CapacityAware strategy", func() {
+			obj.Schedulers.Shoot = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Strategy: schedulerconfigv1alpha1.CapacityAware,
+			}
+
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.CapacityAware).To(Equal(&schedulerconfigv1alpha1.CapacityAwareConfiguration{
+				Scorers: []schedulerconfigv1alpha1.SeedScorer{
+					{Name: schedulerconfigv1alpha1.SeedScorerLeastAllocated, Weight: ptr.To[int32](1)},
+				},
+				ShootCost: &schedulerconfigv1alpha1.ShootCostConfiguration{
+					HighAvailabilityNode: ptr.To[int32](50),
+					HighAvailabilityZone: ptr.To[int32](100),
+					WorkerPool:           ptr.To[int32](5),
+				},
+			}))
+		})
+
+		It("should not default the configuration of the CapacityAware strategy for other strategies", func() {
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.CapacityAware).To(BeNil())
+		})
+
+		It("should not overwrite already set values for the configuration of the CapacityAware strategy", func() {
+			capacityAware := &schedulerconfigv1alpha1.CapacityAwareConfiguration{
+				Scorers: []schedulerconfigv1alpha1.SeedScorer{
+					{Name: schedulerconfigv1alpha1.SeedScorerLeastAllocated, Weight: ptr.To[int32](3)},
+					{Name: schedulerconfigv1alpha1.SeedScorerLeastShoots, Weight: ptr.To[int32](1)},
+				},
+				ShootCost: &schedulerconfigv1alpha1.ShootCostConfiguration{
+					Purposes:             map[string]int32{"production": 200},
+					HighAvailabilityNode: ptr.To[int32](10),
+					HighAvailabilityZone: ptr.To[int32](20),
+					WorkerPool:           ptr.To[int32](0),
+				},
+			}
+			obj.Schedulers.Shoot = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Strategy:      schedulerconfigv1alpha1.CapacityAware,
+				CapacityAware: capacityAware.DeepCopy(),
+			}
+
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.CapacityAware).To(Equal(capacityAware))
+		})
+	})
+
 	Describe("ServerConfiguration defaulting", func() {
 		It("should not overwrite already set values for ServerConfiguration", func() {
 			serverConfiguration := &schedulerconfigv1alpha1.ServerConfiguration{
@@ -14,6 +14,9 @@ const (
 	SameRegion CandidateDeterminationStrategy = "SameRegion"
 	// MinimalDistance Strategy determines a seed candidate for a shoot if the cloud profile are identical. Then chooses the seed with the minimal distance to the shoot.
 	MinimalDistance CandidateDeterminationStrategy = "MinimalDistance"
+	// CapacityAware Strategy determines seed candidates like the MinimalDistance strategy. Then chooses the seed with the
+	// best score computed by the configured seed scorers, which take the weighted resource usage of the seeds into account.
+	CapacityAware CandidateDeterminationStrategy = "CapacityAware"
 	// Default Strategy is the default strategy to use when there is no configuration provided
 	Default = SameRegion
 	// SchedulerDefaultLockObjectNamespace is the default lock namespace for leader election.
@@ -23,7 +26,7 @@ const (
 )
 
 // Strategies defines all currently implemented SeedCandidateDeterminationStrategies
-var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance}
+var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance, CapacityAware}
 
 // CandidateDeterminationStrategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 type CandidateDeterminationStrategy string
@@ -86,6 +89,115 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int `json:"concurrentSyncs"`
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy `json:"candidateDeterminationStrategy"`
+	// CapacityAware contains the configuration for the CapacityAware strategy. It is only considered if the strategy
+	// is set to CapacityAware.
+	// +optional
+	CapacityAware *CapacityAwareConfiguration `json:"capacityAware,omitempty"`
+	// SchedulingPreview contains the configuration of the scheduling preview endpoint.
+	// +optional
+	SchedulingPreview *SchedulingPreviewConfiguration `json:"schedulingPreview,omitempty"`
+	// Filters is a list of additional filter rules for seeds. They are evaluated in the given order after the built-in
+	// filters and before the candidate determination strategy.
+	// +optional
+	Filters []SeedFilterRule `json:"filters,omitempty"`
+}
+
+// SeedFilterRule is a declarative filter rule for seeds.
+type SeedFilterRule struct {
+	// Name is the name of the filter rule. It is reported for seeds rejected by the rule.
+	Name string `json:"name"`
+	// Expression is a CEL expression which must evaluate to true for seeds which are eligible for hosting the shoot.
+	// The expression can access the Shoot to be scheduled (`shoot`), the Seed (`seed`), the Project of the Shoot
+	// (`project`), and the usage of the seed (`usage`). The usage contains the number of shoots hosted by the seed
+	// (`usage.shoots`), the number of these shoots per purpose (`usage.shootsByPurpose`), and the sum of their costs as
+	// computed for the CapacityAware strategy (`usage.cost`).
+	Expression string `json:"expression"`
+	// Message is reported for seeds rejected by the rule. Defaults to a message containing the expression.
+	// +optional
+	Message *string `json:"message,omitempty"`
+}
+
+// SchedulingPreviewConfiguration contains the configuration of the scheduling preview endpoint.
+type SchedulingPreviewConfiguration struct {
+	// Enabled controls whether the scheduler serves the scheduling preview endpoint. The endpoint accepts a Shoot and
+	// returns the verdicts of all filters per seed as well as the final ranking of the seed candidates without
+	// persisting anything. Requests must be authenticated with a token of the garden cluster whose user is allowed to
+	// create Shoots in the namespace of the given Shoot.
+	Enabled bool `json:"enabled"`
+	// Server is the configuration of the HTTPS server serving the scheduling preview endpoint. It is required if the
+	// endpoint is enabled.
+	// +optional
+	Server *HTTPSServer `json:"server,omitempty"`
+}
+
+const (
+	// SeedScorerLeastAllocated is the name of the seed scorer which prefers seeds with the lowest weighted shoot cost
+	// relative to their allocatable capacity for shoots.
+	SeedScorerLeastAllocated SeedScorerName = "LeastAllocated"
+	// SeedScorerLeastShoots is the name of the seed scorer which prefers seeds with the least number of shoots deployed.
+	SeedScorerLeastShoots SeedScorerName = "LeastShoots"
+)
+
+// SeedScorers defines all currently implemented seed scorers.
+var SeedScorers = []SeedScorerName{SeedScorerLeastAllocated, SeedScorerLeastShoots}
+
+// SeedScorerName is the name of a seed scorer.
+type SeedScorerName string
+
+// CapacityAwareConfiguration contains the configuration for the CapacityAware strategy.
+type CapacityAwareConfiguration struct {
+	// Scorers is the list of seed scorers whose weighted scores are combined to rank the seed candidates.
+	// Defaults to the LeastAllocated scorer with weight 1.
+	// +optional
+	Scorers []SeedScorer `json:"scorers,omitempty"`
+	// ShootCost configures how the cost of a shoot hosted by a seed is computed.
+	// +optional
+	ShootCost *ShootCostConfiguration `json:"shootCost,omitempty"`
+}
+
+// SeedScorer configures a seed scorer used by the CapacityAware strategy.
+type SeedScorer struct {
+	// Name is the name of the seed scorer.
+	Name SeedScorerName `json:"name"`
+	// Weight is the weight of the scores computed by this scorer. Defaults to 1.
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// ShootCostConfiguration configures how the cost of a shoot hosted by a seed is computed. The cost of a shoot is the
+// cost for its purpose, increased by the cost for a highly available control plane and the cost for each worker pool.
+// A cost of 100 is equivalent to one unit of the allocatable capacity for shoots of a seed.
+type ShootCostConfiguration struct {
+	// Purposes maps shoot purposes to the cost of a shoot with this purpose. Purposes which are not configured have a
+	// cost of 100.
+	// +optional
+	Purposes map[string]int32 `json:"purposes,omitempty"`
+	// HighAvailabilityNode is the additional cost of a shoot with a highly available control plane with failure
+	// tolerance type 'node'. Defaults to 50.
+	// +optional
+	HighAvailabilityNode *int32 `json:"highAvailabilityNode,omitempty"`
+	// HighAvailabilityZone is the additional cost of a shoot with a highly available control plane with failure
+	// tolerance type 'zone'. Defaults to 100.
+	// +optional
+	HighAvailabilityZone *int32 `json:"highAvailabilityZone,omitempty"`
+	// WorkerPool is the additional cost per worker pool of a shoot. Defaults to 5.
+	// +optional
+	WorkerPool *int32 `json:"workerPool,omitempty"`
+}
+
+// HTTPSServer is the configuration for the HTTPSServer server.
+type HTTPSServer struct {
+	// Server is the configuration for the bind address and the port.
+	Server `json:",inline"`
+	// TLSServer contains information about the TLS configuration for a HTTPS server.
+	TLS TLSServer `json:"tls"`
+}
+
+// TLSServer contains information about the TLS configuration for a HTTPS server.
+type TLSServer struct {
+	// ServerCertDir is the path to a directory containing the server's TLS certificate and key (the files must be
+	// named tls.crt and tls.key respectively).
+	ServerCertDir string `json:"serverCertDir"`
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
@@ -30,6 +30,52 @@ func (in *BackupBucketSchedulerConfiguration) DeepCopy() *BackupBucketSchedulerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityAwareConfiguration) DeepCopyInto(out *CapacityAwareConfiguration) {
+	*out = *in
+	if in.Scorers != nil {
+		in, out := &in.Scorers, &out.Scorers
+		*out = make([]SeedScorer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ShootCost != nil {
+		in, out := &in.ShootCost, &out.ShootCost
+		*out = new(ShootCostConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityAwareConfiguration.
+func (in *CapacityAwareConfiguration) DeepCopy() *CapacityAwareConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityAwareConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSServer) DeepCopyInto(out *HTTPSServer) {
+	*out = *in
+	out.Server = in.Server
+	out.TLS = in.TLS
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSServer.
+func (in *HTTPSServer) DeepCopy() *HTTPSServer {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulerConfiguration) DeepCopyInto(out *SchedulerConfiguration) {
 	*out = *in
@@ -86,7 +132,7 @@ func (in *SchedulerControllerConfiguration) DeepCopyInto(out *SchedulerControlle
 	if in.Shoot != nil {
 		in, out := &in.Shoot, &out.Shoot
 		*out = new(ShootSchedulerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -101,6 +147,69 @@ func (in *SchedulerControllerConfiguration) DeepCopy() *SchedulerControllerConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingPreviewConfiguration) DeepCopyInto(out *SchedulingPreviewConfiguration) {
+	*out = *in
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(HTTPSServer)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingPreviewConfiguration.
+func (in *SchedulingPreviewConfiguration) DeepCopy() *SchedulingPreviewConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingPreviewConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedFilterRule) DeepCopyInto(out *SeedFilterRule) {
+	*out = *in
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedFilterRule.
+func (in *SeedFilterRule) DeepCopy() *SeedFilterRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedFilterRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedScorer) DeepCopyInto(out *SeedScorer) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedScorer.
+func (in *SeedScorer) DeepCopy() *SeedScorer {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedScorer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -143,9 +252,64 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCostConfiguration) DeepCopyInto(out *ShootCostConfiguration) {
+	*out = *in
+	if in.Purposes != nil {
+		in, out := &in.Purposes, &out.Purposes
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.HighAvailabilityNode != nil {
+		in, out := &in.HighAvailabilityNode, &out.HighAvailabilityNode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HighAvailabilityZone != nil {
+		in, out := &in.HighAvailabilityZone, &out.HighAvailabilityZone
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WorkerPool != nil {
+		in, out := &in.WorkerPool, &out.WorkerPool
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCostConfiguration.
+func (in *ShootCostConfiguration) DeepCopy() *ShootCostConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCostConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootSchedulerConfiguration) DeepCopyInto(out *ShootSchedulerConfiguration) {
 	*out = *in
+	if in.CapacityAware != nil {
+		in, out := &in.CapacityAware, &out.CapacityAware
+		*out = new(CapacityAwareConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchedulingPreview != nil {
+		in, out := &in.SchedulingPreview, &out.SchedulingPreview
+		*out = new(SchedulingPreviewConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]SeedFilterRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -158,3 +322,19 @@ func (in *ShootSchedulerConfiguration) DeepCopy() *ShootSchedulerConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSServer) DeepCopyInto(out *TLSServer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSServer.
+func (in *TLSServer) DeepCopy() *TLSServer {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSServer)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -29,4 +29,12 @@ func SetObjectDefaults_SchedulerConfiguration(in *SchedulerConfiguration) {
 	}
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_SchedulerControllerConfiguration(&in.Schedulers)
+	if in.Schedulers.Shoot != nil {
+		if in.Schedulers.Shoot.CapacityAware != nil {
+			SetDefaults_CapacityAwareConfiguration(in.Schedulers.Shoot.CapacityAware)
+			if in.Schedulers.Shoot.CapacityAware.ShootCost != nil {
+				SetDefaults_ShootCostConfiguration(in.Schedulers.Shoot.CapacityAware.ShootCost)
+			}
+		}
+	}
 }
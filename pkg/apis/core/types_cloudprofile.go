@@ -199,6 +199,9 @@ type MachineType struct {
 	Capabilities Capabilities
 	// MachineControllerManager contains a subset of the MachineControllerManagerSettings which can be defaulted for a machine type in a CloudProfile.
 	MachineControllerManager *CloudProfileMachineControllerManagerSettings
+	// HourlyPrice is the price of running a machine of this type for one hour. It is used for estimating the costs of
+	// shoot clusters. The currency is not specified, hence, it must be the same for all machine types.
+	HourlyPrice *resource.Quantity
 }
 
 // MachineTypeStorage is the amount of storage associated with the root volume of this machine type.
@@ -300,6 +303,21 @@ type ExpirableVersionStatus struct {
 	Version string
 	// Classification reflects the current state in the classification lifecycle.
 	Classification VersionClassification
+	// Rollout contains the state of the staged rollout of this version across the fleet of Shoots.
+	Rollout *VersionRolloutStatus
+}
+
+// VersionRolloutStatus contains the state of the staged rollout of a version.
+type VersionRolloutStatus struct {
+	// Wave is the name of the last rollout wave for which the version is eligible for automatic updates.
+	Wave string
+	// LastTransitionTime is the time when the version became eligible for the wave or when the soak period was last
+	// restarted.
+	LastTransitionTime metav1.Time
+	// Halted indicates whether the rollout of the version is halted because Shoots running it are unhealthy.
+	Halted bool
+	// Message is a human-readable message describing the current state of the rollout.
+	Message string
 }
 
 // Limits configures operational limits for Shoot clusters using this CloudProfile.
@@ -5,7 +5,9 @@
 package core
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -63,6 +65,9 @@ type ProjectSpec struct {
 	Tolerations *ProjectTolerations
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	DualApprovalForDeletion []DualApprovalForDeletion
+	// MaintenanceFreezes contains periods during which the automatic maintenance of the shoots in this project is not
+	// performed.
+	MaintenanceFreezes []MaintenanceFreeze
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -80,6 +85,53 @@ type ProjectStatus struct {
 	LastActivityTimestamp *metav1.Time
 	// Conditions represents the latest available observations of a Project's current state.
 	Conditions []Condition
+	// Usage contains the resources consumed by the shoots of the project.
+	Usage *ProjectUsage
+	// QuotaSoftLimitBreaches contains the soft limits of quotas which are exceeded by the shoots of the project.
+	QuotaSoftLimitBreaches []QuotaSoftLimitBreach
+}
+
+// QuotaSoftLimitBreach contains information about a soft limit of a quota which is exceeded by the shoots of a project.
+type QuotaSoftLimitBreach struct {
+	// Quota is a reference to the quota.
+	Quota corev1.ObjectReference
+	// Resource is the name of the resource whose soft limit is exceeded.
+	Resource corev1.ResourceName
+	// Since is the time when the soft limit was first observed to be exceeded. It marks the start of the grace period
+	// of the quota for the resource.
+	Since metav1.Time
+}
+
+// ProjectUsage contains the resources consumed by the shoots of a project.
+type ProjectUsage struct {
+	// Shoots is the number of shoots in the project.
+	Shoots int32
+	// Nodes is the number of nodes observed for the shoots in the project.
+	Nodes int32
+	// Workers contains the resources of the nodes observed for the shoots in the project.
+	Workers corev1.ResourceList
+	// ControlPlanes contains the resources requested by the control planes of the shoots in the project.
+	ControlPlanes corev1.ResourceList
+	// EstimatedHourlyCost is the estimated cost of the nodes observed for the shoots in the project for one hour. It is
+	// only set if hourly prices are maintained for the used machine types in the CloudProfiles.
+	EstimatedHourlyCost *resource.Quantity
+	// Consumption contains the resources consumed by the shoots in the project in the current accounting period.
+	Consumption *ProjectConsumption
+	// LastUpdateTime is the time when the usage was updated the last time.
+	LastUpdateTime metav1.Time
+}
+
+// ProjectConsumption contains the resources consumed by the shoots of a project in an accounting period. The
+// resources are accumulated in resource-hours, e.g., a value of 10 for cpu means 10 CPU core-hours.
+type ProjectConsumption struct {
+	// PeriodStart is the start of the accounting period, i.e., the beginning of the current month (UTC).
+	PeriodStart metav1.Time
+	// Workers contains the resource-hours of the nodes observed for the shoots in the project.
+	Workers corev1.ResourceList
+	// ControlPlanes contains the resource-hours requested by the control planes of the shoots in the project.
+	ControlPlanes corev1.ResourceList
+	// EstimatedCost is the estimated cost of the nodes observed for the shoots in the project in the accounting period.
+	EstimatedCost *resource.Quantity
 }
 
 // ProjectMember is a member of a project.
@@ -119,6 +171,23 @@ type DualApprovalForDeletion struct {
 	IncludeServiceAccounts *bool
 }
 
+// MaintenanceFreeze is a period during which automatic maintenance operations of shoots are not performed. It is either a
+// one-time period (Start and End) or a recurring period (Schedule and Duration).
+type MaintenanceFreeze struct {
+	// Name is the unique name of the freeze.
+	Name string
+	// Start is the beginning of a one-time freeze.
+	Start *metav1.Time
+	// End is the end of a one-time freeze.
+	End *metav1.Time
+	// Schedule is a cron expression describing when a recurring freeze begins.
+	Schedule *string
+	// Duration is the duration of a recurring freeze.
+	Duration *metav1.Duration
+	// Location is the time zone in which the schedule is evaluated.
+	Location *string
+}
+
 const (
 	// ProjectMemberAdmin is a const for a role that provides full admin access.
 	ProjectMemberAdmin = "admin"
@@ -157,4 +226,14 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventQuotaSoftLimitsExceeded indicates that the soft limits of quotas used by the project are exceeded.
+	ProjectEventQuotaSoftLimitsExceeded = "QuotaSoftLimitsExceeded"
+	// ProjectEventQuotaSoftLimitsRecovered indicates that the soft limits of quotas used by the project are no longer
+	// exceeded.
+	ProjectEventQuotaSoftLimitsRecovered = "QuotaSoftLimitsRecovered"
+
+	// ProjectQuotaSoftLimitsExceeded is a constant for a condition type indicating that the soft limits of quotas used by
+	// the project are exceeded. The grace periods of the quotas start individually per quota and resource, see
+	// '.status.quotaSoftLimitBreaches'.
+	ProjectQuotaSoftLimitsExceeded ConditionType = "QuotaSoftLimitsExceeded"
 )
@@ -42,6 +42,13 @@ type QuotaSpec struct {
 	Metrics corev1.ResourceList
 	// Scope is the scope of the Quota object, either 'project', 'secret' or 'workloadidentity'. This field is immutable.
 	Scope corev1.ObjectReference
+	// SoftMetrics is a list of resources with soft limits. Requests exceeding a soft limit are admitted with a warning,
+	// and the projects allocating the resources are marked with the QuotaSoftLimitsExceeded condition.
+	SoftMetrics corev1.ResourceList
+	// SoftLimitGracePeriod is the duration for which the soft limits may be exceeded. Afterwards, requests allocating
+	// further resources are rejected until the allocation falls below the soft limits again. If it is not set, exceeding
+	// the soft limits never leads to rejections.
+	SoftLimitGracePeriod *metav1.Duration
 }
 
 const (
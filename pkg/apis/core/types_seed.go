@@ -99,6 +99,34 @@ type SeedStatus struct {
 	ClientCertificateExpirationTimestamp *metav1.Time
 	// LastOperation holds information about the last operation on the Seed.
 	LastOperation *LastOperation
+	// Drain contains information about the progress of draining the seed. It is only set while the seed has the
+	// `seed.gardener.cloud/draining` taint.
+	Drain *SeedDrainStatus
+}
+
+// SeedDrainStatus contains information about the progress of draining a seed.
+type SeedDrainStatus struct {
+	// StartTime is the time when the draining of the seed was started.
+	StartTime metav1.Time
+	// LastUpdateTime is the time when the progress was last updated.
+	LastUpdateTime metav1.Time
+	// RemainingShoots is the number of shoots whose control planes are still hosted by the seed, including the ones
+	// which are currently being migrated.
+	RemainingShoots int32
+	// MigratingShoots is the number of shoots whose control planes are currently being migrated to another seed.
+	MigratingShoots int32
+	// UnschedulableShoots lists the shoots which could not be migrated to another seed.
+	UnschedulableShoots []SeedDrainShoot
+}
+
+// SeedDrainShoot references a shoot which could not be migrated away from a draining seed.
+type SeedDrainShoot struct {
+	// Namespace is the namespace of the shoot.
+	Namespace string
+	// Name is the name of the shoot.
+	Name string
+	// Message describes why the shoot could not be migrated.
+	Message string
 }
 
 // Backup contains the object store configuration for backups for shoot (currently only etcd).
@@ -393,6 +421,9 @@ const (
 	// SeedTaintProtected is a constant for a taint key on a seed that marks it as protected. Protected seeds
 	// may only be used by shoots in the `garden` namespace.
 	SeedTaintProtected = "seed.gardener.cloud/protected"
+	// SeedTaintDraining is a constant for a taint key on a seed that marks it as being drained. Draining seeds are not
+	// considered for scheduling, and the control planes of the shoots hosted by them are migrated to other seeds.
+	SeedTaintDraining = "seed.gardener.cloud/draining"
 )
 
 // SeedVolume contains settings for persistentvolumes created in the seed cluster.
@@ -172,6 +172,13 @@ type ShootStatus struct {
 	InPlaceUpdates *InPlaceUpdatesStatus
 	// ManualWorkerPoolRollout contains information about the worker pool rollout progress.
 	ManualWorkerPoolRollout *ManualWorkerPoolRollout
+	// WorkerPoolsLastMaintenance holds information about the last maintenance operations of the worker pools having
+	// their own maintenance time window.
+	WorkerPoolsLastMaintenance []WorkerPoolLastMaintenance
+	// Hibernation contains information about the hibernation of the Shoot.
+	Hibernation *HibernationStatus
+	// Usage contains the resources observed to be consumed by the Shoot.
+	Usage *ShootUsage
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -186,6 +193,32 @@ type LastMaintenance struct {
 	FailureReason *string
 }
 
+// WorkerPoolLastMaintenance holds information about the last maintenance operations of a worker pool.
+type WorkerPoolLastMaintenance struct {
+	// Name is the name of the worker pool.
+	Name string
+	// LastMaintenance holds information about the last maintenance operations of the worker pool.
+	LastMaintenance LastMaintenance
+}
+
+// ShootUsage contains the resources observed to be consumed by a Shoot.
+type ShootUsage struct {
+	// Workers contains the observed usage of the worker pools.
+	Workers []WorkerPoolUsage
+	// ControlPlane contains the resources requested by the control plane components of the Shoot running in the seed.
+	ControlPlane corev1.ResourceList
+	// LastUpdateTime is the time when the usage was updated the last time.
+	LastUpdateTime metav1.Time
+}
+
+// WorkerPoolUsage contains the observed usage of a worker pool.
+type WorkerPoolUsage struct {
+	// Name is the name of the worker pool.
+	Name string
+	// Nodes is the number of nodes observed for the worker pool.
+	Nodes int32
+}
+
 // NetworkingStatus contains information about cluster networking such as CIDRs.
 type NetworkingStatus struct {
 	// Pods are the CIDRs of the pod network.
@@ -500,6 +533,14 @@ type Hibernation struct {
 	Enabled *bool
 	// Schedules determine the hibernation schedules.
 	Schedules []HibernationSchedule
+	// Inactivity determines when the Shoot is hibernated due to inactivity.
+	Inactivity *HibernationInactivity
+}
+
+// HibernationInactivity determines when a Shoot is hibernated due to inactivity.
+type HibernationInactivity struct {
+	// Timeout is the duration without user activity on the API server of the Shoot after which it is hibernated.
+	Timeout metav1.Duration
 }
 
 // HibernationSchedule determines the hibernation schedule of a Shoot.
@@ -514,6 +555,27 @@ type HibernationSchedule struct {
 	Location *string
 }
 
+// HibernationStatus contains information about the hibernation of a Shoot.
+type HibernationStatus struct {
+	// LastActivityTime is the last time user activity was observed on the API server of the Shoot. Waking up the Shoot
+	// is considered as activity as well.
+	LastActivityTime *metav1.Time
+	// Reason is the reason for the last change of the hibernation settings by the hibernation controller.
+	Reason HibernationReason
+}
+
+// HibernationReason is a type alias for the reason of a hibernation or wake-up triggered by the hibernation controller.
+type HibernationReason string
+
+const (
+	// HibernationReasonSchedule indicates that the Shoot was hibernated or woken up due to its hibernation schedules.
+	HibernationReasonSchedule HibernationReason = "Schedule"
+	// HibernationReasonInactivity indicates that the Shoot was hibernated due to inactivity.
+	HibernationReasonInactivity HibernationReason = "Inactivity"
+	// HibernationReasonWakeUpOnDemand indicates that the Shoot was woken up on demand.
+	HibernationReasonWakeUpOnDemand HibernationReason = "WakeUpOnDemand"
+)
+
 // Kubernetes contains the version and configuration variables for the Shoot control plane.
 type Kubernetes struct {
 	// ClusterAutoscaler contains the configuration flags for the Kubernetes cluster autoscaler.
@@ -1402,6 +1464,20 @@ type Worker struct {
 	// ControlPlane specifies that the shoot cluster control plane components should be running in this worker pool.
 	// This is only relevant for self-hosted shoot clusters.
 	ControlPlane *WorkerControlPlane
+	// Maintenance contains information about the time window for the machine image and Kubernetes version updates of
+	// this worker pool. If not present, the worker pool is maintained in the maintenance time window of the Shoot.
+	Maintenance *WorkerMaintenance
+}
+
+// WorkerMaintenance contains information about the time window for the maintenance operations of a worker pool.
+type WorkerMaintenance struct {
+	// TimeWindow contains information about the time window for the maintenance operations of the worker pool. If not
+	// present, the maintenance time window of the Shoot is used.
+	TimeWindow *MaintenanceTimeWindow
+	// Weekdays restricts the maintenance operations of the worker pool to the given days of the week, e.g. "Sunday".
+	// The day on which the maintenance time window begins is evaluated in the time zone in which its beginning is
+	// specified. If empty, the worker pool is maintained every day.
+	Weekdays []string
 }
 
 // WorkerControlPlane specifies that the shoot cluster control plane components should be running in this worker pool.
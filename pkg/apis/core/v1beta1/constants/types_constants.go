@@ -226,6 +226,9 @@ const (
 	// GardenerMaintenanceOperation is a constant for an annotation on a Shoot that describes a desired operation which
 	// will be performed during maintenance.
 	GardenerMaintenanceOperation = "maintenance.gardener.cloud/operation"
+	// GardenerMaintenanceDeferredWorkerPools is a constant for an annotation on a Shoot listing the worker pools whose
+	// update to the Kubernetes version of the control plane was deferred to their own maintenance time window.
+	GardenerMaintenanceDeferredWorkerPools = "maintenance.gardener.cloud/deferred-worker-pools"
 	// GardenerOperationReconcile is a constant for the value of the operation annotation describing a reconcile
 	// operation.
 	GardenerOperationReconcile = "reconcile"
@@ -340,6 +343,9 @@ const (
 	ShootDisableIstioTLSTermination = "shoot.gardener.cloud/disable-istio-tls-termination"
 	// ShootIsSelfHosted is a constant for a label on a Shoot indicating that it is self-hosted.
 	ShootIsSelfHosted = "shoot.gardener.cloud/self-hosted"
+	// ShootRebalancing is a constant for a label on a Shoot indicating that its control plane may be migrated to another
+	// seed by the seed rebalancer of the gardener-controller-manager (value "enabled").
+	ShootRebalancing = "shoot.gardener.cloud/rebalancing"
 
 	// ShootAlphaControlPlaneScaleDownDisabled is a constant for an annotation on the Shoot resource stating that the
 	// automatic scale-down shall be disabled for the etcd, kube-apiserver, kube-controller-manager.
@@ -360,6 +366,9 @@ const (
 	// is expired. The lifetime can be extended, but at most by the minimal value of the 'clusterLifetimeDays' property
 	// of referenced quotas.
 	ShootExpirationTimestamp = "shoot.gardener.cloud/expiration-timestamp"
+	// ShootExpirationWarningThreshold is an annotation on a Shoot resource whose value represents the smallest expiration
+	// warning threshold (as duration before the expiration timestamp) for which a warning event has already been emitted.
+	ShootExpirationWarningThreshold = "shoot.gardener.cloud/expiration-warning-threshold"
 	// ShootStatus is a constant for a label on a Shoot resource indicating that the Shoot's health.
 	ShootStatus = "shoot.gardener.cloud/status"
 	// FailedShootNeedsRetryOperation is a constant for an annotation on a Shoot in a failed state indicating that a retry operation should be triggered during the next maintenance time window.
@@ -395,6 +404,9 @@ const (
 	// ShootOperationForceInPlaceUpdate is a constant for the value of the operation annotation that must be set
 	// to forcibly trigger an in-place update when a previous update is still in progress.
 	ShootOperationForceInPlaceUpdate = "force-in-place-update"
+	// ShootOperationWakeUp is a constant for an annotation on a Shoot indicating that the hibernated Shoot shall be woken
+	// up on demand.
+	ShootOperationWakeUp = "wake-up"
 	// OperationRotateCredentialsStart is a constant for an annotation indicating that the rotation of all credentials
 	// shall be started. This includes CAs, certificates, kubeconfigs, SSH keypairs, observability credentials, and
 	// ServiceAccount signing key.
@@ -795,6 +807,9 @@ const (
 	LabelControllerRegistrationName = "controllerregistration.core.gardener.cloud/name"
 	// LabelPodMaintenanceRestart is a constant for a label that describes that a pod should be restarted during maintenance.
 	LabelPodMaintenanceRestart = "maintenance.gardener.cloud/restart"
+	// LabelRolloutWave is a constant for a label on a Shoot which explicitly assigns it to a rollout wave of the staged
+	// rollout of Kubernetes and machine image versions.
+	LabelRolloutWave = "maintenance.gardener.cloud/rollout-wave"
 	// LabelCareConditionType is a key for a label on a ManagedResource indicating to which condition type its status
 	// should be aggregated.
 	LabelCareConditionType = "care.gardener.cloud/condition-type"
@@ -1090,6 +1105,11 @@ const (
 	// If they have the lock, they reconcile and release the Lease at the end. If they don't have the lock, they
 	// wait until it is removed again.
 	AnnotationNodeAgentSerialOSCReconciliation = "reconciliation.osc.node-agent.gardener.cloud/serial"
+	// AnnotationNodeAgentReadyForUpdateRequired is an annotation key on the gardener-node-agent Secret containing the
+	// OperatingSystemConfig that should be reconciled. When set, gardener-node-agent instances watching this Secret only
+	// apply changes to their node once it has the InPlaceUpdate condition with reason ReadyForUpdate, even if the changes
+	// don't require an in-place update. This allows rolling out changes node by node.
+	AnnotationNodeAgentReadyForUpdateRequired = "reconciliation.osc.node-agent.gardener.cloud/require-ready-for-update"
 	// NodeAgentsGroup is the identity group for gardener-node-agents when authenticating to the API server.
 	NodeAgentsGroup = "gardener.cloud:node-agents"
 	// NodeAgentUserNamePrefix is the identity username prefix for gardener-node-agent when authenticating to the API server.
@@ -177,8 +177,12 @@ func (*HelmControllerDeployment) ProtoMessage() {}
 
 func (*Hibernation) ProtoMessage() {}
 
+func (*HibernationInactivity) ProtoMessage() {}
+
 func (*HibernationSchedule) ProtoMessage() {}
 
+func (*HibernationStatus) ProtoMessage() {}
+
 func (*HighAvailability) ProtoMessage() {}
 
 func (*HorizontalPodAutoscalerConfig) ProtoMessage() {}
@@ -259,6 +263,8 @@ func (*MaintenanceAutoUpdate) ProtoMessage() {}
 
 func (*MaintenanceCredentialsAutoRotation) ProtoMessage() {}
 
+func (*MaintenanceFreeze) ProtoMessage() {}
+
 func (*MaintenanceRotationConfig) ProtoMessage() {}
 
 func (*MaintenanceTimeWindow) ProtoMessage() {}
@@ -301,6 +307,8 @@ func (*PendingWorkersRollout) ProtoMessage() {}
 
 func (*Project) ProtoMessage() {}
 
+func (*ProjectConsumption) ProtoMessage() {}
+
 func (*ProjectList) ProtoMessage() {}
 
 func (*ProjectMember) ProtoMessage() {}
@@ -311,12 +319,16 @@ func (*ProjectStatus) ProtoMessage() {}
 
 func (*ProjectTolerations) ProtoMessage() {}
 
+func (*ProjectUsage) ProtoMessage() {}
+
 func (*Provider) ProtoMessage() {}
 
 func (*Quota) ProtoMessage() {}
 
 func (*QuotaList) ProtoMessage() {}
 
+func (*QuotaSoftLimitBreach) ProtoMessage() {}
+
 func (*QuotaSpec) ProtoMessage() {}
 
 func (*Region) ProtoMessage() {}
@@ -341,6 +353,10 @@ func (*SeedDNSProvider) ProtoMessage() {}
 
 func (*SeedDNSProviderConfig) ProtoMessage() {}
 
+func (*SeedDrainShoot) ProtoMessage() {}
+
+func (*SeedDrainStatus) ProtoMessage() {}
+
 func (*SeedList) ProtoMessage() {}
 
 func (*SeedNetworks) ProtoMessage() {}
@@ -421,6 +437,8 @@ func (*ShootStatus) ProtoMessage() {}
 
 func (*ShootTemplate) ProtoMessage() {}
 
+func (*ShootUsage) ProtoMessage() {}
+
 func (*StructuredAuthentication) ProtoMessage() {}
 
 func (*StructuredAuthorization) ProtoMessage() {}
@@ -429,6 +447,8 @@ func (*SystemComponents) ProtoMessage() {}
 
 func (*Toleration) ProtoMessage() {}
 
+func (*VersionRolloutStatus) ProtoMessage() {}
+
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 
 func (*Volume) ProtoMessage() {}
@@ -443,6 +463,12 @@ func (*WorkerControlPlane) ProtoMessage() {}
 
 func (*WorkerKubernetes) ProtoMessage() {}
 
+func (*WorkerMaintenance) ProtoMessage() {}
+
+func (*WorkerPoolLastMaintenance) ProtoMessage() {}
+
+func (*WorkerPoolUsage) ProtoMessage() {}
+
 func (*WorkerSystemComponents) ProtoMessage() {}
 
 func (*WorkersSettings) ProtoMessage() {}
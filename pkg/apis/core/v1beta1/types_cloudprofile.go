@@ -226,6 +226,10 @@ type MachineType struct {
 	// MachineControllerManagerSettings contains a subset of the MachineControllerManagerSettings which can be defaulted for a machine type in a CloudProfile.
 	// +optional
 	MachineControllerManager *CloudProfileMachineControllerManagerSettings `json:"machineControllerManager,omitempty" protobuf:"bytes,9,opt,name=machineControllerManager"`
+	// HourlyPrice is the price of running a machine of this type for one hour. It is used for estimating the costs of
+	// shoot clusters. The currency is not specified, hence, it must be the same for all machine types.
+	// +optional
+	HourlyPrice *resource.Quantity `json:"hourlyPrice,omitempty" protobuf:"bytes,10,opt,name=hourlyPrice"`
 }
 
 // MachineTypeStorage is the amount of storage associated with the root volume of this machine type.
@@ -353,6 +357,24 @@ type ExpirableVersionStatus struct {
 	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
 	// Classification reflects the current state in the classification lifecycle.
 	Classification VersionClassification `json:"classification" protobuf:"bytes,2,opt,name=classification,casttype=VersionClassification"`
+	// Rollout contains the state of the staged rollout of this version across the fleet of Shoots.
+	// +optional
+	Rollout *VersionRolloutStatus `json:"rollout,omitempty" protobuf:"bytes,3,opt,name=rollout"`
+}
+
+// VersionRolloutStatus contains the state of the staged rollout of a version.
+type VersionRolloutStatus struct {
+	// Wave is the name of the last rollout wave for which the version is eligible for automatic updates.
+	Wave string `json:"wave" protobuf:"bytes,1,opt,name=wave"`
+	// LastTransitionTime is the time when the version became eligible for the wave or when the soak period was last
+	// restarted.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime" protobuf:"bytes,2,opt,name=lastTransitionTime"`
+	// Halted indicates whether the rollout of the version is halted because Shoots running it are unhealthy.
+	// +optional
+	Halted bool `json:"halted,omitempty" protobuf:"varint,3,opt,name=halted"`
+	// Message is a human-readable message describing the current state of the rollout.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 }
 
 const (
@@ -5,7 +5,9 @@
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -79,6 +81,11 @@ type ProjectSpec struct {
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	// +optional
 	DualApprovalForDeletion []DualApprovalForDeletion `json:"dualApprovalForDeletion,omitempty" protobuf:"bytes,8,opt,name=dualApprovalForDeletion"`
+	// MaintenanceFreezes contains periods during which the automatic maintenance of the shoots in this project is not
+	// performed, e.g. end-of-quarter or holiday freezes. Shoots can still be maintained explicitly via the
+	// `gardener.cloud/operation=maintain` annotation.
+	// +optional
+	MaintenanceFreezes []MaintenanceFreeze `json:"maintenanceFreezes,omitempty" protobuf:"bytes,9,rep,name=maintenanceFreezes"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -103,6 +110,62 @@ type ProjectStatus struct {
 	// +patchStrategy=merge
 	// +optional
 	Conditions []Condition `json:"conditions,omitempty" patchMergeKey:"type" patchStrategy:"merge" protobuf:"bytes,6,rep,name=conditions"`
+	// Usage contains the resources consumed by the shoots of the project.
+	// +optional
+	Usage *ProjectUsage `json:"usage,omitempty" protobuf:"bytes,7,opt,name=usage"`
+	// QuotaSoftLimitBreaches contains the soft limits of quotas which are exceeded by the shoots of the project.
+	// +optional
+	QuotaSoftLimitBreaches []QuotaSoftLimitBreach `json:"quotaSoftLimitBreaches,omitempty" protobuf:"bytes,8,rep,name=quotaSoftLimitBreaches"`
+}
+
+// QuotaSoftLimitBreach contains information about a soft limit of a quota which is exceeded by the shoots of a project.
+type QuotaSoftLimitBreach struct {
+	// Quota is a reference to the quota.
+	Quota corev1.ObjectReference `json:"quota" protobuf:"bytes,1,opt,name=quota"`
+	// Resource is the name of the resource whose soft limit is exceeded.
+	Resource corev1.ResourceName `json:"resource" protobuf:"bytes,2,opt,name=resource,casttype=k8s.io/api/core/v1.ResourceName"`
+	// Since is the time when the soft limit was first observed to be exceeded. It marks the start of the grace period
+	// of the quota for the resource.
+	Since metav1.Time `json:"since" protobuf:"bytes,3,opt,name=since"`
+}
+
+// ProjectUsage contains the resources consumed by the shoots of a project.
+type ProjectUsage struct {
+	// Shoots is the number of shoots in the project.
+	Shoots int32 `json:"shoots" protobuf:"varint,1,opt,name=shoots"`
+	// Nodes is the number of nodes observed for the shoots in the project.
+	Nodes int32 `json:"nodes" protobuf:"varint,2,opt,name=nodes"`
+	// Workers contains the resources of the nodes observed for the shoots in the project.
+	// +optional
+	Workers corev1.ResourceList `json:"workers,omitempty" protobuf:"bytes,3,rep,name=workers,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// ControlPlanes contains the resources requested by the control planes of the shoots in the project.
+	// +optional
+	ControlPlanes corev1.ResourceList `json:"controlPlanes,omitempty" protobuf:"bytes,4,rep,name=controlPlanes,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// EstimatedHourlyCost is the estimated cost of the nodes observed for the shoots in the project for one hour. It is
+	// only set if hourly prices are maintained for the used machine types in the CloudProfiles.
+	// +optional
+	EstimatedHourlyCost *resource.Quantity `json:"estimatedHourlyCost,omitempty" protobuf:"bytes,5,opt,name=estimatedHourlyCost"`
+	// Consumption contains the resources consumed by the shoots in the project in the current accounting period.
+	// +optional
+	Consumption *ProjectConsumption `json:"consumption,omitempty" protobuf:"bytes,6,opt,name=consumption"`
+	// LastUpdateTime is the time when the usage was updated the last time.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,7,opt,name=lastUpdateTime"`
+}
+
+// ProjectConsumption contains the resources consumed by the shoots of a project in an accounting period. The
+// resources are accumulated in resource-hours, e.g., a value of 10 for cpu means 10 CPU core-hours.
+type ProjectConsumption struct {
+	// PeriodStart is the start of the accounting period, i.e., the beginning of the current month (UTC).
+	PeriodStart metav1.Time `json:"periodStart" protobuf:"bytes,1,opt,name=periodStart"`
+	// Workers contains the resource-hours of the nodes observed for the shoots in the project.
+	// +optional
+	Workers corev1.ResourceList `json:"workers,omitempty" protobuf:"bytes,2,rep,name=workers,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// ControlPlanes contains the resource-hours requested by the control planes of the shoots in the project.
+	// +optional
+	ControlPlanes corev1.ResourceList `json:"controlPlanes,omitempty" protobuf:"bytes,3,rep,name=controlPlanes,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// EstimatedCost is the estimated cost of the nodes observed for the shoots in the project in the accounting period.
+	// +optional
+	EstimatedCost *resource.Quantity `json:"estimatedCost,omitempty" protobuf:"bytes,4,opt,name=estimatedCost"`
 }
 
 // ProjectMember is a member of a project.
@@ -157,6 +220,29 @@ type DualApprovalForDeletion struct {
 	IncludeServiceAccounts *bool `json:"includeServiceAccounts,omitempty" protobuf:"varint,3,opt,name=includeServiceAccounts"`
 }
 
+// MaintenanceFreeze is a period during which automatic maintenance operations of shoots are not performed. It is either a
+// one-time period (Start and End) or a recurring period (Schedule and Duration).
+type MaintenanceFreeze struct {
+	// Name is the unique name of the freeze.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Start is the beginning of a one-time freeze. It is mutually exclusive with Schedule.
+	// +optional
+	Start *metav1.Time `json:"start,omitempty" protobuf:"bytes,2,opt,name=start"`
+	// End is the end of a one-time freeze. It is required if Start is set.
+	// +optional
+	End *metav1.Time `json:"end,omitempty" protobuf:"bytes,3,opt,name=end"`
+	// Schedule is a cron expression describing when a recurring freeze begins, e.g. `0 0 24 12 *` for a freeze
+	// starting on December 24th. It is mutually exclusive with Start.
+	// +optional
+	Schedule *string `json:"schedule,omitempty" protobuf:"bytes,4,opt,name=schedule"`
+	// Duration is the duration of a recurring freeze. It is required if Schedule is set.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty" protobuf:"bytes,5,opt,name=duration"`
+	// Location is the time zone in which the schedule is evaluated (default: UTC).
+	// +optional
+	Location *string `json:"location,omitempty" protobuf:"bytes,6,opt,name=location"`
+}
+
 const (
 	// ProjectMemberAdmin is a const for a role that provides full admin access.
 	ProjectMemberAdmin = "admin"
@@ -195,4 +281,14 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventQuotaSoftLimitsExceeded indicates that the soft limits of quotas used by the project are exceeded.
+	ProjectEventQuotaSoftLimitsExceeded = "QuotaSoftLimitsExceeded"
+	// ProjectEventQuotaSoftLimitsRecovered indicates that the soft limits of quotas used by the project are no longer
+	// exceeded.
+	ProjectEventQuotaSoftLimitsRecovered = "QuotaSoftLimitsRecovered"
+
+	// ProjectQuotaSoftLimitsExceeded is a constant for a condition type indicating that the soft limits of quotas used by
+	// the project are exceeded. The grace periods of the quotas start individually per quota and resource, see
+	// '.status.quotaSoftLimitBreaches'.
+	ProjectQuotaSoftLimitsExceeded ConditionType = "QuotaSoftLimitsExceeded"
 )
@@ -46,4 +46,13 @@ type QuotaSpec struct {
 	Metrics corev1.ResourceList `json:"metrics" protobuf:"bytes,2,rep,name=metrics,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
 	// Scope is the scope of the Quota object, either 'project', 'secret' or 'workloadidentity'. This field is immutable.
 	Scope corev1.ObjectReference `json:"scope" protobuf:"bytes,3,opt,name=scope"` // TODO: When graduating the API to v1 consider reworking this field as described in https://github.com/gardener/gardener/issues/9773#issuecomment-2293340267
+	// SoftMetrics is a list of resources with soft limits. Requests exceeding a soft limit are admitted with a warning,
+	// and the projects allocating the resources are marked with the QuotaSoftLimitsExceeded condition.
+	// +optional
+	SoftMetrics corev1.ResourceList `json:"softMetrics,omitempty" protobuf:"bytes,4,rep,name=softMetrics,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// SoftLimitGracePeriod is the duration for which the soft limits may be exceeded. Afterwards, requests allocating
+	// further resources are rejected until the allocation falls below the soft limits again. If it is not set, exceeding
+	// the soft limits never leads to rejections.
+	// +optional
+	SoftLimitGracePeriod *metav1.Duration `json:"softLimitGracePeriod,omitempty" protobuf:"bytes,5,opt,name=softLimitGracePeriod"`
 }
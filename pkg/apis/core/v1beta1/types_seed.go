@@ -125,6 +125,36 @@ type SeedStatus struct {
 	// LastOperation holds information about the last operation on the Seed.
 	// +optional
 	LastOperation *LastOperation `json:"lastOperation,omitempty" protobuf:"bytes,9,opt,name=lastOperation"`
+	// Drain contains information about the progress of draining the seed. It is only set while the seed has the
+	// `seed.gardener.cloud/draining` taint.
+	// +optional
+	Drain *SeedDrainStatus `json:"drain,omitempty" protobuf:"bytes,10,opt,name=drain"`
+}
+
+// SeedDrainStatus contains information about the progress of draining a seed.
+type SeedDrainStatus struct {
+	// StartTime is the time when the draining of the seed was started.
+	StartTime metav1.Time `json:"startTime" protobuf:"bytes,1,opt,name=startTime"`
+	// LastUpdateTime is the time when the progress was last updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,2,opt,name=lastUpdateTime"`
+	// RemainingShoots is the number of shoots whose control planes are still hosted by the seed, including the ones
+	// which are currently being migrated.
+	RemainingShoots int32 `json:"remainingShoots" protobuf:"varint,3,opt,name=remainingShoots"`
+	// MigratingShoots is the number of shoots whose control planes are currently being migrated to another seed.
+	MigratingShoots int32 `json:"migratingShoots" protobuf:"varint,4,opt,name=migratingShoots"`
+	// UnschedulableShoots lists the shoots which could not be migrated to another seed.
+	// +optional
+	UnschedulableShoots []SeedDrainShoot `json:"unschedulableShoots,omitempty" protobuf:"bytes,5,rep,name=unschedulableShoots"`
+}
+
+// SeedDrainShoot references a shoot which could not be migrated away from a draining seed.
+type SeedDrainShoot struct {
+	// Namespace is the namespace of the shoot.
+	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	// Name is the name of the shoot.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// Message describes why the shoot could not be migrated.
+	Message string `json:"message" protobuf:"bytes,3,opt,name=message"`
 }
 
 // Backup contains the object store configuration for backups for shoot (currently only etcd).
@@ -498,6 +528,9 @@ const (
 	// SeedTaintProtected is a constant for a taint key on a seed that marks it as protected. Protected seeds
 	// may only be used by shoots in the `garden` namespace.
 	SeedTaintProtected = "seed.gardener.cloud/protected"
+	// SeedTaintDraining is a constant for a taint key on a seed that marks it as being drained. Draining seeds are not
+	// considered for scheduling, and the control planes of the shoots hosted by them are migrated to other seeds.
+	SeedTaintDraining = "seed.gardener.cloud/draining"
 )
 
 // SeedVolume contains settings for persistentvolumes created in the seed cluster.
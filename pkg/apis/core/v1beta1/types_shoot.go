@@ -228,6 +228,18 @@ type ShootStatus struct {
 	// ManualWorkerPoolRollout contains information about the worker pool rollout progress.
 	// +optional
 	ManualWorkerPoolRollout *ManualWorkerPoolRollout `json:"manualWorkerPoolRollout,omitempty" protobuf:"bytes,21,opt,name=manualWorkerPoolRollout"`
+	// WorkerPoolsLastMaintenance holds information about the last maintenance operations of the worker pools having
+	// their own maintenance time window.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	WorkerPoolsLastMaintenance []WorkerPoolLastMaintenance `json:"workerPoolsLastMaintenance,omitempty" patchMergeKey:"name" patchStrategy:"merge" protobuf:"bytes,22,rep,name=workerPoolsLastMaintenance"`
+	// Hibernation contains information about the hibernation of the Shoot.
+	// +optional
+	Hibernation *HibernationStatus `json:"hibernation,omitempty" protobuf:"bytes,23,opt,name=hibernation"`
+	// Usage contains the resources observed to be consumed by the Shoot.
+	// +optional
+	Usage *ShootUsage `json:"usage,omitempty" protobuf:"bytes,24,opt,name=usage"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -243,6 +255,36 @@ type LastMaintenance struct {
 	FailureReason *string `json:"failureReason,omitempty" protobuf:"bytes,4,opt,name=failureReason"`
 }
 
+// WorkerPoolLastMaintenance holds information about the last maintenance operations of a worker pool.
+type WorkerPoolLastMaintenance struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// LastMaintenance holds information about the last maintenance operations of the worker pool.
+	LastMaintenance LastMaintenance `json:"lastMaintenance" protobuf:"bytes,2,opt,name=lastMaintenance"`
+}
+
+// ShootUsage contains the resources observed to be consumed by a Shoot.
+type ShootUsage struct {
+	// Workers contains the observed usage of the worker pools.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	Workers []WorkerPoolUsage `json:"workers,omitempty" patchMergeKey:"name" patchStrategy:"merge" protobuf:"bytes,1,rep,name=workers"`
+	// ControlPlane contains the resources requested by the control plane components of the Shoot running in the seed.
+	// +optional
+	ControlPlane corev1.ResourceList `json:"controlPlane,omitempty" protobuf:"bytes,2,rep,name=controlPlane,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// LastUpdateTime is the time when the usage was updated the last time.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,3,opt,name=lastUpdateTime"`
+}
+
+// WorkerPoolUsage contains the observed usage of a worker pool.
+type WorkerPoolUsage struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Nodes is the number of nodes observed for the worker pool.
+	Nodes int32 `json:"nodes" protobuf:"varint,2,opt,name=nodes"`
+}
+
 // NetworkingStatus contains information about cluster networking such as CIDRs.
 type NetworkingStatus struct {
 	// Pods are the CIDRs of the pod network.
@@ -619,6 +661,15 @@ type Hibernation struct {
 	// Schedules determine the hibernation schedules.
 	// +optional
 	Schedules []HibernationSchedule `json:"schedules,omitempty" protobuf:"bytes,2,rep,name=schedules"`
+	// Inactivity determines when the Shoot is hibernated due to inactivity.
+	// +optional
+	Inactivity *HibernationInactivity `json:"inactivity,omitempty" protobuf:"bytes,3,opt,name=inactivity"`
+}
+
+// HibernationInactivity determines when a Shoot is hibernated due to inactivity.
+type HibernationInactivity struct {
+	// Timeout is the duration without user activity on the API server of the Shoot after which it is hibernated.
+	Timeout metav1.Duration `json:"timeout" protobuf:"bytes,1,opt,name=timeout"`
 }
 
 // HibernationSchedule determines the hibernation schedule of a Shoot.
@@ -636,6 +687,29 @@ type HibernationSchedule struct {
 	Location *string `json:"location,omitempty" protobuf:"bytes,3,opt,name=location"`
 }
 
+// HibernationStatus contains information about the hibernation of a Shoot.
+type HibernationStatus struct {
+	// LastActivityTime is the last time user activity was observed on the API server of the Shoot. Waking up the Shoot
+	// is considered as activity as well.
+	// +optional
+	LastActivityTime *metav1.Time `json:"lastActivityTime,omitempty" protobuf:"bytes,1,opt,name=lastActivityTime"`
+	// Reason is the reason for the last change of the hibernation settings by the hibernation controller.
+	// +optional
+	Reason HibernationReason `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason,casttype=HibernationReason"`
+}
+
+// HibernationReason is a type alias for the reason of a hibernation or wake-up triggered by the hibernation controller.
+type HibernationReason string
+
+const (
+	// HibernationReasonSchedule indicates that the Shoot was hibernated or woken up due to its hibernation schedules.
+	HibernationReasonSchedule HibernationReason = "Schedule"
+	// HibernationReasonInactivity indicates that the Shoot was hibernated due to inactivity.
+	HibernationReasonInactivity HibernationReason = "Inactivity"
+	// HibernationReasonWakeUpOnDemand indicates that the Shoot was woken up on demand.
+	HibernationReasonWakeUpOnDemand HibernationReason = "WakeUpOnDemand"
+)
+
 // Kubernetes contains the version and configuration variables for the Shoot control plane.
 type Kubernetes struct {
 	// AllowPrivilegedContainers is tombstoned to show why 1 is reserved protobuf tag.
@@ -1828,6 +1902,23 @@ type Worker struct {
 	// This is only relevant for self-hosted shoot clusters.
 	// +optional
 	ControlPlane *WorkerControlPlane `json:"controlPlane,omitempty" protobuf:"bytes,24,opt,name=controlPlane"`
+	// Maintenance contains information about the time window for the machine image and Kubernetes version updates of
+	// this worker pool. If not present, the worker pool is maintained in the maintenance time window of the Shoot.
+	// +optional
+	Maintenance *WorkerMaintenance `json:"maintenance,omitempty" protobuf:"bytes,25,opt,name=maintenance"`
+}
+
+// WorkerMaintenance contains information about the time window for the maintenance operations of a worker pool.
+type WorkerMaintenance struct {
+	// TimeWindow contains information about the time window for the maintenance operations of the worker pool. If not
+	// present, the maintenance time window of the Shoot is used.
+	// +optional
+	TimeWindow *MaintenanceTimeWindow `json:"timeWindow,omitempty" protobuf:"bytes,1,opt,name=timeWindow"`
+	// Weekdays restricts the maintenance operations of the worker pool to the given days of the week, e.g. "Sunday".
+	// The day on which the maintenance time window begins is evaluated in the time zone in which its beginning is
+	// specified. If empty, the worker pool is maintained every day.
+	// +optional
+	Weekdays []string `json:"weekdays,omitempty" protobuf:"bytes,2,rep,name=weekdays"`
 }
 
 // WorkerControlPlane specifies that the shoot cluster control plane components should be running in this worker pool.
@@ -2123,6 +2214,8 @@ type NodeLocalDNS struct {
 const (
 	// ShootMaintenanceFailed indicates that a shoot maintenance operation failed.
 	ShootMaintenanceFailed = "MaintenanceFailed"
+	// ShootMaintenanceSkipped indicates that a shoot maintenance was skipped due to a maintenance freeze.
+	ShootMaintenanceSkipped = "MaintenanceSkipped"
 	// ShootEventImageVersionMaintenance indicates that a maintenance operation regarding the image version has been performed.
 	ShootEventImageVersionMaintenance = "MachineImageVersionMaintenance"
 	// ShootEventK8sVersionMaintenance indicates that a maintenance operation regarding the K8s version has been performed.
@@ -842,6 +842,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HibernationInactivity)(nil), (*core.HibernationInactivity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HibernationInactivity_To_core_HibernationInactivity(a.(*HibernationInactivity), b.(*core.HibernationInactivity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HibernationInactivity)(nil), (*HibernationInactivity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HibernationInactivity_To_v1beta1_HibernationInactivity(a.(*core.HibernationInactivity), b.(*HibernationInactivity), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HibernationSchedule)(nil), (*core.HibernationSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HibernationSchedule_To_core_HibernationSchedule(a.(*HibernationSchedule), b.(*core.HibernationSchedule), scope)
 	}); err != nil {
@@ -852,6 +862,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HibernationStatus)(nil), (*core.HibernationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HibernationStatus_To_core_HibernationStatus(a.(*HibernationStatus), b.(*core.HibernationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HibernationStatus)(nil), (*HibernationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HibernationStatus_To_v1beta1_HibernationStatus(a.(*core.HibernationStatus), b.(*HibernationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HighAvailability)(nil), (*core.HighAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HighAvailability_To_core_HighAvailability(a.(*HighAvailability), b.(*core.HighAvailability), scope)
 	}); err != nil {
@@ -1247,6 +1267,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreeze)(nil), (*core.MaintenanceFreeze)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(a.(*MaintenanceFreeze), b.(*core.MaintenanceFreeze), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceFreeze)(nil), (*MaintenanceFreeze)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(a.(*core.MaintenanceFreeze), b.(*MaintenanceFreeze), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceRotationConfig)(nil), (*core.MaintenanceRotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MaintenanceRotationConfig_To_core_MaintenanceRotationConfig(a.(*MaintenanceRotationConfig), b.(*core.MaintenanceRotationConfig), scope)
 	}); err != nil {
@@ -1457,6 +1487,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectConsumption)(nil), (*core.ProjectConsumption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectConsumption_To_core_ProjectConsumption(a.(*ProjectConsumption), b.(*core.ProjectConsumption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectConsumption)(nil), (*ProjectConsumption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectConsumption_To_v1beta1_ProjectConsumption(a.(*core.ProjectConsumption), b.(*ProjectConsumption), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectList)(nil), (*core.ProjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectList_To_core_ProjectList(a.(*ProjectList), b.(*core.ProjectList), scope)
 	}); err != nil {
@@ -1487,6 +1527,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectUsage)(nil), (*core.ProjectUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectUsage_To_core_ProjectUsage(a.(*ProjectUsage), b.(*core.ProjectUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectUsage)(nil), (*ProjectUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectUsage_To_v1beta1_ProjectUsage(a.(*core.ProjectUsage), b.(*ProjectUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Provider)(nil), (*core.Provider)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Provider_To_core_Provider(a.(*Provider), b.(*core.Provider), scope)
 	}); err != nil {
@@ -1517,6 +1567,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaSoftLimitBreach)(nil), (*core.QuotaSoftLimitBreach)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaSoftLimitBreach_To_core_QuotaSoftLimitBreach(a.(*QuotaSoftLimitBreach), b.(*core.QuotaSoftLimitBreach), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaSoftLimitBreach)(nil), (*QuotaSoftLimitBreach)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaSoftLimitBreach_To_v1beta1_QuotaSoftLimitBreach(a.(*core.QuotaSoftLimitBreach), b.(*QuotaSoftLimitBreach), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*QuotaSpec)(nil), (*core.QuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(a.(*QuotaSpec), b.(*core.QuotaSpec), scope)
 	}); err != nil {
@@ -1637,6 +1697,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedDrainShoot)(nil), (*core.SeedDrainShoot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedDrainShoot_To_core_SeedDrainShoot(a.(*SeedDrainShoot), b.(*core.SeedDrainShoot), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedDrainShoot)(nil), (*SeedDrainShoot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedDrainShoot_To_v1beta1_SeedDrainShoot(a.(*core.SeedDrainShoot), b.(*SeedDrainShoot), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedDrainStatus)(nil), (*core.SeedDrainStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(a.(*SeedDrainStatus), b.(*core.SeedDrainStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedDrainStatus)(nil), (*SeedDrainStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(a.(*core.SeedDrainStatus), b.(*SeedDrainStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedList)(nil), (*core.SeedList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedList_To_core_SeedList(a.(*SeedList), b.(*core.SeedList), scope)
 	}); err != nil {
@@ -2037,6 +2117,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootUsage)(nil), (*core.ShootUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootUsage_To_core_ShootUsage(a.(*ShootUsage), b.(*core.ShootUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootUsage)(nil), (*ShootUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootUsage_To_v1beta1_ShootUsage(a.(*core.ShootUsage), b.(*ShootUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*StructuredAuthentication)(nil), (*core.StructuredAuthentication)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(a.(*StructuredAuthentication), b.(*core.StructuredAuthentication), scope)
 	}); err != nil {
@@ -2077,6 +2167,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VersionRolloutStatus)(nil), (*core.VersionRolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VersionRolloutStatus_To_core_VersionRolloutStatus(a.(*VersionRolloutStatus), b.(*core.VersionRolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionRolloutStatus)(nil), (*VersionRolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionRolloutStatus_To_v1beta1_VersionRolloutStatus(a.(*core.VersionRolloutStatus), b.(*VersionRolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VerticalPodAutoscaler)(nil), (*core.VerticalPodAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(a.(*VerticalPodAutoscaler), b.(*core.VerticalPodAutoscaler), scope)
 	}); err != nil {
@@ -2147,6 +2247,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerMaintenance)(nil), (*core.WorkerMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerMaintenance_To_core_WorkerMaintenance(a.(*WorkerMaintenance), b.(*core.WorkerMaintenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerMaintenance)(nil), (*WorkerMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerMaintenance_To_v1beta1_WorkerMaintenance(a.(*core.WorkerMaintenance), b.(*WorkerMaintenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolLastMaintenance)(nil), (*core.WorkerPoolLastMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerPoolLastMaintenance_To_core_WorkerPoolLastMaintenance(a.(*WorkerPoolLastMaintenance), b.(*core.WorkerPoolLastMaintenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerPoolLastMaintenance)(nil), (*WorkerPoolLastMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerPoolLastMaintenance_To_v1beta1_WorkerPoolLastMaintenance(a.(*core.WorkerPoolLastMaintenance), b.(*WorkerPoolLastMaintenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolUsage)(nil), (*core.WorkerPoolUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerPoolUsage_To_core_WorkerPoolUsage(a.(*WorkerPoolUsage), b.(*core.WorkerPoolUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerPoolUsage)(nil), (*WorkerPoolUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerPoolUsage_To_v1beta1_WorkerPoolUsage(a.(*core.WorkerPoolUsage), b.(*WorkerPoolUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerSystemComponents)(nil), (*core.WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(a.(*WorkerSystemComponents), b.(*core.WorkerSystemComponents), scope)
 	}); err != nil {
@@ -3965,6 +4095,7 @@ func Convert_core_ExpirableVersion_To_v1beta1_ExpirableVersion(in *core.Expirabl
 func autoConvert_v1beta1_ExpirableVersionStatus_To_core_ExpirableVersionStatus(in *ExpirableVersionStatus, out *core.ExpirableVersionStatus, s conversion.Scope) error {
 	out.Version = in.Version
 	out.Classification = core.VersionClassification(in.Classification)
+	out.Rollout = (*core.VersionRolloutStatus)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -3976,6 +4107,7 @@ func Convert_v1beta1_ExpirableVersionStatus_To_core_ExpirableVersionStatus(in *E
 func autoConvert_core_ExpirableVersionStatus_To_v1beta1_ExpirableVersionStatus(in *core.ExpirableVersionStatus, out *ExpirableVersionStatus, s conversion.Scope) error {
 	out.Version = in.Version
 	out.Classification = VersionClassification(in.Classification)
+	out.Rollout = (*VersionRolloutStatus)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -4235,6 +4367,7 @@ func autoConvert_core_HelmControllerDeployment_To_v1beta1_HelmControllerDeployme
 func autoConvert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]core.HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	out.Inactivity = (*core.HibernationInactivity)(unsafe.Pointer(in.Inactivity))
 	return nil
 }
 
@@ -4246,6 +4379,7 @@ func Convert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.
 func autoConvert_core_Hibernation_To_v1beta1_Hibernation(in *core.Hibernation, out *Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	out.Inactivity = (*HibernationInactivity)(unsafe.Pointer(in.Inactivity))
 	return nil
 }
 
@@ -4254,6 +4388,26 @@ func Convert_core_Hibernation_To_v1beta1_Hibernation(in *core.Hibernation, out *
 	return autoConvert_core_Hibernation_To_v1beta1_Hibernation(in, out, s)
 }
 
+func autoConvert_v1beta1_HibernationInactivity_To_core_HibernationInactivity(in *HibernationInactivity, out *core.HibernationInactivity, s conversion.Scope) error {
+	out.Timeout = in.Timeout
+	return nil
+}
+
+// Convert_v1beta1_HibernationInactivity_To_core_HibernationInactivity is an autogenerated conversion function.
+func Convert_v1beta1_HibernationInactivity_To_core_HibernationInactivity(in *HibernationInactivity, out *core.HibernationInactivity, s conversion.Scope) error {
+	return autoConvert_v1beta1_HibernationInactivity_To_core_HibernationInactivity(in, out, s)
+}
+
+func autoConvert_core_HibernationInactivity_To_v1beta1_HibernationInactivity(in *core.HibernationInactivity, out *HibernationInactivity, s conversion.Scope) error {
+	out.Timeout = in.Timeout
+	return nil
+}
+
+// Convert_core_HibernationInactivity_To_v1beta1_HibernationInactivity is an autogenerated conversion function.
+func Convert_core_HibernationInactivity_To_v1beta1_HibernationInactivity(in *core.HibernationInactivity, out *HibernationInactivity, s conversion.Scope) error {
+	return autoConvert_core_HibernationInactivity_To_v1beta1_HibernationInactivity(in, out, s)
+}
+
 func autoConvert_v1beta1_HibernationSchedule_To_core_HibernationSchedule(in *HibernationSchedule, out *core.HibernationSchedule, s conversion.Scope) error {
 	out.Start = (*string)(unsafe.Pointer(in.Start))
 	out.End = (*string)(unsafe.Pointer(in.End))
@@ -4278,6 +4432,28 @@ func Convert_core_HibernationSchedule_To_v1beta1_HibernationSchedule(in *core.Hi
 	return autoConvert_core_HibernationSchedule_To_v1beta1_HibernationSchedule(in, out, s)
 }
 
+func autoConvert_v1beta1_HibernationStatus_To_core_HibernationStatus(in *HibernationStatus, out *core.HibernationStatus, s conversion.Scope) error {
+	out.LastActivityTime = (*metav1.Time)(unsafe.Pointer(in.LastActivityTime))
+	out.Reason = core.HibernationReason(in.Reason)
+	return nil
+}
+
+// Convert_v1beta1_HibernationStatus_To_core_HibernationStatus is an autogenerated conversion function.
+func Convert_v1beta1_HibernationStatus_To_core_HibernationStatus(in *HibernationStatus, out *core.HibernationStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_HibernationStatus_To_core_HibernationStatus(in, out, s)
+}
+
+func autoConvert_core_HibernationStatus_To_v1beta1_HibernationStatus(in *core.HibernationStatus, out *HibernationStatus, s conversion.Scope) error {
+	out.LastActivityTime = (*metav1.Time)(unsafe.Pointer(in.LastActivityTime))
+	out.Reason = HibernationReason(in.Reason)
+	return nil
+}
+
+// Convert_core_HibernationStatus_To_v1beta1_HibernationStatus is an autogenerated conversion function.
+func Convert_core_HibernationStatus_To_v1beta1_HibernationStatus(in *core.HibernationStatus, out *HibernationStatus, s conversion.Scope) error {
+	return autoConvert_core_HibernationStatus_To_v1beta1_HibernationStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_HighAvailability_To_core_HighAvailability(in *HighAvailability, out *core.HighAvailability, s conversion.Scope) error {
 	if err := Convert_v1beta1_FailureTolerance_To_core_FailureTolerance(&in.FailureTolerance, &out.FailureTolerance, s); err != nil {
 		return err
@@ -5350,6 +5526,7 @@ func autoConvert_v1beta1_MachineType_To_core_MachineType(in *MachineType, out *c
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	out.Capabilities = *(*core.Capabilities)(unsafe.Pointer(&in.Capabilities))
 	out.MachineControllerManager = (*core.CloudProfileMachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManager))
+	out.HourlyPrice = (*resource.Quantity)(unsafe.Pointer(in.HourlyPrice))
 	return nil
 }
 
@@ -5368,6 +5545,7 @@ func autoConvert_core_MachineType_To_v1beta1_MachineType(in *core.MachineType, o
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	out.Capabilities = *(*Capabilities)(unsafe.Pointer(&in.Capabilities))
 	out.MachineControllerManager = (*CloudProfileMachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManager))
+	out.HourlyPrice = (*resource.Quantity)(unsafe.Pointer(in.HourlyPrice))
 	return nil
 }
 
@@ -5494,6 +5672,36 @@ func Convert_core_MaintenanceCredentialsAutoRotation_To_v1beta1_MaintenanceCrede
 	return autoConvert_core_MaintenanceCredentialsAutoRotation_To_v1beta1_MaintenanceCredentialsAutoRotation(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in *MaintenanceFreeze, out *core.MaintenanceFreeze, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Start = (*metav1.Time)(unsafe.Pointer(in.Start))
+	out.End = (*metav1.Time)(unsafe.Pointer(in.End))
+	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in *MaintenanceFreeze, out *core.MaintenanceFreeze, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in, out, s)
+}
+
+func autoConvert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in *core.MaintenanceFreeze, out *MaintenanceFreeze, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Start = (*metav1.Time)(unsafe.Pointer(in.Start))
+	out.End = (*metav1.Time)(unsafe.Pointer(in.End))
+	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	return nil
+}
+
+// Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze is an autogenerated conversion function.
+func Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in *core.MaintenanceFreeze, out *MaintenanceFreeze, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in, out, s)
+}
+
 func autoConvert_v1beta1_MaintenanceRotationConfig_To_core_MaintenanceRotationConfig(in *MaintenanceRotationConfig, out *core.MaintenanceRotationConfig, s conversion.Scope) error {
 	out.RotationPeriod = (*metav1.Duration)(unsafe.Pointer(in.RotationPeriod))
 	return nil
@@ -6036,6 +6244,32 @@ func Convert_core_Project_To_v1beta1_Project(in *core.Project, out *Project, s c
 	return autoConvert_core_Project_To_v1beta1_Project(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectConsumption_To_core_ProjectConsumption(in *ProjectConsumption, out *core.ProjectConsumption, s conversion.Scope) error {
+	out.PeriodStart = in.PeriodStart
+	out.Workers = *(*v1.ResourceList)(unsafe.Pointer(&in.Workers))
+	out.ControlPlanes = *(*v1.ResourceList)(unsafe.Pointer(&in.ControlPlanes))
+	out.EstimatedCost = (*resource.Quantity)(unsafe.Pointer(in.EstimatedCost))
+	return nil
+}
+
+// Convert_v1beta1_ProjectConsumption_To_core_ProjectConsumption is an autogenerated conversion function.
+func Convert_v1beta1_ProjectConsumption_To_core_ProjectConsumption(in *ProjectConsumption, out *core.ProjectConsumption, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectConsumption_To_core_ProjectConsumption(in, out, s)
+}
+
+func autoConvert_core_ProjectConsumption_To_v1beta1_ProjectConsumption(in *core.ProjectConsumption, out *ProjectConsumption, s conversion.Scope) error {
+	out.PeriodStart = in.PeriodStart
+	out.Workers = *(*v1.ResourceList)(unsafe.Pointer(&in.Workers))
+	out.ControlPlanes = *(*v1.ResourceList)(unsafe.Pointer(&in.ControlPlanes))
+	out.EstimatedCost = (*resource.Quantity)(unsafe.Pointer(in.EstimatedCost))
+	return nil
+}
+
+// Convert_core_ProjectConsumption_To_v1beta1_ProjectConsumption is an autogenerated conversion function.
+func Convert_core_ProjectConsumption_To_v1beta1_ProjectConsumption(in *core.ProjectConsumption, out *ProjectConsumption, s conversion.Scope) error {
+	return autoConvert_core_ProjectConsumption_To_v1beta1_ProjectConsumption(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectList_To_core_ProjectList(in *ProjectList, out *core.ProjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -6110,6 +6344,7 @@ func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *c
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*core.ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]core.DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.MaintenanceFreezes = *(*[]core.MaintenanceFreeze)(unsafe.Pointer(&in.MaintenanceFreezes))
 	return nil
 }
 
@@ -6132,6 +6367,7 @@ func autoConvert_core_ProjectSpec_To_v1beta1_ProjectSpec(in *core.ProjectSpec, o
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.MaintenanceFreezes = *(*[]MaintenanceFreeze)(unsafe.Pointer(&in.MaintenanceFreezes))
 	return nil
 }
 
@@ -6142,6 +6378,8 @@ func autoConvert_v1beta1_ProjectStatus_To_core_ProjectStatus(in *ProjectStatus,
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
+	out.Usage = (*core.ProjectUsage)(unsafe.Pointer(in.Usage))
+	out.QuotaSoftLimitBreaches = *(*[]core.QuotaSoftLimitBreach)(unsafe.Pointer(&in.QuotaSoftLimitBreaches))
 	return nil
 }
 
@@ -6157,6 +6395,8 @@ func autoConvert_core_ProjectStatus_To_v1beta1_ProjectStatus(in *core.ProjectSta
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
 	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
+	out.Usage = (*ProjectUsage)(unsafe.Pointer(in.Usage))
+	out.QuotaSoftLimitBreaches = *(*[]QuotaSoftLimitBreach)(unsafe.Pointer(&in.QuotaSoftLimitBreaches))
 	return nil
 }
 
@@ -6187,6 +6427,38 @@ func Convert_core_ProjectTolerations_To_v1beta1_ProjectTolerations(in *core.Proj
 	return autoConvert_core_ProjectTolerations_To_v1beta1_ProjectTolerations(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectUsage_To_core_ProjectUsage(in *ProjectUsage, out *core.ProjectUsage, s conversion.Scope) error {
+	out.Shoots = in.Shoots
+	out.Nodes = in.Nodes
+	out.Workers = *(*v1.ResourceList)(unsafe.Pointer(&in.Workers))
+	out.ControlPlanes = *(*v1.ResourceList)(unsafe.Pointer(&in.ControlPlanes))
+	out.EstimatedHourlyCost = (*resource.Quantity)(unsafe.Pointer(in.EstimatedHourlyCost))
+	out.Consumption = (*core.ProjectConsumption)(unsafe.Pointer(in.Consumption))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_ProjectUsage_To_core_ProjectUsage is an autogenerated conversion function.
+func Convert_v1beta1_ProjectUsage_To_core_ProjectUsage(in *ProjectUsage, out *core.ProjectUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectUsage_To_core_ProjectUsage(in, out, s)
+}
+
+func autoConvert_core_ProjectUsage_To_v1beta1_ProjectUsage(in *core.ProjectUsage, out *ProjectUsage, s conversion.Scope) error {
+	out.Shoots = in.Shoots
+	out.Nodes = in.Nodes
+	out.Workers = *(*v1.ResourceList)(unsafe.Pointer(&in.Workers))
+	out.ControlPlanes = *(*v1.ResourceList)(unsafe.Pointer(&in.ControlPlanes))
+	out.EstimatedHourlyCost = (*resource.Quantity)(unsafe.Pointer(in.EstimatedHourlyCost))
+	out.Consumption = (*ProjectConsumption)(unsafe.Pointer(in.Consumption))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_ProjectUsage_To_v1beta1_ProjectUsage is an autogenerated conversion function.
+func Convert_core_ProjectUsage_To_v1beta1_ProjectUsage(in *core.ProjectUsage, out *ProjectUsage, s conversion.Scope) error {
+	return autoConvert_core_ProjectUsage_To_v1beta1_ProjectUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_Provider_To_core_Provider(in *Provider, out *core.Provider, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ControlPlaneConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ControlPlaneConfig))
@@ -6283,10 +6555,36 @@ func Convert_core_QuotaList_To_v1beta1_QuotaList(in *core.QuotaList, out *QuotaL
 	return autoConvert_core_QuotaList_To_v1beta1_QuotaList(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaSoftLimitBreach_To_core_QuotaSoftLimitBreach(in *QuotaSoftLimitBreach, out *core.QuotaSoftLimitBreach, s conversion.Scope) error {
+	out.Quota = in.Quota
+	out.Resource = v1.ResourceName(in.Resource)
+	out.Since = in.Since
+	return nil
+}
+
+// Convert_v1beta1_QuotaSoftLimitBreach_To_core_QuotaSoftLimitBreach is an autogenerated conversion function.
+func Convert_v1beta1_QuotaSoftLimitBreach_To_core_QuotaSoftLimitBreach(in *QuotaSoftLimitBreach, out *core.QuotaSoftLimitBreach, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaSoftLimitBreach_To_core_QuotaSoftLimitBreach(in, out, s)
+}
+
+func autoConvert_core_QuotaSoftLimitBreach_To_v1beta1_QuotaSoftLimitBreach(in *core.QuotaSoftLimitBreach, out *QuotaSoftLimitBreach, s conversion.Scope) error {
+	out.Quota = in.Quota
+	out.Resource = v1.ResourceName(in.Resource)
+	out.Since = in.Since
+	return nil
+}
+
+// Convert_core_QuotaSoftLimitBreach_To_v1beta1_QuotaSoftLimitBreach is an autogenerated conversion function.
+func Convert_core_QuotaSoftLimitBreach_To_v1beta1_QuotaSoftLimitBreach(in *core.QuotaSoftLimitBreach, out *QuotaSoftLimitBreach, s conversion.Scope) error {
+	return autoConvert_core_QuotaSoftLimitBreach_To_v1beta1_QuotaSoftLimitBreach(in, out, s)
+}
+
 func autoConvert_v1beta1_QuotaSpec_To_core_QuotaSpec(in *QuotaSpec, out *core.QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int32)(unsafe.Pointer(in.ClusterLifetimeDays))
 	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	out.Scope = in.Scope
+	out.SoftMetrics = *(*v1.ResourceList)(unsafe.Pointer(&in.SoftMetrics))
+	out.SoftLimitGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.SoftLimitGracePeriod))
 	return nil
 }
 
@@ -6299,6 +6597,8 @@ func autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *Qu
 	out.ClusterLifetimeDays = (*int32)(unsafe.Pointer(in.ClusterLifetimeDays))
 	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	out.Scope = in.Scope
+	out.SoftMetrics = *(*v1.ResourceList)(unsafe.Pointer(&in.SoftMetrics))
+	out.SoftLimitGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.SoftLimitGracePeriod))
 	return nil
 }
 
@@ -6571,6 +6871,58 @@ func Convert_core_SeedDNSProviderConfig_To_v1beta1_SeedDNSProviderConfig(in *cor
 	return autoConvert_core_SeedDNSProviderConfig_To_v1beta1_SeedDNSProviderConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedDrainShoot_To_core_SeedDrainShoot(in *SeedDrainShoot, out *core.SeedDrainShoot, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_SeedDrainShoot_To_core_SeedDrainShoot is an autogenerated conversion function.
+func Convert_v1beta1_SeedDrainShoot_To_core_SeedDrainShoot(in *SeedDrainShoot, out *core.SeedDrainShoot, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedDrainShoot_To_core_SeedDrainShoot(in, out, s)
+}
+
+func autoConvert_core_SeedDrainShoot_To_v1beta1_SeedDrainShoot(in *core.SeedDrainShoot, out *SeedDrainShoot, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_core_SeedDrainShoot_To_v1beta1_SeedDrainShoot is an autogenerated conversion function.
+func Convert_core_SeedDrainShoot_To_v1beta1_SeedDrainShoot(in *core.SeedDrainShoot, out *SeedDrainShoot, s conversion.Scope) error {
+	return autoConvert_core_SeedDrainShoot_To_v1beta1_SeedDrainShoot(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(in *SeedDrainStatus, out *core.SeedDrainStatus, s conversion.Scope) error {
+	out.StartTime = in.StartTime
+	out.LastUpdateTime = in.LastUpdateTime
+	out.RemainingShoots = in.RemainingShoots
+	out.MigratingShoots = in.MigratingShoots
+	out.UnschedulableShoots = *(*[]core.SeedDrainShoot)(unsafe.Pointer(&in.UnschedulableShoots))
+	return nil
+}
+
+// Convert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus is an autogenerated conversion function.
+func Convert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(in *SeedDrainStatus, out *core.SeedDrainStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(in, out, s)
+}
+
+func autoConvert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(in *core.SeedDrainStatus, out *SeedDrainStatus, s conversion.Scope) error {
+	out.StartTime = in.StartTime
+	out.LastUpdateTime = in.LastUpdateTime
+	out.RemainingShoots = in.RemainingShoots
+	out.MigratingShoots = in.MigratingShoots
+	out.UnschedulableShoots = *(*[]SeedDrainShoot)(unsafe.Pointer(&in.UnschedulableShoots))
+	return nil
+}
+
+// Convert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus is an autogenerated conversion function.
+func Convert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(in *core.SeedDrainStatus, out *SeedDrainStatus, s conversion.Scope) error {
+	return autoConvert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedList_To_core_SeedList(in *SeedList, out *core.SeedList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -7053,6 +7405,7 @@ func autoConvert_v1beta1_SeedStatus_To_core_SeedStatus(in *SeedStatus, out *core
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.Drain = (*core.SeedDrainStatus)(unsafe.Pointer(in.Drain))
 	return nil
 }
 
@@ -7071,6 +7424,7 @@ func autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.Drain = (*SeedDrainStatus)(unsafe.Pointer(in.Drain))
 	return nil
 }
 
@@ -7638,6 +7992,9 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.Networking = (*core.NetworkingStatus)(unsafe.Pointer(in.Networking))
 	out.InPlaceUpdates = (*core.InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
 	out.ManualWorkerPoolRollout = (*core.ManualWorkerPoolRollout)(unsafe.Pointer(in.ManualWorkerPoolRollout))
+	out.WorkerPoolsLastMaintenance = *(*[]core.WorkerPoolLastMaintenance)(unsafe.Pointer(&in.WorkerPoolsLastMaintenance))
+	out.Hibernation = (*core.HibernationStatus)(unsafe.Pointer(in.Hibernation))
+	out.Usage = (*core.ShootUsage)(unsafe.Pointer(in.Usage))
 	return nil
 }
 
@@ -7669,6 +8026,9 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.Networking = (*NetworkingStatus)(unsafe.Pointer(in.Networking))
 	out.InPlaceUpdates = (*InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
 	out.ManualWorkerPoolRollout = (*ManualWorkerPoolRollout)(unsafe.Pointer(in.ManualWorkerPoolRollout))
+	out.WorkerPoolsLastMaintenance = *(*[]WorkerPoolLastMaintenance)(unsafe.Pointer(&in.WorkerPoolsLastMaintenance))
+	out.Hibernation = (*HibernationStatus)(unsafe.Pointer(in.Hibernation))
+	out.Usage = (*ShootUsage)(unsafe.Pointer(in.Usage))
 	return nil
 }
 
@@ -7703,6 +8063,30 @@ func Convert_core_ShootTemplate_To_v1beta1_ShootTemplate(in *core.ShootTemplate,
 	return autoConvert_core_ShootTemplate_To_v1beta1_ShootTemplate(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootUsage_To_core_ShootUsage(in *ShootUsage, out *core.ShootUsage, s conversion.Scope) error {
+	out.Workers = *(*[]core.WorkerPoolUsage)(unsafe.Pointer(&in.Workers))
+	out.ControlPlane = *(*v1.ResourceList)(unsafe.Pointer(&in.ControlPlane))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_ShootUsage_To_core_ShootUsage is an autogenerated conversion function.
+func Convert_v1beta1_ShootUsage_To_core_ShootUsage(in *ShootUsage, out *core.ShootUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootUsage_To_core_ShootUsage(in, out, s)
+}
+
+func autoConvert_core_ShootUsage_To_v1beta1_ShootUsage(in *core.ShootUsage, out *ShootUsage, s conversion.Scope) error {
+	out.Workers = *(*[]WorkerPoolUsage)(unsafe.Pointer(&in.Workers))
+	out.ControlPlane = *(*v1.ResourceList)(unsafe.Pointer(&in.ControlPlane))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_ShootUsage_To_v1beta1_ShootUsage is an autogenerated conversion function.
+func Convert_core_ShootUsage_To_v1beta1_ShootUsage(in *core.ShootUsage, out *ShootUsage, s conversion.Scope) error {
+	return autoConvert_core_ShootUsage_To_v1beta1_ShootUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_StructuredAuthentication_To_core_StructuredAuthentication(in *StructuredAuthentication, out *core.StructuredAuthentication, s conversion.Scope) error {
 	out.ConfigMapName = in.ConfigMapName
 	return nil
@@ -7789,6 +8173,32 @@ func Convert_core_Toleration_To_v1beta1_Toleration(in *core.Toleration, out *Tol
 	return autoConvert_core_Toleration_To_v1beta1_Toleration(in, out, s)
 }
 
+func autoConvert_v1beta1_VersionRolloutStatus_To_core_VersionRolloutStatus(in *VersionRolloutStatus, out *core.VersionRolloutStatus, s conversion.Scope) error {
+	out.Wave = in.Wave
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Halted = in.Halted
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_VersionRolloutStatus_To_core_VersionRolloutStatus is an autogenerated conversion function.
+func Convert_v1beta1_VersionRolloutStatus_To_core_VersionRolloutStatus(in *VersionRolloutStatus, out *core.VersionRolloutStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_VersionRolloutStatus_To_core_VersionRolloutStatus(in, out, s)
+}
+
+func autoConvert_core_VersionRolloutStatus_To_v1beta1_VersionRolloutStatus(in *core.VersionRolloutStatus, out *VersionRolloutStatus, s conversion.Scope) error {
+	out.Wave = in.Wave
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Halted = in.Halted
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_core_VersionRolloutStatus_To_v1beta1_VersionRolloutStatus is an autogenerated conversion function.
+func Convert_core_VersionRolloutStatus_To_v1beta1_VersionRolloutStatus(in *core.VersionRolloutStatus, out *VersionRolloutStatus, s conversion.Scope) error {
+	return autoConvert_core_VersionRolloutStatus_To_v1beta1_VersionRolloutStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *VerticalPodAutoscaler, out *core.VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
@@ -7958,6 +8368,7 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	out.UpdateStrategy = (*core.MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	out.ControlPlane = (*core.WorkerControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.Maintenance = (*core.WorkerMaintenance)(unsafe.Pointer(in.Maintenance))
 	return nil
 }
 
@@ -8001,6 +8412,7 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	out.UpdateStrategy = (*MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	out.ControlPlane = (*WorkerControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.Maintenance = (*WorkerMaintenance)(unsafe.Pointer(in.Maintenance))
 	return nil
 }
 
@@ -8069,6 +8481,76 @@ func Convert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in *core.WorkerKu
 	return autoConvert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerMaintenance_To_core_WorkerMaintenance(in *WorkerMaintenance, out *core.WorkerMaintenance, s conversion.Scope) error {
+	out.TimeWindow = (*core.MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
+	return nil
+}
+
+// Convert_v1beta1_WorkerMaintenance_To_core_WorkerMaintenance is an autogenerated conversion function.
+func Convert_v1beta1_WorkerMaintenance_To_core_WorkerMaintenance(in *WorkerMaintenance, out *core.WorkerMaintenance, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerMaintenance_To_core_WorkerMaintenance(in, out, s)
+}
+
+func autoConvert_core_WorkerMaintenance_To_v1beta1_WorkerMaintenance(in *core.WorkerMaintenance, out *WorkerMaintenance, s conversion.Scope) error {
+	out.TimeWindow = (*MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
+	return nil
+}
+
+// Convert_core_WorkerMaintenance_To_v1beta1_WorkerMaintenance is an autogenerated conversion function.
+func Convert_core_WorkerMaintenance_To_v1beta1_WorkerMaintenance(in *core.WorkerMaintenance, out *WorkerMaintenance, s conversion.Scope) error {
+	return autoConvert_core_WorkerMaintenance_To_v1beta1_WorkerMaintenance(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerPoolLastMaintenance_To_core_WorkerPoolLastMaintenance(in *WorkerPoolLastMaintenance, out *core.WorkerPoolLastMaintenance, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1beta1_LastMaintenance_To_core_LastMaintenance(&in.LastMaintenance, &out.LastMaintenance, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_WorkerPoolLastMaintenance_To_core_WorkerPoolLastMaintenance is an autogenerated conversion function.
+func Convert_v1beta1_WorkerPoolLastMaintenance_To_core_WorkerPoolLastMaintenance(in *WorkerPoolLastMaintenance, out *core.WorkerPoolLastMaintenance, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerPoolLastMaintenance_To_core_WorkerPoolLastMaintenance(in, out, s)
+}
+
+func autoConvert_core_WorkerPoolLastMaintenance_To_v1beta1_WorkerPoolLastMaintenance(in *core.WorkerPoolLastMaintenance, out *WorkerPoolLastMaintenance, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_core_LastMaintenance_To_v1beta1_LastMaintenance(&in.LastMaintenance, &out.LastMaintenance, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_WorkerPoolLastMaintenance_To_v1beta1_WorkerPoolLastMaintenance is an autogenerated conversion function.
+func Convert_core_WorkerPoolLastMaintenance_To_v1beta1_WorkerPoolLastMaintenance(in *core.WorkerPoolLastMaintenance, out *WorkerPoolLastMaintenance, s conversion.Scope) error {
+	return autoConvert_core_WorkerPoolLastMaintenance_To_v1beta1_WorkerPoolLastMaintenance(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerPoolUsage_To_core_WorkerPoolUsage(in *WorkerPoolUsage, out *core.WorkerPoolUsage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Nodes = in.Nodes
+	return nil
+}
+
+// Convert_v1beta1_WorkerPoolUsage_To_core_WorkerPoolUsage is an autogenerated conversion function.
+func Convert_v1beta1_WorkerPoolUsage_To_core_WorkerPoolUsage(in *WorkerPoolUsage, out *core.WorkerPoolUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerPoolUsage_To_core_WorkerPoolUsage(in, out, s)
+}
+
+func autoConvert_core_WorkerPoolUsage_To_v1beta1_WorkerPoolUsage(in *core.WorkerPoolUsage, out *WorkerPoolUsage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Nodes = in.Nodes
+	return nil
+}
+
+// Convert_core_WorkerPoolUsage_To_v1beta1_WorkerPoolUsage is an autogenerated conversion function.
+func Convert_core_WorkerPoolUsage_To_v1beta1_WorkerPoolUsage(in *core.WorkerPoolUsage, out *WorkerPoolUsage, s conversion.Scope) error {
+	return autoConvert_core_WorkerPoolUsage_To_v1beta1_WorkerPoolUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(in *WorkerSystemComponents, out *core.WorkerSystemComponents, s conversion.Scope) error {
 	out.Allow = in.Allow
 	return nil
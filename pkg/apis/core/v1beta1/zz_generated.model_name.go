@@ -424,11 +424,21 @@ func (in Hibernation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Hibernation"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in HibernationInactivity) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.HibernationInactivity"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in HibernationSchedule) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.HibernationSchedule"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in HibernationStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.HibernationStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in HighAvailability) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.HighAvailability"
@@ -629,6 +639,11 @@ func (in MaintenanceCredentialsAutoRotation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceCredentialsAutoRotation"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MaintenanceFreeze) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceFreeze"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in MaintenanceRotationConfig) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceRotationConfig"
@@ -734,6 +749,11 @@ func (in Project) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Project"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ProjectConsumption) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ProjectConsumption"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ProjectList) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ProjectList"
@@ -759,6 +779,11 @@ func (in ProjectTolerations) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ProjectTolerations"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ProjectUsage) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ProjectUsage"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Provider) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Provider"
@@ -774,6 +799,11 @@ func (in QuotaList) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.QuotaList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in QuotaSoftLimitBreach) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.QuotaSoftLimitBreach"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in QuotaSpec) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec"
@@ -834,6 +864,16 @@ func (in SeedDNSProviderConfig) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedDNSProviderConfig"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedDrainShoot) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedDrainShoot"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedDrainStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedDrainStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in SeedList) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedList"
@@ -1034,6 +1074,11 @@ func (in ShootTemplate) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootTemplate"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootUsage) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootUsage"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in StructuredAuthentication) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.StructuredAuthentication"
@@ -1054,6 +1099,11 @@ func (in Toleration) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Toleration"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VersionRolloutStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.VersionRolloutStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in VerticalPodAutoscaler) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.VerticalPodAutoscaler"
@@ -1089,6 +1139,21 @@ func (in WorkerKubernetes) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.WorkerKubernetes"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkerMaintenance) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.WorkerMaintenance"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkerPoolLastMaintenance) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.WorkerPoolLastMaintenance"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkerPoolUsage) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.WorkerPoolUsage"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in WorkerSystemComponents) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.WorkerSystemComponents"
@@ -2073,6 +2073,11 @@ func (in *ExpirableVersion) DeepCopy() *ExpirableVersion {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExpirableVersionStatus) DeepCopyInto(out *ExpirableVersionStatus) {
 	*out = *in
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(VersionRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2394,6 +2399,11 @@ func (in *Hibernation) DeepCopyInto(out *Hibernation) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Inactivity != nil {
+		in, out := &in.Inactivity, &out.Inactivity
+		*out = new(HibernationInactivity)
+		**out = **in
+	}
 	return
 }
 
@@ -2407,6 +2417,23 @@ func (in *Hibernation) DeepCopy() *Hibernation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationInactivity) DeepCopyInto(out *HibernationInactivity) {
+	*out = *in
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationInactivity.
+func (in *HibernationInactivity) DeepCopy() *HibernationInactivity {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationInactivity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HibernationSchedule) DeepCopyInto(out *HibernationSchedule) {
 	*out = *in
@@ -2438,6 +2465,26 @@ func (in *HibernationSchedule) DeepCopy() *HibernationSchedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationStatus) DeepCopyInto(out *HibernationStatus) {
+	*out = *in
+	if in.LastActivityTime != nil {
+		in, out := &in.LastActivityTime, &out.LastActivityTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationStatus.
+func (in *HibernationStatus) DeepCopy() *HibernationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HighAvailability) DeepCopyInto(out *HighAvailability) {
 	*out = *in
@@ -3298,7 +3345,9 @@ func (in *KubernetesStatus) DeepCopyInto(out *KubernetesStatus) {
 	if in.Versions != nil {
 		in, out := &in.Versions, &out.Versions
 		*out = make([]ExpirableVersionStatus, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -3581,7 +3630,9 @@ func (in *MachineImageStatus) DeepCopyInto(out *MachineImageStatus) {
 	if in.Versions != nil {
 		in, out := &in.Versions, &out.Versions
 		*out = make([]ExpirableVersionStatus, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -3683,6 +3734,11 @@ func (in *MachineType) DeepCopyInto(out *MachineType) {
 		*out = new(CloudProfileMachineControllerManagerSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HourlyPrice != nil {
+		in, out := &in.HourlyPrice, &out.HourlyPrice
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -3831,6 +3887,45 @@ func (in *MaintenanceCredentialsAutoRotation) DeepCopy() *MaintenanceCredentials
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceFreeze) DeepCopyInto(out *MaintenanceFreeze) {
+	*out = *in
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceFreeze.
+func (in *MaintenanceFreeze) DeepCopy() *MaintenanceFreeze {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceFreeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenanceRotationConfig) DeepCopyInto(out *MaintenanceRotationConfig) {
 	*out = *in
@@ -4473,6 +4568,42 @@ func (in *Project) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectConsumption) DeepCopyInto(out *ProjectConsumption) {
+	*out = *in
+	in.PeriodStart.DeepCopyInto(&out.PeriodStart)
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.ControlPlanes != nil {
+		in, out := &in.ControlPlanes, &out.ControlPlanes
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.EstimatedCost != nil {
+		in, out := &in.EstimatedCost, &out.EstimatedCost
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectConsumption.
+func (in *ProjectConsumption) DeepCopy() *ProjectConsumption {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectConsumption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectList) DeepCopyInto(out *ProjectList) {
 	*out = *in
@@ -4575,6 +4706,13 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MaintenanceFreezes != nil {
+		in, out := &in.MaintenanceFreezes, &out.MaintenanceFreezes
+		*out = make([]MaintenanceFreeze, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4610,6 +4748,18 @@ func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(ProjectUsage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QuotaSoftLimitBreaches != nil {
+		in, out := &in.QuotaSoftLimitBreaches, &out.QuotaSoftLimitBreaches
+		*out = make([]QuotaSoftLimitBreach, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4653,6 +4803,47 @@ func (in *ProjectTolerations) DeepCopy() *ProjectTolerations {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectUsage) DeepCopyInto(out *ProjectUsage) {
+	*out = *in
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.ControlPlanes != nil {
+		in, out := &in.ControlPlanes, &out.ControlPlanes
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.EstimatedHourlyCost != nil {
+		in, out := &in.EstimatedHourlyCost, &out.EstimatedHourlyCost
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Consumption != nil {
+		in, out := &in.Consumption, &out.Consumption
+		*out = new(ProjectConsumption)
+		(*in).DeepCopyInto(*out)
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectUsage.
+func (in *ProjectUsage) DeepCopy() *ProjectUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Provider) DeepCopyInto(out *Provider) {
 	*out = *in
@@ -4751,6 +4942,24 @@ func (in *QuotaList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaSoftLimitBreach) DeepCopyInto(out *QuotaSoftLimitBreach) {
+	*out = *in
+	out.Quota = in.Quota
+	in.Since.DeepCopyInto(&out.Since)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaSoftLimitBreach.
+func (in *QuotaSoftLimitBreach) DeepCopy() *QuotaSoftLimitBreach {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaSoftLimitBreach)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
 	*out = *in
@@ -4767,6 +4976,18 @@ func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
 		}
 	}
 	out.Scope = in.Scope
+	if in.SoftMetrics != nil {
+		in, out := &in.SoftMetrics, &out.SoftMetrics
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.SoftLimitGracePeriod != nil {
+		in, out := &in.SoftLimitGracePeriod, &out.SoftLimitGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -5060,6 +5281,45 @@ func (in *SeedDNSProviderConfig) DeepCopy() *SeedDNSProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedDrainShoot) DeepCopyInto(out *SeedDrainShoot) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDrainShoot.
+func (in *SeedDrainShoot) DeepCopy() *SeedDrainShoot {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedDrainShoot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedDrainStatus) DeepCopyInto(out *SeedDrainStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.UnschedulableShoots != nil {
+		in, out := &in.UnschedulableShoots, &out.UnschedulableShoots
+		*out = make([]SeedDrainShoot, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDrainStatus.
+func (in *SeedDrainStatus) DeepCopy() *SeedDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedList) DeepCopyInto(out *SeedList) {
 	*out = *in
@@ -5644,6 +5904,11 @@ func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
 		*out = new(LastOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(SeedDrainStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6367,6 +6632,23 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = new(ManualWorkerPoolRollout)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WorkerPoolsLastMaintenance != nil {
+		in, out := &in.WorkerPoolsLastMaintenance, &out.WorkerPoolsLastMaintenance
+		*out = make([]WorkerPoolLastMaintenance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(ShootUsage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6398,6 +6680,35 @@ func (in *ShootTemplate) DeepCopy() *ShootTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootUsage) DeepCopyInto(out *ShootUsage) {
+	*out = *in
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]WorkerPoolUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootUsage.
+func (in *ShootUsage) DeepCopy() *ShootUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StructuredAuthentication) DeepCopyInto(out *StructuredAuthentication) {
 	*out = *in
@@ -6482,6 +6793,23 @@ func (in *Toleration) DeepCopy() *Toleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionRolloutStatus) DeepCopyInto(out *VersionRolloutStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionRolloutStatus.
+func (in *VersionRolloutStatus) DeepCopy() *VersionRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerticalPodAutoscaler) DeepCopyInto(out *VerticalPodAutoscaler) {
 	*out = *in
@@ -6801,6 +7129,11 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 		*out = new(WorkerControlPlane)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(WorkerMaintenance)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6866,6 +7199,65 @@ func (in *WorkerKubernetes) DeepCopy() *WorkerKubernetes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerMaintenance) DeepCopyInto(out *WorkerMaintenance) {
+	*out = *in
+	if in.TimeWindow != nil {
+		in, out := &in.TimeWindow, &out.TimeWindow
+		*out = new(MaintenanceTimeWindow)
+		**out = **in
+	}
+	if in.Weekdays != nil {
+		in, out := &in.Weekdays, &out.Weekdays
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerMaintenance.
+func (in *WorkerMaintenance) DeepCopy() *WorkerMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolLastMaintenance) DeepCopyInto(out *WorkerPoolLastMaintenance) {
+	*out = *in
+	in.LastMaintenance.DeepCopyInto(&out.LastMaintenance)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolLastMaintenance.
+func (in *WorkerPoolLastMaintenance) DeepCopy() *WorkerPoolLastMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolLastMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolUsage) DeepCopyInto(out *WorkerPoolUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolUsage.
+func (in *WorkerPoolUsage) DeepCopy() *WorkerPoolUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerSystemComponents) DeepCopyInto(out *WorkerSystemComponents) {
 	*out = *in
@@ -261,8 +261,31 @@ type ManagedResourceSpec struct {
 	// resource, should also be deleted when the corresponding StatefulSet is deleted (defaults to false).
 	// +optional
 	DeletePersistentVolumeClaims *bool `json:"deletePersistentVolumeClaims,omitempty"`
+	// ApplyMode specifies how the resources are applied to the target cluster. With `ClientSide`, the resource manager
+	// merges the desired state into the existing objects and updates them. With `ServerSide`, it uses server-side apply
+	// with its own field manager and does not overwrite fields owned by other field managers. Conflicts are reported in
+	// the `ResourcesApplied` condition. ForceOverwriteLabels and ForceOverwriteAnnotations are not considered for
+	// `ServerSide`. Defaults to `ClientSide`.
+	// +kubebuilder:validation:Enum=ClientSide;ServerSide
+	// +optional
+	ApplyMode *ApplyMode `json:"applyMode,omitempty"`
+	// DryRun specifies that the resources are neither applied to nor deleted from the target cluster. Instead, the
+	// changes which would be made to the objects in the target cluster are recorded in the status. Defaults to false.
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
 }
 
+// ApplyMode specifies how the resources of a ManagedResource are applied to the target cluster.
+type ApplyMode string
+
+const (
+	// ApplyModeClientSide is the apply mode in which the resource manager merges the desired state into the existing
+	// objects and updates them.
+	ApplyModeClientSide ApplyMode = "ClientSide"
+	// ApplyModeServerSide is the apply mode in which the resource manager uses server-side apply.
+	ApplyModeServerSide ApplyMode = "ServerSide"
+)
+
 // ManagedResourceStatus is the status of a managed resource.
 type ManagedResourceStatus struct {
 	Conditions []gardencorev1beta1.Condition `json:"conditions,omitempty"`
@@ -274,8 +297,39 @@ type ManagedResourceStatus struct {
 	// SecretsDataChecksum is the checksum of referenced secrets data.
 	// +optional
 	SecretsDataChecksum *string `json:"secretsDataChecksum,omitempty"`
+	// PendingChanges is a list of changes which would be made to the objects in the target cluster. It is only
+	// maintained if the ManagedResource is in dry-run mode.
+	// +optional
+	PendingChanges []PendingChange `json:"pendingChanges,omitempty"`
 }
 
+// PendingChange describes a change which would be made to an object in the target cluster.
+type PendingChange struct {
+	corev1.ObjectReference `json:",inline"`
+
+	// Operation is the operation which would be performed for the object.
+	Operation ChangeOperation `json:"operation"`
+	// Diff is a unified diff between the current and the desired state of the object in YAML format. It is truncated if
+	// it is too long.
+	// +optional
+	Diff string `json:"diff,omitempty"`
+	// Message contains the error in case the desired state of the object could not be computed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ChangeOperation is an operation which would be performed for an object in the target cluster.
+type ChangeOperation string
+
+const (
+	// ChangeOperationCreate means that the object would be created.
+	ChangeOperationCreate ChangeOperation = "Create"
+	// ChangeOperationUpdate means that the object would be updated.
+	ChangeOperationUpdate ChangeOperation = "Update"
+	// ChangeOperationDelete means that the object would be deleted.
+	ChangeOperationDelete ChangeOperation = "Delete"
+)
+
 // ObjectReference is a reference to another object.
 type ObjectReference struct {
 	corev1.ObjectReference `json:",inline"`
@@ -293,6 +347,9 @@ const (
 	ResourcesHealthy gardencorev1beta1.ConditionType = "ResourcesHealthy"
 	// ResourcesProgressing is a condition type that indicates whether some resources are still progressing to be rolled out.
 	ResourcesProgressing gardencorev1beta1.ConditionType = "ResourcesProgressing"
+	// ResourcesDrifted is a condition type that indicates whether fields of some resources have been changed by other
+	// field managers since they were applied.
+	ResourcesDrifted gardencorev1beta1.ConditionType = "ResourcesDrifted"
 )
 
 // These are well-known reasons for Conditions.
@@ -303,6 +360,12 @@ const (
 	// ConditionApplyFailed indicates that the `ResourcesApplied` condition is `False`,
 	// because applying the resources failed.
 	ConditionApplyFailed = "ApplyFailed"
+	// ConditionApplyConflict indicates that the `ResourcesApplied` condition is `False`,
+	// because fields of the resources are owned by other field managers (only for the `ServerSide` apply mode).
+	ConditionApplyConflict = "ApplyConflict"
+	// ConditionDryRun indicates that the `ResourcesApplied` condition is `False`, because the ManagedResource is in
+	// dry-run mode and the resources are not applied.
+	ConditionDryRun = "DryRun"
 	// ConditionDecodingFailed indicates that the `ResourcesApplied` condition is `False`,
 	// because decoding the resources of the ManagedResource failed.
 	ConditionDecodingFailed = "DecodingFailed"
@@ -324,4 +387,10 @@ const (
 	// ConditionChecksPending indicates that the `ResourcesProgressing` condition is `Unknown`,
 	// because the condition checks have not been completely executed yet for the current set of resources.
 	ConditionChecksPending = "ChecksPending"
+	// ConditionDriftDetected indicates that the `ResourcesDrifted` condition is `True`,
+	// because fields of some resources have been changed by other field managers.
+	ConditionDriftDetected = "DriftDetected"
+	// ConditionNoDriftDetected indicates that the `ResourcesDrifted` condition is `False`,
+	// because no changes of other field managers are pending to be reverted.
+	ConditionNoDriftDetected = "NoDriftDetected"
 )
@@ -46,6 +46,18 @@ func ParseMaintenanceTime(value string) (*MaintenanceTime, error) {
 	return timeToMaintenanceTime(t), nil
 }
 
+// ParseMaintenanceTimeLocation parses the given value and returns the time zone in which the maintenance time is
+// specified, i.e., a fixed zone with the offset of the value. In case the parsing fails, an error is returned.
+func ParseMaintenanceTimeLocation(value string) (*time.Location, error) {
+	t, err := time.Parse(maintenanceTimeLayout, value)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the value into the maintenanceTime format: %s", err.Error())
+	}
+
+	_, offset := t.Zone()
+	return time.FixedZone("", offset), nil
+}
+
 func timeToMaintenanceTime(t time.Time) *MaintenanceTime {
 	t = t.UTC()
 	return NewMaintenanceTime(t.Hour(), t.Minute(), t.Second())
@@ -52,6 +52,21 @@ var _ = Describe("utils", func() {
 			})
 		})
 
+		Describe("#ParseMaintenanceTimeLocation", func() {
+			It("should return the time zone of the maintenance time", func() {
+				location, err := ParseMaintenanceTimeLocation("230000+0200")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(time.Date(2025, time.January, 1, 0, 0, 0, 0, location).UTC()).To(Equal(time.Date(2024, time.December, 31, 22, 0, 0, 0, time.UTC)))
+			})
+
+			It("should return an error for an invalid value", func() {
+				_, err := ParseMaintenanceTimeLocation("invalid")
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
 		Describe("#RandomMaintenanceTimeWindow", func() {
 			It("should return the a random time window", func() {
 				rand.Seed(0)
@@ -126,7 +126,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.GardenerResourceData{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_GardenerResourceData(ref),
 		v1beta1.HelmControllerDeployment{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_HelmControllerDeployment(ref),
 		v1beta1.Hibernation{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_Hibernation(ref),
+		v1beta1.HibernationInactivity{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_HibernationInactivity(ref),
 		v1beta1.HibernationSchedule{}.OpenAPIModelName():                          schema_pkg_apis_core_v1beta1_HibernationSchedule(ref),
+		v1beta1.HibernationStatus{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_HibernationStatus(ref),
 		v1beta1.HighAvailability{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_HighAvailability(ref),
 		v1beta1.HorizontalPodAutoscalerConfig{}.OpenAPIModelName():                schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref),
 		v1beta1.InPlaceUpdates{}.OpenAPIModelName():                               schema_pkg_apis_core_v1beta1_InPlaceUpdates(ref),
@@ -167,6 +169,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.MaintenanceAutoRotation{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_MaintenanceAutoRotation(ref),
 		v1beta1.MaintenanceAutoUpdate{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref),
 		v1beta1.MaintenanceCredentialsAutoRotation{}.OpenAPIModelName():           schema_pkg_apis_core_v1beta1_MaintenanceCredentialsAutoRotation(ref),
+		v1beta1.MaintenanceFreeze{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_MaintenanceFreeze(ref),
 		v1beta1.MaintenanceRotationConfig{}.OpenAPIModelName():                    schema_pkg_apis_core_v1beta1_MaintenanceRotationConfig(ref),
 		v1beta1.MaintenanceTimeWindow{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref),
 		v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_ManualWorkerPoolRollout(ref),
@@ -188,14 +191,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.PendingWorkerUpdates{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_PendingWorkerUpdates(ref),
 		v1beta1.PendingWorkersRollout{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_PendingWorkersRollout(ref),
 		v1beta1.Project{}.OpenAPIModelName():                                      schema_pkg_apis_core_v1beta1_Project(ref),
+		v1beta1.ProjectConsumption{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_ProjectConsumption(ref),
 		v1beta1.ProjectList{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_ProjectList(ref),
 		v1beta1.ProjectMember{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_ProjectMember(ref),
 		v1beta1.ProjectSpec{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_ProjectSpec(ref),
 		v1beta1.ProjectStatus{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_ProjectStatus(ref),
 		v1beta1.ProjectTolerations{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_ProjectTolerations(ref),
+		v1beta1.ProjectUsage{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_ProjectUsage(ref),
 		v1beta1.Provider{}.OpenAPIModelName():                                     schema_pkg_apis_core_v1beta1_Provider(ref),
 		v1beta1.Quota{}.OpenAPIModelName():                                        schema_pkg_apis_core_v1beta1_Quota(ref),
 		v1beta1.QuotaList{}.OpenAPIModelName():                                    schema_pkg_apis_core_v1beta1_QuotaList(ref),
+		v1beta1.QuotaSoftLimitBreach{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_QuotaSoftLimitBreach(ref),
 		v1beta1.QuotaSpec{}.OpenAPIModelName():                                    schema_pkg_apis_core_v1beta1_QuotaSpec(ref),
 		v1beta1.Region{}.OpenAPIModelName():                                       schema_pkg_apis_core_v1beta1_Region(ref),
 		v1beta1.ResourceData{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_ResourceData(ref),
@@ -208,6 +214,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.SeedDNS{}.OpenAPIModelName():                                      schema_pkg_apis_core_v1beta1_SeedDNS(ref),
 		v1beta1.SeedDNSProvider{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_SeedDNSProvider(ref),
 		v1beta1.SeedDNSProviderConfig{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_SeedDNSProviderConfig(ref),
+		v1beta1.SeedDrainShoot{}.OpenAPIModelName():                               schema_pkg_apis_core_v1beta1_SeedDrainShoot(ref),
+		v1beta1.SeedDrainStatus{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_SeedDrainStatus(ref),
 		v1beta1.SeedList{}.OpenAPIModelName():                                     schema_pkg_apis_core_v1beta1_SeedList(ref),
 		v1beta1.SeedNetworks{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_SeedNetworks(ref),
 		v1beta1.SeedProvider{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_SeedProvider(ref),
@@ -248,10 +256,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.ShootStateSpec{}.OpenAPIModelName():                               schema_pkg_apis_core_v1beta1_ShootStateSpec(ref),
 		v1beta1.ShootStatus{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_ShootStatus(ref),
 		v1beta1.ShootTemplate{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_ShootTemplate(ref),
+		v1beta1.ShootUsage{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_ShootUsage(ref),
 		v1beta1.StructuredAuthentication{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_StructuredAuthentication(ref),
 		v1beta1.StructuredAuthorization{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_StructuredAuthorization(ref),
 		v1beta1.SystemComponents{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_SystemComponents(ref),
 		v1beta1.Toleration{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_Toleration(ref),
+		v1beta1.VersionRolloutStatus{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_VersionRolloutStatus(ref),
 		v1beta1.VerticalPodAutoscaler{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref),
 		v1beta1.Volume{}.OpenAPIModelName():                                       schema_pkg_apis_core_v1beta1_Volume(ref),
 		v1beta1.VolumeType{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_VolumeType(ref),
@@ -259,6 +269,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.Worker{}.OpenAPIModelName():                                       schema_pkg_apis_core_v1beta1_Worker(ref),
 		v1beta1.WorkerControlPlane{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_WorkerControlPlane(ref),
 		v1beta1.WorkerKubernetes{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref),
+		v1beta1.WorkerMaintenance{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_WorkerMaintenance(ref),
+		v1beta1.WorkerPoolLastMaintenance{}.OpenAPIModelName():                    schema_pkg_apis_core_v1beta1_WorkerPoolLastMaintenance(ref),
+		v1beta1.WorkerPoolUsage{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_WorkerPoolUsage(ref),
 		v1beta1.WorkerSystemComponents{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref),
 		v1beta1.WorkersSettings{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_WorkersSettings(ref),
 		operationsv1alpha1.Bastion{}.OpenAPIModelName():                           schema_pkg_apis_operations_v1alpha1_Bastion(ref),
@@ -4041,10 +4054,18 @@ func schema_pkg_apis_core_v1beta1_ExpirableVersionStatus(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout contains the state of the staged rollout of this version across the fleet of Shoots.",
+							Ref:         ref(v1beta1.VersionRolloutStatus{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"version", "classification"},
 			},
 		},
+		Dependencies: []string{
+			v1beta1.VersionRolloutStatus{}.OpenAPIModelName()},
 	}
 }
 
@@ -4517,11 +4538,39 @@ func schema_pkg_apis_core_v1beta1_Hibernation(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"inactivity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inactivity determines when the Shoot is hibernated due to inactivity.",
+							Ref:         ref(v1beta1.HibernationInactivity{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.HibernationInactivity{}.OpenAPIModelName(), v1beta1.HibernationSchedule{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_HibernationInactivity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernationInactivity determines when a Shoot is hibernated due to inactivity.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the duration without user activity on the API server of the Shoot after which it is hibernated.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
 				},
+				Required: []string{"timeout"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.HibernationSchedule{}.OpenAPIModelName()},
+			metav1.Duration{}.OpenAPIModelName()},
 	}
 }
 
@@ -4559,6 +4608,34 @@ func schema_pkg_apis_core_v1beta1_HibernationSchedule(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_HibernationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernationStatus contains information about the hibernation of a Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastActivityTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastActivityTime is the last time user activity was observed on the API server of the Shoot. Waking up the Shoot is considered as activity as well.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the reason for the last change of the hibernation settings by the hibernation controller.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_HighAvailability(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6382,6 +6459,12 @@ func schema_pkg_apis_core_v1beta1_MachineType(ref common.ReferenceCallback) comm
 							Ref:         ref(v1beta1.CloudProfileMachineControllerManagerSettings{}.OpenAPIModelName()),
 						},
 					},
+					"hourlyPrice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HourlyPrice is the price of running a machine of this type for one hour. It is used for estimating the costs of shoot clusters. The currency is not specified, hence, it must be the same for all machine types.",
+							Ref:         ref(resource.Quantity{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"cpu", "gpu", "memory", "name"},
 			},
@@ -6558,6 +6641,62 @@ func schema_pkg_apis_core_v1beta1_MaintenanceCredentialsAutoRotation(ref common.
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_MaintenanceFreeze(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceFreeze is a period during which automatic maintenance operations of shoots are not performed. It is either a one-time period (Start and End) or a recurring period (Schedule and Duration).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the freeze.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the beginning of a one-time freeze. It is mutually exclusive with Schedule.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the end of a one-time freeze. It is required if Start is set.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a cron expression describing when a recurring freeze begins, e.g. `0 0 24 12 *` for a freeze starting on December 24th. It is mutually exclusive with Start.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the duration of a recurring freeze. It is required if Schedule is set.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"location": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Location is the time zone in which the schedule is evaluated (default: UTC).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_MaintenanceRotationConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7531,6 +7670,62 @@ func schema_pkg_apis_core_v1beta1_Project(ref common.ReferenceCallback) common.O
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ProjectConsumption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectConsumption contains the resources consumed by the shoots of a project in an accounting period. The resources are accumulated in resource-hours, e.g., a value of 10 for cpu means 10 CPU core-hours.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"periodStart": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PeriodStart is the start of the accounting period, i.e., the beginning of the current month (UTC).",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers contains the resource-hours of the nodes observed for the shoots in the project.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"controlPlanes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlanes contains the resource-hours requested by the control planes of the shoots in the project.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"estimatedCost": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EstimatedCost is the estimated cost of the nodes observed for the shoots in the project in the accounting period.",
+							Ref:         ref(resource.Quantity{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"periodStart"},
+			},
+		},
+		Dependencies: []string{
+			resource.Quantity{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7723,11 +7918,25 @@ func schema_pkg_apis_core_v1beta1_ProjectSpec(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"maintenanceFreezes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceFreezes contains periods during which the automatic maintenance of the shoots in this project is not performed, e.g. end-of-quarter or holiday freezes. Shoots can still be maintained explicitly via the `gardener.cloud/operation=maintain` annotation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.MaintenanceFreeze{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.DualApprovalForDeletion{}.OpenAPIModelName(), v1beta1.ProjectMember{}.OpenAPIModelName(), v1beta1.ProjectTolerations{}.OpenAPIModelName(), rbacv1.Subject{}.OpenAPIModelName()},
+			v1beta1.DualApprovalForDeletion{}.OpenAPIModelName(), v1beta1.MaintenanceFreeze{}.OpenAPIModelName(), v1beta1.ProjectMember{}.OpenAPIModelName(), v1beta1.ProjectTolerations{}.OpenAPIModelName(), rbacv1.Subject{}.OpenAPIModelName()},
 	}
 }
 
@@ -7790,11 +7999,31 @@ func schema_pkg_apis_core_v1beta1_ProjectStatus(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"usage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usage contains the resources consumed by the shoots of the project.",
+							Ref:         ref(v1beta1.ProjectUsage{}.OpenAPIModelName()),
+						},
+					},
+					"quotaSoftLimitBreaches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuotaSoftLimitBreaches contains the soft limits of quotas which are exceeded by the shoots of the project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.QuotaSoftLimitBreach{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.Condition{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.ProjectUsage{}.OpenAPIModelName(), v1beta1.QuotaSoftLimitBreach{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -7853,6 +8082,84 @@ func schema_pkg_apis_core_v1beta1_ProjectTolerations(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ProjectUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectUsage contains the resources consumed by the shoots of a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shoots is the number of shoots in the project.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the number of nodes observed for the shoots in the project.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers contains the resources of the nodes observed for the shoots in the project.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"controlPlanes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlanes contains the resources requested by the control planes of the shoots in the project.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"estimatedHourlyCost": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EstimatedHourlyCost is the estimated cost of the nodes observed for the shoots in the project for one hour. It is only set if hourly prices are maintained for the used machine types in the CloudProfiles.",
+							Ref:         ref(resource.Quantity{}.OpenAPIModelName()),
+						},
+					},
+					"consumption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Consumption contains the resources consumed by the shoots in the project in the current accounting period.",
+							Ref:         ref(v1beta1.ProjectConsumption{}.OpenAPIModelName()),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the usage was updated the last time.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"shoots", "nodes", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ProjectConsumption{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Provider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8009,39 +8316,96 @@ func schema_pkg_apis_core_v1beta1_QuotaList(ref common.ReferenceCallback) common
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_QuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_QuotaSoftLimitBreach(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "QuotaSpec is the specification of a Quota.",
+				Description: "QuotaSoftLimitBreach contains information about a soft limit of a quota which is exceeded by the shoots of a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"clusterLifetimeDays": {
+					"quota": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClusterLifetimeDays is the lifetime of a Shoot cluster in days before it will be terminated automatically.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Quota is a reference to the quota.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(corev1.ObjectReference{}.OpenAPIModelName()),
 						},
 					},
-					"metrics": {
+					"resource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Metrics is a list of resources which will be put under constraints.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
-									},
-								},
-							},
+							Description: "Resource is the name of the resource whose soft limit is exceeded.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"scope": {
+					"since": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Scope is the scope of the Quota object, either 'project', 'secret' or 'workloadidentity'. This field is immutable.",
-							Default:     map[string]interface{}{},
-							Ref:         ref(corev1.ObjectReference{}.OpenAPIModelName()),
+							Description: "Since is the time when the soft limit was first observed to be exceeded. It marks the start of the grace period of the quota for the resource.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"quota", "resource", "since"},
+			},
+		},
+		Dependencies: []string{
+			corev1.ObjectReference{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_QuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuotaSpec is the specification of a Quota.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusterLifetimeDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterLifetimeDays is the lifetime of a Shoot cluster in days before it will be terminated automatically.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics is a list of resources which will be put under constraints.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scope is the scope of the Quota object, either 'project', 'secret' or 'workloadidentity'. This field is immutable.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(corev1.ObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"softMetrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SoftMetrics is a list of resources with soft limits. Requests exceeding a soft limit are admitted with a warning, and the projects allocating the resources are marked with the QuotaSoftLimitsExceeded condition.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"softLimitGracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SoftLimitGracePeriod is the duration for which the soft limits may be exceeded. Afterwards, requests allocating further resources are rejected until the allocation falls below the soft limits again. If it is not set, exceeding the soft limits never leads to rejections.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
 						},
 					},
 				},
@@ -8049,7 +8413,7 @@ func schema_pkg_apis_core_v1beta1_QuotaSpec(ref common.ReferenceCallback) common
 			},
 		},
 		Dependencies: []string{
-			corev1.ObjectReference{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName()},
+			corev1.ObjectReference{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName()},
 	}
 }
 
@@ -8535,6 +8899,102 @@ func schema_pkg_apis_core_v1beta1_SeedDNSProviderConfig(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedDrainShoot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedDrainShoot references a shoot which could not be migrated away from a draining seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes why the shoot could not be migrated.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace", "name", "message"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedDrainStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedDrainStatus contains information about the progress of draining a seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time when the draining of the seed was started.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the progress was last updated.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"remainingShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemainingShoots is the number of shoots whose control planes are still hosted by the seed, including the ones which are currently being migrated.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"migratingShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigratingShoots is the number of shoots whose control planes are currently being migrated to another seed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"unschedulableShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UnschedulableShoots lists the shoots which could not be migrated to another seed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.SeedDrainShoot{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"startTime", "lastUpdateTime", "remainingShoots", "migratingShoots"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.SeedDrainShoot{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9480,11 +9940,17 @@ func schema_pkg_apis_core_v1beta1_SeedStatus(ref common.ReferenceCallback) commo
 							Ref:         ref(v1beta1.LastOperation{}.OpenAPIModelName()),
 						},
 					},
+					"drain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Drain contains information about the progress of draining the seed. It is only set while the seed has the `seed.gardener.cloud/draining` taint.",
+							Ref:         ref(v1beta1.SeedDrainStatus{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), v1beta1.SeedDrainStatus{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -10610,12 +11076,44 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							Ref:         ref(v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName()),
 						},
 					},
+					"workerPoolsLastMaintenance": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerPoolsLastMaintenance holds information about the last maintenance operations of the worker pools having their own maintenance time window.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.WorkerPoolLastMaintenance{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"hibernation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hibernation contains information about the hibernation of the Shoot.",
+							Ref:         ref(v1beta1.HibernationStatus{}.OpenAPIModelName()),
+						},
+					},
+					"usage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usage contains the resources observed to be consumed by the Shoot.",
+							Ref:         ref(v1beta1.ShootUsage{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.InPlaceUpdatesStatus{}.OpenAPIModelName(), v1beta1.LastError{}.OpenAPIModelName(), v1beta1.LastMaintenance{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName(), v1beta1.NetworkingStatus{}.OpenAPIModelName(), v1beta1.ShootAdvertisedAddress{}.OpenAPIModelName(), v1beta1.ShootCredentials{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.HibernationStatus{}.OpenAPIModelName(), v1beta1.InPlaceUpdatesStatus{}.OpenAPIModelName(), v1beta1.LastError{}.OpenAPIModelName(), v1beta1.LastMaintenance{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName(), v1beta1.NetworkingStatus{}.OpenAPIModelName(), v1beta1.ShootAdvertisedAddress{}.OpenAPIModelName(), v1beta1.ShootCredentials{}.OpenAPIModelName(), v1beta1.ShootUsage{}.OpenAPIModelName(), v1beta1.WorkerPoolLastMaintenance{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -10648,6 +11146,62 @@ func schema_pkg_apis_core_v1beta1_ShootTemplate(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ShootUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootUsage contains the resources observed to be consumed by a Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers contains the observed usage of the worker pools.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.WorkerPoolUsage{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"controlPlane": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlane contains the resources requested by the control plane components of the Shoot running in the seed.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the usage was updated the last time.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.WorkerPoolUsage{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_StructuredAuthentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10764,6 +11318,50 @@ func schema_pkg_apis_core_v1beta1_Toleration(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_VersionRolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionRolloutStatus contains the state of the staged rollout of a version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"wave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Wave is the name of the last rollout wave for which the version is eligible for automatic updates.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the time when the version became eligible for the wave or when the soak period was last restarted.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"halted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Halted indicates whether the rollout of the version is halted because Shoots running it are unhealthy.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable message describing the current state of the rollout.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"wave", "lastTransitionTime"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11279,12 +11877,18 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 							Ref:         ref(v1beta1.WorkerControlPlane{}.OpenAPIModelName()),
 						},
 					},
+					"maintenance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maintenance contains information about the time window for the machine image and Kubernetes version updates of this worker pool. If not present, the worker pool is maintained in the maintenance time window of the Shoot.",
+							Ref:         ref(v1beta1.WorkerMaintenance{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"name", "machine", "maximum", "minimum"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.CRI{}.OpenAPIModelName(), v1beta1.ClusterAutoscalerOptions{}.OpenAPIModelName(), v1beta1.DataVolume{}.OpenAPIModelName(), v1beta1.Machine{}.OpenAPIModelName(), v1beta1.MachineControllerManagerSettings{}.OpenAPIModelName(), v1beta1.Volume{}.OpenAPIModelName(), v1beta1.WorkerControlPlane{}.OpenAPIModelName(), v1beta1.WorkerKubernetes{}.OpenAPIModelName(), v1beta1.WorkerSystemComponents{}.OpenAPIModelName(), corev1.Taint{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName(), intstr.IntOrString{}.OpenAPIModelName()},
+			v1beta1.CRI{}.OpenAPIModelName(), v1beta1.ClusterAutoscalerOptions{}.OpenAPIModelName(), v1beta1.DataVolume{}.OpenAPIModelName(), v1beta1.Machine{}.OpenAPIModelName(), v1beta1.MachineControllerManagerSettings{}.OpenAPIModelName(), v1beta1.Volume{}.OpenAPIModelName(), v1beta1.WorkerControlPlane{}.OpenAPIModelName(), v1beta1.WorkerKubernetes{}.OpenAPIModelName(), v1beta1.WorkerMaintenance{}.OpenAPIModelName(), v1beta1.WorkerSystemComponents{}.OpenAPIModelName(), corev1.Taint{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName(), intstr.IntOrString{}.OpenAPIModelName()},
 	}
 }
 
@@ -11343,6 +11947,103 @@ func schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_WorkerMaintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerMaintenance contains information about the time window for the maintenance operations of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeWindow contains information about the time window for the maintenance operations of the worker pool. If not present, the maintenance time window of the Shoot is used.",
+							Ref:         ref(v1beta1.MaintenanceTimeWindow{}.OpenAPIModelName()),
+						},
+					},
+					"weekdays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weekdays restricts the maintenance operations of the worker pool to the given days of the week, e.g. \"Sunday\". The day on which the maintenance time window begins is evaluated in the time zone in which its beginning is specified. If empty, the worker pool is maintained every day.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.MaintenanceTimeWindow{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerPoolLastMaintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerPoolLastMaintenance holds information about the last maintenance operations of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastMaintenance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastMaintenance holds information about the last maintenance operations of the worker pool.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1beta1.LastMaintenance{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"name", "lastMaintenance"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.LastMaintenance{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerPoolUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerPoolUsage contains the observed usage of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the number of nodes observed for the worker pool.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "nodes"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
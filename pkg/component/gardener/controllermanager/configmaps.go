@@ -18,6 +18,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -95,6 +96,9 @@ func (g *gardenerControllerManager) configMapControllerManagerConfig() (*corev1.
 			ShootReference: &controllermanagerconfigv1alpha1.ShootReferenceControllerConfiguration{
 				ConcurrentSyncs: ptr.To(20),
 			},
+			ShootScheduler: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Strategy: schedulerconfigv1alpha1.MinimalDistance,
+			},
 		},
 		LeaderElection: &componentbaseconfigv1alpha1.LeaderElectionConfiguration{
 			LeaderElect:       ptr.To(true),
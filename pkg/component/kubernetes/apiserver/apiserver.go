@@ -476,7 +476,8 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		}
 	}
 
-	if !k.values.IsWorkerless {
+	// apiserver deployed for shoot cluster, or one with nodes
+	if k.values.NamePrefix == "" || !k.values.IsWorkerless {
 		data, err := k.computeShootResourcesData()
 		if err != nil {
 			return err
@@ -22,6 +22,7 @@ import (
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -814,6 +815,46 @@ var _ = Describe("KubeAPIServer", func() {
 		})
 
 		Describe("Shoot Resources", func() {
+			var flowSchemaLoopback *flowcontrolv1.FlowSchema
+
+			BeforeEach(func() {
+				flowSchemaLoopback = &flowcontrolv1.FlowSchema{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "apiserver-loopback",
+					},
+					Spec: flowcontrolv1.FlowSchemaSpec{
+						PriorityLevelConfiguration: flowcontrolv1.PriorityLevelConfigurationReference{Name: "exempt"},
+						MatchingPrecedence:         1,
+						Rules: []flowcontrolv1.PolicyRulesWithSubjects{{
+							Subjects: []flowcontrolv1.Subject{{
+								Kind: "User",
+								User: &flowcontrolv1.UserSubject{Name: "system:apiserver"},
+							}},
+							ResourceRules: []flowcontrolv1.ResourcePolicyRule{{
+								Verbs:        []string{"*"},
+								APIGroups:    []string{"*"},
+								Resources:    []string{"*"},
+								ClusterScope: true,
+								Namespaces:   []string{"*"},
+							}},
+							NonResourceRules: []flowcontrolv1.NonResourcePolicyRule{{
+								Verbs:           []string{"*"},
+								NonResourceURLs: []string{"*"},
+							}},
+						}},
+					},
+				}
+			})
+
+			It("should deploy only the loopback FlowSchema for workerless shoots", func() {
+				values.IsWorkerless = true
+				kapi = New(kubernetesInterface, namespace, sm, values)
+
+				Expect(kapi.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				Expect(managedResource).To(consistOf(flowSchemaLoopback))
+			})
+
 			It("should successfully deploy the managed resource and its secret", func() {
 				var (
 					clusterRole = &rbacv1.ClusterRole{
@@ -880,7 +921,7 @@ var _ = Describe("KubeAPIServer", func() {
 				}
 				utilruntime.Must(references.InjectAnnotations(expectedMr))
 				Expect(managedResource).To(DeepEqual(expectedMr))
-				Expect(managedResource).To(consistOf(clusterRole, clusterRoleBinding))
+				Expect(managedResource).To(consistOf(flowSchemaLoopback, clusterRole, clusterRoleBinding))
 			})
 		})
 
@@ -5,16 +5,22 @@
 package apiserver
 
 import (
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
 
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 )
 
-// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
-const ManagedResourceName = "shoot-core-kube-apiserver"
+const (
+	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
+	ManagedResourceName = "shoot-core-kube-apiserver"
+	// FlowSchemaNameLoopback is the name of the FlowSchema matching the requests the kube-apiserver sends to itself.
+	FlowSchemaNameLoopback = "apiserver-loopback"
+)
 
 func (k *kubeAPIServer) emptyManagedResource() *resourcesv1alpha1.ManagedResource {
 	return &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: ManagedResourceName, Namespace: k.namespace}}
@@ -24,6 +30,40 @@ func (k *kubeAPIServer) computeShootResourcesData() (map[string][]byte, error) {
 	var (
 		registry = managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
 
+		// The kube-apiserver sends requests to itself as user 'system:apiserver', which is a member of the 'system:masters'
+		// group. Like all requests of this group, they are exempt from API Priority and Fairness, but they are matched by
+		// a dedicated FlowSchema. This way, the requests dispatched for the 'exempt' FlowSchema only contain those of
+		// users, e.g., of admin kubeconfigs, which is required for observing the user activity. The FlowSchema takes
+		// precedence over the 'exempt' FlowSchema because of the lexicographically smaller name.
+		flowSchemaLoopback = &flowcontrolv1.FlowSchema{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: FlowSchemaNameLoopback,
+			},
+			Spec: flowcontrolv1.FlowSchemaSpec{
+				PriorityLevelConfiguration: flowcontrolv1.PriorityLevelConfigurationReference{
+					Name: flowcontrolv1.PriorityLevelConfigurationNameExempt,
+				},
+				MatchingPrecedence: 1,
+				Rules: []flowcontrolv1.PolicyRulesWithSubjects{{
+					Subjects: []flowcontrolv1.Subject{{
+						Kind: flowcontrolv1.SubjectKindUser,
+						User: &flowcontrolv1.UserSubject{Name: user.APIServerUser},
+					}},
+					ResourceRules: []flowcontrolv1.ResourcePolicyRule{{
+						Verbs:        []string{flowcontrolv1.VerbAll},
+						APIGroups:    []string{flowcontrolv1.APIGroupAll},
+						Resources:    []string{flowcontrolv1.ResourceAll},
+						ClusterScope: true,
+						Namespaces:   []string{flowcontrolv1.NamespaceEvery},
+					}},
+					NonResourceRules: []flowcontrolv1.NonResourcePolicyRule{{
+						Verbs:           []string{flowcontrolv1.VerbAll},
+						NonResourceURLs: []string{flowcontrolv1.NonResourceAll},
+					}},
+				}},
+			},
+		}
+
 		clusterRole = &rbacv1.ClusterRole{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "system:apiserver:kubelet",
@@ -64,7 +104,12 @@ func (k *kubeAPIServer) computeShootResourcesData() (map[string][]byte, error) {
 		}
 	)
 
+	if k.values.IsWorkerless {
+		return registry.AddAllAndSerialize(flowSchemaLoopback)
+	}
+
 	return registry.AddAllAndSerialize(
+		flowSchemaLoopback,
 		clusterRole,
 		clusterRoleBinding,
 	)
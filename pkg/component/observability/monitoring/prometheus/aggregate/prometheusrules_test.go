@@ -72,6 +72,32 @@ var _ = ginkgo.Describe("PrometheusRules", func() {
 					"summary":     "Too many etcd full snapshots are failing for a specific namespace.",
 				},
 			},
+			{
+				Alert: "CertificateAuthorityExpiringSoon",
+				Expr:  intstr.FromString(`min by (namespace, name, manager_identity) (gardenlet_secrets_manager_certificate_expiration_timestamp_seconds{ca="true",rotation="current"}) - time() < 30 * 24 * 3600`),
+				Labels: map[string]string{
+					"severity":   "warning",
+					"type":       "seed",
+					"visibility": "operator",
+				},
+				Annotations: map[string]string{
+					"description": "CA {{$labels.name}} managed by {{$labels.manager_identity}} in namespace {{$labels.namespace}} on seed {{$externalLabels.seed}} expires in less than 30 days. Rotate the CA before it expires.",
+					"summary":     "A CA expires in less than 30 days.",
+				},
+			},
+			{
+				Alert: "CertificateAuthorityExpiringVerySoon",
+				Expr:  intstr.FromString(`min by (namespace, name, manager_identity) (gardenlet_secrets_manager_certificate_expiration_timestamp_seconds{ca="true",rotation="current"}) - time() < 7 * 24 * 3600`),
+				Labels: map[string]string{
+					"severity":   "critical",
+					"type":       "seed",
+					"visibility": "operator",
+				},
+				Annotations: map[string]string{
+					"description": "CA {{$labels.name}} managed by {{$labels.manager_identity}} in namespace {{$labels.namespace}} on seed {{$externalLabels.seed}} expires in less than 7 days. Rotate the CA immediately.",
+					"summary":     "A CA expires in less than 7 days.",
+				},
+			},
 		}
 
 		ginkgo.Context("the seed is also the garden cluster", func() {
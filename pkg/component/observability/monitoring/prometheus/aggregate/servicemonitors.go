@@ -11,6 +11,7 @@ import (
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus"
+	monitoringutils "github.com/gardener/gardener/pkg/component/observability/monitoring/utils"
 )
 
 // CentralServiceMonitors returns the central ServiceMonitor resources for the aggregate prometheus.
@@ -59,5 +60,18 @@ func CentralServiceMonitors() []*monitoringv1.ServiceMonitor {
 				}},
 			},
 		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "gardenlet"},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Selector: metav1.LabelSelector{MatchLabels: map[string]string{
+					v1beta1constants.LabelApp:  v1beta1constants.LabelGardener,
+					v1beta1constants.LabelRole: "gardenlet",
+				}},
+				Endpoints: []monitoringv1.Endpoint{{
+					Port:                 "metrics",
+					MetricRelabelConfigs: monitoringutils.StandardMetricRelabelConfig("gardenlet_secrets_manager_certificate_expiration_timestamp_seconds"),
+				}},
+			},
+		},
 	}
 }
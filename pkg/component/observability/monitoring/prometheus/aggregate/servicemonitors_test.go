@@ -57,6 +57,22 @@ var _ = Describe("ServiceMonitors", func() {
 						},
 					}},
 				},
+			}, &monitoringv1.ServiceMonitor{
+				ObjectMeta: metav1.ObjectMeta{Name: "gardenlet"},
+				Spec: monitoringv1.ServiceMonitorSpec{
+					Selector: metav1.LabelSelector{MatchLabels: map[string]string{
+						"app":  "gardener",
+						"role": "gardenlet",
+					}},
+					Endpoints: []monitoringv1.Endpoint{{
+						Port: "metrics",
+						MetricRelabelConfigs: []monitoringv1.RelabelConfig{{
+							SourceLabels: []monitoringv1.LabelName{"__name__"},
+							Action:       "keep",
+							Regex:        `^(gardenlet_secrets_manager_certificate_expiration_timestamp_seconds)$`,
+						}},
+					}},
+				},
 			}))
 		})
 	})
@@ -13,8 +13,10 @@ import (
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/activity"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/project"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/quota"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/resourcequota"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/stale"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/usage"
 )
 
 // AddToManager adds all Project controllers to the given manager.
@@ -43,5 +45,19 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg controllermanage
 		return fmt.Errorf("failed adding resourcequota reconciler: %w", err)
 	}
 
+	if err := (&quota.Reconciler{
+		Config: *cfg.Controllers.Project,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding quota reconciler: %w", err)
+	}
+
+	if cfg.Controllers.Project.Usage != nil {
+		if err := (&usage.Reconciler{
+			Config: *cfg.Controllers.Project,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding usage reconciler: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,295 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/core/helper"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reconciles Projects and maintains their QuotaSoftLimitsExceeded condition based on the soft limits of the
+// Quotas referenced by the SecretBindings and CredentialsBindings in the project namespace.
+type Reconciler struct {
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.ProjectControllerConfiguration
+	Clock    clock.Clock
+	Recorder events.EventRecorder
+}
+
+// Reconcile reconciles Projects and maintains their QuotaSoftLimitsExceeded condition.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.DeletionTimestamp != nil {
+		log.V(1).Info("Skipping Project because it is marked for deletion")
+		return reconcile.Result{}, nil
+	}
+
+	if project.Spec.Namespace == nil {
+		log.V(1).Info("Skipping Project because it does not have a namespace yet")
+		return reconcile.Result{RequeueAfter: r.Config.SoftQuotaSyncPeriod.Duration}, nil
+	}
+
+	if err := r.reconcile(ctx, log, project); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SoftQuotaSyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project) error {
+	quotas, err := r.quotasWithSoftLimits(ctx, *project.Spec.Namespace)
+	if err != nil {
+		return err
+	}
+
+	oldCondition := v1beta1helper.GetCondition(project.Status.Conditions, gardencorev1beta1.ProjectQuotaSoftLimitsExceeded)
+	if len(quotas) == 0 && oldCondition == nil {
+		return nil
+	}
+
+	var (
+		exceeded []string
+		breaches []gardencorev1beta1.QuotaSoftLimitBreach
+	)
+	for _, quota := range quotas {
+		allocatedResources, err := r.allocatedResources(ctx, log, quota, *project.Spec.Namespace)
+		if err != nil {
+			return fmt.Errorf("failed computing resources allocated for quota %s: %w", client.ObjectKeyFromObject(quota), err)
+		}
+
+		var exceededMetrics []string
+		for _, metric := range gardenerutils.QuotaMetricNames {
+			allocated := allocatedResources[metric]
+			if limit, ok := quota.Spec.SoftMetrics[metric]; ok && allocated.Cmp(limit) > 0 {
+				exceededMetrics = append(exceededMetrics, string(metric))
+				breaches = append(breaches, r.quotaSoftLimitBreach(project.Status.QuotaSoftLimitBreaches, quota, metric))
+			}
+		}
+		if len(exceededMetrics) > 0 {
+			exceeded = append(exceeded, fmt.Sprintf("%s/%s (%s)", quota.Namespace, quota.Name, strings.Join(exceededMetrics, ", ")))
+		}
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, project.Status.Conditions, gardencorev1beta1.ProjectQuotaSoftLimitsExceeded)
+	if len(exceeded) > 0 {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, "SoftLimitsExceeded", "Soft limits of quotas are exceeded: "+strings.Join(exceeded, ", "))
+	} else {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "SoftLimitsNotExceeded", "Soft limits of quotas are not exceeded.")
+	}
+
+	if !v1beta1helper.ConditionsNeedUpdate(project.Status.Conditions, []gardencorev1beta1.Condition{condition}) &&
+		apiequality.Semantic.DeepEqual(project.Status.QuotaSoftLimitBreaches, breaches) {
+		return nil
+	}
+
+	wasExceeded := oldCondition != nil && oldCondition.Status == gardencorev1beta1.ConditionTrue
+	switch {
+	case condition.Status == gardencorev1beta1.ConditionTrue && (!wasExceeded || oldCondition.Message != condition.Message):
+		log.Info("Soft limits of quotas are exceeded", "quotas", exceeded)
+		r.Recorder.Eventf(project, nil, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventQuotaSoftLimitsExceeded, gardencorev1beta1.EventActionReconcile, condition.Message)
+	case condition.Status == gardencorev1beta1.ConditionFalse && wasExceeded:
+		log.Info("Soft limits of quotas are no longer exceeded")
+		r.Recorder.Eventf(project, nil, corev1.EventTypeNormal, gardencorev1beta1.ProjectEventQuotaSoftLimitsRecovered, gardencorev1beta1.EventActionReconcile, condition.Message)
+	}
+
+	patch := client.StrategicMergeFrom(project.DeepCopy())
+	project.Status.Conditions = v1beta1helper.MergeConditions(project.Status.Conditions, condition)
+	project.Status.QuotaSoftLimitBreaches = breaches
+	return r.Client.Status().Patch(ctx, project, patch)
+}
+
+// quotaSoftLimitBreach returns the breach of the soft limit of the given quota for the given resource. If the breach is
+// already contained in the given previous breaches, the time since when the soft limit is exceeded is retained, so that
+// the grace period is not extended.
+func (r *Reconciler) quotaSoftLimitBreach(previousBreaches []gardencorev1beta1.QuotaSoftLimitBreach, quota *gardencorev1beta1.Quota, resource corev1.ResourceName) gardencorev1beta1.QuotaSoftLimitBreach {
+	breach := gardencorev1beta1.QuotaSoftLimitBreach{
+		Quota:    corev1.ObjectReference{Namespace: quota.Namespace, Name: quota.Name},
+		Resource: resource,
+		Since:    metav1.NewTime(r.Clock.Now()),
+	}
+
+	for _, previous := range previousBreaches {
+		if previous.Quota.Namespace == quota.Namespace && previous.Quota.Name == quota.Name && previous.Resource == resource {
+			breach.Since = previous.Since
+			break
+		}
+	}
+
+	return breach
+}
+
+// quotasWithSoftLimits returns the Quotas with soft limits which are referenced by the SecretBindings and
+// CredentialsBindings in the given namespace.
+func (r *Reconciler) quotasWithSoftLimits(ctx context.Context, namespace string) ([]*gardencorev1beta1.Quota, error) {
+	secretBindingList := &gardencorev1beta1.SecretBindingList{}
+	if err := r.Client.List(ctx, secretBindingList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing SecretBindings: %w", err)
+	}
+	credentialsBindingList := &securityv1alpha1.CredentialsBindingList{}
+	if err := r.Client.List(ctx, credentialsBindingList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing CredentialsBindings: %w", err)
+	}
+
+	quotaKeys := sets.New[client.ObjectKey]()
+	for _, binding := range secretBindingList.Items {
+		for _, quotaRef := range binding.Quotas {
+			quotaKeys.Insert(client.ObjectKey{Namespace: quotaRef.Namespace, Name: quotaRef.Name})
+		}
+	}
+	for _, binding := range credentialsBindingList.Items {
+		for _, quotaRef := range binding.Quotas {
+			quotaKeys.Insert(client.ObjectKey{Namespace: quotaRef.Namespace, Name: quotaRef.Name})
+		}
+	}
+
+	keys := quotaKeys.UnsortedList()
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+
+	var quotas []*gardencorev1beta1.Quota
+	for _, key := range keys {
+		quota := &gardencorev1beta1.Quota{}
+		if err := r.Client.Get(ctx, key, quota); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed reading Quota %s: %w", key, err)
+		}
+
+		if len(quota.Spec.SoftMetrics) > 0 {
+			quotas = append(quotas, quota)
+		}
+	}
+
+	return quotas, nil
+}
+
+// allocatedResources computes the resources allocated by the Shoots using the given Quota. For Quotas with project
+// scope, only the Shoots in the given project namespace are considered.
+func (r *Reconciler) allocatedResources(ctx context.Context, log logr.Logger, quota *gardencorev1beta1.Quota, projectNamespace string) (corev1.ResourceList, error) {
+	scope, err := helper.QuotaScope(quota.Spec.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := corev1.NamespaceAll
+	if scope == "project" {
+		namespace = projectNamespace
+	}
+
+	secretBindingList := &gardencorev1beta1.SecretBindingList{}
+	if err := r.Client.List(ctx, secretBindingList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing SecretBindings: %w", err)
+	}
+	credentialsBindingList := &securityv1alpha1.CredentialsBindingList{}
+	if err := r.Client.List(ctx, credentialsBindingList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing CredentialsBindings: %w", err)
+	}
+
+	var (
+		secretBindings      = sets.New[client.ObjectKey]()
+		credentialsBindings = sets.New[client.ObjectKey]()
+		namespaces          = sets.New[string]()
+	)
+
+	for _, binding := range secretBindingList.Items {
+		if referencesQuota(binding.Quotas, quota) {
+			secretBindings.Insert(client.ObjectKeyFromObject(&binding))
+			namespaces.Insert(binding.Namespace)
+		}
+	}
+	for _, binding := range credentialsBindingList.Items {
+		if referencesQuota(binding.Quotas, quota) {
+			credentialsBindings.Insert(client.ObjectKeyFromObject(&binding))
+			namespaces.Insert(binding.Namespace)
+		}
+	}
+
+	allocatedResources := make(corev1.ResourceList)
+	for _, ns := range sets.List(namespaces) {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, shootList, client.InNamespace(ns)); err != nil {
+			return nil, fmt.Errorf("failed listing Shoots: %w", err)
+		}
+
+		for _, shoot := range shootList.Items {
+			if !secretBindings.Has(client.ObjectKey{Namespace: shoot.Namespace, Name: ptr.Deref(shoot.Spec.SecretBindingName, "")}) &&
+				!credentialsBindings.Has(client.ObjectKey{Namespace: shoot.Namespace, Name: ptr.Deref(shoot.Spec.CredentialsBindingName, "")}) {
+				continue
+			}
+
+			shootResources, err := r.shootResources(ctx, &shoot)
+			if err != nil {
+				// Shoots whose resources cannot be computed (e.g., because their CloudProfile is gone) must not prevent
+				// checking the allocation of the other shoots.
+				log.Error(err, "Failed computing resources allocated by shoot", "shoot", client.ObjectKeyFromObject(&shoot))
+				continue
+			}
+
+			for _, metric := range gardenerutils.QuotaMetricNames {
+				sum := allocatedResources[metric]
+				sum.Add(shootResources[metric])
+				allocatedResources[metric] = sum
+			}
+		}
+	}
+
+	return allocatedResources, nil
+}
+
+func (r *Reconciler) shootResources(ctx context.Context, shoot *gardencorev1beta1.Shoot) (corev1.ResourceList, error) {
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading CloudProfile: %w", err)
+	}
+
+	coreShoot := &core.Shoot{}
+	if err := gardencorev1beta1.Convert_v1beta1_Shoot_To_core_Shoot(shoot, coreShoot, nil); err != nil {
+		return nil, err
+	}
+
+	return gardenerutils.QuotaResourcesForShoot(coreShoot, &cloudProfile.Spec)
+}
+
+func referencesQuota(quotaRefs []corev1.ObjectReference, quota *gardencorev1beta1.Quota) bool {
+	for _, quotaRef := range quotaRefs {
+		if quotaRef.Namespace == quota.Namespace && quotaRef.Name == quota.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,265 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quota_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/quota"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *events.FakeRecorder
+		reconciler *Reconciler
+
+		cloudProfile  *gardencorev1beta1.CloudProfile
+		quota         *gardencorev1beta1.Quota
+		secretBinding *gardencorev1beta1.SecretBinding
+		project       *gardencorev1beta1.Project
+		shoot         *gardencorev1beta1.Shoot
+		request       reconcile.Request
+
+		syncPeriod = 5 * time.Minute
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 10, 1, 10, 0, 0, 0, time.UTC))
+		recorder = events.NewFakeRecorder(10)
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Project{}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Clock:    fakeClock,
+			Recorder: recorder,
+			Config: controllermanagerconfigv1alpha1.ProjectControllerConfiguration{
+				SoftQuotaSyncPeriod: &metav1.Duration{Duration: syncPeriod},
+			},
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{{
+					Name:   "large",
+					CPU:    resource.MustParse("2"),
+					GPU:    resource.MustParse("0"),
+					Memory: resource.MustParse("4Gi"),
+				}},
+				VolumeTypes: []gardencorev1beta1.VolumeType{{
+					Name:  "standard",
+					Class: "standard",
+				}},
+			},
+		}
+
+		quota = &gardencorev1beta1.Quota{
+			ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.QuotaSpec{
+				Scope: corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Project"},
+				Metrics: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("20"),
+				},
+				SoftMetrics: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("10"),
+				},
+			},
+		}
+
+		secretBinding = &gardencorev1beta1.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "garden-foo"},
+			Quotas:     []corev1.ObjectReference{{Name: "quota", Namespace: "garden-foo"}},
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfile:      &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "profile"},
+				SecretBindingName: ptr.To("binding"),
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{
+						Name:    "pool",
+						Machine: gardencorev1beta1.Machine{Type: "large"},
+						Volume:  &gardencorev1beta1.Volume{Type: ptr.To("standard"), VolumeSize: "20Gi"},
+						Minimum: 1,
+						Maximum: 3,
+					}},
+				},
+			},
+		}
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)}
+	})
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+		Expect(fakeClient.Create(ctx, secretBinding)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		status := project.Status
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		project.Status = status
+		Expect(fakeClient.Status().Update(ctx, project)).To(Succeed())
+	})
+
+	It("should do nothing if the project is gone", func() {
+		Expect(fakeClient.Delete(ctx, project)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should set the condition to False if the soft limits are not exceeded", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		condition := v1beta1helper.GetCondition(project.Status.Conditions, gardencorev1beta1.ProjectQuotaSoftLimitsExceeded)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("SoftLimitsNotExceeded"))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should not add the condition if no quota with soft limits is used", func() {
+		quota.Spec.SoftMetrics = nil
+		Expect(fakeClient.Update(ctx, quota)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.Conditions).To(BeEmpty())
+	})
+
+	Context("soft limits exceeded", func() {
+		BeforeEach(func() {
+			shoot.Spec.Provider.Workers[0].Maximum = 6
+		})
+
+		It("should set the condition to True and emit a warning event", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			condition := v1beta1helper.GetCondition(project.Status.Conditions, gardencorev1beta1.ProjectQuotaSoftLimitsExceeded)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("SoftLimitsExceeded"))
+			Expect(condition.Message).To(ContainSubstring("garden-foo/quota (cpu)"))
+			Expect(condition.LastTransitionTime.Time).To(BeTemporally("==", fakeClock.Now()))
+			Expect(project.Status.QuotaSoftLimitBreaches).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Quota":    Equal(corev1.ObjectReference{Namespace: "garden-foo", Name: "quota"}),
+				"Resource": Equal(corev1.ResourceCPU),
+				"Since":    WithTransform(func(t metav1.Time) time.Time { return t.Time }, BeTemporally("==", fakeClock.Now())),
+			})))
+			Eventually(recorder.Events).Should(Receive(ContainSubstring("Warning QuotaSoftLimitsExceeded")))
+		})
+
+		It("should record the first breach per quota and resource", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Eventually(recorder.Events).Should(Receive())
+
+			firstBreach := fakeClock.Now()
+			fakeClock.Step(time.Hour)
+
+			otherQuota := &gardencorev1beta1.Quota{
+				ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "garden-foo"},
+				Spec: gardencorev1beta1.QuotaSpec{
+					Scope:       corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Project"},
+					Metrics:     corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("100Gi")},
+					SoftMetrics: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+			}
+			Expect(fakeClient.Create(ctx, otherQuota)).To(Succeed())
+			secretBinding.Quotas = append(secretBinding.Quotas, corev1.ObjectReference{Name: "other", Namespace: "garden-foo"})
+			Expect(fakeClient.Update(ctx, secretBinding)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Status.QuotaSoftLimitBreaches).To(HaveLen(2))
+			for _, breach := range project.Status.QuotaSoftLimitBreaches {
+				switch breach.Quota.Name {
+				case "quota":
+					Expect(breach.Since.Time).To(BeTemporally("==", firstBreach))
+				case "other":
+					Expect(breach.Resource).To(Equal(corev1.ResourceMemory))
+					Expect(breach.Since.Time).To(BeTemporally("==", fakeClock.Now()))
+				}
+			}
+		})
+
+		It("should keep the last transition time and not emit further events on subsequent reconciliations", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Eventually(recorder.Events).Should(Receive())
+
+			transitionTime := fakeClock.Now()
+			fakeClock.Step(time.Hour)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			condition := v1beta1helper.GetCondition(project.Status.Conditions, gardencorev1beta1.ProjectQuotaSoftLimitsExceeded)
+			Expect(condition.LastTransitionTime.Time).To(BeTemporally("==", transitionTime))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should set the condition to False and emit a normal event when the allocation drops below the soft limits", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Eventually(recorder.Events).Should(Receive())
+
+			Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			condition := v1beta1helper.GetCondition(project.Status.Conditions, gardencorev1beta1.ProjectQuotaSoftLimitsExceeded)
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(project.Status.QuotaSoftLimitBreaches).To(BeEmpty())
+			Eventually(recorder.Events).Should(Receive(ContainSubstring("Normal QuotaSoftLimitsRecovered")))
+		})
+	})
+
+	Context("shoot not using the quota", func() {
+		BeforeEach(func() {
+			shoot.Spec.Provider.Workers[0].Maximum = 6
+			shoot.Spec.SecretBindingName = ptr.To("other")
+		})
+
+		It("should not account the shoot", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			condition := v1beta1helper.GetCondition(project.Status.Conditions, gardencorev1beta1.ProjectQuotaSoftLimitsExceeded)
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		})
+	})
+})
@@ -0,0 +1,368 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	quotav1 "k8s.io/apiserver/pkg/quota/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/metrics"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// resourceGPU is the name of the resource for the GPUs of the machines of the worker pools.
+	resourceGPU corev1.ResourceName = "gpu"
+
+	componentWorkers      = "workers"
+	componentControlPlane = "control-plane"
+
+	labelValuesSeparator = "\x00"
+)
+
+// Reconciler reconciles Projects and accounts the resources consumed by their shoots.
+type Reconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.ProjectControllerConfiguration
+	Clock  clock.Clock
+
+	seriesLock sync.Mutex
+	// series contains the metric series recorded per project. It is used to delete the series which vanished (e.g., of
+	// deleted shoots or worker pools) without resetting the series which are still present.
+	series map[string]metricSeries
+}
+
+// Reconcile reconciles Projects and accounts the resources consumed by their shoots.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			r.deleteMetrics(request.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.DeletionTimestamp != nil {
+		log.V(1).Info("Skipping Project because it is marked for deletion")
+		r.deleteMetrics(project.Name)
+		return reconcile.Result{}, nil
+	}
+
+	if project.Spec.Namespace == nil {
+		log.V(1).Info("Skipping Project because it does not have a namespace yet")
+		return reconcile.Result{RequeueAfter: r.Config.Usage.SyncPeriod.Duration}, nil
+	}
+
+	if err := r.reconcile(ctx, log, project); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.Usage.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project) error {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+		return fmt.Errorf("failed listing shoots of project: %w", err)
+	}
+
+	var (
+		series = metricSeries{}
+		now    = r.Clock.Now().UTC()
+		usage  = &gardencorev1beta1.ProjectUsage{
+			Workers:        corev1.ResourceList{},
+			ControlPlanes:  corev1.ResourceList{},
+			LastUpdateTime: metav1.Time{Time: now},
+		}
+	)
+
+	for _, shoot := range shootList.Items {
+		// The failure counter is kept as long as the shoot exists.
+		series.add(metrics.ShootUsageComputationFailures, project.Name, shoot.Name)
+
+		shootUsage, err := r.computeShootUsage(ctx, &shoot)
+		if err != nil {
+			// Shoots whose usage cannot be computed (e.g., because their CloudProfile is gone) must not prevent the
+			// accounting of the other shoots. Their usage metrics are deleted since they are no longer accurate.
+			log.Error(err, "Failed computing usage of shoot", "shoot", client.ObjectKeyFromObject(&shoot))
+			metrics.ShootUsageComputationFailures.WithLabelValues(project.Name, shoot.Name).Inc()
+			continue
+		}
+
+		usage.Shoots++
+		usage.Nodes += shootUsage.nodes
+		usage.Workers = quotav1.Add(usage.Workers, shootUsage.workers)
+		usage.ControlPlanes = quotav1.Add(usage.ControlPlanes, shootUsage.controlPlane)
+		if shootUsage.hourlyCost != nil {
+			usage.EstimatedHourlyCost = addQuantity(usage.EstimatedHourlyCost, *shootUsage.hourlyCost)
+		}
+
+		shootUsage.recordMetrics(series, project.Name, shoot.Name)
+	}
+
+	usage.Consumption = computeConsumption(project.Status.Usage, now)
+	recordConsumptionMetrics(series, project.Name, usage.Consumption)
+	r.replaceMetricSeries(project.Name, series)
+
+	log.V(1).Info("Updating usage of project", "shoots", usage.Shoots, "nodes", usage.Nodes)
+
+	patch := client.MergeFrom(project.DeepCopy())
+	project.Status.Usage = usage
+	if err := r.Client.Status().Patch(ctx, project, patch); err != nil {
+		return fmt.Errorf("failed patching usage of project: %w", err)
+	}
+
+	return nil
+}
+
+// shootUsage contains the resources currently consumed by a shoot.
+type shootUsage struct {
+	nodes        int32
+	nodesPerPool map[string]int32
+	workers      corev1.ResourceList
+	controlPlane corev1.ResourceList
+	hourlyCost   *resource.Quantity
+	workerPools  []gardencorev1beta1.Worker
+}
+
+// computeShootUsage computes the resources currently consumed by the given shoot. The node counts of the worker pools
+// are taken from the usage observed by gardenlet. If it was not observed yet, the minimum of the worker pools is
+// assumed for awake shoots.
+func (r *Reconciler) computeShootUsage(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*shootUsage, error) {
+	result := &shootUsage{
+		nodesPerPool: make(map[string]int32, len(shoot.Spec.Provider.Workers)),
+		workers:      corev1.ResourceList{},
+		controlPlane: corev1.ResourceList{},
+		workerPools:  shoot.Spec.Provider.Workers,
+	}
+
+	if shoot.Status.Usage != nil {
+		result.controlPlane = shoot.Status.Usage.ControlPlane.DeepCopy()
+		for _, pool := range shoot.Status.Usage.Workers {
+			result.nodesPerPool[pool.Name] = pool.Nodes
+		}
+	} else if !v1beta1helper.HibernationIsEnabled(shoot) {
+		for _, worker := range shoot.Spec.Provider.Workers {
+			result.nodesPerPool[worker.Name] = worker.Minimum
+		}
+	}
+
+	if len(shoot.Spec.Provider.Workers) == 0 {
+		return result, nil
+	}
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading CloudProfile: %w", err)
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		nodes := result.nodesPerPool[worker.Name]
+		result.nodes += nodes
+
+		machineType := v1beta1helper.FindMachineTypeByName(cloudProfile.Spec.MachineTypes, worker.Machine.Type)
+		if machineType == nil {
+			return nil, fmt.Errorf("machine type %q of worker pool %q not found in CloudProfile", worker.Machine.Type, worker.Name)
+		}
+
+		result.workers = quotav1.Add(result.workers, corev1.ResourceList{
+			corev1.ResourceCPU:    multiplyQuantity(machineType.CPU, nodes),
+			resourceGPU:           multiplyQuantity(machineType.GPU, nodes),
+			corev1.ResourceMemory: multiplyQuantity(machineType.Memory, nodes),
+		})
+
+		if machineType.HourlyPrice != nil {
+			result.hourlyCost = addQuantity(result.hourlyCost, multiplyQuantity(*machineType.HourlyPrice, nodes))
+		}
+	}
+
+	return result, nil
+}
+
+func (s *shootUsage) recordMetrics(series metricSeries, projectName, shootName string) {
+	for _, worker := range s.workerPools {
+		series.set(metrics.ShootNodes, float64(s.nodesPerPool[worker.Name]), projectName, shootName, worker.Name)
+		series.set(metrics.ShootWorkerPoolCapacityNodes, float64(worker.Minimum), projectName, shootName, worker.Name, "min")
+		series.set(metrics.ShootWorkerPoolCapacityNodes, float64(worker.Maximum), projectName, shootName, worker.Name, "max")
+	}
+
+	for component, resources := range map[string]corev1.ResourceList{componentWorkers: s.workers, componentControlPlane: s.controlPlane} {
+		for name, quantity := range resources {
+			series.set(metrics.ShootResources, quantity.AsApproximateFloat64(), projectName, shootName, component, string(name))
+		}
+	}
+
+	if s.hourlyCost != nil {
+		series.set(metrics.ShootEstimatedHourlyCost, s.hourlyCost.AsApproximateFloat64(), projectName, shootName)
+	}
+}
+
+// computeConsumption accumulates the resources consumed by the shoots of a project in the current accounting period,
+// i.e., the current month in UTC. The resources and costs of the previous usage are accounted for the time elapsed since
+// its last update. When a new accounting period starts, the consumption is reset.
+func computeConsumption(previous *gardencorev1beta1.ProjectUsage, now time.Time) *gardencorev1beta1.ProjectConsumption {
+	periodStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	consumption := &gardencorev1beta1.ProjectConsumption{
+		PeriodStart:   metav1.Time{Time: periodStart},
+		Workers:       corev1.ResourceList{},
+		ControlPlanes: corev1.ResourceList{},
+	}
+
+	if previous == nil {
+		return consumption
+	}
+
+	accountedSince := previous.LastUpdateTime.Time
+	if previous.Consumption != nil && !previous.Consumption.PeriodStart.Time.Before(periodStart) {
+		consumption = previous.Consumption.DeepCopy()
+	} else if accountedSince.Before(periodStart) {
+		accountedSince = periodStart
+	}
+
+	hours := now.Sub(accountedSince).Hours()
+	if hours <= 0 {
+		return consumption
+	}
+
+	consumption.Workers = quotav1.Add(consumption.Workers, scaleResourceList(previous.Workers, hours))
+	consumption.ControlPlanes = quotav1.Add(consumption.ControlPlanes, scaleResourceList(previous.ControlPlanes, hours))
+	if previous.EstimatedHourlyCost != nil {
+		consumption.EstimatedCost = addQuantity(consumption.EstimatedCost, scaleQuantity(*previous.EstimatedHourlyCost, hours, resource.DecimalSI))
+	}
+
+	return consumption
+}
+
+func recordConsumptionMetrics(series metricSeries, projectName string, consumption *gardencorev1beta1.ProjectConsumption) {
+	for component, resources := range map[string]corev1.ResourceList{componentWorkers: consumption.Workers, componentControlPlane: consumption.ControlPlanes} {
+		for name, quantity := range resources {
+			series.set(metrics.ProjectConsumption, quantity.AsApproximateFloat64(), projectName, component, string(name))
+		}
+	}
+
+	if consumption.EstimatedCost != nil {
+		series.set(metrics.ProjectEstimatedCost, consumption.EstimatedCost.AsApproximateFloat64(), projectName)
+	}
+}
+
+// metricVec is a metric vector whose series can be deleted by their label values.
+type metricVec interface {
+	DeleteLabelValues(labelValues ...string) bool
+}
+
+// metricSeries contains the (joined) label values of the series recorded per metric vector.
+type metricSeries map[metricVec]sets.Set[string]
+
+func (m metricSeries) set(gauge *prometheus.GaugeVec, value float64, labelValues ...string) {
+	gauge.WithLabelValues(labelValues...).Set(value)
+	m.add(gauge, labelValues...)
+}
+
+func (m metricSeries) add(vec metricVec, labelValues ...string) {
+	if m[vec] == nil {
+		m[vec] = sets.New[string]()
+	}
+	m[vec].Insert(strings.Join(labelValues, labelValuesSeparator))
+}
+
+// deleteVanished deletes the series of the given previous metric series which are not contained in these series.
+func (m metricSeries) deleteVanished(previous metricSeries) {
+	for vec, labelValues := range previous {
+		for _, joined := range labelValues.Difference(m[vec]).UnsortedList() {
+			vec.DeleteLabelValues(strings.Split(joined, labelValuesSeparator)...)
+		}
+	}
+}
+
+func (r *Reconciler) replaceMetricSeries(projectName string, series metricSeries) {
+	r.seriesLock.Lock()
+	defer r.seriesLock.Unlock()
+
+	series.deleteVanished(r.series[projectName])
+
+	if r.series == nil {
+		r.series = make(map[string]metricSeries)
+	}
+	r.series[projectName] = series
+}
+
+func (r *Reconciler) deleteMetrics(projectName string) {
+	r.seriesLock.Lock()
+	defer r.seriesLock.Unlock()
+
+	delete(r.series, projectName)
+
+	labels := prometheus.Labels{"project": projectName}
+
+	metrics.ShootNodes.DeletePartialMatch(labels)
+	metrics.ShootWorkerPoolCapacityNodes.DeletePartialMatch(labels)
+	metrics.ShootResources.DeletePartialMatch(labels)
+	metrics.ShootEstimatedHourlyCost.DeletePartialMatch(labels)
+	metrics.ProjectConsumption.DeletePartialMatch(labels)
+	metrics.ProjectEstimatedCost.DeletePartialMatch(labels)
+	metrics.ShootUsageComputationFailures.DeletePartialMatch(labels)
+}
+
+func scaleResourceList(resources corev1.ResourceList, factor float64) corev1.ResourceList {
+	out := make(corev1.ResourceList, len(resources))
+	for name, quantity := range resources {
+		format := resource.DecimalSI
+		if name == corev1.ResourceMemory {
+			format = resource.BinarySI
+		}
+		out[name] = scaleQuantity(quantity, factor, format)
+	}
+	return out
+}
+
+// scaleQuantity multiplies the given quantity with the given factor. Binary quantities (memory) are rounded to bytes,
+// decimal quantities are rounded to thousandths.
+func scaleQuantity(quantity resource.Quantity, factor float64, format resource.Format) resource.Quantity {
+	value := quantity.AsApproximateFloat64() * factor
+	if format == resource.BinarySI {
+		return *resource.NewQuantity(int64(value), resource.BinarySI)
+	}
+	return *resource.NewMilliQuantity(int64(value*1000), resource.DecimalSI)
+}
+
+func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
+	res := resource.Quantity{}
+	for i := 0; i < int(multiplier); i++ {
+		res.Add(quantity)
+	}
+	return res
+}
+
+func addQuantity(sum *resource.Quantity, quantity resource.Quantity) *resource.Quantity {
+	res := quantity.DeepCopy()
+	if sum != nil {
+		res.Add(*sum)
+	}
+	return &res
+}
@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/usage"
+	"github.com/gardener/gardener/pkg/controllermanager/metrics"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		project      *gardencorev1beta1.Project
+		shoot1       *gardencorev1beta1.Shoot
+		shoot2       *gardencorev1beta1.Shoot
+		request      reconcile.Request
+
+		syncPeriod = 10 * time.Minute
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 10, 1, 10, 0, 0, 0, time.UTC))
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Project{}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Clock:  fakeClock,
+			Config: controllermanagerconfigv1alpha1.ProjectControllerConfiguration{
+				Usage: &controllermanagerconfigv1alpha1.ProjectUsageConfiguration{
+					SyncPeriod: &metav1.Duration{Duration: syncPeriod},
+				},
+			},
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{{
+					Name:        "large",
+					CPU:         resource.MustParse("2"),
+					GPU:         resource.MustParse("0"),
+					Memory:      resource.MustParse("4Gi"),
+					HourlyPrice: ptr.To(resource.MustParse("0.5")),
+				}},
+			},
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		}
+
+		shoot1 = newShoot("shoot1", 1, 3)
+		shoot1.Status.Usage = &gardencorev1beta1.ShootUsage{
+			Workers:      []gardencorev1beta1.WorkerPoolUsage{{Name: "pool", Nodes: 2}},
+			ControlPlane: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("2Gi")},
+		}
+		shoot2 = newShoot("shoot2", 1, 2)
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)}
+	})
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot1)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot2)).To(Succeed())
+
+		status := project.Status
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		project.Status = status
+		Expect(fakeClient.Status().Update(ctx, project)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(fakeClient.Delete(ctx, project)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(testutil.CollectAndCount(metrics.ShootNodes)).To(BeZero())
+		Expect(testutil.CollectAndCount(metrics.ShootUsageComputationFailures)).To(BeZero())
+	})
+
+	It("should account the usage of the shoots of the project", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		usage := project.Status.Usage
+		Expect(usage.Shoots).To(Equal(int32(2)))
+		Expect(usage.Nodes).To(Equal(int32(3)))
+		Expect(usage.Workers.Cpu().Cmp(resource.MustParse("6"))).To(BeZero())
+		Expect(usage.Workers.Memory().Cmp(resource.MustParse("12Gi"))).To(BeZero())
+		Expect(usage.ControlPlanes.Cpu().Cmp(resource.MustParse("1"))).To(BeZero())
+		Expect(usage.ControlPlanes.Memory().Cmp(resource.MustParse("2Gi"))).To(BeZero())
+		Expect(usage.EstimatedHourlyCost.Cmp(resource.MustParse("1.5"))).To(BeZero())
+		Expect(usage.LastUpdateTime.Time).To(BeTemporally("==", fakeClock.Now()))
+		Expect(usage.Consumption.PeriodStart.Time).To(BeTemporally("==", time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(usage.Consumption.Workers).To(BeEmpty())
+		Expect(usage.Consumption.EstimatedCost).To(BeNil())
+
+		Expect(testutil.ToFloat64(metrics.ShootNodes.WithLabelValues("foo", "shoot1", "pool"))).To(Equal(2.0))
+		Expect(testutil.ToFloat64(metrics.ShootWorkerPoolCapacityNodes.WithLabelValues("foo", "shoot1", "pool", "max"))).To(Equal(3.0))
+		Expect(testutil.ToFloat64(metrics.ShootResources.WithLabelValues("foo", "shoot1", "control-plane", "cpu"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(metrics.ShootEstimatedHourlyCost.WithLabelValues("foo", "shoot2"))).To(Equal(0.5))
+	})
+
+	It("should only delete the metrics of vanished shoots and worker pools", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(testutil.CollectAndCount(metrics.ShootNodes)).To(Equal(2))
+		Expect(testutil.CollectAndCount(metrics.ShootEstimatedHourlyCost)).To(Equal(2))
+
+		Expect(fakeClient.Delete(ctx, shoot2)).To(Succeed())
+		shoot1.Spec.Provider.Workers[0].Name = "other"
+		shoot1.Status.Usage.Workers[0].Name = "other"
+		Expect(fakeClient.Update(ctx, shoot1)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(testutil.CollectAndCount(metrics.ShootNodes)).To(Equal(1))
+		Expect(testutil.ToFloat64(metrics.ShootNodes.WithLabelValues("foo", "shoot1", "other"))).To(Equal(2.0))
+		Expect(testutil.CollectAndCount(metrics.ShootWorkerPoolCapacityNodes)).To(Equal(2))
+		Expect(testutil.CollectAndCount(metrics.ShootEstimatedHourlyCost)).To(Equal(1))
+		Expect(testutil.ToFloat64(metrics.ShootEstimatedHourlyCost.WithLabelValues("foo", "shoot1"))).To(Equal(1.0))
+	})
+
+	Context("hibernated shoot", func() {
+		BeforeEach(func() {
+			shoot2.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+		})
+
+		It("should not account nodes for hibernated shoots without observed usage", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Status.Usage.Shoots).To(Equal(int32(2)))
+			Expect(project.Status.Usage.Nodes).To(Equal(int32(2)))
+		})
+	})
+
+	Context("shoot with unknown machine type", func() {
+		BeforeEach(func() {
+			shoot2.Spec.Provider.Workers[0].Machine.Type = "unknown"
+		})
+
+		It("should skip the shoot", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Status.Usage.Shoots).To(Equal(int32(1)))
+			Expect(project.Status.Usage.Nodes).To(Equal(int32(2)))
+			Expect(testutil.CollectAndCount(metrics.ShootNodes)).To(Equal(1))
+			Expect(testutil.ToFloat64(metrics.ShootUsageComputationFailures.WithLabelValues("foo", "shoot2"))).To(Equal(1.0))
+		})
+
+		It("should delete the metrics of the shoot once its usage cannot be computed anymore", func() {
+			shoot2.Spec.Provider.Workers[0].Machine.Type = "large"
+			Expect(fakeClient.Update(ctx, shoot2)).To(Succeed())
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(testutil.CollectAndCount(metrics.ShootNodes)).To(Equal(2))
+
+			shoot2.Spec.Provider.Workers[0].Machine.Type = "unknown"
+			Expect(fakeClient.Update(ctx, shoot2)).To(Succeed())
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(testutil.CollectAndCount(metrics.ShootNodes)).To(Equal(1))
+			Expect(testutil.ToFloat64(metrics.ShootUsageComputationFailures.WithLabelValues("foo", "shoot2"))).To(Equal(1.0))
+		})
+	})
+
+	Context("previous usage in the current accounting period", func() {
+		BeforeEach(func() {
+			project.Status.Usage = &gardencorev1beta1.ProjectUsage{
+				Workers:             corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+				EstimatedHourlyCost: ptr.To(resource.MustParse("1")),
+				LastUpdateTime:      metav1.Time{Time: fakeClock.Now().Add(-2 * time.Hour)},
+				Consumption: &gardencorev1beta1.ProjectConsumption{
+					PeriodStart:   metav1.Time{Time: time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)},
+					Workers:       corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10")},
+					EstimatedCost: ptr.To(resource.MustParse("3")),
+				},
+			}
+		})
+
+		It("should accumulate the consumption since the last update", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			consumption := project.Status.Usage.Consumption
+			Expect(consumption.Workers.Cpu().Cmp(resource.MustParse("18"))).To(BeZero())
+			Expect(consumption.Workers.Memory().Cmp(resource.MustParse("2Gi"))).To(BeZero())
+			Expect(consumption.EstimatedCost.Cmp(resource.MustParse("5"))).To(BeZero())
+
+			Expect(testutil.ToFloat64(metrics.ProjectEstimatedCost.WithLabelValues("foo"))).To(Equal(5.0))
+		})
+	})
+
+	Context("previous usage in the previous accounting period", func() {
+		BeforeEach(func() {
+			project.Status.Usage = &gardencorev1beta1.ProjectUsage{
+				Workers:        corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+				LastUpdateTime: metav1.Time{Time: time.Date(2025, 9, 30, 22, 0, 0, 0, time.UTC)},
+				Consumption: &gardencorev1beta1.ProjectConsumption{
+					PeriodStart: metav1.Time{Time: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)},
+					Workers:     corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100")},
+				},
+			}
+		})
+
+		It("should reset the consumption and account from the start of the new period", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			consumption := project.Status.Usage.Consumption
+			Expect(consumption.PeriodStart.Time).To(BeTemporally("==", time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(consumption.Workers.Cpu().Cmp(resource.MustParse("40"))).To(BeZero())
+		})
+	})
+})
+
+func newShoot(name string, minimum, maximum int32) *gardencorev1beta1.Shoot {
+	return &gardencorev1beta1.Shoot{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo"},
+		Spec: gardencorev1beta1.ShootSpec{
+			CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "profile"},
+			Provider: gardencorev1beta1.Provider{
+				Workers: []gardencorev1beta1.Worker{{
+					Name:    "pool",
+					Machine: gardencorev1beta1.Machine{Type: "large"},
+					Minimum: minimum,
+					Maximum: maximum,
+				}},
+			},
+		},
+	}
+}
@@ -12,9 +12,12 @@ import (
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/backupbucketscheck"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/drain"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/extensionscheck"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/rebalancer"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/reference"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/secrets"
+	shootscheduler "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
 )
 
 // AddToManager adds all Seed controllers to the given manager.
@@ -25,12 +28,35 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding backupbuckets check reconciler: %w", err)
 	}
 
+	// The destination seeds of shoots migrated by the drain and rebalancer reconcilers are determined with the same
+	// scheduler.
+	scheduler, err := shootscheduler.NewReschedulingReconciler(mgr.GetClient(), cfg.Controllers.ShootScheduler)
+	if err != nil {
+		return fmt.Errorf("failed creating scheduler for rescheduling shoots: %w", err)
+	}
+
+	if err := (&drain.Reconciler{
+		Config:    *cfg.Controllers.SeedDrain,
+		Scheduler: scheduler,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding drain reconciler: %w", err)
+	}
+
 	if err := (&extensionscheck.Reconciler{
 		Config: *cfg.Controllers.SeedExtensionsCheck,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding extensions check reconciler: %w", err)
 	}
 
+	if config := cfg.Controllers.SeedRebalancer; config != nil {
+		if err := (&rebalancer.Reconciler{
+			Config:    *config,
+			Scheduler: scheduler,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding rebalancer reconciler: %w", err)
+		}
+	}
+
 	if err := (&secrets.Reconciler{}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding secrets reconciler: %w", err)
 	}
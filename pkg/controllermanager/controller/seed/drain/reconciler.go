@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	shootscheduler "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// EventShootRescheduled is an event reason for shoots which were rescheduled to another seed because their seed
+	// is being drained.
+	EventShootRescheduled = "RescheduledForSeedDrain"
+	// EventShootReschedulingFailed is an event reason for shoots which could not be rescheduled to another seed
+	// although their seed is being drained.
+	EventShootReschedulingFailed = "SeedDrainReschedulingFailed"
+	// EventSeedDrained is an event reason for seeds which no longer host any shoot control planes after they were
+	// drained.
+	EventSeedDrained = "SeedDrained"
+)
+
+// Reconciler reconciles Seeds tainted with the draining taint and migrates the control planes of their shoots to other
+// seeds.
+type Reconciler struct {
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.SeedDrainControllerConfiguration
+	Clock    clock.Clock
+	Recorder events.EventRecorder
+	// Scheduler determines the destination seeds of the shoots, see shootscheduler.NewReschedulingReconciler.
+	Scheduler *shootscheduler.Reconciler
+}
+
+// Reconcile reconciles Seeds tainted with the draining taint and migrates the control planes of their shoots to other
+// seeds.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, req.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if !v1beta1helper.TaintsHave(seed.Spec.Taints, gardencorev1beta1.SeedTaintDraining) {
+		if seed.Status.Drain == nil {
+			return reconcile.Result{}, nil
+		}
+
+		log.Info("Seed is no longer being drained, removing drain status")
+		patch := client.MergeFrom(seed.DeepCopy())
+		seed.Status.Drain = nil
+		return reconcile.Result{}, r.Client.Status().Patch(ctx, seed, patch)
+	}
+
+	shoots, err := r.listShoots(ctx, seed.Name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var (
+		now                 = r.Clock.Now()
+		candidates          []*gardencorev1beta1.Shoot
+		migratingShoots     int32
+		unschedulableShoots []gardencorev1beta1.SeedDrainShoot
+	)
+
+	for _, shoot := range shoots {
+		if v1beta1helper.IsShootControlPlaneMigrating(shoot) {
+			// The shoot was already rescheduled, but its control plane has not been restored on the destination seed yet,
+			// or it is currently being restored on this seed.
+			migratingShoots++
+			continue
+		}
+
+		if shoot.DeletionTimestamp != nil || shoot.Status.SeedName == nil {
+			// Shoots which are being deleted will leave the seed anyway, and shoots which have not yet been created on
+			// the seed cannot be migrated.
+			continue
+		}
+
+		if ptr.Deref(r.Config.RespectMaintenanceTimeWindow, false) && !gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot).Contains(now) {
+			continue
+		}
+
+		candidates = append(candidates, shoot)
+	}
+
+	slices.SortFunc(candidates, func(a, b *gardencorev1beta1.Shoot) int {
+		return strings.Compare(client.ObjectKeyFromObject(a).String(), client.ObjectKeyFromObject(b).String())
+	})
+
+	var (
+		scheduler = r.Scheduler.ForSourceSeed(seed)
+		freeSlots = ptr.Deref(r.Config.MaxConcurrentMigrations, 1) - migratingShoots
+	)
+
+	reportUnschedulable := func(shoot *gardencorev1beta1.Shoot, message string) {
+		if !isUnschedulable(seed.Status.Drain, shoot) {
+			r.Recorder.Eventf(shoot, nil, corev1.EventTypeWarning, EventShootReschedulingFailed, gardencorev1beta1.EventActionReconcile, "Seed %q is being drained but the shoot cannot be migrated: %s", seed.Name, message)
+		}
+		unschedulableShoots = append(unschedulableShoots, drainShoot(shoot, message))
+	}
+
+	for _, shoot := range candidates {
+		if seed.Spec.Backup == nil {
+			unschedulableShoots = append(unschedulableShoots, drainShoot(shoot, "backup is not configured for the seed, hence the control plane cannot be migrated"))
+			continue
+		}
+
+		if freeSlots <= 0 {
+			break
+		}
+
+		destinationSeed, err := scheduler.DetermineSeed(ctx, log, shoot)
+		if err != nil {
+			log.Info("No destination seed found for shoot", "shoot", client.ObjectKeyFromObject(shoot), "reason", err.Error())
+			reportUnschedulable(shoot, fmt.Sprintf("no destination seed found: %s", err.Error()))
+			continue
+		}
+
+		if err := r.reschedule(ctx, log, shoot, seed.Name, destinationSeed.Name); err != nil {
+			log.Error(err, "Failed rescheduling shoot", "shoot", client.ObjectKeyFromObject(shoot))
+			reportUnschedulable(shoot, err.Error())
+			continue
+		}
+
+		migratingShoots++
+		freeSlots--
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.patchDrainStatus(ctx, log, seed, int32(len(shoots)), migratingShoots, unschedulableShoots)
+}
+
+func (r *Reconciler) listShoots(ctx context.Context, seedName string) ([]*gardencorev1beta1.Shoot, error) {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.MatchingFields{core.ShootSeedName: seedName}); err != nil {
+		return nil, fmt.Errorf("failed listing shoots scheduled to seed: %w", err)
+	}
+	shootItems := v1beta1helper.ShootItems(*shootList)
+
+	shootList2 := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList2, client.MatchingFields{core.ShootStatusSeedName: seedName}); err != nil {
+		return nil, fmt.Errorf("failed listing shoots hosted by seed: %w", err)
+	}
+	shootItems2 := v1beta1helper.ShootItems(*shootList2)
+
+	var shoots []*gardencorev1beta1.Shoot
+	for _, shoot := range shootItems.Union(&shootItems2) {
+		shoots = append(shoots, shoot.DeepCopy())
+	}
+	return shoots, nil
+}
+
+func (r *Reconciler) reschedule(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, sourceSeedName, destinationSeedName string) error {
+	log = log.WithValues("shoot", client.ObjectKeyFromObject(shoot), "destinationSeed", destinationSeedName)
+
+	shoot.Spec.SeedName = &destinationSeedName
+	if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+		return fmt.Errorf("failed to bind shoot %s to seed %q: %w", client.ObjectKeyFromObject(shoot), destinationSeedName, err)
+	}
+
+	log.Info("Rescheduled shoot to another seed because its seed is being drained")
+	r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, EventShootRescheduled, gardencorev1beta1.EventActionReconcile, "Rescheduled from seed %q to seed %q because seed %q is being drained", sourceSeedName, destinationSeedName, sourceSeedName)
+	return nil
+}
+
+func (r *Reconciler) patchDrainStatus(ctx context.Context, log logr.Logger, seed *gardencorev1beta1.Seed, remainingShoots, migratingShoots int32, unschedulableShoots []gardencorev1beta1.SeedDrainShoot) error {
+	now := metav1.NewTime(r.Clock.Now())
+
+	drainStatus := &gardencorev1beta1.SeedDrainStatus{
+		StartTime:           now,
+		LastUpdateTime:      now,
+		RemainingShoots:     remainingShoots,
+		MigratingShoots:     migratingShoots,
+		UnschedulableShoots: unschedulableShoots,
+	}
+
+	if seed.Status.Drain != nil {
+		drainStatus.StartTime = seed.Status.Drain.StartTime
+	}
+
+	if remainingShoots == 0 && (seed.Status.Drain == nil || seed.Status.Drain.RemainingShoots != 0) {
+		log.Info("Seed is drained, it no longer hosts any shoot control planes")
+		r.Recorder.Eventf(seed, nil, corev1.EventTypeNormal, EventSeedDrained, gardencorev1beta1.EventActionReconcile, "Seed is drained, it no longer hosts any shoot control planes")
+	}
+
+	patch := client.MergeFrom(seed.DeepCopy())
+	seed.Status.Drain = drainStatus
+	return r.Client.Status().Patch(ctx, seed, patch)
+}
+
+func drainShoot(shoot *gardencorev1beta1.Shoot, message string) gardencorev1beta1.SeedDrainShoot {
+	return gardencorev1beta1.SeedDrainShoot{
+		Namespace: shoot.Namespace,
+		Name:      shoot.Name,
+		Message:   message,
+	}
+}
+
+func isUnschedulable(drainStatus *gardencorev1beta1.SeedDrainStatus, shoot *gardencorev1beta1.Shoot) bool {
+	if drainStatus == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(drainStatus.UnschedulableShoots, func(s gardencorev1beta1.SeedDrainShoot) bool {
+		return s.Namespace == shoot.Namespace && s.Name == shoot.Name
+	})
+}
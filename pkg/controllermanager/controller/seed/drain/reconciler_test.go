@@ -0,0 +1,372 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/drain"
+	shootscheduler "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *events.FakeRecorder
+		reconciler *Reconciler
+
+		cloudProfile    *gardencorev1beta1.CloudProfile
+		project         *gardencorev1beta1.Project
+		seed            *gardencorev1beta1.Seed
+		destinationSeed *gardencorev1beta1.Seed
+		shoot1          *gardencorev1beta1.Shoot
+		shoot2          *gardencorev1beta1.Shoot
+		request         reconcile.Request
+
+		bindingErrors map[string]error
+
+		syncPeriod = time.Minute
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 10, 1, 10, 0, 0, 0, time.UTC))
+		recorder = events.NewFakeRecorder(10)
+		bindingErrors = make(map[string]error)
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Seed{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				// The fake client does not support the binding subresource of shoots.
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					if subResourceName == "binding" {
+						if err := bindingErrors[obj.GetName()]; err != nil {
+							return err
+						}
+						return c.Update(ctx, obj)
+					}
+					return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+				},
+			}).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Spec.SeedName, "")}
+			}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Status.SeedName, "")}
+			}).
+			Build()
+
+		scheduler, err := shootscheduler.NewReschedulingReconciler(fakeClient, &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.SameRegion})
+		Expect(err).NotTo(HaveOccurred())
+
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Clock:    fakeClock,
+			Recorder: recorder,
+			Config: controllermanagerconfigv1alpha1.SeedDrainControllerConfiguration{
+				SyncPeriod:              &metav1.Duration{Duration: syncPeriod},
+				MaxConcurrentMigrations: ptr.To[int32](1),
+			},
+			Scheduler: scheduler,
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "profile"}}
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		}
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "source"},
+			Spec: gardencorev1beta1.SeedSpec{
+				Backup:   &gardencorev1beta1.Backup{Provider: "local"},
+				Provider: gardencorev1beta1.SeedProvider{Type: "local", Region: "local"},
+				Networks: gardencorev1beta1.SeedNetworks{
+					Nodes:    ptr.To("10.10.0.0/16"),
+					Pods:     "10.20.0.0/16",
+					Services: "10.30.0.0/16",
+				},
+				Settings: &gardencorev1beta1.SeedSettings{
+					Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+				},
+				Taints: []gardencorev1beta1.SeedTaint{{Key: gardencorev1beta1.SeedTaintDraining}},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: gardencorev1beta1.GardenletReady, Status: gardencorev1beta1.ConditionTrue},
+					{Type: gardencorev1beta1.SeedBackupBucketsReady, Status: gardencorev1beta1.ConditionTrue},
+				},
+				LastOperation: &gardencorev1beta1.LastOperation{},
+			},
+		}
+
+		destinationSeed = seed.DeepCopy()
+		destinationSeed.Name = "destination"
+		destinationSeed.Spec.Taints = nil
+
+		shoot1 = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot1", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: ptr.To("profile"),
+				Region:           "local",
+				Provider:         gardencorev1beta1.Provider{Type: "local", Workers: []gardencorev1beta1.Worker{{Name: "pool"}}},
+				Networking: &gardencorev1beta1.Networking{
+					Nodes:    ptr.To("10.40.0.0/16"),
+					Pods:     ptr.To("10.50.0.0/16"),
+					Services: ptr.To("10.60.0.0/16"),
+				},
+				SeedName: ptr.To("source"),
+			},
+			Status: gardencorev1beta1.ShootStatus{SeedName: ptr.To("source")},
+		}
+
+		shoot2 = shoot1.DeepCopy()
+		shoot2.Name = "shoot2"
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+	})
+
+	createSeed := func(seed *gardencorev1beta1.Seed) {
+		status := seed.Status
+		ExpectWithOffset(1, fakeClient.Create(ctx, seed)).To(Succeed())
+		seed.Status = status
+		ExpectWithOffset(1, fakeClient.Status().Update(ctx, seed)).To(Succeed())
+	}
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		createSeed(seed)
+		createSeed(destinationSeed)
+		Expect(fakeClient.Create(ctx, shoot1)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot2)).To(Succeed())
+	})
+
+	It("should do nothing if the seed is gone", func() {
+		Expect(fakeClient.Delete(ctx, seed)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	Context("seed not being drained", func() {
+		BeforeEach(func() {
+			seed.Spec.Taints = nil
+		})
+
+		It("should not reschedule any shoot", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot1), shoot1)).To(Succeed())
+			Expect(shoot1.Spec.SeedName).To(HaveValue(Equal("source")))
+		})
+
+		It("should remove the drain status", func() {
+			seed.Status.Drain = &gardencorev1beta1.SeedDrainStatus{RemainingShoots: 1}
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+			Expect(seed.Status.Drain).To(BeNil())
+		})
+	})
+
+	It("should reschedule shoots up to the maximum number of concurrent migrations", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot1), shoot1)).To(Succeed())
+		Expect(shoot1.Spec.SeedName).To(HaveValue(Equal("destination")))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot2), shoot2)).To(Succeed())
+		Expect(shoot2.Spec.SeedName).To(HaveValue(Equal("source")))
+		Eventually(recorder.Events).Should(Receive(ContainSubstring(`Normal RescheduledForSeedDrain Rescheduled from seed "source" to seed "destination"`)))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+		Expect(seed.Status.Drain.StartTime.Time).To(BeTemporally("==", fakeClock.Now()))
+		Expect(seed.Status.Drain.LastUpdateTime.Time).To(BeTemporally("==", fakeClock.Now()))
+		Expect(seed.Status.Drain.RemainingShoots).To(Equal(int32(2)))
+		Expect(seed.Status.Drain.MigratingShoots).To(Equal(int32(1)))
+		Expect(seed.Status.Drain.UnschedulableShoots).To(BeEmpty())
+
+		By("Not rescheduling further shoots while the migration is in progress")
+		fakeClock.Step(syncPeriod)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot2), shoot2)).To(Succeed())
+		Expect(shoot2.Spec.SeedName).To(HaveValue(Equal("source")))
+
+		By("Rescheduling the next shoot once the migration has finished")
+		shoot1.Status.SeedName = ptr.To("destination")
+		Expect(fakeClient.Update(ctx, shoot1)).To(Succeed())
+
+		fakeClock.Step(syncPeriod)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot2), shoot2)).To(Succeed())
+		Expect(shoot2.Spec.SeedName).To(HaveValue(Equal("destination")))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+		Expect(seed.Status.Drain.StartTime.Time).To(BeTemporally("==", fakeClock.Now().Add(-2*syncPeriod)))
+		Expect(seed.Status.Drain.LastUpdateTime.Time).To(BeTemporally("==", fakeClock.Now()))
+		Expect(seed.Status.Drain.RemainingShoots).To(Equal(int32(1)))
+		Expect(seed.Status.Drain.MigratingShoots).To(Equal(int32(1)))
+	})
+
+	It("should not reschedule further shoots while a shoot is being restored on the seed", func() {
+		shoot1.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore, State: gardencorev1beta1.LastOperationStateProcessing}
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot2), shoot2)).To(Succeed())
+		Expect(shoot2.Spec.SeedName).To(HaveValue(Equal("source")))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+		Expect(seed.Status.Drain.MigratingShoots).To(Equal(int32(1)))
+	})
+
+	It("should continue with the next shoot if a shoot cannot be bound to the destination seed", func() {
+		reconciler.Config.MaxConcurrentMigrations = ptr.To[int32](2)
+		bindingErrors[shoot1.Name] = errors.New("fake error")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot1), shoot1)).To(Succeed())
+		Expect(shoot1.Spec.SeedName).To(HaveValue(Equal("source")))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot2), shoot2)).To(Succeed())
+		Expect(shoot2.Spec.SeedName).To(HaveValue(Equal("destination")))
+		Eventually(recorder.Events).Should(Receive(ContainSubstring("Warning SeedDrainReschedulingFailed")))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+		Expect(seed.Status.Drain.MigratingShoots).To(Equal(int32(1)))
+		Expect(seed.Status.Drain.UnschedulableShoots).To(ConsistOf(
+			And(HaveField("Name", "shoot1"), HaveField("Message", ContainSubstring("fake error"))),
+		))
+	})
+
+	It("should report that the seed is drained once no shoots are left", func() {
+		Expect(fakeClient.Delete(ctx, shoot1)).To(Succeed())
+		Expect(fakeClient.Delete(ctx, shoot2)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+		Expect(seed.Status.Drain.RemainingShoots).To(BeZero())
+		Eventually(recorder.Events).Should(Receive(ContainSubstring("Normal SeedDrained")))
+
+		By("Not reporting the event again")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	Context("seed without backup", func() {
+		BeforeEach(func() {
+			seed.Spec.Backup = nil
+		})
+
+		It("should report shoots as unschedulable", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+			Expect(seed.Status.Drain.MigratingShoots).To(BeZero())
+			Expect(seed.Status.Drain.UnschedulableShoots).To(ConsistOf(
+				HaveField("Name", "shoot1"),
+				HaveField("Name", "shoot2"),
+			))
+		})
+	})
+
+	Context("no destination seed", func() {
+		BeforeEach(func() {
+			destinationSeed.Spec.Backup = nil
+		})
+
+		It("should report shoots as unschedulable", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot1), shoot1)).To(Succeed())
+			Expect(shoot1.Spec.SeedName).To(HaveValue(Equal("source")))
+			Eventually(recorder.Events).Should(Receive(ContainSubstring("Warning SeedDrainReschedulingFailed")))
+			Eventually(recorder.Events).Should(Receive(ContainSubstring("Warning SeedDrainReschedulingFailed")))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+			Expect(seed.Status.Drain.UnschedulableShoots).To(ConsistOf(
+				And(HaveField("Name", "shoot1"), HaveField("Message", ContainSubstring("backup is not configured for seed"))),
+				HaveField("Name", "shoot2"),
+			))
+
+			By("Not reporting the events again")
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+
+	Context("respecting maintenance time windows", func() {
+		BeforeEach(func() {
+			reconciler.Config.RespectMaintenanceTimeWindow = ptr.To(true)
+			shoot1.Spec.Maintenance = &gardencorev1beta1.Maintenance{TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"}}
+			shoot2.Spec.Maintenance = &gardencorev1beta1.Maintenance{TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "093000+0000", End: "113000+0000"}}
+		})
+
+		It("should only reschedule shoots within their maintenance time window", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot1), shoot1)).To(Succeed())
+			Expect(shoot1.Spec.SeedName).To(HaveValue(Equal("source")))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot2), shoot2)).To(Succeed())
+			Expect(shoot2.Spec.SeedName).To(HaveValue(Equal("destination")))
+		})
+	})
+
+	Describe("#SeedPredicate", func() {
+		var (
+			p       = (&Reconciler{}).SeedPredicate()
+			oldSeed *gardencorev1beta1.Seed
+		)
+
+		BeforeEach(func() {
+			oldSeed = seed.DeepCopy()
+			oldSeed.Spec.Taints = nil
+		})
+
+		It("should react on create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: seed})).To(BeTrue())
+		})
+
+		It("should react when the draining taint is added or removed", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldSeed, ObjectNew: seed})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: oldSeed})).To(BeTrue())
+		})
+
+		It("should not react on other updates", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: seed.DeepCopy()})).To(BeFalse())
+		})
+
+		It("should not react on delete and generic events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: seed})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: seed})).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	shootscheduler "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// EventShootRebalanced is an event reason for shoots which were rescheduled to another seed in order to balance
+	// the utilization of the seeds.
+	EventShootRebalanced = "Rebalanced"
+	// EventRebalancingPlanned is an event reason for seeds from which a shoot would be migrated to another seed if the
+	// rebalancer was not running in dry-run mode.
+	EventRebalancingPlanned = "RebalancingPlanned"
+	// EventShootRebalancingFailed is an event reason for shoots which could not be rescheduled to another seed in
+	// order to balance the utilization of the seeds.
+	EventShootRebalancingFailed = "RebalancingFailed"
+)
+
+// Reconciler reconciles Seeds and migrates the control planes of shoots which opted in for rebalancing to less
+// utilized seeds.
+type Reconciler struct {
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration
+	Clock    clock.Clock
+	Recorder events.EventRecorder
+	// Scheduler determines the destination seeds of the shoots, see shootscheduler.NewReschedulingReconciler.
+	Scheduler *shootscheduler.Reconciler
+}
+
+// Reconcile reconciles Seeds and migrates the control planes of shoots which opted in for rebalancing to less
+// utilized seeds.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, req.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	result := reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}
+
+	if seed.DeletionTimestamp != nil || seed.Spec.Backup == nil || v1beta1helper.TaintsHave(seed.Spec.Taints, gardencorev1beta1.SeedTaintDraining) {
+		// Shoots cannot be migrated away from seeds without backup, and shoots on deleting or draining seeds are not
+		// subject to rebalancing.
+		log.V(1).Info("Skipping rebalancing of seed because it is being deleted, being drained or has no backup")
+		return result, nil
+	}
+
+	// All shoots are listed only once since both the utilization of the seeds and the number of migrations are
+	// determined for the whole landscape.
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	var (
+		now             = r.Clock.Now()
+		dryRun          = ptr.Deref(r.Config.DryRun, true)
+		shootsPerSeed   = make(map[string]int32)
+		migratingShoots int32
+		candidates      []*gardencorev1beta1.Shoot
+	)
+
+	for _, shoot := range shootList.Items {
+		if shoot.Spec.SeedName == nil {
+			continue
+		}
+		shootsPerSeed[*shoot.Spec.SeedName]++
+
+		if v1beta1helper.IsShootControlPlaneMigrating(&shoot) {
+			migratingShoots++
+			continue
+		}
+
+		if *shoot.Spec.SeedName != seed.Name ||
+			shoot.Status.SeedName == nil ||
+			shoot.DeletionTimestamp != nil ||
+			shoot.Labels[v1beta1constants.ShootRebalancing] != "enabled" {
+			continue
+		}
+
+		if ptr.Deref(r.Config.RespectMaintenanceTimeWindow, true) && !gardenerutils.EffectiveShootMaintenanceTimeWindow(&shoot).Contains(now) {
+			continue
+		}
+
+		candidates = append(candidates, shoot.DeepCopy())
+	}
+
+	slices.SortFunc(candidates, func(a, b *gardencorev1beta1.Shoot) int {
+		return strings.Compare(client.ObjectKeyFromObject(a).String(), client.ObjectKeyFromObject(b).String())
+	})
+
+	scheduler := r.Scheduler.ForSourceSeed(seed)
+
+	for _, shoot := range candidates {
+		if migratingShoots >= ptr.Deref(r.Config.MaxConcurrentMigrations, 1) {
+			log.Info("Maximum number of concurrent migrations reached, not rebalancing further shoots", "migratingShoots", migratingShoots)
+			break
+		}
+
+		shootLog := log.WithValues("shoot", client.ObjectKeyFromObject(shoot))
+
+		destinationSeed, err := scheduler.DetermineSeed(ctx, shootLog, shoot)
+		if err != nil {
+			shootLog.V(1).Info("No destination seed found for shoot", "reason", err.Error())
+			continue
+		}
+
+		sourceShoots, destinationShoots := shootsPerSeed[seed.Name], shootsPerSeed[destinationSeed.Name]
+		if sourceShoots-destinationShoots <= ptr.Deref(r.Config.MaxShootDifference, 10) {
+			shootLog.V(1).Info("Seed utilization is balanced enough, not rebalancing shoot", "destinationSeed", destinationSeed.Name, "sourceShoots", sourceShoots, "destinationShoots", destinationShoots)
+			continue
+		}
+
+		if dryRun {
+			shootLog.Info("Dry-run: Shoot would be rebalanced to another seed", "destinationSeed", destinationSeed.Name, "sourceShoots", sourceShoots, "destinationShoots", destinationShoots)
+			r.Recorder.Eventf(seed, nil, corev1.EventTypeNormal, EventRebalancingPlanned, gardencorev1beta1.EventActionReconcile, "Shoot %s would be migrated to seed %q (%d shoots on this seed, %d shoots on destination seed)", client.ObjectKeyFromObject(shoot), destinationSeed.Name, sourceShoots, destinationShoots)
+		} else {
+			shoot.Spec.SeedName = &destinationSeed.Name
+			if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+				shootLog.Error(err, "Failed rebalancing shoot to another seed", "destinationSeed", destinationSeed.Name)
+				r.Recorder.Eventf(shoot, nil, corev1.EventTypeWarning, EventShootRebalancingFailed, gardencorev1beta1.EventActionReconcile, "Failed rescheduling from seed %q to seed %q: %s", seed.Name, destinationSeed.Name, err.Error())
+				continue
+			}
+
+			shootLog.Info("Rebalanced shoot to another seed", "destinationSeed", destinationSeed.Name, "sourceShoots", sourceShoots, "destinationShoots", destinationShoots)
+			r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, EventShootRebalanced, gardencorev1beta1.EventActionReconcile, "Rescheduled from seed %q (%d shoots) to seed %q (%d shoots) to balance the utilization of the seeds", seed.Name, sourceShoots, destinationSeed.Name, destinationShoots)
+		}
+
+		shootsPerSeed[seed.Name]--
+		shootsPerSeed[destinationSeed.Name]++
+		migratingShoots++
+	}
+
+	return result, nil
+}
@@ -0,0 +1,337 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancer_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/rebalancer"
+	shootscheduler "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *events.FakeRecorder
+		reconciler *Reconciler
+
+		cloudProfile    *gardencorev1beta1.CloudProfile
+		project         *gardencorev1beta1.Project
+		seed            *gardencorev1beta1.Seed
+		destinationSeed *gardencorev1beta1.Seed
+		shoots          []*gardencorev1beta1.Shoot
+		request         reconcile.Request
+		bindingErrors   map[string]error
+
+		syncPeriod = time.Hour
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 10, 1, 10, 0, 0, 0, time.UTC))
+		recorder = events.NewFakeRecorder(10)
+		bindingErrors = make(map[string]error)
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Seed{}).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			WithInterceptorFuncs(interceptor.Funcs{
+				// The fake client does not support the binding subresource of shoots.
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					if subResourceName == "binding" {
+						if err := bindingErrors[obj.GetName()]; err != nil {
+							return err
+						}
+						return c.Update(ctx, obj)
+					}
+					return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+				},
+			}).
+			Build()
+
+		scheduler, err := shootscheduler.NewReschedulingReconciler(fakeClient, &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.SameRegion})
+		Expect(err).NotTo(HaveOccurred())
+
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Clock:    fakeClock,
+			Recorder: recorder,
+			Config: controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration{
+				SyncPeriod:                   &metav1.Duration{Duration: syncPeriod},
+				DryRun:                       ptr.To(false),
+				MaxShootDifference:           ptr.To[int32](2),
+				MaxConcurrentMigrations:      ptr.To[int32](1),
+				RespectMaintenanceTimeWindow: ptr.To(false),
+			},
+			Scheduler: scheduler,
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "profile"}}
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		}
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "source"},
+			Spec: gardencorev1beta1.SeedSpec{
+				Backup:   &gardencorev1beta1.Backup{Provider: "local"},
+				Provider: gardencorev1beta1.SeedProvider{Type: "local", Region: "local"},
+				Networks: gardencorev1beta1.SeedNetworks{
+					Nodes:    ptr.To("10.10.0.0/16"),
+					Pods:     "10.20.0.0/16",
+					Services: "10.30.0.0/16",
+				},
+				Settings: &gardencorev1beta1.SeedSettings{
+					Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+				},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: gardencorev1beta1.GardenletReady, Status: gardencorev1beta1.ConditionTrue},
+					{Type: gardencorev1beta1.SeedBackupBucketsReady, Status: gardencorev1beta1.ConditionTrue},
+				},
+				LastOperation: &gardencorev1beta1.LastOperation{},
+			},
+		}
+
+		destinationSeed = seed.DeepCopy()
+		destinationSeed.Name = "destination"
+
+		shoots = nil
+		for i := range 4 {
+			shoots = append(shoots, &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("shoot%d", i),
+					Namespace: "garden-foo",
+					Labels:    map[string]string{v1beta1constants.ShootRebalancing: "enabled"},
+				},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfileName: ptr.To("profile"),
+					Region:           "local",
+					Provider:         gardencorev1beta1.Provider{Type: "local", Workers: []gardencorev1beta1.Worker{{Name: "pool"}}},
+					Networking: &gardencorev1beta1.Networking{
+						Nodes:    ptr.To("10.40.0.0/16"),
+						Pods:     ptr.To("10.50.0.0/16"),
+						Services: ptr.To("10.60.0.0/16"),
+					},
+					SeedName: ptr.To("source"),
+				},
+				Status: gardencorev1beta1.ShootStatus{SeedName: ptr.To("source")},
+			})
+		}
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+	})
+
+	createSeed := func(seed *gardencorev1beta1.Seed) {
+		status := seed.Status
+		ExpectWithOffset(1, fakeClient.Create(ctx, seed)).To(Succeed())
+		seed.Status = status
+		ExpectWithOffset(1, fakeClient.Status().Update(ctx, seed)).To(Succeed())
+	}
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		createSeed(seed)
+		createSeed(destinationSeed)
+		for _, shoot := range shoots {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		}
+	})
+
+	seedNamesOfShoots := func() []string {
+		var seedNames []string
+		for _, shoot := range shoots {
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			seedNames = append(seedNames, ptr.Deref(shoot.Spec.SeedName, ""))
+		}
+		return seedNames
+	}
+
+	It("should do nothing if the seed is gone", func() {
+		Expect(fakeClient.Delete(ctx, seed)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should migrate a shoot to the less utilized seed", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(seedNamesOfShoots()).To(Equal([]string{"destination", "source", "source", "source"}))
+		Eventually(recorder.Events).Should(Receive(ContainSubstring(`Normal Rebalanced Rescheduled from seed "source" (4 shoots) to seed "destination" (0 shoots)`)))
+
+		By("Not migrating further shoots while the migration is in progress")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(seedNamesOfShoots()).To(Equal([]string{"destination", "source", "source", "source"}))
+
+		By("Not migrating further shoots once the seeds are balanced enough")
+		shoots[0].Status.SeedName = ptr.To("destination")
+		Expect(fakeClient.Update(ctx, shoots[0])).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(seedNamesOfShoots()).To(Equal([]string{"destination", "source", "source", "source"}))
+	})
+
+	Context("higher maximum number of concurrent migrations", func() {
+		BeforeEach(func() {
+			reconciler.Config.MaxConcurrentMigrations = ptr.To[int32](5)
+
+			for i := 4; i < 6; i++ {
+				shoot := shoots[0].DeepCopy()
+				shoot.Name = fmt.Sprintf("shoot%d", i)
+				shoots = append(shoots, shoot)
+			}
+		})
+
+		It("should migrate multiple shoots until the seeds are balanced enough", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(seedNamesOfShoots()).To(Equal([]string{"destination", "destination", "source", "source", "source", "source"}))
+		})
+	})
+
+	Context("shoots not opted in", func() {
+		BeforeEach(func() {
+			for _, shoot := range shoots {
+				shoot.Labels = nil
+			}
+		})
+
+		It("should not migrate any shoot", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(seedNamesOfShoots()).To(Equal([]string{"source", "source", "source", "source"}))
+		})
+	})
+
+	Context("seeds balanced enough", func() {
+		BeforeEach(func() {
+			shoots = shoots[:2]
+		})
+
+		It("should not migrate any shoot", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(seedNamesOfShoots()).To(Equal([]string{"source", "source"}))
+		})
+	})
+
+	Context("seed without backup", func() {
+		BeforeEach(func() {
+			seed.Spec.Backup = nil
+		})
+
+		It("should not migrate any shoot", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(seedNamesOfShoots()).To(Equal([]string{"source", "source", "source", "source"}))
+		})
+	})
+
+	Context("draining seed", func() {
+		BeforeEach(func() {
+			seed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: gardencorev1beta1.SeedTaintDraining}}
+		})
+
+		It("should not migrate any shoot", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(seedNamesOfShoots()).To(Equal([]string{"source", "source", "source", "source"}))
+		})
+	})
+
+	Context("migration in progress in the landscape", func() {
+		BeforeEach(func() {
+			shoots[3].Spec.SeedName = ptr.To("other")
+		})
+
+		It("should not migrate any shoot", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(seedNamesOfShoots()).To(Equal([]string{"source", "source", "source", "other"}))
+		})
+	})
+
+	Context("shoot being restored in the landscape", func() {
+		BeforeEach(func() {
+			shoots[3].Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore, State: gardencorev1beta1.LastOperationStateProcessing}
+		})
+
+		It("should not migrate any shoot", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(seedNamesOfShoots()).To(Equal([]string{"source", "source", "source", "source"}))
+		})
+	})
+
+	Context("binding failure", func() {
+		BeforeEach(func() {
+			bindingErrors["shoot0"] = errors.New("fake error")
+		})
+
+		It("should continue with the next shoot", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(seedNamesOfShoots()).To(Equal([]string{"source", "destination", "source", "source"}))
+			Eventually(recorder.Events).Should(Receive(ContainSubstring(`Warning RebalancingFailed Failed rescheduling from seed "source" to seed "destination": fake error`)))
+			Eventually(recorder.Events).Should(Receive(ContainSubstring(`Normal Rebalanced Rescheduled from seed "source" (4 shoots) to seed "destination" (0 shoots)`)))
+		})
+	})
+
+	Context("respecting maintenance time windows", func() {
+		BeforeEach(func() {
+			reconciler.Config.RespectMaintenanceTimeWindow = ptr.To(true)
+			for _, shoot := range shoots {
+				shoot.Spec.Maintenance = &gardencorev1beta1.Maintenance{TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"}}
+			}
+			shoots[2].Spec.Maintenance.TimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{Begin: "093000+0000", End: "113000+0000"}
+		})
+
+		It("should only migrate shoots within their maintenance time window", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(seedNamesOfShoots()).To(Equal([]string{"source", "source", "destination", "source"}))
+		})
+	})
+
+	Context("dry-run", func() {
+		BeforeEach(func() {
+			reconciler.Config.DryRun = ptr.To(true)
+		})
+
+		It("should only emit the plan as events", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(seedNamesOfShoots()).To(Equal([]string{"source", "source", "source", "source"}))
+			Eventually(recorder.Events).Should(Receive(ContainSubstring(`Normal RebalancingPlanned Shoot garden-foo/shoot0 would be migrated to seed "destination" (4 shoots on this seed, 0 shoots on destination seed)`)))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+})
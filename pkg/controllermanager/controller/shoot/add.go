@@ -17,6 +17,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/quota"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/reference"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/retry"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/rollout"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/statuslabel"
 )
 
@@ -40,6 +41,14 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding maintenance reconciler: %w", err)
 	}
 
+	if cfg.Controllers.ShootMaintenance.StagedRollout != nil {
+		if err := (&rollout.Reconciler{
+			Config: cfg.Controllers.ShootMaintenance,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding rollout reconciler: %w", err)
+		}
+	}
+
 	if err := (&quota.Reconciler{
 		Config: *cfg.Controllers.ShootQuota,
 	}).AddToManager(mgr); err != nil {
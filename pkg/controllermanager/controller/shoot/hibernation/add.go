@@ -53,7 +53,9 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 			if !ok {
 				return false
 			}
-			return len(getShootHibernationSchedules(shoot.Spec.Hibernation)) > 0
+			return len(getShootHibernationSchedules(shoot.Spec.Hibernation)) > 0 ||
+				getShootHibernationInactivity(shoot.Spec.Hibernation) != nil ||
+				hasWakeUpAnnotation(shoot)
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
@@ -66,12 +68,25 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 				return false
 			}
 
+			if hasWakeUpAnnotation(shoot) {
+				return true
+			}
+
 			var (
 				oldSchedules = getShootHibernationSchedules(oldShoot.Spec.Hibernation)
 				newSchedules = getShootHibernationSchedules(shoot.Spec.Hibernation)
+
+				oldInactivity = getShootHibernationInactivity(oldShoot.Spec.Hibernation)
+				newInactivity = getShootHibernationInactivity(shoot.Spec.Hibernation)
 			)
 
-			return !reflect.DeepEqual(oldSchedules, newSchedules) && len(newSchedules) > 0
+			if !reflect.DeepEqual(oldSchedules, newSchedules) && len(newSchedules) > 0 {
+				return true
+			}
+
+			// Shoots with an inactivity timeout must be reconciled when the timeout changes or when they have been woken up,
+			// since they are not requeued while being hibernated.
+			return newInactivity != nil && (!reflect.DeepEqual(oldInactivity, newInactivity) || oldShoot.Status.IsHibernated != shoot.Status.IsHibernated)
 		},
 	}
 }
@@ -5,6 +5,8 @@
 package hibernation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -59,6 +61,17 @@ var _ = Describe("Add", func() {
 			It("should return true because shoot has hibernation schedules", func() {
 				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
 			})
+
+			It("should return true because shoot has an inactivity timeout", func() {
+				shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Inactivity: &gardencorev1beta1.HibernationInactivity{Timeout: metav1.Duration{Duration: time.Hour}}}
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			})
+
+			It("should return true because shoot has the wake-up annotation", func() {
+				shoot.Spec.Hibernation = nil
+				shoot.Annotations = map[string]string{"gardener.cloud/operation": "wake-up"}
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			})
 		})
 
 		Describe("#Update", func() {
@@ -85,6 +98,43 @@ var _ = Describe("Add", func() {
 				shoot.Spec.Hibernation.Schedules[0].Start = ptr.To("00 20 * * 1,2,3,4,5,6,7")
 				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
 			})
+
+			It("should return true because shoot has the wake-up annotation", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Annotations = map[string]string{"gardener.cloud/operation": "wake-up"}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			Context("inactivity timeout", func() {
+				BeforeEach(func() {
+					shoot.Spec.Hibernation.Inactivity = &gardencorev1beta1.HibernationInactivity{Timeout: metav1.Duration{Duration: time.Hour}}
+				})
+
+				It("should return true because the inactivity timeout changed", func() {
+					oldShoot := shoot.DeepCopy()
+					shoot.Spec.Hibernation.Inactivity.Timeout.Duration = 2 * time.Hour
+					Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+				})
+
+				It("should return true because the shoot has been woken up", func() {
+					oldShoot := shoot.DeepCopy()
+					oldShoot.Status.IsHibernated = true
+					Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+				})
+
+				It("should return false because the inactivity timeout was removed", func() {
+					oldShoot := shoot.DeepCopy()
+					shoot.Spec.Hibernation.Inactivity = nil
+					Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+				})
+
+				It("should return false because the shoot has been woken up but has no inactivity timeout", func() {
+					shoot.Spec.Hibernation.Inactivity = nil
+					oldShoot := shoot.DeepCopy()
+					oldShoot.Status.IsHibernated = true
+					Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+				})
+			})
 		})
 	})
 })
@@ -10,6 +10,7 @@ import (
 	"slices"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/robfig/cron"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -21,8 +22,10 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -38,6 +41,12 @@ const (
 	wakeUp
 )
 
+var reasonDescriptions = map[gardencorev1beta1.HibernationReason]string{
+	gardencorev1beta1.HibernationReasonSchedule:       "due to schedule",
+	gardencorev1beta1.HibernationReasonInactivity:     "due to inactivity",
+	gardencorev1beta1.HibernationReasonWakeUpOnDemand: "on demand",
+}
+
 // parsedHibernationSchedule holds the loaded location, parsed cron schedule and information whether
 // the cluster should be hibernated or woken up.
 type parsedHibernationSchedule struct {
@@ -67,7 +76,8 @@ func (s *parsedHibernationSchedule) previous(from, to time.Time) *time.Time {
 	return previousActivationTime
 }
 
-// Reconciler reconciles Shoots and hibernates or wakes them up according to their hibernation schedules.
+// Reconciler reconciles Shoots and hibernates or wakes them up according to their hibernation schedules, hibernates
+// them after a period of inactivity, and wakes them up on demand.
 type Reconciler struct {
 	Client   client.Client
 	Config   controllermanagerconfigv1alpha1.ShootHibernationControllerConfiguration
@@ -75,7 +85,8 @@ type Reconciler struct {
 	Recorder events.EventRecorder
 }
 
-// Reconcile reconciles Shoots and hibernates or wakes them up according to their hibernation schedules.
+// Reconcile reconciles Shoots and hibernates or wakes them up according to their hibernation schedules, hibernates
+// them after a period of inactivity, and wakes them up on demand.
 func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log := logf.FromContext(ctx)
 
@@ -93,23 +104,54 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	now := r.Clock.Now()
+
+	if hasWakeUpAnnotation(shoot) {
+		if err := r.wakeUpShootOnDemand(ctx, shoot, now); err != nil {
+			return reconcile.Result{}, err
+		}
+		log.Info("Successfully processed on-demand wake-up request")
+	}
+
+	scheduleRequeueAfter, err := r.reconcileSchedules(ctx, log, shoot, now)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	inactivityRequeueAfter, err := r.reconcileInactivity(ctx, log, shoot, now)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	requeueAfter := scheduleRequeueAfter
+	if inactivityRequeueAfter > 0 && (requeueAfter == 0 || inactivityRequeueAfter < requeueAfter) {
+		requeueAfter = inactivityRequeueAfter
+	}
+
+	if requeueAfter > 0 {
+		log.Info("Requeuing shoot hibernation", "requeueAfter", requeueAfter)
+	}
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// reconcileSchedules hibernates or wakes up the shoot according to its hibernation schedules. It returns the duration
+// after which the shoot must be reconciled again, or zero if it does not have valid hibernation schedules.
+func (r *Reconciler) reconcileSchedules(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, now time.Time) (time.Duration, error) {
 	schedules := getShootHibernationSchedules(shoot.Spec.Hibernation)
 	if len(schedules) == 0 {
-		log.Info("Hibernation schedules have been removed from shoot, stopping reconciliation")
-		return reconcile.Result{}, nil
+		log.V(1).Info("Shoot has no hibernation schedules, skipping scheduled hibernation")
+		return 0, nil
 	}
 
 	parsedSchedules, err := parseHibernationSchedules(schedules)
 	if err != nil {
-		log.Error(err, "Invalid hibernation schedules, stopping reconciliation")
-		return reconcile.Result{}, nil
+		log.Error(err, "Invalid hibernation schedules, skipping scheduled hibernation")
+		return 0, nil
 	}
 
-	now := r.Clock.Now()
 	if gardenerutils.IsShootFailedAndUpToDate(shoot) {
-		requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
-		log.Info("Shoot is in Failed state, requeuing shoot hibernation", "requeueAfter", requeueAfter)
-		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		log.Info("Shoot is in Failed state, skipping scheduled hibernation")
+		return nextHibernationTimeDuration(parsedSchedules, now), nil
 	}
 
 	// Get the schedule which caused the current reconciliation and check whether the shoot should be hibernated or woken up.
@@ -117,26 +159,72 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	// hibernated or wakeup the at a later time.
 	mostRecentSchedule := getScheduleWithMostRecentTime(parsedSchedules, r.Config.TriggerDeadlineDuration, shoot, now)
 	if mostRecentSchedule != nil {
-		if err := r.hibernateOrWakeUpShootBasedOnSchedule(ctx, shoot, mostRecentSchedule, now); err != nil {
-			return reconcile.Result{}, err
+		if err := r.hibernateOrWakeUpShoot(ctx, shoot, mostRecentSchedule.operation, gardencorev1beta1.HibernationReasonSchedule, now); err != nil {
+			return 0, err
 		}
 		log.Info("Successfully set hibernation.enabled", "enabled", *shoot.Spec.Hibernation.Enabled)
 	}
 
-	requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
-	log.Info("Requeuing shoot hibernation", "requeueAfter", requeueAfter)
-	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	return nextHibernationTimeDuration(parsedSchedules, now), nil
+}
+
+// reconcileInactivity hibernates the shoot if no user activity was observed within its inactivity timeout. It returns
+// the duration after which the shoot must be reconciled again, or zero if it is not subject to the inactivity timeout.
+func (r *Reconciler) reconcileInactivity(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, now time.Time) (time.Duration, error) {
+	inactivity := getShootHibernationInactivity(shoot.Spec.Hibernation)
+	if inactivity == nil {
+		return 0, nil
+	}
+
+	// Shoots which are (being) hibernated are not subject to the inactivity timeout. They are reconciled again as soon as
+	// they have been woken up, see ShootPredicate.
+	if v1beta1helper.HibernationIsEnabled(shoot) || shoot.Status.IsHibernated {
+		log.V(1).Info("Shoot is hibernated, skipping inactivity hibernation")
+		return 0, nil
+	}
+
+	timeout := inactivity.Timeout.Duration
+	if deadline := lastActivityTime(shoot).Add(timeout); now.Before(deadline) {
+		return deadline.Sub(now), nil
+	}
+
+	if gardenerutils.IsShootFailedAndUpToDate(shoot) {
+		log.Info("Shoot is in Failed state, skipping inactivity hibernation")
+		return timeout, nil
+	}
+
+	if err := r.hibernateOrWakeUpShoot(ctx, shoot, hibernate, gardencorev1beta1.HibernationReasonInactivity, now); err != nil {
+		return 0, err
+	}
+	log.Info("Successfully hibernated shoot due to inactivity", "timeout", timeout)
+
+	return 0, nil
 }
 
-func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context, shoot *gardencorev1beta1.Shoot, schedule *parsedHibernationSchedule, now time.Time) error {
+// wakeUpShootOnDemand wakes up the shoot if it is hibernated and removes the wake-up operation annotation.
+func (r *Reconciler) wakeUpShootOnDemand(ctx context.Context, shoot *gardencorev1beta1.Shoot, now time.Time) error {
 	patch := client.MergeFrom(shoot.DeepCopy())
-	switch schedule.operation {
+	delete(shoot.Annotations, v1beta1constants.GardenerOperation)
+
+	if !v1beta1helper.HibernationIsEnabled(shoot) {
+		return r.Client.Patch(ctx, shoot, patch)
+	}
+
+	return r.patchHibernation(ctx, shoot, patch, wakeUp, gardencorev1beta1.HibernationReasonWakeUpOnDemand, now)
+}
+
+func (r *Reconciler) hibernateOrWakeUpShoot(ctx context.Context, shoot *gardencorev1beta1.Shoot, op operation, reason gardencorev1beta1.HibernationReason, now time.Time) error {
+	return r.patchHibernation(ctx, shoot, client.MergeFrom(shoot.DeepCopy()), op, reason, now)
+}
+
+func (r *Reconciler) patchHibernation(ctx context.Context, shoot *gardencorev1beta1.Shoot, patch client.Patch, op operation, reason gardencorev1beta1.HibernationReason, now time.Time) error {
+	switch op {
 	case hibernate:
 		shoot.Spec.Hibernation.Enabled = ptr.To(true)
-		r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationEnabled, gardencorev1beta1.EventActionReconcile, "Hibernating cluster due to schedule")
+		r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationEnabled, gardencorev1beta1.EventActionReconcile, "Hibernating cluster %s", reasonDescriptions[reason])
 	case wakeUp:
 		shoot.Spec.Hibernation.Enabled = ptr.To(false)
-		r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationDisabled, gardencorev1beta1.EventActionReconcile, "Waking up cluster due to schedule")
+		r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationDisabled, gardencorev1beta1.EventActionReconcile, "Waking up cluster %s", reasonDescriptions[reason])
 	}
 	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
 		return err
@@ -144,6 +232,10 @@ func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context,
 
 	patch = client.MergeFrom(shoot.DeepCopy())
 	shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: now}
+	if shoot.Status.Hibernation == nil {
+		shoot.Status.Hibernation = &gardencorev1beta1.HibernationStatus{}
+	}
+	shoot.Status.Hibernation.Reason = reason
 	return r.Client.Status().Patch(ctx, shoot, patch)
 }
 
@@ -248,9 +340,38 @@ func getScheduleWithMostRecentTime(schedules []parsedHibernationSchedule, trigge
 	return scheduleWithMostRecentTime
 }
 
+// lastActivityTime returns the time from which the inactivity timeout of the given shoot is measured. This is the
+// last time user activity was observed, the last time the hibernation controller hibernated or woke up the shoot, or
+// the creation time of the shoot, whichever is the latest.
+func lastActivityTime(shoot *gardencorev1beta1.Shoot) time.Time {
+	lastActivityTime := shoot.CreationTimestamp.Time
+
+	if t := shoot.Status.LastHibernationTriggerTime; t != nil && t.After(lastActivityTime) {
+		lastActivityTime = t.Time
+	}
+	if shoot.Status.Hibernation != nil {
+		if t := shoot.Status.Hibernation.LastActivityTime; t != nil && t.After(lastActivityTime) {
+			lastActivityTime = t.Time
+		}
+	}
+
+	return lastActivityTime
+}
+
+func hasWakeUpAnnotation(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.ShootOperationWakeUp
+}
+
 func getShootHibernationSchedules(hibernation *gardencorev1beta1.Hibernation) []gardencorev1beta1.HibernationSchedule {
 	if hibernation == nil {
 		return nil
 	}
 	return hibernation.Schedules
 }
+
+func getShootHibernationInactivity(hibernation *gardencorev1beta1.Hibernation) *gardencorev1beta1.HibernationInactivity {
+	if hibernation == nil {
+		return nil
+	}
+	return hibernation.Inactivity
+}
@@ -417,6 +417,129 @@ var _ = Describe("Shoot Hibernation", func() {
 					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
 				}),
 			)
+
+			Context("inactivity timeout", func() {
+				var (
+					reconciler *Reconciler
+					recorder   *events.FakeRecorder
+					timeout    = 8 * time.Hour
+				)
+
+				BeforeEach(func() {
+					now = mustParseRFC3339Time(weekDayAt19)
+					fakeClock = testclock.NewFakeClock(now)
+					recorder = events.NewFakeRecorder(1)
+
+					reconciler = &Reconciler{
+						Client:   c,
+						Recorder: recorder,
+						Clock:    fakeClock,
+					}
+
+					shoot.CreationTimestamp = metav1.Time{Time: now.Add(-24 * time.Hour)}
+					shoot.Spec.Hibernation.Inactivity = &gardencorev1beta1.HibernationInactivity{Timeout: metav1.Duration{Duration: timeout}}
+				})
+
+				It("should requeue the shoot until the inactivity timeout expires", func() {
+					shoot.Status.Hibernation = &gardencorev1beta1.HibernationStatus{LastActivityTime: &metav1.Time{Time: now.Add(-time.Hour)}}
+					Expect(c.Create(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{RequeueAfter: timeout - time.Hour}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+				})
+
+				It("should hibernate the shoot when the inactivity timeout expired", func() {
+					shoot.Status.Hibernation = &gardencorev1beta1.HibernationStatus{LastActivityTime: &metav1.Time{Time: now.Add(-timeout)}}
+					Expect(c.Create(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+					Expect(shoot.Status.LastHibernationTriggerTime.Time.UTC()).To(Equal(now))
+					Expect(shoot.Status.Hibernation.Reason).To(Equal(gardencorev1beta1.HibernationReasonInactivity))
+					Expect(recorder.Events).To(Receive(ContainSubstring("Hibernating cluster due to inactivity")))
+				})
+
+				It("should measure the inactivity timeout from the last hibernation trigger time", func() {
+					shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: now.Add(-2 * time.Hour)}
+					Expect(c.Create(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{RequeueAfter: timeout - 2*time.Hour}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+				})
+
+				It("should not hibernate the shoot if it is already hibernated", func() {
+					shoot.Status.IsHibernated = true
+					Expect(c.Create(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+				})
+
+				It("should requeue the shoot at the earlier of the next schedule and the inactivity timeout", func() {
+					shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{End: &everyDayAt7}}
+					shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: now.Add(-time.Hour)}
+					Expect(c.Create(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{RequeueAfter: timeout - time.Hour}))
+				})
+			})
+
+			Context("wake-up on demand", func() {
+				var (
+					reconciler *Reconciler
+					recorder   *events.FakeRecorder
+				)
+
+				BeforeEach(func() {
+					now = mustParseRFC3339Time(weekDayAt19)
+					fakeClock = testclock.NewFakeClock(now)
+					recorder = events.NewFakeRecorder(1)
+
+					reconciler = &Reconciler{
+						Client:   c,
+						Recorder: recorder,
+						Clock:    fakeClock,
+					}
+
+					shoot.CreationTimestamp = metav1.Time{Time: now.Add(-24 * time.Hour)}
+					shoot.Annotations = map[string]string{"gardener.cloud/operation": "wake-up"}
+				})
+
+				It("should wake up the hibernated shoot and remove the annotation", func() {
+					shoot.Spec.Hibernation.Enabled = ptr.To(true)
+					shoot.Status.IsHibernated = true
+					Expect(c.Create(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+					Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeFalse()))
+					Expect(shoot.Status.LastHibernationTriggerTime.Time.UTC()).To(Equal(now))
+					Expect(shoot.Status.Hibernation.Reason).To(Equal(gardencorev1beta1.HibernationReasonWakeUpOnDemand))
+					Expect(recorder.Events).To(Receive(ContainSubstring("Waking up cluster on demand")))
+				})
+
+				It("should only remove the annotation if the shoot is not hibernated", func() {
+					Expect(c.Create(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+					Expect(shoot.Status.LastHibernationTriggerTime).To(BeNil())
+					Expect(recorder.Events).To(BeEmpty())
+				})
+			})
 		})
 	})
 })
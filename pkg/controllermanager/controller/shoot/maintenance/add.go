@@ -16,6 +16,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // ControllerName is the name of this controller.
@@ -41,7 +42,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
 			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
 		}).
-		Complete(r)
+		Complete(tracing.Reconciler(ControllerName, "shoot", r))
 }
 
 // ShootPredicate returns the predicates for the core.gardener.cloud/v1beta1.Shoot watch.
@@ -59,7 +60,20 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 			}
 
 			return (hasMaintainNowAnnotation(shoot) && !hasMaintainNowAnnotation(oldShoot)) ||
-				!apiequality.Semantic.DeepEqual(oldShoot.Spec.Maintenance.TimeWindow, shoot.Spec.Maintenance.TimeWindow)
+				!apiequality.Semantic.DeepEqual(oldShoot.Spec.Maintenance.TimeWindow, shoot.Spec.Maintenance.TimeWindow) ||
+				!apiequality.Semantic.DeepEqual(workerPoolMaintenances(oldShoot), workerPoolMaintenances(shoot))
 		},
 	}
 }
+
+// workerPoolMaintenances returns the maintenance configurations of the worker pools having their own maintenance time
+// window.
+func workerPoolMaintenances(shoot *gardencorev1beta1.Shoot) map[string]*gardencorev1beta1.WorkerMaintenance {
+	maintenances := make(map[string]*gardencorev1beta1.WorkerMaintenance)
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Maintenance != nil {
+			maintenances[worker.Name] = worker.Maintenance
+		}
+	}
+	return maintenances
+}
@@ -82,6 +82,13 @@ var _ = Describe("Add", func() {
 				shoot.Spec.Maintenance.TimeWindow.End = "456"
 				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
 			})
+
+			It("should return true because there is a worker pool maintenance change", func() {
+				shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Name: "worker"}}
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maintenance = &gardencorev1beta1.WorkerMaintenance{Weekdays: []string{"Sunday"}}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
 		})
 
 		Describe("#Delete", func() {
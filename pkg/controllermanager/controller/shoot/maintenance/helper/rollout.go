@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// RolloutWaveIndex returns the index of the rollout wave the given shoot belongs to. A shoot explicitly assigned to a
+// wave via the 'maintenance.gardener.cloud/rollout-wave' label belongs to this wave. Otherwise, it belongs to the first
+// wave whose criteria it matches, or to the last wave if it does not match any wave.
+func RolloutWaveIndex(waves []controllermanagerconfigv1alpha1.RolloutWave, shoot *gardencorev1beta1.Shoot, projectLabels map[string]string) int {
+	if index := RolloutWaveIndexForName(waves, shoot.Labels[v1beta1constants.LabelRolloutWave]); index >= 0 {
+		return index
+	}
+
+	for i, wave := range waves {
+		if len(wave.Purposes) > 0 && (shoot.Spec.Purpose == nil || !slices.Contains(wave.Purposes, *shoot.Spec.Purpose)) {
+			continue
+		}
+
+		if wave.ProjectSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(wave.ProjectSelector)
+			if err != nil || !selector.Matches(labels.Set(projectLabels)) {
+				continue
+			}
+		}
+
+		return i
+	}
+
+	return len(waves) - 1
+}
+
+// RolloutWaveIndexForName returns the index of the rollout wave with the given name, or -1 if no such wave exists.
+func RolloutWaveIndexForName(waves []controllermanagerconfigv1alpha1.RolloutWave, name string) int {
+	if name == "" {
+		return -1
+	}
+
+	return slices.IndexFunc(waves, func(wave controllermanagerconfigv1alpha1.RolloutWave) bool {
+		return wave.Name == name
+	})
+}
+
+// RolloutEligibility determines which versions of a CloudProfile are eligible for automatic updates of a shoot
+// belonging to a certain rollout wave. A nil RolloutEligibility considers all versions eligible.
+type RolloutEligibility struct {
+	// Waves is the ordered list of configured rollout waves.
+	Waves []controllermanagerconfigv1alpha1.RolloutWave
+	// WaveIndex is the index of the wave the shoot belongs to.
+	WaveIndex int
+	// Status is the status of the CloudProfile containing the rollout state of the versions.
+	Status gardencorev1beta1.CloudProfileStatus
+}
+
+// FilterKubernetesVersions returns the given Kubernetes versions without those which are not yet eligible for the
+// shoot's rollout wave. The current version of the shoot is always kept.
+func (r *RolloutEligibility) FilterKubernetesVersions(versions []gardencorev1beta1.ExpirableVersion, currentVersion string) []gardencorev1beta1.ExpirableVersion {
+	if r == nil || r.Status.Kubernetes == nil {
+		return versions
+	}
+
+	return slices.DeleteFunc(slices.Clone(versions), func(version gardencorev1beta1.ExpirableVersion) bool {
+		return version.Version != currentVersion && !r.isEligible(r.Status.Kubernetes.Versions, version.Version)
+	})
+}
+
+// FilterMachineImageVersions returns the given machine image without those versions which are not yet eligible for the
+// shoot's rollout wave. The current version of the shoot's worker pool is always kept.
+func (r *RolloutEligibility) FilterMachineImageVersions(machineImage *gardencorev1beta1.MachineImage, currentVersion string) *gardencorev1beta1.MachineImage {
+	if r == nil {
+		return machineImage
+	}
+
+	index := slices.IndexFunc(r.Status.MachineImages, func(status gardencorev1beta1.MachineImageStatus) bool {
+		return status.Name == machineImage.Name
+	})
+	if index < 0 {
+		return machineImage
+	}
+
+	filteredMachineImage := *machineImage
+	filteredMachineImage.Versions = slices.DeleteFunc(slices.Clone(machineImage.Versions), func(version gardencorev1beta1.MachineImageVersion) bool {
+		return version.Version != currentVersion && !r.isEligible(r.Status.MachineImages[index].Versions, version.Version)
+	})
+
+	return &filteredMachineImage
+}
+
+// isEligible returns whether the given version is eligible for automatic updates of shoots in the wave. Versions
+// without rollout state are eligible for all waves. Versions whose rollout is halted are not eligible for any wave.
+func (r *RolloutEligibility) isEligible(statuses []gardencorev1beta1.ExpirableVersionStatus, version string) bool {
+	index := slices.IndexFunc(statuses, func(status gardencorev1beta1.ExpirableVersionStatus) bool {
+		return status.Version == version
+	})
+	if index < 0 || statuses[index].Rollout == nil {
+		return true
+	}
+
+	rollout := statuses[index].Rollout
+	if rollout.Halted {
+		return false
+	}
+
+	waveIndex := RolloutWaveIndexForName(r.Waves, rollout.Wave)
+	return waveIndex < 0 || r.WaveIndex <= waveIndex
+}
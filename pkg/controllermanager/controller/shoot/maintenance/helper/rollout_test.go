@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance/helper"
+)
+
+var _ = Describe("Rollout", func() {
+	var waves []controllermanagerconfigv1alpha1.RolloutWave
+
+	BeforeEach(func() {
+		waves = []controllermanagerconfigv1alpha1.RolloutWave{
+			{Name: "canary", Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeEvaluation}},
+			{Name: "early", ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"rollout": "early"}}},
+			{Name: "rest", Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeProduction}},
+		}
+	})
+
+	Describe("#RolloutWaveIndex", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{}
+		})
+
+		It("should return the wave matching the purpose", func() {
+			shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeEvaluation)
+
+			Expect(RolloutWaveIndex(waves, shoot, nil)).To(Equal(0))
+		})
+
+		It("should return the wave matching the project labels", func() {
+			shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeProduction)
+
+			Expect(RolloutWaveIndex(waves, shoot, map[string]string{"rollout": "early"})).To(Equal(1))
+		})
+
+		It("should return the explicitly assigned wave", func() {
+			shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeEvaluation)
+			shoot.Labels = map[string]string{"maintenance.gardener.cloud/rollout-wave": "rest"}
+
+			Expect(RolloutWaveIndex(waves, shoot, nil)).To(Equal(2))
+		})
+
+		It("should ignore explicit assignments to unknown waves", func() {
+			shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeEvaluation)
+			shoot.Labels = map[string]string{"maintenance.gardener.cloud/rollout-wave": "foo"}
+
+			Expect(RolloutWaveIndex(waves, shoot, nil)).To(Equal(0))
+		})
+
+		It("should return the last wave if no wave matches", func() {
+			shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeDevelopment)
+
+			Expect(RolloutWaveIndex(waves, shoot, nil)).To(Equal(2))
+		})
+	})
+
+	Describe("#RolloutEligibility", func() {
+		var (
+			rollout      *RolloutEligibility
+			versions     []gardencorev1beta1.ExpirableVersion
+			machineImage *gardencorev1beta1.MachineImage
+		)
+
+		BeforeEach(func() {
+			statuses := []gardencorev1beta1.ExpirableVersionStatus{
+				{Version: "1.0.0"},
+				{Version: "1.0.1", Rollout: &gardencorev1beta1.VersionRolloutStatus{Wave: "rest"}},
+				{Version: "1.0.2", Rollout: &gardencorev1beta1.VersionRolloutStatus{Wave: "early"}},
+				{Version: "1.0.3", Rollout: &gardencorev1beta1.VersionRolloutStatus{Wave: "canary"}},
+				{Version: "1.0.4", Rollout: &gardencorev1beta1.VersionRolloutStatus{Wave: "rest", Halted: true}},
+			}
+
+			rollout = &RolloutEligibility{
+				Waves:     waves,
+				WaveIndex: 1,
+				Status: gardencorev1beta1.CloudProfileStatus{
+					Kubernetes:    &gardencorev1beta1.KubernetesStatus{Versions: statuses},
+					MachineImages: []gardencorev1beta1.MachineImageStatus{{Name: "image", Versions: statuses}},
+				},
+			}
+
+			versions = []gardencorev1beta1.ExpirableVersion{{Version: "1.0.0"}, {Version: "1.0.1"}, {Version: "1.0.2"}, {Version: "1.0.3"}, {Version: "1.0.4"}, {Version: "1.0.5"}}
+
+			machineImage = &gardencorev1beta1.MachineImage{Name: "image"}
+			for _, version := range versions {
+				machineImage.Versions = append(machineImage.Versions, gardencorev1beta1.MachineImageVersion{ExpirableVersion: version})
+			}
+		})
+
+		It("should consider all versions eligible if no rollout is configured", func() {
+			rollout = nil
+
+			Expect(rollout.FilterKubernetesVersions(versions, "1.0.0")).To(Equal(versions))
+			Expect(rollout.FilterMachineImageVersions(machineImage, "1.0.0")).To(Equal(machineImage))
+		})
+
+		It("should filter the Kubernetes versions not yet eligible for the wave", func() {
+			Expect(rollout.FilterKubernetesVersions(versions, "1.0.0")).To(Equal([]gardencorev1beta1.ExpirableVersion{
+				{Version: "1.0.0"}, {Version: "1.0.1"}, {Version: "1.0.2"}, {Version: "1.0.5"},
+			}))
+		})
+
+		It("should keep the current version", func() {
+			Expect(rollout.FilterKubernetesVersions(versions, "1.0.4")).To(Equal([]gardencorev1beta1.ExpirableVersion{
+				{Version: "1.0.0"}, {Version: "1.0.1"}, {Version: "1.0.2"}, {Version: "1.0.4"}, {Version: "1.0.5"},
+			}))
+		})
+
+		It("should filter the machine image versions not yet eligible for the wave", func() {
+			filtered := rollout.FilterMachineImageVersions(machineImage, "1.0.0")
+
+			Expect(filtered.Name).To(Equal("image"))
+			Expect(filtered.Versions).To(Equal([]gardencorev1beta1.MachineImageVersion{
+				{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}},
+				{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.1"}},
+				{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.2"}},
+				{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.5"}},
+			}))
+			Expect(machineImage.Versions).To(HaveLen(6))
+		})
+
+		It("should not filter machine images without rollout state", func() {
+			machineImage.Name = "other"
+
+			Expect(rollout.FilterMachineImageVersions(machineImage, "1.0.0")).To(Equal(machineImage))
+		})
+	})
+})
@@ -7,6 +7,7 @@ package maintenance
 import (
 	"context"
 	"fmt"
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -65,14 +67,35 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	requeueAfter, nextMaintenance := requeueAfterDuration(shoot)
 
-	if !mustMaintainNow(shoot, r.Clock) {
+	var (
+		maintainShoot = mustMaintainNow(shoot, r.Clock)
+		workerPools   = workerPoolsToMaintainNow(shoot, r.Clock)
+	)
+
+	if !maintainShoot && workerPools.Len() == 0 {
 		log.V(1).Info("Skipping Shoot because it doesn't need to be maintained now")
 		log.V(1).Info("Scheduled next maintenance for Shoot", "duration", requeueAfter.Round(time.Minute), "nextMaintenance", nextMaintenance.Round(time.Minute))
 		return reconcile.Result{RequeueAfter: requeueAfter}, nil
 	}
 
-	if err := r.reconcile(ctx, log, shoot); err != nil {
-		return reconcile.Result{}, err
+	var freeze *maintenanceFreeze
+	if !hasMaintainNowAnnotation(shoot) {
+		var err error
+		if freeze, err = r.activeMaintenanceFreeze(ctx, shoot); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if maintainShoot {
+		if err := r.reconcile(ctx, log, shoot, freeze, false); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if workerPools.Len() > 0 {
+		if err := r.reconcileWorkerPools(ctx, log, shoot, workerPools, freeze, false); err != nil {
+			return reconcile.Result{}, err
+		}
 	}
 
 	log.V(1).Info("Scheduled next maintenance for Shoot", "duration", requeueAfter.Round(time.Minute), "nextMaintenance", nextMaintenance.Round(time.Minute))
@@ -81,13 +104,97 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 func requeueAfterDuration(shoot *gardencorev1beta1.Shoot) (time.Duration, time.Time) {
 	var (
-		now             = time.Now()
-		window          = gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot)
-		duration        = window.RandomDurationUntilNext(now, false)
-		nextMaintenance = time.Now().UTC().Add(duration)
+		now      = time.Now()
+		window   = gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot)
+		duration = window.RandomDurationUntilNext(now, false)
 	)
 
-	return duration, nextMaintenance
+	// Worker pools with their own maintenance time window might have to be maintained earlier. The allowed weekdays are
+	// not considered here, i.e., the Shoot is requeued at the next occurrence of the time window regardless of the day.
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if hasOwnMaintenanceTimeWindow(worker) {
+			duration = min(duration, gardenerutils.EffectiveWorkerMaintenanceTimeWindow(shoot, worker).RandomDurationUntilNext(now, false))
+		}
+	}
+
+	return duration, time.Now().UTC().Add(duration)
+}
+
+// maintenanceFreeze is a maintenance freeze which is currently active for a Shoot.
+type maintenanceFreeze struct {
+	name string
+	end  time.Time
+}
+
+// skippedDescription returns the description of a maintenance which was skipped due to the freeze.
+func (f *maintenanceFreeze) skippedDescription(skippedOperations string) string {
+	return fmt.Sprintf("Maintenance skipped due to maintenance freeze %q (until %s). Skipped operations: %s", f.name, f.end.UTC().Format(time.RFC3339), skippedOperations)
+}
+
+// activeMaintenanceFreeze returns the maintenance freeze which is currently active for the given Shoot, considering the
+// freezes of its Project and the globally configured freezes. It returns nil if no freeze is active.
+func (r *Reconciler) activeMaintenanceFreeze(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*maintenanceFreeze, error) {
+	freezes := slices.Clone(r.Config.MaintenanceFreezes)
+
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed getting project for namespace %q: %w", shoot.Namespace, err)
+	}
+	if project != nil {
+		freezes = append(freezes, project.Spec.MaintenanceFreezes...)
+	}
+
+	freeze, end, err := v1beta1helper.ActiveMaintenanceFreeze(freezes, r.Clock.Now())
+	if err != nil || freeze == nil {
+		return nil, err
+	}
+
+	return &maintenanceFreeze{name: freeze.Name, end: end}, nil
+}
+
+// rolloutEligibility returns which versions are eligible for automatic updates of the given Shoot according to the
+// staged rollout. It returns nil if no staged rollout is configured.
+func (r *Reconciler) rolloutEligibility(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*helper.RolloutEligibility, error) {
+	if r.Config.StagedRollout == nil || len(r.Config.StagedRollout.Waves) == 0 {
+		return nil, nil
+	}
+
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed getting project for namespace %q: %w", shoot.Namespace, err)
+	}
+
+	var projectLabels map[string]string
+	if project != nil {
+		projectLabels = project.Labels
+	}
+
+	// The rollout state is maintained in the status of the CloudProfile. Shoots using a NamespacedCloudProfile follow
+	// the rollout state of its parent CloudProfile.
+	cloudProfileReference := gardenerutils.BuildV1beta1CloudProfileReference(shoot)
+	if cloudProfileReference == nil {
+		return nil, fmt.Errorf("could not determine cloudprofile from shoot")
+	}
+
+	cloudProfileName := cloudProfileReference.Name
+	if cloudProfileReference.Kind == v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile {
+		namespacedCloudProfile := &gardencorev1beta1.NamespacedCloudProfile{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: cloudProfileReference.Name, Namespace: shoot.Namespace}, namespacedCloudProfile); err != nil {
+			return nil, fmt.Errorf("failed getting NamespacedCloudProfile %q: %w", cloudProfileReference.Name, err)
+		}
+		cloudProfileName = namespacedCloudProfile.Spec.Parent.Name
+	}
+
+	cloudProfile := &gardencorev1beta1.CloudProfile{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: cloudProfileName}, cloudProfile); err != nil {
+		return nil, fmt.Errorf("failed getting CloudProfile %q: %w", cloudProfileName, err)
+	}
+
+	return &helper.RolloutEligibility{
+		Waves:     r.Config.StagedRollout.Waves,
+		WaveIndex: helper.RolloutWaveIndex(r.Config.StagedRollout.Waves, shoot, projectLabels),
+		Status:    cloudProfile.Status,
+	}, nil
 }
 
 // updateResult represents the result of a Kubernetes or Machine image maintenance operation
@@ -97,10 +204,24 @@ type updateResult struct {
 	description  string
 	reason       string
 	isSuccessful bool
+	// isForced is true if the update is a forceful update of an expired version. Such updates are not subject to
+	// maintenance freezes.
+	isForced bool
 }
 
-func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
-	log.Info("Maintaining Shoot")
+// reconcile maintains the given Shoot. If a maintenance freeze is given, the maintenance operations are only computed
+// and recorded as skipped in the Shoot status, but neither the Shoot nor any other object is changed. Forceful updates
+// of expired versions are exempt from the freeze, i.e., if there are any, the Shoot is maintained again with
+// onlyExpiredVersions set to true, which restricts the maintenance to these updates and the changes they require.
+func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, freeze *maintenanceFreeze, onlyExpiredVersions bool) error {
+	switch {
+	case freeze != nil && onlyExpiredVersions:
+		log.Info("Maintenance freeze is active, maintaining expired versions only", "maintenanceFreeze", freeze.name, "end", freeze.end)
+	case freeze != nil:
+		log.Info("Maintenance freeze is active, computing skipped maintenance operations", "maintenanceFreeze", freeze.name, "end", freeze.end)
+	default:
+		log.Info("Maintaining Shoot")
+	}
 
 	var (
 		maintainedShoot = shoot.DeepCopy()
@@ -109,7 +230,6 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		err        error
 	)
 
-	workerToKubernetesUpdate := make(map[string]updateResult)
 	workerToMachineImageUpdate := make(map[string]updateResult)
 
 	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
@@ -117,15 +237,20 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		return err
 	}
 
+	rollout, err := r.rolloutEligibility(ctx, shoot)
+	if err != nil {
+		return err
+	}
+
 	if !v1beta1helper.IsWorkerless(shoot) {
-		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile)
+		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile, rollout, onlyExpiredVersions, withoutOwnMaintenanceTimeWindow)
 		if err != nil {
 			// continue execution to allow the kubernetes version update
 			log.Error(err, "Failed to maintain Shoot machine images")
 		}
 	}
 
-	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, rollout, onlyExpiredVersions, func(v string) (string, error) {
 		maintainedShoot.Spec.Kubernetes.Version = v
 		return v, nil
 	})
@@ -134,7 +259,14 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		log.Error(err, "Failed to maintain Shoot kubernetes version")
 	}
 
-	credentialsToRotationUpdate := computeCredentialsToRotationResults(log, maintainedShoot, metav1.Time{Time: r.Clock.Now()})
+	for _, name := range deferWorkerPoolKubernetesVersionUpdates(maintainedShoot, shoot.Spec.Kubernetes.Version) {
+		operations = append(operations, fmt.Sprintf("Kubernetes version update of worker pool %q deferred to its maintenance time window", name))
+	}
+
+	var credentialsToRotationUpdate map[string]updateResult
+	if !onlyExpiredVersions {
+		credentialsToRotationUpdate = computeCredentialsToRotationResults(log, maintainedShoot, metav1.Time{Time: r.Clock.Now()})
+	}
 
 	oldShootKubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
 	if err != nil {
@@ -226,7 +358,9 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		oldK8sLess134 := versionutils.ConstraintK8sLess134.Check(oldShootKubernetesVersion)
 		newK8sGreaterEqual134 := versionutils.ConstraintK8sGreaterEqual134.Check(shootKubernetesVersion)
 		if oldK8sLess134 && newK8sGreaterEqual134 && maintainedShoot.Spec.SecretBindingName != nil && maintainedShoot.Spec.CredentialsBindingName == nil {
-			if err := r.migrateSecretBindingToCredentialsBinding(ctx, maintainedShoot); err != nil {
+			if freeze != nil && !onlyExpiredVersions {
+				operations = append(operations, ".spec.secretBindingName would be migrated to .spec.credentialsBindingName")
+			} else if err := r.migrateSecretBindingToCredentialsBinding(ctx, maintainedShoot); err != nil {
 				log.Error(err, "Failed to migrate SecretBinding to CredentialsBinding")
 				operations = append(operations, fmt.Sprintf("Failed to migrate from secretBindingName to credentialsBindingName: %v", err))
 			} else {
@@ -286,41 +420,17 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 	}
 
-	// Now it's time to update worker pool kubernetes version if specified
-	for i, pool := range maintainedShoot.Spec.Provider.Workers {
-		if pool.Kubernetes == nil || pool.Kubernetes.Version == nil {
-			continue
-		}
-
-		workerLog := log.WithValues("worker", pool.Name)
-		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
-			workerPoolSemver, err := semver.NewVersion(v)
-			if err != nil {
-				return "", err
-			}
-			// If during autoupdate a worker pool kubernetes gets forcefully updated to the next minor which might be higher than the same minor of the shoot, take this
-			if workerPoolSemver.GreaterThan(shootKubernetesVersion) {
-				workerPoolSemver = shootKubernetesVersion
-			}
-			v = workerPoolSemver.String()
-			maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version = &v
-			return v, nil
-		})
-		if err != nil {
-			// continue execution to allow other maintenance activities to continue
-			workerLog.Error(err, "Could not maintain Kubernetes version for worker pool")
-		}
-
-		if workerKubernetesUpdate != nil {
-			result := updateResult{
-				reason: workerKubernetesUpdate.reason,
-			}
-			result.isSuccessful = workerKubernetesUpdate.isSuccessful
-			result.description = workerKubernetesUpdate.description
-			workerToKubernetesUpdate[pool.Name] = result
-		}
+	// Worker pools whose update was deferred, but which no longer have their own maintenance time window, follow the
+	// control plane again.
+	var caughtUpWorkerPools map[string]updateResult
+	if !onlyExpiredVersions {
+		caughtUpWorkerPools = catchUpDeferredWorkerPools(log, maintainedShoot, withoutOwnMaintenanceTimeWindow)
 	}
 
+	// Now it's time to update worker pool kubernetes version if specified
+	workerToKubernetesUpdate := maintainWorkerKubernetesVersions(log, maintainedShoot, shootKubernetesVersion, cloudProfile, rollout, onlyExpiredVersions, withoutOwnMaintenanceTimeWindow)
+	maps.Copy(workerToKubernetesUpdate, caughtUpWorkerPools)
+
 	if reasons := maintainFeatureGatesForShoot(maintainedShoot); len(reasons) > 0 {
 		operations = append(operations, reasons...)
 	}
@@ -368,6 +478,18 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 	}
 
 	requirePatch := len(operations) > 0 || kubernetesControlPlaneUpdate != nil || len(workerToKubernetesUpdate) > 0 || len(workerToMachineImageUpdate) > 0 || len(credentialsToRotationUpdate) > 0
+
+	if freeze != nil && !onlyExpiredVersions {
+		if err := r.skipMaintenance(ctx, log, shoot, freeze, requirePatch, operations, kubernetesControlPlaneUpdate, workerToKubernetesUpdate, workerToMachineImageUpdate, credentialsToRotationUpdate); err != nil {
+			return err
+		}
+
+		if !hasForcedUpdate(kubernetesControlPlaneUpdate, workerToKubernetesUpdate, workerToMachineImageUpdate) {
+			return nil
+		}
+		return r.reconcile(ctx, log, shoot, freeze, true)
+	}
+
 	if requirePatch {
 		patch := client.MergeFrom(shoot.DeepCopy())
 
@@ -384,6 +506,10 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 			description = fmt.Sprintf("%s, %s", description, strings.Join(operations, ", "))
 		}
 
+		if onlyExpiredVersions {
+			description = fmt.Sprintf("%s. Other maintenance operations skipped due to maintenance freeze %q (until %s)", description, freeze.name, freeze.end.UTC().Format(time.RFC3339))
+		}
+
 		shoot.Status.LastMaintenance = &gardencorev1beta1.LastMaintenance{
 			Description:   description,
 			TriggeredTime: metav1.Time{Time: r.Clock.Now()},
@@ -428,6 +554,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 
 	// update shoot spec changes in maintenance call
 	shoot.Spec = *maintainedShoot.Spec.DeepCopy()
+	setDeferredWorkerPools(shoot, deferredWorkerPools(maintainedShoot))
 	_ = maintainOperation(shoot, credentialsToRotationUpdate)
 	maintainTasks(shoot, r.Config)
 
@@ -441,7 +568,9 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 	// if the maintenance patch is not required and the last maintenance operation state is failed,
 	// this means the maintenance was retried and succeeded. Alternatively, changes could have been made
 	// outside of the maintenance window to fix the maintenance error. In either case, remove the failed state.
-	if !requirePatch && shoot.Status.LastMaintenance != nil && shoot.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStateFailed {
+	// The same applies if the last maintenance was skipped due to a maintenance freeze.
+	if !requirePatch && shoot.Status.LastMaintenance != nil &&
+		(shoot.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStateFailed || shoot.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStatePending) {
 		patch := client.MergeFrom(shoot.DeepCopy())
 		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
 		shoot.Status.LastMaintenance.Description = "Maintenance succeeded"
@@ -477,9 +606,315 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 	return nil
 }
 
+// skipMaintenance records the maintenance operations which were skipped due to the given maintenance freeze in the
+// status of the Shoot.
+func (r *Reconciler) skipMaintenance(
+	ctx context.Context,
+	log logr.Logger,
+	shoot *gardencorev1beta1.Shoot,
+	freeze *maintenanceFreeze,
+	hasOperations bool,
+	operations []string,
+	kubernetesControlPlaneUpdate *updateResult,
+	workerToKubernetesUpdate, workerToMachineImageUpdate, credentialsToRotationUpdate map[string]updateResult,
+) error {
+	skipped := "no pending operations"
+	if hasOperations {
+		description, _, _, _ := describeMaintenanceOperations(kubernetesControlPlaneUpdate, workerToKubernetesUpdate, workerToMachineImageUpdate, credentialsToRotationUpdate)
+		skipped = strings.Join(slices.DeleteFunc(append([]string{description}, operations...), func(s string) bool { return s == "" }), ", ")
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.LastMaintenance = &gardencorev1beta1.LastMaintenance{
+		Description:   freeze.skippedDescription(skipped),
+		TriggeredTime: metav1.Time{Time: r.Clock.Now()},
+		State:         gardencorev1beta1.LastOperationStatePending,
+	}
+	if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+		return err
+	}
+
+	r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.ShootMaintenanceSkipped, gardencorev1beta1.EventActionReconcile, "%s", shoot.Status.LastMaintenance.Description)
+	log.Info("Shoot maintenance skipped due to maintenance freeze", "maintenanceFreeze", freeze.name, "end", freeze.end)
+	return nil
+}
+
+// reconcileWorkerPools maintains the machine image and Kubernetes versions of the given worker pools having their own
+// maintenance time window and records the results per worker pool in the Shoot status. If a maintenance freeze is given,
+// the maintenance operations are only computed and recorded as skipped. Worker pools with forceful updates of expired
+// versions are maintained again with onlyExpiredVersions set to true, as these updates are exempt from the freeze.
+func (r *Reconciler) reconcileWorkerPools(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, workerPools sets.Set[string], freeze *maintenanceFreeze, onlyExpiredVersions bool) error {
+	log = log.WithValues("workerPools", sets.List(workerPools))
+	switch {
+	case freeze != nil && onlyExpiredVersions:
+		log.Info("Maintenance freeze is active, maintaining expired versions of worker pools only", "maintenanceFreeze", freeze.name, "end", freeze.end)
+	case freeze != nil:
+		log.Info("Maintenance freeze is active, computing skipped maintenance operations of worker pools", "maintenanceFreeze", freeze.name, "end", freeze.end)
+	default:
+		log.Info("Maintaining worker pools")
+	}
+
+	var (
+		maintainedShoot    = shoot.DeepCopy()
+		maintainWorkerPool = func(worker gardencorev1beta1.Worker) bool { return workerPools.Has(worker.Name) }
+	)
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return err
+	}
+
+	rollout, err := r.rolloutEligibility(ctx, shoot)
+	if err != nil {
+		return err
+	}
+
+	workerToMachineImageUpdate, err := maintainMachineImages(log, maintainedShoot, cloudProfile, rollout, onlyExpiredVersions, maintainWorkerPool)
+	if err != nil {
+		// continue execution to allow the Kubernetes updates of the worker pools
+		log.Error(err, "Failed to maintain worker pool machine images")
+	}
+
+	controlPlaneVersion, err := semver.NewVersion(maintainedShoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return err
+	}
+
+	var caughtUpWorkerPools map[string]updateResult
+	if !onlyExpiredVersions {
+		caughtUpWorkerPools = catchUpDeferredWorkerPools(log, maintainedShoot, maintainWorkerPool)
+	}
+
+	workerToKubernetesUpdate := maintainWorkerKubernetesVersions(log, maintainedShoot, controlPlaneVersion, cloudProfile, rollout, onlyExpiredVersions, maintainWorkerPool)
+	maps.Copy(workerToKubernetesUpdate, caughtUpWorkerPools)
+
+	var (
+		patch              = client.MergeFrom(shoot.DeepCopy())
+		now                = metav1.Time{Time: r.Clock.Now()}
+		updatedPools       []string
+		forcedPools        = sets.New[string]()
+		requireStatusPatch = pruneWorkerPoolsLastMaintenance(shoot)
+	)
+
+	for _, name := range sets.List(workerPools) {
+		var (
+			kubernetesUpdate   = updateResultsForWorkerPool(workerToKubernetesUpdate, name)
+			machineImageUpdate = updateResultsForWorkerPool(workerToMachineImageUpdate, name)
+			lastMaintenance    = workerPoolLastMaintenance(shoot, name)
+		)
+
+		if freeze != nil && !onlyExpiredVersions {
+			skipped := "no pending operations"
+			if len(kubernetesUpdate) > 0 || len(machineImageUpdate) > 0 {
+				skipped, _, _, _ = describeMaintenanceOperations(nil, kubernetesUpdate, machineImageUpdate, nil)
+			}
+			if hasForcedUpdate(nil, kubernetesUpdate, machineImageUpdate) {
+				forcedPools.Insert(name)
+			}
+
+			setWorkerPoolLastMaintenance(shoot, name, gardencorev1beta1.LastMaintenance{
+				Description:   freeze.skippedDescription(skipped),
+				TriggeredTime: now,
+				State:         gardencorev1beta1.LastOperationStatePending,
+			})
+			requireStatusPatch = true
+			continue
+		}
+
+		if len(kubernetesUpdate) == 0 && len(machineImageUpdate) == 0 {
+			// the maintenance was retried and succeeded or the failure was fixed outside of the maintenance time window
+			if lastMaintenance != nil && (lastMaintenance.State == gardencorev1beta1.LastOperationStateFailed || lastMaintenance.State == gardencorev1beta1.LastOperationStatePending) {
+				lastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
+				lastMaintenance.Description = "Maintenance succeeded"
+				lastMaintenance.FailureReason = nil
+				requireStatusPatch = true
+			}
+			continue
+		}
+
+		description, failureReason := buildMaintenanceMessages(nil, kubernetesUpdate, machineImageUpdate, nil)
+		if onlyExpiredVersions {
+			description = fmt.Sprintf("%s. Other maintenance operations skipped due to maintenance freeze %q (until %s)", description, freeze.name, freeze.end.UTC().Format(time.RFC3339))
+		}
+		newLastMaintenance := gardencorev1beta1.LastMaintenance{
+			Description:   description,
+			TriggeredTime: now,
+			State:         gardencorev1beta1.LastOperationStateProcessing,
+		}
+
+		// if any maintenance operation failed, set the status to 'Failed' and retry in the next maintenance cycle
+		if failureReason != "" {
+			newLastMaintenance.State = gardencorev1beta1.LastOperationStateFailed
+			newLastMaintenance.FailureReason = &failureReason
+		}
+
+		setWorkerPoolLastMaintenance(shoot, name, newLastMaintenance)
+		updatedPools = append(updatedPools, name)
+		requireStatusPatch = true
+	}
+
+	if freeze != nil && !onlyExpiredVersions {
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return err
+		}
+
+		for _, name := range sets.List(workerPools) {
+			r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.ShootMaintenanceSkipped, gardencorev1beta1.EventActionReconcile, "Worker pool %q: %s", name, workerPoolLastMaintenance(shoot, name).Description)
+		}
+		log.Info("Worker pool maintenance skipped due to maintenance freeze", "maintenanceFreeze", freeze.name, "end", freeze.end)
+
+		if forcedPools.Len() == 0 {
+			return nil
+		}
+		return r.reconcileWorkerPools(ctx, log, shoot, forcedPools, freeze, true)
+	}
+
+	if len(updatedPools) > 0 {
+		// The updates of the worker pools are rolled out outside the maintenance time window of the Shoot, hence, trigger
+		// a reconciliation (this is required if the rollout of spec updates is confined to the maintenance time window).
+		if len(v1beta1helper.GetShootGardenerOperations(maintainedShoot.Annotations)) == 0 {
+			metav1.SetMetaDataAnnotation(&maintainedShoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+		}
+
+		// First dry run the update call to check if it can be executed successfully (maintenance might yield a Shoot
+		// configuration that is rejected by the ApiServer). If the dry run fails, the maintenance of the worker pools is
+		// marked as failed and is retried only in their next maintenance window.
+		if err := r.Client.Update(ctx, maintainedShoot.DeepCopy(), &client.UpdateOptions{
+			DryRun: []string{metav1.DryRunAll},
+		}); err != nil {
+			for _, name := range updatedPools {
+				lastMaintenance := workerPoolLastMaintenance(shoot, name)
+				lastMaintenance.Description = "Maintenance failed"
+				lastMaintenance.State = gardencorev1beta1.LastOperationStateFailed
+				lastMaintenance.FailureReason = ptr.To(fmt.Sprintf("Updates to the Shoot failed to be applied: %s", err.Error()))
+			}
+			if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+				return err
+			}
+
+			log.Info("Worker pool maintenance failed", "reason", err)
+			return nil
+		}
+	}
+
+	if requireStatusPatch {
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return err
+		}
+	}
+
+	if len(updatedPools) == 0 {
+		log.Info("Worker pool maintenance completed")
+		return nil
+	}
+
+	// update shoot spec changes in maintenance call, don't retry on conflict (see above)
+	shoot.Spec = *maintainedShoot.Spec.DeepCopy()
+	shoot.Annotations = maintainedShoot.Annotations
+	if err := r.Client.Update(ctx, shoot); err != nil {
+		r.Recorder.Eventf(shoot, nil, corev1.EventTypeWarning, gardencorev1beta1.ShootMaintenanceFailed, gardencorev1beta1.EventActionReconcile, err.Error())
+		return err
+	}
+
+	patch = client.MergeFrom(shoot.DeepCopy())
+	for _, name := range updatedPools {
+		if lastMaintenance := workerPoolLastMaintenance(shoot, name); lastMaintenance.State == gardencorev1beta1.LastOperationStateProcessing {
+			lastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
+		}
+	}
+	if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+		return err
+	}
+
+	// make sure to report (partial) maintenance failures
+	r.recordMaintenanceEventsForPool(workerToKubernetesUpdate, shoot, gardencorev1beta1.ShootEventK8sVersionMaintenance, "Kubernetes")
+	r.recordMaintenanceEventsForPool(workerToMachineImageUpdate, shoot, gardencorev1beta1.ShootEventImageVersionMaintenance, "Machine image")
+
+	log.Info("Worker pool maintenance completed")
+	return nil
+}
+
+// updateResultsForWorkerPool returns the update result of the given worker pool, if any.
+func updateResultsForWorkerPool(workerToUpdateResult map[string]updateResult, name string) map[string]updateResult {
+	if result, ok := workerToUpdateResult[name]; ok {
+		return map[string]updateResult{name: result}
+	}
+	return nil
+}
+
+// hasForcedUpdate returns true if any of the given update results is a forceful update of an expired version.
+func hasForcedUpdate(kubernetesControlPlaneUpdate *updateResult, workerToUpdateResults ...map[string]updateResult) bool {
+	if kubernetesControlPlaneUpdate != nil && kubernetesControlPlaneUpdate.isForced {
+		return true
+	}
+
+	for _, workerToUpdateResult := range workerToUpdateResults {
+		for _, result := range workerToUpdateResult {
+			if result.isForced {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// workerPoolLastMaintenance returns the last maintenance of the worker pool with the given name from the Shoot status.
+func workerPoolLastMaintenance(shoot *gardencorev1beta1.Shoot, name string) *gardencorev1beta1.LastMaintenance {
+	for i := range shoot.Status.WorkerPoolsLastMaintenance {
+		if shoot.Status.WorkerPoolsLastMaintenance[i].Name == name {
+			return &shoot.Status.WorkerPoolsLastMaintenance[i].LastMaintenance
+		}
+	}
+	return nil
+}
+
+// setWorkerPoolLastMaintenance sets the last maintenance of the worker pool with the given name in the Shoot status.
+func setWorkerPoolLastMaintenance(shoot *gardencorev1beta1.Shoot, name string, lastMaintenance gardencorev1beta1.LastMaintenance) {
+	if existing := workerPoolLastMaintenance(shoot, name); existing != nil {
+		*existing = lastMaintenance
+		return
+	}
+
+	shoot.Status.WorkerPoolsLastMaintenance = append(shoot.Status.WorkerPoolsLastMaintenance, gardencorev1beta1.WorkerPoolLastMaintenance{
+		Name:            name,
+		LastMaintenance: lastMaintenance,
+	})
+}
+
+// pruneWorkerPoolsLastMaintenance removes the last maintenance of worker pools which no longer exist or no longer have
+// their own maintenance time window from the Shoot status. It returns true if any entry was removed.
+func pruneWorkerPoolsLastMaintenance(shoot *gardencorev1beta1.Shoot) bool {
+	workerPools := sets.New[string]()
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if hasOwnMaintenanceTimeWindow(worker) {
+			workerPools.Insert(worker.Name)
+		}
+	}
+
+	length := len(shoot.Status.WorkerPoolsLastMaintenance)
+	shoot.Status.WorkerPoolsLastMaintenance = slices.DeleteFunc(shoot.Status.WorkerPoolsLastMaintenance, func(lastMaintenance gardencorev1beta1.WorkerPoolLastMaintenance) bool {
+		return !workerPools.Has(lastMaintenance.Name)
+	})
+
+	return len(shoot.Status.WorkerPoolsLastMaintenance) != length
+}
+
 // buildMaintenanceMessages builds a combined message containing the performed maintenance operations over all worker pools. If the maintenance operation failed, the description
 // contains an indication for the failure and the reason the update was triggered. Details for failed maintenance operations are returned in the second return string.
 func buildMaintenanceMessages(kubernetesControlPlaneUpdate *updateResult, workerToKubernetesUpdate, workerToMachineImageUpdate, credentialsToRotationUpdate map[string]updateResult) (string, string) {
+	description, failureReason, countSuccessfulOperations, countFailedOperations := describeMaintenanceOperations(kubernetesControlPlaneUpdate, workerToKubernetesUpdate, workerToMachineImageUpdate, credentialsToRotationUpdate)
+
+	if countFailedOperations == 0 {
+		return fmt.Sprintf("All maintenance operations successful. %s", description), failureReason
+	}
+
+	return fmt.Sprintf("(%d/%d) maintenance operations successful. %s", countSuccessfulOperations, countSuccessfulOperations+countFailedOperations, description), failureReason
+}
+
+// describeMaintenanceOperations returns the description and failure reason of the given maintenance operations
+// together with the number of successful and failed operations.
+func describeMaintenanceOperations(kubernetesControlPlaneUpdate *updateResult, workerToKubernetesUpdate, workerToMachineImageUpdate, credentialsToRotationUpdate map[string]updateResult) (string, string, int, int) {
 	countSuccessfulOperations := 0
 	countFailedOperations := 0
 	description := ""
@@ -535,11 +970,7 @@ func buildMaintenanceMessages(kubernetesControlPlaneUpdate *updateResult, worker
 	description = strings.TrimPrefix(description, ", ")
 	failureReason = strings.TrimPrefix(failureReason, ", ")
 
-	if countFailedOperations == 0 {
-		return fmt.Sprintf("All maintenance operations successful. %s", description), failureReason
-	}
-
-	return fmt.Sprintf("(%d/%d) maintenance operations successful. %s", countSuccessfulOperations, countSuccessfulOperations+countFailedOperations, description), failureReason
+	return description, failureReason, countSuccessfulOperations, countFailedOperations
 }
 
 // recordMaintenanceEventsForPool records dedicated events for each failed/succeeded maintenance operation per pool
@@ -602,8 +1033,9 @@ func maintainTasks(shoot *gardencorev1beta1.Shoot, config controllermanagerconfi
 	}
 }
 
-// maintainMachineImages updates the machine images of a Shoot's worker pools if necessary
-func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (map[string]updateResult, error) {
+// maintainMachineImages updates the machine images of the Shoot's worker pools selected by maintainWorkerPool if necessary.
+// If onlyExpiredVersions is true, only expired machine image versions are updated.
+func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, rollout *helper.RolloutEligibility, onlyExpiredVersions bool, maintainWorkerPool func(gardencorev1beta1.Worker) bool) (map[string]updateResult, error) {
 	maintenanceResults := make(map[string]updateResult)
 
 	controlPlaneVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
@@ -612,6 +1044,10 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 	}
 
 	for i, worker := range shoot.Spec.Provider.Workers {
+		if !maintainWorkerPool(worker) {
+			continue
+		}
+
 		workerImage := worker.Machine.Image
 		workerLog := log.WithValues("worker", worker.Name, "image", workerImage.Name, "version", workerImage.Version)
 
@@ -634,10 +1070,15 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 
 		// first check if the machine image version should be updated
 		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
-		if !shouldBeUpdated {
+		if !shouldBeUpdated || (onlyExpiredVersions && !isExpired) {
 			continue
 		}
 
+		// forceful updates of expired versions are not subject to the staged rollout
+		if !isExpired {
+			filteredMachineImageVersionsFromCloudProfile = rollout.FilterMachineImageVersions(filteredMachineImageVersionsFromCloudProfile, ptr.Deref(workerImage.Version, ""))
+		}
+
 		updatedMachineImageVersion, err := helper.DetermineMachineImageVersion(workerImage, filteredMachineImageVersionsFromCloudProfile, isExpired)
 		if err != nil {
 			log.Error(err, "Maintenance of machine image failed", "workerPool", worker.Name, "machineImage", workerImage.Name)
@@ -645,6 +1086,7 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 				description:  fmt.Sprintf("failed to update machine image %q: %s", workerImage.Name, err.Error()),
 				reason:       reason,
 				isSuccessful: false,
+				isForced:     isExpired,
 			}
 			continue
 		}
@@ -658,6 +1100,7 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 			description:  fmt.Sprintf("Updated machine image %q from %q to %q", workerImage.Name, *workerImage.Version, updatedMachineImageVersion),
 			reason:       reason,
 			isSuccessful: true,
+			isForced:     isExpired,
 		}
 
 		// update the machine image version
@@ -667,22 +1110,68 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 	return maintenanceResults, nil
 }
 
-// maintainKubernetesVersion updates the Kubernetes version if necessary and returns the reason why an update was done
-func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, updateFunc func(string) (string, error)) (*updateResult, error) {
+// maintainWorkerKubernetesVersions updates the Kubernetes versions of the Shoot's worker pools selected by
+// maintainWorkerPool if necessary. The versions are not updated beyond the given Kubernetes version of the control plane.
+// If onlyExpiredVersions is true, only expired Kubernetes versions are updated.
+func maintainWorkerKubernetesVersions(log logr.Logger, shoot *gardencorev1beta1.Shoot, controlPlaneVersion *semver.Version, cloudProfile *gardencorev1beta1.CloudProfile, rollout *helper.RolloutEligibility, onlyExpiredVersions bool, maintainWorkerPool func(gardencorev1beta1.Worker) bool) map[string]updateResult {
+	maintenanceResults := make(map[string]updateResult)
+
+	for i, pool := range shoot.Spec.Provider.Workers {
+		if !maintainWorkerPool(pool) || pool.Kubernetes == nil || pool.Kubernetes.Version == nil {
+			continue
+		}
+
+		workerLog := log.WithValues("worker", pool.Name)
+		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, rollout, onlyExpiredVersions, func(v string) (string, error) {
+			workerPoolSemver, err := semver.NewVersion(v)
+			if err != nil {
+				return "", err
+			}
+			// If during autoupdate a worker pool kubernetes gets forcefully updated to the next minor which might be higher than the same minor of the shoot, take this
+			if workerPoolSemver.GreaterThan(controlPlaneVersion) {
+				workerPoolSemver = controlPlaneVersion
+			}
+			v = workerPoolSemver.String()
+			shoot.Spec.Provider.Workers[i].Kubernetes.Version = &v
+			return v, nil
+		})
+		if err != nil {
+			// continue execution to allow other maintenance activities to continue
+			workerLog.Error(err, "Could not maintain Kubernetes version for worker pool")
+		}
+
+		if workerKubernetesUpdate != nil {
+			result := updateResult{
+				reason:   workerKubernetesUpdate.reason,
+				isForced: workerKubernetesUpdate.isForced,
+			}
+			result.isSuccessful = workerKubernetesUpdate.isSuccessful
+			result.description = workerKubernetesUpdate.description
+			maintenanceResults[pool.Name] = result
+		}
+	}
+
+	return maintenanceResults
+}
+
+// maintainKubernetesVersion updates the Kubernetes version if necessary and returns the reason why an update was done.
+// If onlyExpiredVersions is true, the version is only updated if it is expired.
+func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, rollout *helper.RolloutEligibility, onlyExpiredVersions bool, updateFunc func(string) (string, error)) (*updateResult, error) {
 	shouldBeUpdated, reason, isExpired, err := shouldKubernetesVersionBeUpdated(kubernetesVersion, autoUpdate, profile)
 	if err != nil {
 		return nil, err
 	}
-	if !shouldBeUpdated {
+	if !shouldBeUpdated || (onlyExpiredVersions && !isExpired) {
 		return nil, nil
 	}
 
-	updatedKubernetesVersion, err := determineKubernetesVersion(kubernetesVersion, profile, isExpired)
+	updatedKubernetesVersion, err := determineKubernetesVersion(kubernetesVersion, profile, rollout, isExpired)
 	if err != nil {
 		return &updateResult{
 			description:  fmt.Sprintf("could not determine higher suitable version than %q: %v", kubernetesVersion, err),
 			reason:       reason,
 			isSuccessful: false,
+			isForced:     isExpired,
 		}, err
 	}
 	// current version is already the latest
@@ -697,6 +1186,7 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 			description:  err.Error(),
 			reason:       reason,
 			isSuccessful: false,
+			isForced:     isExpired,
 		}, err
 	}
 
@@ -705,6 +1195,7 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 		description:  fmt.Sprintf("Updated Kubernetes version from %q to %q", kubernetesVersion, actualUpdatedKubernetesVersion),
 		reason:       reason,
 		isSuccessful: true,
+		isForced:     isExpired,
 	}, nil
 }
 
@@ -808,11 +1299,17 @@ func etcdEncryptionKeyRotationPassedRotationPeriod(shoot *gardencorev1beta1.Shoo
 	return latestRotationCompletionTime.Before(now.Add(-period.Duration))
 }
 
-func determineKubernetesVersion(kubernetesVersion string, profile *gardencorev1beta1.CloudProfile, isExpired bool) (string, error) {
+func determineKubernetesVersion(kubernetesVersion string, profile *gardencorev1beta1.CloudProfile, rollout *helper.RolloutEligibility, isExpired bool) (string, error) {
 	getHigherVersionAutoUpdate := v1beta1helper.GetLatestVersionForPatchAutoUpdate
 	getHigherVersionForceUpdate := v1beta1helper.GetVersionForForcefulUpdateToConsecutiveMinor
 
-	version, err := helper.DetermineVersionForStrategy(profile.Spec.Kubernetes.Versions, kubernetesVersion, getHigherVersionAutoUpdate, getHigherVersionForceUpdate, isExpired)
+	versions := profile.Spec.Kubernetes.Versions
+	// forceful updates of expired versions are not subject to the staged rollout
+	if !isExpired {
+		versions = rollout.FilterKubernetesVersions(versions, kubernetesVersion)
+	}
+
+	version, err := helper.DetermineVersionForStrategy(versions, kubernetesVersion, getHigherVersionAutoUpdate, getHigherVersionForceUpdate, isExpired)
 	if err != nil {
 		return "", err
 	}
@@ -848,6 +1345,127 @@ func mustMaintainNow(shoot *gardencorev1beta1.Shoot, clock clock.Clock) bool {
 	return hasMaintainNowAnnotation(shoot) || gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, clock)
 }
 
+// workerPoolsToMaintainNow returns the names of the worker pools having their own maintenance time window which must be
+// maintained now.
+func workerPoolsToMaintainNow(shoot *gardencorev1beta1.Shoot, clock clock.Clock) sets.Set[string] {
+	workerPools := sets.New[string]()
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if hasOwnMaintenanceTimeWindow(worker) && (hasMaintainNowAnnotation(shoot) || gardenerutils.IsNowInEffectiveWorkerMaintenanceTimeWindow(shoot, worker, clock)) {
+			workerPools.Insert(worker.Name)
+		}
+	}
+
+	return workerPools
+}
+
+// deferWorkerPoolKubernetesVersionUpdates pins the worker pools having their own maintenance time window which follow
+// the Kubernetes version of the control plane to the given previous version of the control plane, if it was updated.
+// This defers their update to their own maintenance time window, see catchUpDeferredWorkerPools. It returns the names of
+// the pinned worker pools.
+func deferWorkerPoolKubernetesVersionUpdates(shoot *gardencorev1beta1.Shoot, previousControlPlaneVersion string) []string {
+	if shoot.Spec.Kubernetes.Version == previousControlPlaneVersion {
+		return nil
+	}
+
+	var (
+		deferred    = deferredWorkerPools(shoot)
+		workerPools []string
+	)
+
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if !hasOwnMaintenanceTimeWindow(worker) || (worker.Kubernetes != nil && worker.Kubernetes.Version != nil) {
+			continue
+		}
+
+		if worker.Kubernetes == nil {
+			shoot.Spec.Provider.Workers[i].Kubernetes = &gardencorev1beta1.WorkerKubernetes{}
+		}
+		shoot.Spec.Provider.Workers[i].Kubernetes.Version = ptr.To(previousControlPlaneVersion)
+
+		deferred.Insert(worker.Name)
+		workerPools = append(workerPools, worker.Name)
+	}
+
+	setDeferredWorkerPools(shoot, deferred)
+	return workerPools
+}
+
+// catchUpDeferredWorkerPools updates the selected worker pools whose update was deferred by
+// deferWorkerPoolKubernetesVersionUpdates to the Kubernetes version of the control plane by unpinning their version, so
+// that they follow the control plane again. Worker pools which were removed or whose version was set to the one of the
+// control plane in the meantime are no longer considered deferred.
+func catchUpDeferredWorkerPools(log logr.Logger, shoot *gardencorev1beta1.Shoot, maintainWorkerPool func(gardencorev1beta1.Worker) bool) map[string]updateResult {
+	var (
+		deferred           = deferredWorkerPools(shoot)
+		existing           = sets.New[string]()
+		maintenanceResults = make(map[string]updateResult)
+	)
+
+	for i, worker := range shoot.Spec.Provider.Workers {
+		existing.Insert(worker.Name)
+
+		if !deferred.Has(worker.Name) {
+			continue
+		}
+
+		if worker.Kubernetes == nil || worker.Kubernetes.Version == nil || *worker.Kubernetes.Version == shoot.Spec.Kubernetes.Version {
+			deferred.Delete(worker.Name)
+			continue
+		}
+
+		if !maintainWorkerPool(worker) {
+			continue
+		}
+
+		previousVersion := *worker.Kubernetes.Version
+		shoot.Spec.Provider.Workers[i].Kubernetes.Version = nil
+		if *shoot.Spec.Provider.Workers[i].Kubernetes == (gardencorev1beta1.WorkerKubernetes{}) {
+			shoot.Spec.Provider.Workers[i].Kubernetes = nil
+		}
+		deferred.Delete(worker.Name)
+
+		log.Info("Kubernetes version of worker pool follows the control plane again", "worker", worker.Name, "version", previousVersion, "newVersion", shoot.Spec.Kubernetes.Version)
+		maintenanceResults[worker.Name] = updateResult{
+			description:  fmt.Sprintf("Updated Kubernetes version from %q to %q", previousVersion, shoot.Spec.Kubernetes.Version),
+			reason:       "Update to the Kubernetes version of the control plane was deferred to the maintenance time window of the worker pool",
+			isSuccessful: true,
+		}
+	}
+
+	setDeferredWorkerPools(shoot, deferred.Intersection(existing))
+	return maintenanceResults
+}
+
+// deferredWorkerPools returns the names of the worker pools whose update to the Kubernetes version of the control plane
+// was deferred to their own maintenance time window.
+func deferredWorkerPools(shoot *gardencorev1beta1.Shoot) sets.Set[string] {
+	value := shoot.Annotations[v1beta1constants.GardenerMaintenanceDeferredWorkerPools]
+	if value == "" {
+		return sets.New[string]()
+	}
+	return sets.New(strings.Split(value, ",")...)
+}
+
+// setDeferredWorkerPools records the names of the given worker pools in an annotation on the given Shoot.
+func setDeferredWorkerPools(shoot *gardencorev1beta1.Shoot, workerPools sets.Set[string]) {
+	if workerPools.Len() == 0 {
+		delete(shoot.Annotations, v1beta1constants.GardenerMaintenanceDeferredWorkerPools)
+		return
+	}
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerMaintenanceDeferredWorkerPools, strings.Join(sets.List(workerPools), ","))
+}
+
+// hasOwnMaintenanceTimeWindow returns true if the given worker pool is maintained independently of the Shoot.
+func hasOwnMaintenanceTimeWindow(worker gardencorev1beta1.Worker) bool {
+	return worker.Maintenance != nil
+}
+
+// withoutOwnMaintenanceTimeWindow selects the worker pools which are maintained together with the Shoot.
+func withoutOwnMaintenanceTimeWindow(worker gardencorev1beta1.Worker) bool {
+	return !hasOwnMaintenanceTimeWindow(worker)
+}
+
 func hasMaintainNowAnnotation(shoot *gardencorev1beta1.Shoot) bool {
 	operations := v1beta1helper.GetShootGardenerOperations(shoot.Annotations)
 	return slices.Contains(operations, v1beta1constants.ShootOperationMaintain)
@@ -12,16 +12,25 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance/helper"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/test"
 	admissionpluginsvalidation "github.com/gardener/gardener/pkg/utils/validation/admissionplugins"
@@ -156,7 +165,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			})
 
 			It("should update machine image version to overall latest. Auto update: already on latest patch for minor, and there is an overall higher version available", func() {
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -165,7 +174,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			It("should update machine image version to overall latest. Auto update: already on latest patch for minor, and there is an overall higher version available for in-place updates", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To(shootCurrentImageVersion + "-inplace")
 				shoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(gardencorev1beta1.AutoInPlaceUpdate)
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion+"-inplace")
@@ -201,7 +210,7 @@ var _ = Describe("Shoot Maintenance", func() {
 
 				shoot.Spec.Provider.Workers[0].Machine.Architecture = ptr.To("arm64")
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
 			})
@@ -244,7 +253,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, otherWorker)
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 
@@ -264,7 +273,7 @@ var _ = Describe("Shoot Maintenance", func() {
 
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestForMinor)
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -274,7 +283,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(false)
 				cloudProfile.Spec.MachineImages[0].Versions[0].ExpirationDate = &expirationDateInThePast
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -309,7 +318,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -325,7 +334,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -333,7 +342,7 @@ var _ = Describe("Shoot Maintenance", func() {
 
 			It("should not change version: already on highest version.", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &overallLatestVersion
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -357,7 +366,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1")
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "2")
@@ -388,7 +397,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1")
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "2.0.1")
@@ -423,7 +432,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -479,7 +488,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -522,7 +531,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestPatchNextMinor)
@@ -558,7 +567,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestNonPreviewPatchVersionNplusTwoMinor.Version)
@@ -603,7 +612,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expiredPatchVersionNextMinor.Version)
@@ -637,7 +646,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestVersionForMinor
 				expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 			})
@@ -652,7 +661,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -676,7 +685,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1.7")
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.7")
@@ -700,7 +709,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1.7.2")
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.7.3")
@@ -752,7 +761,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestPatchCurrentMinor)
@@ -801,7 +810,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForCurrentMajor)
@@ -851,7 +860,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForCurrentMajor)
@@ -897,7 +906,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &latestVersionForCurrentMajor
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", latestVersionNextMajor)
@@ -933,7 +942,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestNonPreviewVersionNplusTwoMajor.Version)
@@ -949,7 +958,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -985,7 +994,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestVersionForMajor
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForMajor)
@@ -1076,21 +1085,21 @@ var _ = Describe("Shoot Maintenance", func() {
 
 			It("should update to the latest version with supported capabilities", func() {
 				// the latest overall version does not support the workers' capabilities, hence it should not be updated to
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", latestVersionWithSupportedCapabilities)
 			})
 
 			It("should update to the latest version as all capabilities are supported", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Type = "someOtherMachineType"
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
 			})
 
 			It("should not update if the current version is the latest/only that supports the machine type capabilities", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Type = "anotherMachineType"
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", shootCurrentImageVersion)
 			})
@@ -1113,7 +1122,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1.7.3")
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.8")
@@ -1137,7 +1146,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1.7")
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.8")
@@ -1148,7 +1157,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.MachineImages[0].Versions[1].CRI = []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRIName("other")}}
 			cloudProfile.Spec.MachineImages[0].Versions[3].CRI = []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRIName("other")}}
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
 		})
@@ -1157,7 +1166,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(false)
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
@@ -1169,7 +1178,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Provider.Workers[0].CRI = &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD}
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -1182,7 +1191,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// add another pool without CRI constraints -> should be updated via auto-update
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-without-cri-config", Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -1198,7 +1207,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// add another pool without CRI constraints -> should be updated via auto-update to the highest patch version of the same minor
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-without-containerruntime", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -1213,7 +1222,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-with-gvisor-and-kata", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD, ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{{Type: "gvisor"}, {Type: "kata-container"}}}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-with-gvisor", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD, ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{{Type: "gvisor"}}}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -1227,7 +1236,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Kubernetes.Version = "1.26.0"
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -1236,7 +1245,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.MachineImages[0].Versions[1].KubeletVersionConstraint = ptr.To("< 1.26")
 			shoot.Spec.Kubernetes.Version = "1.25.1"
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
 		})
@@ -1250,7 +1259,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			}
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -1263,7 +1272,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				Version: ptr.To("1.26.0"),
 			}
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", cloudProfile.Spec.MachineImages[0].Versions[1].Version)
 		})
@@ -1271,7 +1280,7 @@ var _ = Describe("Shoot Maintenance", func() {
 		It("should return an error - cloud profile has no matching (machineImage.name) machine image defined", func() {
 			cloudProfile.Spec.MachineImages = cloudProfile.Spec.MachineImages[1:]
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 			Expect(err).To(HaveOccurred())
 
@@ -1280,7 +1289,7 @@ var _ = Describe("Shoot Maintenance", func() {
 		It("should return an error - cloud profile has no matching (machineImage.type) machine type defined", func() {
 			shoot.Spec.Provider.Workers[0].Machine.Type = "non-existing-machine-type"
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, nil, false, withoutOwnMaintenanceTimeWindow)
 
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("machine type \"non-existing-machine-type\" of worker \"cpu-worker\" does not exist in cloudprofile"))
@@ -1350,7 +1359,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[4].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.1"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, false, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1368,7 +1377,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// mark latest version 1.02 as preview
 			cloudProfile.Spec.Kubernetes.Versions[3].Classification = &previewClassification
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, false, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1382,7 +1391,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, false, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1396,7 +1405,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, false, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1417,7 +1426,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[1].ExpirationDate = &expirationDateInThePast
 			cloudProfile.Spec.Kubernetes.Versions[2].ExpirationDate = &expirationDateInThePast
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, false, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1435,7 +1444,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, false, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1447,7 +1456,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.1"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, false, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1461,7 +1470,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[4].ExpirationDate = &expirationDateInTheFuture
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.1"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, false, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1475,7 +1484,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.0"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, false, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1489,7 +1498,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, false, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1503,7 +1512,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.1.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, nil, false, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1511,6 +1520,63 @@ var _ = Describe("Shoot Maintenance", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.1.2"))
 		})
+
+		Context("with staged rollout", func() {
+			var rollout *helper.RolloutEligibility
+
+			BeforeEach(func() {
+				rollout = &helper.RolloutEligibility{
+					Waves:     []controllermanagerconfigv1alpha1.RolloutWave{{Name: "canary"}, {Name: "rest"}},
+					WaveIndex: 1,
+					Status: gardencorev1beta1.CloudProfileStatus{
+						Kubernetes: &gardencorev1beta1.KubernetesStatus{
+							Versions: []gardencorev1beta1.ExpirableVersionStatus{
+								{Version: "1.0.1", Rollout: &gardencorev1beta1.VersionRolloutStatus{Wave: "rest"}},
+								{Version: "1.0.2", Rollout: &gardencorev1beta1.VersionRolloutStatus{Wave: "canary"}},
+							},
+						},
+					},
+				}
+			})
+
+			It("should only update to versions eligible for the wave of the shoot", func() {
+				shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.0"}
+
+				_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, rollout, false, func(v string) (string, error) {
+					shoot.Spec.Kubernetes.Version = v
+					return v, nil
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.0.1"))
+			})
+
+			It("should update to versions which already reached the wave of the shoot", func() {
+				rollout.WaveIndex = 0
+				shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.0"}
+
+				_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, rollout, false, func(v string) (string, error) {
+					shoot.Spec.Kubernetes.Version = v
+					return v, nil
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.0.2"))
+			})
+
+			It("should not consider the staged rollout for forceful updates of expired versions", func() {
+				cloudProfile.Spec.Kubernetes.Versions[5].ExpirationDate = &expirationDateInThePast
+				shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.0"}
+
+				_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, rollout, false, func(v string) (string, error) {
+					shoot.Spec.Kubernetes.Version = v
+					return v, nil
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.0.2"))
+			})
+		})
 	})
 
 	Describe("#computeCredentialsToRotationResults", func() {
@@ -2211,6 +2277,447 @@ var _ = Describe("Shoot Maintenance", func() {
 		})
 	})
 
+	Describe("#Reconcile with maintenance freezes", func() {
+		var (
+			ctx        context.Context
+			reconciler *Reconciler
+			fakeClient client.Client
+			fakeClock  *testclock.FakeClock
+			recorder   *events.FakeRecorder
+
+			cloudProfile *gardencorev1beta1.CloudProfile
+			project      *gardencorev1beta1.Project
+			shoot        *gardencorev1beta1.Shoot
+			request      reconcile.Request
+		)
+
+		BeforeEach(func() {
+			ctx = context.TODO()
+			fakeClock = testclock.NewFakeClock(time.Date(2025, 12, 24, 10, 0, 0, 0, time.UTC))
+			recorder = events.NewFakeRecorder(10)
+
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+				Build()
+
+			reconciler = &Reconciler{
+				Client:   fakeClient,
+				Clock:    fakeClock,
+				Recorder: recorder,
+			}
+
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					Kubernetes: gardencorev1beta1.KubernetesSettings{
+						Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.33.0"}, {Version: "1.33.1"}},
+					},
+				},
+			}
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: gardencorev1beta1.ProjectSpec{
+					Namespace: ptr.To("garden-foo"),
+					MaintenanceFreezes: []gardencorev1beta1.MaintenanceFreeze{{
+						Name:  "holidays",
+						Start: ptr.To(metav1.NewTime(time.Date(2025, 12, 20, 0, 0, 0, 0, time.UTC))),
+						End:   ptr.To(metav1.NewTime(time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC))),
+					}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo", Annotations: map[string]string{"foo": "bar"}},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: cloudProfile.Name},
+					Kubernetes:   gardencorev1beta1.Kubernetes{Version: "1.33.0"},
+					Maintenance: &gardencorev1beta1.Maintenance{
+						AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{KubernetesVersion: true},
+					},
+				},
+			}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+			request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+		})
+
+		It("should skip the maintenance and record the skipped operations if a project freeze is active", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.33.0"))
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			Expect(shoot.Status.LastMaintenance).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Description":   And(HavePrefix(`Maintenance skipped due to maintenance freeze "holidays" (until 2026-01-06T00:00:00Z). Skipped operations: `), ContainSubstring(`Control Plane: Updated Kubernetes version from "1.33.0" to "1.33.1"`)),
+				"TriggeredTime": HaveField("Time", BeTemporally("==", fakeClock.Now())),
+				"State":         Equal(gardencorev1beta1.LastOperationStatePending),
+			})))
+			Expect(recorder.Events).To(Receive(HavePrefix("Normal MaintenanceSkipped")))
+		})
+
+		It("should perform forceful updates of expired versions despite an active freeze", func() {
+			cloudProfile.Spec.Kubernetes.Versions[0].ExpirationDate = ptr.To(metav1.NewTime(time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = false
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.33.1"))
+			Expect(shoot.Status.LastMaintenance).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Description": And(ContainSubstring(`Control Plane: Updated Kubernetes version from "1.33.0" to "1.33.1"`), HaveSuffix(`Other maintenance operations skipped due to maintenance freeze "holidays" (until 2026-01-06T00:00:00Z)`)),
+				"State":       Equal(gardencorev1beta1.LastOperationStateSucceeded),
+			})))
+		})
+
+		It("should skip the maintenance if a globally configured freeze is active", func() {
+			project.Spec.MaintenanceFreezes = nil
+			Expect(fakeClient.Update(ctx, project)).To(Succeed())
+			reconciler.Config.MaintenanceFreezes = []gardencorev1beta1.MaintenanceFreeze{{
+				Name:     "christmas",
+				Schedule: ptr.To("0 0 24 12 *"),
+				Duration: &metav1.Duration{Duration: 72 * time.Hour},
+			}}
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.33.0"))
+			Expect(shoot.Status.LastMaintenance.Description).To(HavePrefix(`Maintenance skipped due to maintenance freeze "christmas" (until 2025-12-27T00:00:00Z)`))
+		})
+
+		It("should maintain the shoot if no freeze is active", func() {
+			fakeClock.SetTime(time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC))
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.33.1"))
+			Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+		})
+
+		It("should maintain the shoot despite an active freeze if maintenance is triggered explicitly", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.33.1"))
+			Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+		})
+
+		It("should mark a skipped maintenance as succeeded once the freeze has ended and nothing is pending", func() {
+			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = false
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Status.LastMaintenance.Description).To(HaveSuffix("Skipped operations: no pending operations"))
+			Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStatePending))
+
+			fakeClock.SetTime(time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC))
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Status.LastMaintenance.Description).To(Equal("Maintenance succeeded"))
+			Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+		})
+	})
+
+	Describe("#Reconcile with worker pool maintenance time windows", func() {
+		var (
+			ctx        context.Context
+			reconciler *Reconciler
+			fakeClient client.Client
+			fakeClock  *testclock.FakeClock
+			recorder   *events.FakeRecorder
+
+			cloudProfile *gardencorev1beta1.CloudProfile
+			shoot        *gardencorev1beta1.Shoot
+			request      reconcile.Request
+		)
+
+		BeforeEach(func() {
+			ctx = context.TODO()
+			// 2024-06-02 is a Sunday
+			fakeClock = testclock.NewFakeClock(time.Date(2024, 6, 2, 3, 0, 0, 0, time.UTC))
+			recorder = events.NewFakeRecorder(10)
+
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+				Build()
+
+			reconciler = &Reconciler{
+				Client:   fakeClient,
+				Clock:    fakeClock,
+				Recorder: recorder,
+			}
+
+			machineImageVersion := func(version string) gardencorev1beta1.MachineImageVersion {
+				return gardencorev1beta1.MachineImageVersion{
+					ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: version},
+					CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+					Architectures:    []string{"amd64"},
+				}
+			}
+
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					Kubernetes: gardencorev1beta1.KubernetesSettings{
+						Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.33.0"}},
+					},
+					MachineImages: []gardencorev1beta1.MachineImage{{
+						Name:           "CoreOs",
+						UpdateStrategy: ptr.To(gardencorev1beta1.UpdateStrategyMajor),
+						Versions:       []gardencorev1beta1.MachineImageVersion{machineImageVersion("1.0.0"), machineImageVersion("1.1.0")},
+					}},
+					MachineTypes: []gardencorev1beta1.MachineType{{Name: "large"}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+			worker := func(name string) gardencorev1beta1.Worker {
+				return gardencorev1beta1.Worker{
+					Name: name,
+					Machine: gardencorev1beta1.Machine{
+						Type:         "large",
+						Image:        &gardencorev1beta1.ShootMachineImage{Name: "CoreOs", Version: ptr.To("1.0.0")},
+						Architecture: ptr.To("amd64"),
+					},
+				}
+			}
+
+			gpuWorker := worker("gpu")
+			gpuWorker.Maintenance = &gardencorev1beta1.WorkerMaintenance{
+				TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "020000+0000", End: "040000+0000"},
+				Weekdays:   []string{"Sunday"},
+			}
+
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo", Annotations: map[string]string{"foo": "bar"}},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: cloudProfile.Name},
+					Kubernetes:   gardencorev1beta1.Kubernetes{Version: "1.33.0"},
+					Maintenance: &gardencorev1beta1.Maintenance{
+						AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{MachineImageVersion: ptr.To(true)},
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+					},
+					Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{worker("web"), gpuWorker}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+			request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+		})
+
+		It("should only maintain the worker pools whose maintenance time window is due", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Provider.Workers[0].Machine.Image.Version).To(PointTo(Equal("1.0.0")))
+			Expect(shoot.Spec.Provider.Workers[1].Machine.Image.Version).To(PointTo(Equal("1.1.0")))
+			Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+			Expect(shoot.Status.LastMaintenance).To(BeNil())
+			Expect(shoot.Status.WorkerPoolsLastMaintenance).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name": Equal("gpu"),
+				"LastMaintenance": MatchFields(IgnoreExtras, Fields{
+					"Description":   ContainSubstring(`Worker pool "gpu": Updated machine image "CoreOs" from "1.0.0" to "1.1.0"`),
+					"TriggeredTime": HaveField("Time", BeTemporally("==", fakeClock.Now())),
+					"State":         Equal(gardencorev1beta1.LastOperationStateSucceeded),
+				}),
+			})))
+			Expect(recorder.Events).To(Receive(HavePrefix("Normal MachineImageVersionMaintenance")))
+		})
+
+		It("should not maintain the worker pools on other weekdays", func() {
+			fakeClock.SetTime(time.Date(2024, 6, 3, 3, 0, 0, 0, time.UTC))
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Provider.Workers[1].Machine.Image.Version).To(PointTo(Equal("1.0.0")))
+			Expect(shoot.Status.WorkerPoolsLastMaintenance).To(BeEmpty())
+		})
+
+		It("should not maintain worker pools having their own time window in the maintenance time window of the shoot", func() {
+			fakeClock.SetTime(time.Date(2024, 6, 2, 22, 10, 0, 0, time.UTC))
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Provider.Workers[0].Machine.Image.Version).To(PointTo(Equal("1.1.0")))
+			Expect(shoot.Spec.Provider.Workers[1].Machine.Image.Version).To(PointTo(Equal("1.0.0")))
+			Expect(shoot.Status.LastMaintenance.Description).NotTo(ContainSubstring(`Worker pool "gpu"`))
+			Expect(shoot.Status.WorkerPoolsLastMaintenance).To(BeEmpty())
+		})
+
+		It("should maintain all worker pools if maintenance is triggered explicitly", func() {
+			fakeClock.SetTime(time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC))
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Provider.Workers[0].Machine.Image.Version).To(PointTo(Equal("1.1.0")))
+			Expect(shoot.Spec.Provider.Workers[1].Machine.Image.Version).To(PointTo(Equal("1.1.0")))
+			Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+			Expect(shoot.Status.WorkerPoolsLastMaintenance).To(ConsistOf(HaveField("LastMaintenance.State", gardencorev1beta1.LastOperationStateSucceeded)))
+		})
+
+		It("should record the skipped maintenance of the worker pools if a maintenance freeze is active", func() {
+			reconciler.Config.MaintenanceFreezes = []gardencorev1beta1.MaintenanceFreeze{{
+				Name:  "release",
+				Start: ptr.To(metav1.NewTime(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))),
+				End:   ptr.To(metav1.NewTime(time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC))),
+			}}
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Provider.Workers[1].Machine.Image.Version).To(PointTo(Equal("1.0.0")))
+			Expect(shoot.Status.WorkerPoolsLastMaintenance).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name": Equal("gpu"),
+				"LastMaintenance": MatchFields(IgnoreExtras, Fields{
+					"Description": And(HavePrefix(`Maintenance skipped due to maintenance freeze "release"`), ContainSubstring(`Worker pool "gpu": Updated machine image "CoreOs"`)),
+					"State":       Equal(gardencorev1beta1.LastOperationStatePending),
+				}),
+			})))
+			Expect(recorder.Events).To(Receive(HavePrefix("Normal MaintenanceSkipped")))
+		})
+
+		It("should perform forceful updates of expired machine images of the worker pools despite an active maintenance freeze", func() {
+			cloudProfile.Spec.MachineImages[0].Versions[0].ExpirationDate = ptr.To(metav1.NewTime(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+			reconciler.Config.MaintenanceFreezes = []gardencorev1beta1.MaintenanceFreeze{{
+				Name:  "release",
+				Start: ptr.To(metav1.NewTime(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))),
+				End:   ptr.To(metav1.NewTime(time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC))),
+			}}
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Provider.Workers[0].Machine.Image.Version).To(PointTo(Equal("1.0.0")))
+			Expect(shoot.Spec.Provider.Workers[1].Machine.Image.Version).To(PointTo(Equal("1.1.0")))
+			Expect(shoot.Status.WorkerPoolsLastMaintenance).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name": Equal("gpu"),
+				"LastMaintenance": MatchFields(IgnoreExtras, Fields{
+					"Description": And(ContainSubstring(`Worker pool "gpu": Updated machine image "CoreOs"`), HaveSuffix(`Other maintenance operations skipped due to maintenance freeze "release" (until 2024-06-03T00:00:00Z)`)),
+					"State":       Equal(gardencorev1beta1.LastOperationStateSucceeded),
+				}),
+			})))
+		})
+
+		It("should evaluate the weekdays in the time zone of the time window of the worker pool", func() {
+			// 2024-06-02 23:30 UTC is already a Monday in the time zone of the time window
+			fakeClock.SetTime(time.Date(2024, 6, 2, 23, 30, 0, 0, time.UTC))
+			shoot.Spec.Provider.Workers[1].Maintenance = &gardencorev1beta1.WorkerMaintenance{
+				TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "010000+0200", End: "030000+0200"},
+				Weekdays:   []string{"Monday"},
+			}
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Provider.Workers[1].Machine.Image.Version).To(PointTo(Equal("1.1.0")))
+			Expect(shoot.Status.WorkerPoolsLastMaintenance).To(ConsistOf(HaveField("Name", "gpu")))
+		})
+
+		It("should defer the Kubernetes version update of worker pools following the control plane to their own time window", func() {
+			cloudProfile.Spec.Kubernetes.Versions = append(cloudProfile.Spec.Kubernetes.Versions, gardencorev1beta1.ExpirableVersion{Version: "1.33.1"})
+			Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			By("Update the control plane in the maintenance time window of the shoot")
+			fakeClock.SetTime(time.Date(2024, 6, 2, 22, 10, 0, 0, time.UTC))
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.33.1"))
+			Expect(shoot.Spec.Provider.Workers[0].Kubernetes).To(BeNil())
+			Expect(shoot.Spec.Provider.Workers[1].Kubernetes).To(PointTo(HaveField("Version", PointTo(Equal("1.33.0")))))
+			Expect(shoot.Annotations).To(HaveKeyWithValue("maintenance.gardener.cloud/deferred-worker-pools", "gpu"))
+			Expect(shoot.Status.LastMaintenance.Description).To(ContainSubstring(`Kubernetes version update of worker pool "gpu" deferred to its maintenance time window`))
+
+			By("Update the worker pool in its own maintenance time window")
+			fakeClock.SetTime(time.Date(2024, 6, 9, 3, 0, 0, 0, time.UTC))
+
+			_, err = reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.33.1"))
+			Expect(shoot.Spec.Provider.Workers[1].Kubernetes).To(BeNil())
+			Expect(shoot.Annotations).NotTo(HaveKey("maintenance.gardener.cloud/deferred-worker-pools"))
+			Expect(shoot.Status.WorkerPoolsLastMaintenance).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name": Equal("gpu"),
+				"LastMaintenance": MatchFields(IgnoreExtras, Fields{
+					"Description": ContainSubstring(`Worker pool "gpu": Updated Kubernetes version from "1.33.0" to "1.33.1"`),
+					"State":       Equal(gardencorev1beta1.LastOperationStateSucceeded),
+				}),
+			})))
+		})
+
+		It("should no longer defer the update of worker pools whose own time window was removed", func() {
+			cloudProfile.Spec.Kubernetes.Versions = append(cloudProfile.Spec.Kubernetes.Versions, gardencorev1beta1.ExpirableVersion{Version: "1.33.1"})
+			Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+			fakeClock.SetTime(time.Date(2024, 6, 2, 22, 10, 0, 0, time.UTC))
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.gardener.cloud/deferred-worker-pools", "gpu,removed")
+			shoot.Spec.Kubernetes.Version = "1.33.1"
+			shoot.Spec.Provider.Workers[1].Maintenance = nil
+			shoot.Spec.Provider.Workers[1].Kubernetes = &gardencorev1beta1.WorkerKubernetes{Version: ptr.To("1.33.0")}
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Spec.Provider.Workers[1].Kubernetes).To(BeNil())
+			Expect(shoot.Annotations).NotTo(HaveKey("maintenance.gardener.cloud/deferred-worker-pools"))
+			Expect(shoot.Status.LastMaintenance.Description).To(ContainSubstring(`Worker pool "gpu": Updated Kubernetes version from "1.33.0" to "1.33.1"`))
+		})
+
+		It("should remove the last maintenance of worker pools no longer having their own time window", func() {
+			shoot.Status.WorkerPoolsLastMaintenance = []gardencorev1beta1.WorkerPoolLastMaintenance{{Name: "web"}}
+			Expect(fakeClient.Status().Update(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Status.WorkerPoolsLastMaintenance).To(ConsistOf(HaveField("Name", "gpu")))
+		})
+	})
+
 	Describe("#quotasEqual", func() {
 		It("should return true for empty slices", func() {
 			Expect(quotasEqual(nil, nil)).To(BeTrue())
@@ -9,8 +9,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -23,11 +26,16 @@ import (
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
+// EventShootExpirationWarning is the reason of the events emitted when the lifetime of a Shoot is about to expire.
+const EventShootExpirationWarning = "ShootExpirationWarning"
+
 // Reconciler reconciles Shoots and auto-deletes them if they are bound to a Quota with a configured cluster lifetime.
+// Before the cluster lifetime expires, warning events are emitted for each configured expiration warning threshold.
 type Reconciler struct {
-	Client client.Client
-	Config controllermanagerconfigv1alpha1.ShootQuotaControllerConfiguration
-	Clock  clock.Clock
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.ShootQuotaControllerConfiguration
+	Clock    clock.Clock
+	Recorder events.EventRecorder
 }
 
 // Reconcile reconciles Shoots and auto-deletes them if they are bound to a Quota with a configured cluster lifetime.
@@ -79,11 +87,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	// then we will not check for cluster lifetime expiration, even if the Shoot has a clusterLifetime timestamp already
 	// annotated.
 	if clusterLifeTime == nil {
-		if metav1.HasAnnotation(shoot.ObjectMeta, v1beta1constants.ShootExpirationTimestamp) {
+		if metav1.HasAnnotation(shoot.ObjectMeta, v1beta1constants.ShootExpirationTimestamp) ||
+			metav1.HasAnnotation(shoot.ObjectMeta, v1beta1constants.ShootExpirationWarningThreshold) {
 			log.Info("Removing expiration timestamp annotation")
 
 			patch := client.MergeFrom(shoot.DeepCopy())
 			delete(shoot.Annotations, v1beta1constants.ShootExpirationTimestamp)
+			delete(shoot.Annotations, v1beta1constants.ShootExpirationWarningThreshold)
 			if err := r.Client.Patch(ctx, shoot, patch); err != nil {
 				return reconcile.Result{}, err
 			}
@@ -108,7 +118,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, err
 	}
 
-	if r.Clock.Now().UTC().After(expirationTimeParsed.UTC()) {
+	remaining := expirationTimeParsed.Sub(r.Clock.Now())
+	if remaining <= 0 {
 		log.Info("Shoot cluster lifetime expired, deleting Shoot", "expirationTime", expirationTime)
 
 		// We have to annotate the Shoot to confirm the deletion.
@@ -123,5 +134,63 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, shoot))
 	}
 
-	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	if err := r.warnAboutExpiration(ctx, log, shoot, expirationTime, remaining); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.requeueAfter(remaining)}, nil
+}
+
+// warnAboutExpiration emits a warning event when the remaining lifetime of the Shoot falls below one of the configured
+// expiration warning thresholds. The smallest threshold for which a warning was emitted is remembered in an annotation
+// to make sure that each stage is only warned about once. The annotation is removed if the lifetime was extended
+// beyond all thresholds.
+func (r *Reconciler) warnAboutExpiration(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, expirationTime string, remaining time.Duration) error {
+	var threshold *time.Duration
+	for _, t := range r.Config.ExpirationWarningThresholds {
+		if remaining <= t.Duration && (threshold == nil || t.Duration < *threshold) {
+			threshold = &t.Duration
+		}
+	}
+
+	currentThreshold, hasThreshold := shoot.Annotations[v1beta1constants.ShootExpirationWarningThreshold]
+	if threshold == nil {
+		if !hasThreshold {
+			return nil
+		}
+
+		log.Info("Removing expiration warning threshold annotation")
+		patch := client.MergeFrom(shoot.DeepCopy())
+		delete(shoot.Annotations, v1beta1constants.ShootExpirationWarningThreshold)
+		return r.Client.Patch(ctx, shoot, patch)
+	}
+
+	if hasThreshold && currentThreshold == threshold.String() {
+		return nil
+	}
+
+	log.Info("Shoot cluster lifetime expires soon", "expirationTime", expirationTime, "threshold", threshold.String())
+	r.Recorder.Eventf(shoot, nil, corev1.EventTypeWarning, EventShootExpirationWarning, gardencorev1beta1.EventActionReconcile,
+		"Shoot cluster lifetime expires at %s (in less than %s), the cluster will be deleted afterwards", expirationTime, threshold.String())
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootExpirationWarningThreshold, threshold.String())
+	return r.Client.Patch(ctx, shoot, patch)
+}
+
+// requeueAfter returns the duration after which the Shoot must be reconciled again such that neither the next
+// expiration warning threshold nor the expiration itself is missed.
+func (r *Reconciler) requeueAfter(remaining time.Duration) time.Duration {
+	requeueAfter := r.Config.SyncPeriod.Duration
+	if remaining < requeueAfter {
+		requeueAfter = remaining
+	}
+
+	for _, t := range r.Config.ExpirationWarningThresholds {
+		if untilThreshold := remaining - t.Duration; untilThreshold > 0 && untilThreshold < requeueAfter {
+			requeueAfter = untilThreshold
+		}
+	}
+
+	return requeueAfter
 }
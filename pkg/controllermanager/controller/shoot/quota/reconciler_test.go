@@ -13,7 +13,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/clock"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -171,4 +173,97 @@ var _ = Describe("Reconciler", func() {
 		_, ok := shoot.Annotations["shoot.gardener.cloud/expiration-timestamp"]
 		Expect(ok).To(BeTrue())
 	})
+
+	Context("expiration warnings", func() {
+		var (
+			fakeClock *testclock.FakeClock
+			recorder  *events.FakeRecorder
+			request   reconcile.Request
+		)
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakeClock(time.Date(2025, 10, 1, 10, 0, 0, 0, time.UTC))
+			recorder = events.NewFakeRecorder(10)
+
+			reconciler = &shootquota.Reconciler{
+				Client:   fakeClient,
+				Clock:    fakeClock,
+				Recorder: recorder,
+				Config: controllermanagerconfigv1alpha1.ShootQuotaControllerConfiguration{
+					ConcurrentSyncs:             ptr.To(1),
+					SyncPeriod:                  &metav1.Duration{Duration: time.Hour},
+					ExpirationWarningThresholds: []metav1.Duration{{Duration: 7 * 24 * time.Hour}, {Duration: 24 * time.Hour}},
+				},
+			}
+
+			request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+			quota.Spec.ClusterLifetimeDays = ptr.To[int32](30)
+		})
+
+		JustBeforeEach(func() {
+			Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+			Expect(fakeClient.Create(ctx, secretBinding)).To(Succeed())
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		})
+
+		setExpirationTime := func(remaining time.Duration) {
+			patch := client.MergeFrom(shoot.DeepCopy())
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/expiration-timestamp", fakeClock.Now().Add(remaining).Format(time.RFC3339))
+			ExpectWithOffset(1, fakeClient.Patch(ctx, shoot, patch)).To(Succeed())
+		}
+
+		It("should not warn if the expiration is beyond all thresholds", func() {
+			setExpirationTime(10 * 24 * time.Hour)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/expiration-warning-threshold"))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should requeue when the next threshold is reached earlier than the sync period", func() {
+			setExpirationTime(7*24*time.Hour + 10*time.Minute)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Minute}))
+		})
+
+		It("should warn once per stage", func() {
+			setExpirationTime(5 * 24 * time.Hour)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/expiration-warning-threshold", "168h0m0s"))
+			Eventually(recorder.Events).Should(Receive(ContainSubstring("Warning ShootExpirationWarning")))
+
+			fakeClock.Step(time.Hour)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+			Expect(recorder.Events).To(BeEmpty())
+
+			fakeClock.Step(4*24*time.Hour - time.Hour)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/expiration-warning-threshold", "24h0m0s"))
+			Eventually(recorder.Events).Should(Receive(ContainSubstring("less than 24h0m0s")))
+		})
+
+		It("should requeue at the expiration time and delete the shoot afterwards", func() {
+			setExpirationTime(30 * time.Minute)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+
+			fakeClock.Step(30 * time.Minute)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(BeNotFoundError())
+		})
+
+		It("should remove the threshold annotation if the lifetime was extended", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/expiration-warning-threshold", "24h0m0s")
+			setExpirationTime(20 * 24 * time.Hour)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/expiration-warning-threshold"))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
 })
@@ -0,0 +1,381 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance/helper"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// healthConditionTypes are the types of the Shoot conditions which are considered when checking whether the rollout
+// of a version causes regressions.
+var healthConditionTypes = []gardencorev1beta1.ConditionType{
+	gardencorev1beta1.ShootAPIServerAvailable,
+	gardencorev1beta1.ShootControlPlaneHealthy,
+	gardencorev1beta1.ShootObservabilityComponentsHealthy,
+	gardencorev1beta1.ShootEveryNodeReady,
+	gardencorev1beta1.ShootSystemComponentsHealthy,
+}
+
+// Reconciler reconciles CloudProfiles and advances the staged rollout of their Kubernetes and machine image versions
+// through the configured rollout waves.
+type Reconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.ShootMaintenanceControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile reconciles CloudProfiles and advances the staged rollout of their Kubernetes and machine image versions
+// through the configured rollout waves.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	cloudProfile := &gardencorev1beta1.CloudProfile{}
+	if err := r.Client.Get(ctx, request.NamespacedName, cloudProfile); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if cloudProfile.DeletionTimestamp != nil {
+		log.V(1).Info("Skipping CloudProfile because it is marked for deletion")
+		return reconcile.Result{}, nil
+	}
+
+	shoots, err := r.shootsForCloudProfile(ctx, cloudProfile)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var (
+		now    = r.Clock.Now()
+		status = gardencorev1beta1.CloudProfileStatus{
+			Kubernetes: &gardencorev1beta1.KubernetesStatus{},
+		}
+	)
+
+	for _, version := range cloudProfile.Spec.Kubernetes.Versions {
+		var (
+			previous = findVersionStatus(kubernetesVersionStatuses(cloudProfile.Status), version.Version)
+			running  = shoots.filter(func(shoot *gardencorev1beta1.Shoot) bool { return runsKubernetesVersion(shoot, version.Version) })
+			updated  = updatedByMaintenance(kubernetesVersionUpdatePattern(version.Version))
+		)
+
+		status.Kubernetes.Versions = append(status.Kubernetes.Versions, gardencorev1beta1.ExpirableVersionStatus{
+			Version:        version.Version,
+			Classification: v1beta1helper.CurrentLifecycleClassification(version),
+			Rollout:        r.computeRollout(log.WithValues("kubernetesVersion", version.Version), previous, running, updated, now),
+		})
+	}
+
+	for _, machineImage := range cloudProfile.Spec.MachineImages {
+		var (
+			imageStatus   = gardencorev1beta1.MachineImageStatus{Name: machineImage.Name}
+			previousImage = findMachineImageStatus(cloudProfile.Status, machineImage.Name)
+		)
+
+		for _, version := range machineImage.Versions {
+			var (
+				previous = findVersionStatus(previousImage, version.Version)
+				running  = shoots.filter(func(shoot *gardencorev1beta1.Shoot) bool {
+					return runsMachineImageVersion(shoot, machineImage.Name, version.Version)
+				})
+				updated = updatedByMaintenance(machineImageVersionUpdatePattern(machineImage.Name, version.Version))
+			)
+
+			imageStatus.Versions = append(imageStatus.Versions, gardencorev1beta1.ExpirableVersionStatus{
+				Version:        version.Version,
+				Classification: v1beta1helper.CurrentLifecycleClassification(version.ExpirableVersion),
+				Rollout:        r.computeRollout(log.WithValues("machineImage", machineImage.Name, "machineImageVersion", version.Version), previous, running, updated, now),
+			})
+		}
+
+		status.MachineImages = append(status.MachineImages, imageStatus)
+	}
+
+	patch := client.MergeFrom(cloudProfile.DeepCopy())
+	cloudProfile.Status = status
+	if err := r.Client.Status().Patch(ctx, cloudProfile, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed patching rollout status of CloudProfile: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.StagedRollout.SyncPeriod.Duration}, nil
+}
+
+// rolloutShoot is a Shoot together with the index of the rollout wave it belongs to.
+type rolloutShoot struct {
+	shoot     *gardencorev1beta1.Shoot
+	waveIndex int
+}
+
+type rolloutShoots []rolloutShoot
+
+func (s rolloutShoots) filter(fn func(*gardencorev1beta1.Shoot) bool) rolloutShoots {
+	var out rolloutShoots
+	for _, shoot := range s {
+		if fn(shoot.shoot) {
+			out = append(out, shoot)
+		}
+	}
+	return out
+}
+
+// shootsForCloudProfile returns all Shoots using the given CloudProfile, either directly or via a
+// NamespacedCloudProfile, together with the index of the rollout wave they belong to.
+func (r *Reconciler) shootsForCloudProfile(ctx context.Context, cloudProfile *gardencorev1beta1.CloudProfile) (rolloutShoots, error) {
+	namespacedCloudProfileList := &gardencorev1beta1.NamespacedCloudProfileList{}
+	if err := r.Client.List(ctx, namespacedCloudProfileList); err != nil {
+		return nil, fmt.Errorf("failed listing NamespacedCloudProfiles: %w", err)
+	}
+
+	namespacedCloudProfiles := make(map[client.ObjectKey]struct{})
+	for _, namespacedCloudProfile := range namespacedCloudProfileList.Items {
+		if namespacedCloudProfile.Spec.Parent.Kind == v1beta1constants.CloudProfileReferenceKindCloudProfile && namespacedCloudProfile.Spec.Parent.Name == cloudProfile.Name {
+			namespacedCloudProfiles[client.ObjectKeyFromObject(&namespacedCloudProfile)] = struct{}{}
+		}
+	}
+
+	projectList := &gardencorev1beta1.ProjectList{}
+	if err := r.Client.List(ctx, projectList); err != nil {
+		return nil, fmt.Errorf("failed listing Projects: %w", err)
+	}
+
+	projectLabels := make(map[string]map[string]string, len(projectList.Items))
+	for _, project := range projectList.Items {
+		if project.Spec.Namespace != nil {
+			projectLabels[*project.Spec.Namespace] = project.Labels
+		}
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
+		return nil, fmt.Errorf("failed listing Shoots: %w", err)
+	}
+
+	var shoots rolloutShoots
+	for i := range shootList.Items {
+		shoot := &shootList.Items[i]
+
+		cloudProfileReference := gardenerutils.BuildV1beta1CloudProfileReference(shoot)
+		if cloudProfileReference == nil {
+			continue
+		}
+
+		switch cloudProfileReference.Kind {
+		case v1beta1constants.CloudProfileReferenceKindCloudProfile:
+			if cloudProfileReference.Name != cloudProfile.Name {
+				continue
+			}
+		case v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile:
+			if _, ok := namespacedCloudProfiles[client.ObjectKey{Namespace: shoot.Namespace, Name: cloudProfileReference.Name}]; !ok {
+				continue
+			}
+		default:
+			continue
+		}
+
+		shoots = append(shoots, rolloutShoot{
+			shoot:     shoot,
+			waveIndex: helper.RolloutWaveIndex(r.Config.StagedRollout.Waves, shoot, projectLabels[shoot.Namespace]),
+		})
+	}
+
+	return shoots, nil
+}
+
+// computeRollout computes the rollout state of a version based on its previous state and the Shoots running it. Only
+// Shoots which were updated to the version by the rollout within the soak duration are considered for halting the
+// rollout, i.e., Shoots which already ran the version before or were updated manually do not affect it. The rollout is
+// halted if more than the configured percentage of these Shoots is unhealthy.
+func (r *Reconciler) computeRollout(log logr.Logger, previous *gardencorev1beta1.ExpirableVersionStatus, running rolloutShoots, updated func(*gardencorev1beta1.Shoot, time.Time) bool, now time.Time) *gardencorev1beta1.VersionRolloutStatus {
+	waves := r.Config.StagedRollout.Waves
+
+	var rollout *gardencorev1beta1.VersionRolloutStatus
+	if previous != nil && previous.Rollout != nil && helper.RolloutWaveIndexForName(waves, previous.Rollout.Wave) >= 0 {
+		rollout = previous.Rollout.DeepCopy()
+	} else {
+		// Versions without rollout state are initialized with the highest wave whose Shoots already run the version,
+		// e.g., because the version was already available before the staged rollout was configured.
+		waveIndex := 0
+		for _, shoot := range running {
+			waveIndex = max(waveIndex, shoot.waveIndex)
+		}
+
+		log.Info("Initializing rollout of version", "wave", waves[waveIndex].Name)
+		rollout = &gardencorev1beta1.VersionRolloutStatus{
+			Wave:               waves[waveIndex].Name,
+			LastTransitionTime: metav1.NewTime(now),
+			Message:            fmt.Sprintf("Version is eligible for rollout wave %q.", waves[waveIndex].Name),
+		}
+	}
+
+	// While the rollout is halted, no Shoots are updated to the version anymore. Hence, the Shoots which caused the halt
+	// are considered until they are healthy again, even if they were updated before the current soak duration.
+	var (
+		soakDuration = r.Config.StagedRollout.SoakDuration.Duration
+		since        = now.Add(-soakDuration)
+	)
+	if rollout.Halted {
+		since = rollout.LastTransitionTime.Add(-soakDuration)
+	}
+
+	var updatedShoots, unhealthyShoots []string
+	for _, shoot := range running {
+		if !updated(shoot.shoot, since) {
+			continue
+		}
+
+		updatedShoots = append(updatedShoots, client.ObjectKeyFromObject(shoot.shoot).String())
+		if !isShootHealthy(shoot.shoot) {
+			unhealthyShoots = append(unhealthyShoots, client.ObjectKeyFromObject(shoot.shoot).String())
+		}
+	}
+	slices.Sort(unhealthyShoots)
+
+	maxUnhealthyPercentage := int(ptr.Deref(r.Config.StagedRollout.MaxUnhealthyPercentage, 0))
+	if len(unhealthyShoots) > 0 && len(unhealthyShoots)*100 > maxUnhealthyPercentage*len(updatedShoots) {
+		message := fmt.Sprintf("Rollout is halted because %d of %d Shoots updated to the version are unhealthy (more than %d%%): %s.", len(unhealthyShoots), len(updatedShoots), maxUnhealthyPercentage, strings.Join(unhealthyShoots, ", "))
+		if !rollout.Halted {
+			log.Info("Halting rollout of version because Shoots updated to it are unhealthy", "wave", rollout.Wave, "shoots", unhealthyShoots, "updatedShoots", len(updatedShoots))
+			rollout.Halted = true
+			rollout.LastTransitionTime = metav1.NewTime(now)
+		}
+		rollout.Message = message
+		return rollout
+	}
+
+	if rollout.Halted {
+		log.Info("Resuming rollout of version because Shoots updated to it are healthy again", "wave", rollout.Wave)
+		rollout.Halted = false
+		rollout.LastTransitionTime = metav1.NewTime(now)
+		rollout.Message = fmt.Sprintf("Rollout is resumed, version is eligible for rollout wave %q.", rollout.Wave)
+		return rollout
+	}
+
+	waveIndex := helper.RolloutWaveIndexForName(waves, rollout.Wave)
+	if waveIndex < len(waves)-1 && !now.Before(rollout.LastTransitionTime.Add(soakDuration)) {
+		next := waves[waveIndex+1].Name
+		log.Info("Advancing rollout of version to next wave", "wave", next)
+		rollout.Wave = next
+		rollout.LastTransitionTime = metav1.NewTime(now)
+		rollout.Message = fmt.Sprintf("Version is eligible for rollout wave %q.", next)
+	}
+
+	return rollout
+}
+
+// isShootHealthy returns false if any of the health conditions of the given Shoot is False. Hibernated Shoots are
+// always considered healthy.
+func isShootHealthy(shoot *gardencorev1beta1.Shoot) bool {
+	if shoot.Status.IsHibernated {
+		return true
+	}
+
+	for _, conditionType := range healthConditionTypes {
+		if condition := v1beta1helper.GetCondition(shoot.Status.Conditions, conditionType); condition != nil && condition.Status == gardencorev1beta1.ConditionFalse {
+			return false
+		}
+	}
+
+	return true
+}
+
+// kubernetesVersionUpdatePattern returns a pattern matching the description of a maintenance which updated the
+// Kubernetes version of the control plane or of a worker pool to the given version (see the "Maintenance" reconciler).
+func kubernetesVersionUpdatePattern(version string) *regexp.Regexp {
+	return regexp.MustCompile(`Updated Kubernetes version from "[^"]*" to ` + regexp.QuoteMeta(strconv.Quote(version)))
+}
+
+// machineImageVersionUpdatePattern returns a pattern matching the description of a maintenance which updated the
+// given machine image of a worker pool to the given version (see the "Maintenance" reconciler).
+func machineImageVersionUpdatePattern(name, version string) *regexp.Regexp {
+	return regexp.MustCompile(`Updated machine image ` + regexp.QuoteMeta(strconv.Quote(name)) + ` from "[^"]*" to ` + regexp.QuoteMeta(strconv.Quote(version)))
+}
+
+// updatedByMaintenance returns a function checking whether the last maintenance of a Shoot or of one of its worker
+// pools was triggered at or after the given time and performed an update matching the given pattern. Maintenances
+// skipped due to a maintenance freeze are pending and only list the operations they would have performed.
+func updatedByMaintenance(pattern *regexp.Regexp) func(*gardencorev1beta1.Shoot, time.Time) bool {
+	return func(shoot *gardencorev1beta1.Shoot, since time.Time) bool {
+		matches := func(lastMaintenance *gardencorev1beta1.LastMaintenance) bool {
+			return lastMaintenance != nil &&
+				lastMaintenance.State != gardencorev1beta1.LastOperationStatePending &&
+				!lastMaintenance.TriggeredTime.Time.Before(since) &&
+				pattern.MatchString(lastMaintenance.Description)
+		}
+
+		if matches(shoot.Status.LastMaintenance) {
+			return true
+		}
+
+		return slices.ContainsFunc(shoot.Status.WorkerPoolsLastMaintenance, func(lastMaintenance gardencorev1beta1.WorkerPoolLastMaintenance) bool {
+			return matches(&lastMaintenance.LastMaintenance)
+		})
+	}
+}
+
+func runsKubernetesVersion(shoot *gardencorev1beta1.Shoot, version string) bool {
+	if shoot.Spec.Kubernetes.Version == version {
+		return true
+	}
+
+	return slices.ContainsFunc(shoot.Spec.Provider.Workers, func(worker gardencorev1beta1.Worker) bool {
+		return worker.Kubernetes != nil && ptr.Deref(worker.Kubernetes.Version, "") == version
+	})
+}
+
+func runsMachineImageVersion(shoot *gardencorev1beta1.Shoot, name, version string) bool {
+	return slices.ContainsFunc(shoot.Spec.Provider.Workers, func(worker gardencorev1beta1.Worker) bool {
+		return worker.Machine.Image != nil && worker.Machine.Image.Name == name && ptr.Deref(worker.Machine.Image.Version, "") == version
+	})
+}
+
+func kubernetesVersionStatuses(status gardencorev1beta1.CloudProfileStatus) []gardencorev1beta1.ExpirableVersionStatus {
+	if status.Kubernetes == nil {
+		return nil
+	}
+	return status.Kubernetes.Versions
+}
+
+func findMachineImageStatus(status gardencorev1beta1.CloudProfileStatus, name string) []gardencorev1beta1.ExpirableVersionStatus {
+	for _, machineImage := range status.MachineImages {
+		if machineImage.Name == name {
+			return machineImage.Versions
+		}
+	}
+	return nil
+}
+
+func findVersionStatus(statuses []gardencorev1beta1.ExpirableVersionStatus, version string) *gardencorev1beta1.ExpirableVersionStatus {
+	for i := range statuses {
+		if statuses[i].Version == version {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,323 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/rollout"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		project      *gardencorev1beta1.Project
+		request      reconcile.Request
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 10, 1, 10, 0, 0, 0, time.UTC))
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.CloudProfile{}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Clock:  fakeClock,
+			Config: controllermanagerconfigv1alpha1.ShootMaintenanceControllerConfiguration{
+				StagedRollout: &controllermanagerconfigv1alpha1.StagedRolloutConfiguration{
+					Waves: []controllermanagerconfigv1alpha1.RolloutWave{
+						{Name: "canary", Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeEvaluation}},
+						{Name: "early", ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"rollout": "early"}}},
+						{Name: "rest"},
+					},
+					SoakDuration:           &metav1.Duration{Duration: 24 * time.Hour},
+					MaxUnhealthyPercentage: ptr.To[int32](10),
+					SyncPeriod:             &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Kubernetes: gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{
+						{Version: "1.33.0", Classification: ptr.To(gardencorev1beta1.ClassificationSupported)},
+						{Version: "1.33.1", Classification: ptr.To(gardencorev1beta1.ClassificationSupported)},
+					},
+				},
+				MachineImages: []gardencorev1beta1.MachineImage{{
+					Name: "image",
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}},
+					},
+				}},
+			},
+		}
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(cloudProfile)}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{"rollout": "early"}},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		}
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+	})
+
+	createShoot := func(name string, purpose gardencorev1beta1.ShootPurpose, version string, healthy bool) *gardencorev1beta1.Shoot {
+		shoot := &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-bar"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: ptr.To("profile"),
+				Purpose:          &purpose,
+				Kubernetes:       gardencorev1beta1.Kubernetes{Version: version},
+				Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{
+					Name:    "worker",
+					Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "image", Version: ptr.To("1.0.0")}},
+				}}},
+			},
+		}
+		if !healthy {
+			shoot.Status.Conditions = []gardencorev1beta1.Condition{{Type: gardencorev1beta1.ShootEveryNodeReady, Status: gardencorev1beta1.ConditionFalse}}
+		}
+		ExpectWithOffset(1, fakeClient.Create(ctx, shoot)).To(Succeed())
+		return shoot
+	}
+
+	// markUpdated records a maintenance of the given shoot which updated its Kubernetes version to the given version.
+	markUpdated := func(shoot *gardencorev1beta1.Shoot, from, to string, triggeredTime time.Time) {
+		shoot.Status.LastMaintenance = &gardencorev1beta1.LastMaintenance{
+			Description:   fmt.Sprintf("All maintenance operations successful. Control Plane: Updated Kubernetes version from %q to %q. Reason: Automatic update of Kubernetes version configured", from, to),
+			TriggeredTime: metav1.NewTime(triggeredTime),
+			State:         gardencorev1beta1.LastOperationStateSucceeded,
+		}
+		ExpectWithOffset(1, fakeClient.Update(ctx, shoot)).To(Succeed())
+	}
+
+	reconcileAndGetStatus := func() gardencorev1beta1.CloudProfileStatus {
+		result, err := reconciler.Reconcile(ctx, request)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, result).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Minute}))
+
+		ExpectWithOffset(1, fakeClient.Get(ctx, request.NamespacedName, cloudProfile)).To(Succeed())
+		return cloudProfile.Status
+	}
+
+	rolloutOf := func(status gardencorev1beta1.CloudProfileStatus, version string) *gardencorev1beta1.VersionRolloutStatus {
+		for _, v := range status.Kubernetes.Versions {
+			if v.Version == version {
+				return v.Rollout
+			}
+		}
+		return nil
+	}
+
+	It("should initialize the rollout of versions", func() {
+		createShoot("shoot", gardencorev1beta1.ShootPurposeProduction, "1.33.0", true)
+
+		status := reconcileAndGetStatus()
+
+		Expect(status.Kubernetes.Versions).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{
+				"Version":        Equal("1.33.0"),
+				"Classification": Equal(gardencorev1beta1.ClassificationSupported),
+				"Rollout":        PointTo(MatchFields(IgnoreExtras, Fields{"Wave": Equal("rest"), "Halted": BeFalse()})),
+			}),
+			MatchFields(IgnoreExtras, Fields{
+				"Version":        Equal("1.33.1"),
+				"Classification": Equal(gardencorev1beta1.ClassificationSupported),
+				"Rollout":        PointTo(MatchFields(IgnoreExtras, Fields{"Wave": Equal("canary"), "Halted": BeFalse()})),
+			}),
+		))
+		Expect(status.MachineImages).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Name": Equal("image"),
+			"Versions": ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Version": Equal("1.0.0"),
+				"Rollout": PointTo(MatchFields(IgnoreExtras, Fields{"Wave": Equal("rest")})),
+			})),
+		})))
+	})
+
+	It("should advance the rollout to the next wave after the soak duration", func() {
+		createShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation, "1.33.1", true)
+
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1").Wave).To(Equal("canary"))
+
+		fakeClock.Step(23 * time.Hour)
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1").Wave).To(Equal("canary"))
+
+		fakeClock.Step(time.Hour)
+		rollout := rolloutOf(reconcileAndGetStatus(), "1.33.1")
+		Expect(rollout.Wave).To(Equal("early"))
+		Expect(rollout.LastTransitionTime.Time).To(BeTemporally("==", fakeClock.Now()))
+
+		fakeClock.Step(24 * time.Hour)
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1").Wave).To(Equal("rest"))
+
+		fakeClock.Step(24 * time.Hour)
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1").Wave).To(Equal("rest"))
+	})
+
+	It("should halt the rollout if shoots updated to the version are unhealthy and resume it once they are healthy", func() {
+		shoot := createShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation, "1.33.1", false)
+		markUpdated(shoot, "1.33.0", "1.33.1", fakeClock.Now())
+
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1")).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Wave":    Equal("canary"),
+			"Halted":  BeTrue(),
+			"Message": Equal("Rollout is halted because 1 of 1 Shoots updated to the version are unhealthy (more than 10%): garden-bar/shoot."),
+		})))
+
+		fakeClock.Step(48 * time.Hour)
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1")).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Wave":   Equal("canary"),
+			"Halted": BeTrue(),
+		})))
+
+		shoot.Status.Conditions = nil
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		rollout := rolloutOf(reconcileAndGetStatus(), "1.33.1")
+		Expect(rollout.Halted).To(BeFalse())
+		Expect(rollout.Wave).To(Equal("canary"))
+		Expect(rollout.LastTransitionTime.Time).To(BeTemporally("==", fakeClock.Now()))
+
+		fakeClock.Step(24 * time.Hour)
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1").Wave).To(Equal("early"))
+	})
+
+	It("should not halt the rollout because of hibernated shoots", func() {
+		shoot := createShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation, "1.33.1", false)
+		shoot.Status.IsHibernated = true
+		markUpdated(shoot, "1.33.0", "1.33.1", fakeClock.Now())
+
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1").Halted).To(BeFalse())
+	})
+
+	It("should not halt the rollout because of unhealthy shoots which were not updated to the version by the maintenance", func() {
+		createShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation, "1.33.1", false)
+
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1").Halted).To(BeFalse())
+	})
+
+	It("should not halt the rollout because of unhealthy shoots which were updated before the soak duration", func() {
+		shoot := createShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation, "1.33.1", false)
+		markUpdated(shoot, "1.33.0", "1.33.1", fakeClock.Now().Add(-25*time.Hour))
+
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1").Halted).To(BeFalse())
+	})
+
+	It("should not halt the rollout because of unhealthy shoots whose maintenance was skipped", func() {
+		shoot := createShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation, "1.33.1", false)
+		markUpdated(shoot, "1.33.0", "1.33.1", fakeClock.Now())
+		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStatePending
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1").Halted).To(BeFalse())
+	})
+
+	It("should only halt the rollout if more than the configured percentage of updated shoots is unhealthy", func() {
+		for i := range 10 {
+			shoot := createShoot(fmt.Sprintf("shoot-%d", i), gardencorev1beta1.ShootPurposeEvaluation, "1.33.1", i != 0)
+			markUpdated(shoot, "1.33.0", "1.33.1", fakeClock.Now())
+		}
+
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1").Halted).To(BeFalse())
+
+		shoot := createShoot("shoot-10", gardencorev1beta1.ShootPurposeEvaluation, "1.33.1", false)
+		markUpdated(shoot, "1.33.0", "1.33.1", fakeClock.Now())
+
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1")).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Halted":  BeTrue(),
+			"Message": HavePrefix("Rollout is halted because 2 of 11 Shoots updated to the version are unhealthy (more than 10%)"),
+		})))
+	})
+
+	It("should halt the rollout of machine image versions updated in the maintenance of worker pools", func() {
+		cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, gardencorev1beta1.MachineImageVersion{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.0"}})
+		Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+
+		shoot := createShoot("shoot", gardencorev1beta1.ShootPurposeEvaluation, "1.33.1", false)
+		shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1.1.0")
+		shoot.Status.WorkerPoolsLastMaintenance = []gardencorev1beta1.WorkerPoolLastMaintenance{{
+			Name: "worker",
+			LastMaintenance: gardencorev1beta1.LastMaintenance{
+				Description:   `All maintenance operations successful. Worker pool "worker": Updated machine image "image" from "1.0.0" to "1.1.0". Reason: Automatic update of the machine image version is configured`,
+				TriggeredTime: metav1.NewTime(fakeClock.Now()),
+				State:         gardencorev1beta1.LastOperationStateSucceeded,
+			},
+		}}
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		status := reconcileAndGetStatus()
+		Expect(rolloutOf(status, "1.33.1").Halted).To(BeFalse())
+		Expect(status.MachineImages[0].Versions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Version": Equal("1.1.0"),
+			"Rollout": PointTo(HaveField("Halted", BeTrue())),
+		})))
+	})
+
+	It("should consider the wave assignment by project labels", func() {
+		shoot := createShoot("shoot", gardencorev1beta1.ShootPurposeProduction, "1.33.1", true)
+		Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+		shoot.ResourceVersion = ""
+		shoot.Namespace = "garden-foo"
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1").Wave).To(Equal("early"))
+	})
+
+	It("should consider shoots using a NamespacedCloudProfile with the CloudProfile as parent", func() {
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.NamespacedCloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: "garden-bar"},
+			Spec:       gardencorev1beta1.NamespacedCloudProfileSpec{Parent: gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "profile"}},
+		})).To(Succeed())
+
+		shoot := createShoot("shoot", gardencorev1beta1.ShootPurposeProduction, "1.33.1", false)
+		shoot.Spec.CloudProfileName = nil
+		shoot.Spec.CloudProfile = &gardencorev1beta1.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "custom"}
+		markUpdated(shoot, "1.33.0", "1.33.1", fakeClock.Now())
+
+		Expect(rolloutOf(reconcileAndGetStatus(), "1.33.1")).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Wave":   Equal("rest"),
+			"Halted": BeTrue(),
+		})))
+	})
+
+	It("should drop the rollout state of versions removed from the CloudProfile", func() {
+		reconcileAndGetStatus()
+
+		cloudProfile.Spec.Kubernetes.Versions = cloudProfile.Spec.Kubernetes.Versions[:1]
+		Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+
+		Expect(reconcileAndGetStatus().Kubernetes.Versions).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{"Version": Equal("1.33.0")}),
+		))
+	})
+})
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Namespace is the metric namespace for the gardener-controller-manager.
+const Namespace = "gardener_controller_manager"
+
+var (
+	// Factory is used for registering metrics in the controller-runtime metrics registry.
+	factory = promauto.With(runtimemetrics.Registry)
+
+	// ShootNodes defines the gauge shoot_nodes.
+	ShootNodes = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "shoot_nodes",
+			Help:      "Number of nodes of the worker pools of shoots.",
+		},
+		[]string{
+			"project",
+			"shoot",
+			"worker_pool",
+		},
+	)
+
+	// ShootWorkerPoolCapacityNodes defines the gauge shoot_worker_pool_capacity_nodes.
+	ShootWorkerPoolCapacityNodes = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "shoot_worker_pool_capacity_nodes",
+			Help:      "Minimum and maximum number of nodes of the worker pools of shoots.",
+		},
+		[]string{
+			"project",
+			"shoot",
+			"worker_pool",
+			"bound",
+		},
+	)
+
+	// ShootResources defines the gauge shoot_resources.
+	ShootResources = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "shoot_resources",
+			Help:      "Resources consumed by the workers and the control planes of shoots. Memory is measured in bytes.",
+		},
+		[]string{
+			"project",
+			"shoot",
+			"component",
+			"resource",
+		},
+	)
+
+	// ShootEstimatedHourlyCost defines the gauge shoot_estimated_hourly_cost.
+	ShootEstimatedHourlyCost = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "shoot_estimated_hourly_cost",
+			Help:      "Estimated hourly cost of the workers of shoots based on the prices of the machine types in the CloudProfile.",
+		},
+		[]string{
+			"project",
+			"shoot",
+		},
+	)
+
+	// ProjectConsumption defines the gauge project_consumption_resource_hours.
+	ProjectConsumption = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "project_consumption_resource_hours",
+			Help:      "Resources consumed by the shoots of projects in the current accounting period. Memory is measured in byte-hours.",
+		},
+		[]string{
+			"project",
+			"component",
+			"resource",
+		},
+	)
+
+	// ProjectEstimatedCost defines the gauge project_estimated_cost.
+	ProjectEstimatedCost = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "project_estimated_cost",
+			Help:      "Estimated cost of the workers of the shoots of projects in the current accounting period.",
+		},
+		[]string{
+			"project",
+		},
+	)
+
+	// ShootUsageComputationFailures defines the counter shoot_usage_computation_failures_total.
+	ShootUsageComputationFailures = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "shoot_usage_computation_failures_total",
+			Help:      "Number of failed computations of the usage of shoots, e.g., because their machine types are not found in the CloudProfile.",
+		},
+		[]string{
+			"project",
+			"shoot",
+		},
+	)
+)
@@ -6,12 +6,16 @@ package reconciler
 
 import (
 	"context"
+	"reflect"
+	"strings"
 
+	"go.opentelemetry.io/otel/trace"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 type operationAnnotationWrapper struct {
@@ -23,6 +27,11 @@ type operationAnnotationWrapper struct {
 
 // OperationAnnotationWrapper is a wrapper for an reconciler that
 // removes the Gardener operation annotation before `Reconcile` is called.
+// If the object carries a span context in its annotations (see tracing.InjectIntoAnnotations) and an operation
+// annotation, the reconciliation is recorded as a child span, i.e., it continues the trace of the operation which
+// requested the reconciliation. The span context is removed together with the operation annotation, so that later
+// reconciliations don't continue a stale trace. It is ignored if the reconciliation is already recorded as a span, e.g.,
+// by tracing.Reconciler.
 //
 // This is useful in conjunction with the HasOperationAnnotation predicate.
 func OperationAnnotationWrapper(mgr manager.Manager, newObjFunc func() client.Object, reconciler reconcile.Reconciler) reconcile.Reconciler {
@@ -44,14 +53,29 @@ func (o *operationAnnotationWrapper) Reconcile(ctx context.Context, request reco
 		return reconcile.Result{}, nil
 	}
 
+	var span trace.Span
+	if annotations[v1beta1constants.GardenerOperation] != "" && !trace.SpanContextFromContext(ctx).IsValid() {
+		if parentCtx := tracing.ContextFromAnnotations(ctx, obj); trace.SpanContextFromContext(parentCtx).IsValid() {
+			kind := reflect.TypeOf(obj).Elem().Name()
+			ctx, span = tracing.Tracer().Start(parentCtx, kind+" reconcile",
+				trace.WithAttributes(tracing.ObjectAttributes(strings.ToLower(kind), request.Namespace, request.Name)...))
+			defer span.End()
+		}
+	}
+
 	if annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationReconcile {
 		withOpAnnotation := obj.DeepCopyObject().(client.Object)
 		delete(annotations, v1beta1constants.GardenerOperation)
 		obj.SetAnnotations(annotations)
+		tracing.RemoveFromAnnotations(obj)
 		if err := o.client.Patch(ctx, obj, client.MergeFrom(withOpAnnotation)); err != nil {
 			return reconcile.Result{}, err
 		}
 	}
 
-	return o.Reconciler.Reconcile(ctx, request)
+	result, err := o.Reconciler.Reconcile(ctx, request)
+	if span != nil {
+		tracing.RecordError(span, err)
+	}
+	return result, err
 }
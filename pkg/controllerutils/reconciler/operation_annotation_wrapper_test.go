@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconciler_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gardener/gardener/pkg/utils/test"
+	"github.com/gardener/gardener/pkg/utils/tracing"
+)
+
+var _ = Describe("OperationAnnotationWrapper", func() {
+	var (
+		ctx          = context.Background()
+		fakeClient   client.Client
+		spanRecorder *tracetest.SpanRecorder
+
+		obj               *corev1.ConfigMap
+		parentSpanContext trace.SpanContext
+		innerSpanContext  trace.SpanContext
+		r                 reconcile.Reconciler
+	)
+
+	BeforeEach(func() {
+		oldTracerProvider := otel.GetTracerProvider()
+		DeferCleanup(func() { otel.SetTracerProvider(oldTracerProvider) })
+
+		spanRecorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+
+		obj = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar", Annotations: map[string]string{"gardener.cloud/operation": "reconcile"}}}
+		spanCtx, span := tracing.Tracer().Start(ctx, "deploy")
+		tracing.InjectIntoAnnotations(spanCtx, obj)
+		span.End()
+		parentSpanContext = span.SpanContext()
+
+		innerSpanContext = trace.SpanContext{}
+		r = OperationAnnotationWrapper(
+			test.FakeManager{Client: fakeClient},
+			func() client.Object { return &corev1.ConfigMap{} },
+			reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				innerSpanContext = trace.SpanContextFromContext(ctx)
+				return reconcile.Result{}, nil
+			}),
+		)
+	})
+
+	It("should continue the trace and remove the span context together with the operation annotation", func() {
+		Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+
+		Expect(r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)})).To(Equal(reconcile.Result{}))
+
+		Expect(innerSpanContext.TraceID()).To(Equal(parentSpanContext.TraceID()))
+		Expect(spanRecorder.Ended()).To(HaveLen(2))
+		Expect(spanRecorder.Ended()[1].Name()).To(Equal("ConfigMap reconcile"))
+		Expect(spanRecorder.Ended()[1].Parent().SpanID()).To(Equal(parentSpanContext.SpanID()))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+		Expect(obj.Annotations).To(BeEmpty())
+	})
+
+	It("should ignore a stale span context if no operation was requested", func() {
+		delete(obj.Annotations, "gardener.cloud/operation")
+		Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+
+		Expect(r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)})).To(Equal(reconcile.Result{}))
+
+		Expect(innerSpanContext.IsValid()).To(BeFalse())
+		Expect(spanRecorder.Ended()).To(HaveLen(1))
+	})
+
+	It("should not start another span if the reconciliation is already recorded as a span", func() {
+		Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+
+		Expect(tracing.Reconciler("test", "configmap", r).Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)})).To(Equal(reconcile.Result{}))
+
+		Expect(spanRecorder.Ended()).To(HaveLen(2))
+		Expect(spanRecorder.Ended()[1].Name()).To(Equal("test reconcile"))
+		Expect(innerSpanContext).To(Equal(spanRecorder.Ended()[1].SpanContext()))
+	})
+})
@@ -112,6 +112,13 @@ const (
 	// owner: @rrhubenov
 	// alpha: v1.140.0
 	RemoveVali featuregate.Feature = "RemoveVali"
+
+	// ResumableShootFlows enables checkpointing of the shoot reconcile flow. When the flow is retried with unchanged
+	// inputs, e.g., after a gardenlet restart, resumable tasks which already succeeded in the previous execution are
+	// skipped.
+	// owner: @gardener/gardener-maintainers
+	// alpha: v1.140.0
+	ResumableShootFlows featuregate.Feature = "ResumableShootFlows"
 )
 
 // DefaultFeatureGate is the central feature gate map used by all gardener components.
@@ -154,6 +161,7 @@ var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	PrometheusHealthChecks:         {Default: false, PreRelease: featuregate.Alpha},
 	VersionClassificationLifecycle: {Default: false, PreRelease: featuregate.Alpha},
 	RemoveVali:                     {Default: false, PreRelease: featuregate.Alpha},
+	ResumableShootFlows:            {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GetFeatures returns a feature gate map with the respective specifications. Non-existing feature gates are ignored.
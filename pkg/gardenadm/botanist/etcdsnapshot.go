@@ -0,0 +1,441 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/imagevector"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	bootstrapetcd "github.com/gardener/gardener/pkg/component/etcd/bootstrap"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+const (
+	// etcdRestoreDir is the directory in which the static pod manifests are stashed while etcd is restored.
+	etcdRestoreDir = GardenadmBaseDir + "/etcd-restore"
+
+	restoreContainerName           = "restore"
+	restoreVolumeNameData          = "restore-data"
+	restoreVolumeNameSnapshot      = "restore-snapshot"
+	restoreVolumeMountPathData     = "/var/etcd/restore/data"
+	restoreVolumeMountPathSnapshot = "/var/etcd/restore/snapshot.db"
+	// dataDirName is the name of the data directory of etcd in the directory of its volume claim template.
+	dataDirName = "new.etcd"
+)
+
+// EtcdRoles are the roles of the etcds of a self-hosted shoot cluster.
+var EtcdRoles = []string{v1beta1constants.ETCDRoleMain, v1beta1constants.ETCDRoleEvents}
+
+var (
+	// NewEtcdClient creates a new client for the etcd running on this machine with the given endpoint and TLS config.
+	// Exposed for testing.
+	NewEtcdClient = func(endpoint string, tlsConfig *tls.Config) (EtcdClient, error) {
+		return clientv3.New(clientv3.Config{
+			Endpoints:   []string{endpoint},
+			TLS:         tlsConfig,
+			DialTimeout: 5 * time.Second,
+		})
+	}
+	// EtcdRestoreInterval is the interval at which the state of etcd is checked during the restoration.
+	// Exposed for testing.
+	EtcdRestoreInterval = 2 * time.Second
+	// EtcdRestoreTimeout is the timeout for etcd to stop or to become ready again during the restoration.
+	// Exposed for testing.
+	EtcdRestoreTimeout = 5 * time.Minute
+)
+
+// EtcdClient is the subset of the etcd client used for taking and restoring snapshots.
+type EtcdClient interface {
+	SnapshotWithVersion(ctx context.Context) (*clientv3.SnapshotResponse, error)
+	MemberList(ctx context.Context, opts ...clientv3.OpOption) (*clientv3.MemberListResponse, error)
+	Status(ctx context.Context, endpoint string) (*clientv3.StatusResponse, error)
+	Close() error
+}
+
+// EtcdSnapshotFileName returns the file name of the snapshot of the etcd with the given role.
+func EtcdSnapshotFileName(role string) string {
+	return etcd.Name(role) + ".db"
+}
+
+func etcdClientEndpoint(role string) string {
+	port := etcdconstants.PortEtcdClient
+	if role == v1beta1constants.ETCDRoleEvents {
+		port = etcdconstants.StaticPodPortEtcdEventsClient
+	}
+	return fmt.Sprintf("https://localhost:%d", port)
+}
+
+func etcdPeerURL(role string) string {
+	port := 2380
+	if role == v1beta1constants.ETCDRoleEvents {
+		port = 2383
+	}
+	return fmt.Sprintf("https://localhost:%d", port)
+}
+
+// newEtcdClient creates a client for the etcd with the given role. It uses the client certificate of kube-apiserver
+// which is written to the host by gardener-node-agent for the kube-apiserver static pod.
+func (b *GardenadmBotanist) newEtcdClient(role string) (EtcdClient, string, error) {
+	var (
+		// The names of the volumes are defined in the `pkg/component/apiserver` package.
+		caDir     = staticpod.HostPath(v1beta1constants.DeploymentNameKubeAPIServer, "ca-etcd")
+		clientDir = staticpod.HostPath(v1beta1constants.DeploymentNameKubeAPIServer, "etcd-client")
+	)
+
+	caBundle, err := b.FS.ReadFile(filepath.Join(caDir, secretsutils.DataKeyCertificateBundle))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed reading etcd CA bundle (is this a control plane node?): %w", err)
+	}
+	certificate, err := b.FS.ReadFile(filepath.Join(clientDir, secretsutils.DataKeyCertificate))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed reading etcd client certificate: %w", err)
+	}
+	privateKey, err := b.FS.ReadFile(filepath.Join(clientDir, secretsutils.DataKeyPrivateKey))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed reading etcd client private key: %w", err)
+	}
+
+	keyPair, err := tls.X509KeyPair(certificate, privateKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed parsing etcd client certificate: %w", err)
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caBundle) {
+		return nil, "", fmt.Errorf("failed parsing etcd CA bundle")
+	}
+
+	endpoint := etcdClientEndpoint(role)
+	client, err := NewEtcdClient(endpoint, &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		RootCAs:      rootCAs,
+		MinVersion:   tls.VersionTLS12,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed creating client for etcd %s: %w", etcd.Name(role), err)
+	}
+
+	return client, endpoint, nil
+}
+
+// SnapshotEtcd takes a snapshot of the etcd with the given role and writes it to the given writer. It returns the
+// version of etcd which created the snapshot.
+func (b *GardenadmBotanist) SnapshotEtcd(ctx context.Context, role string, w io.Writer) (string, error) {
+	client, _, err := b.newEtcdClient(role)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = client.Close() }()
+
+	resp, err := client.SnapshotWithVersion(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed taking snapshot of etcd %s: %w", etcd.Name(role), err)
+	}
+	defer func() { _ = resp.Snapshot.Close() }()
+
+	if _, err := io.Copy(w, resp.Snapshot); err != nil {
+		return "", fmt.Errorf("failed writing snapshot of etcd %s: %w", etcd.Name(role), err)
+	}
+
+	return resp.Version, nil
+}
+
+// RestoreEtcd restores the etcds with the given roles from the snapshot files with the given paths. It stops
+// kube-apiserver and the etcds by removing their static pod manifests. Then, it moves the current data directories
+// aside and restores the snapshots into the empty data directories with an init container in the etcd static pods. Finally, it puts the original
+// static pod manifests back in place. gardener-node-agent is stopped during the restoration so that it does not
+// interfere with the static pod manifests.
+// Only etcds with a single member are supported.
+func (b *GardenadmBotanist) RestoreEtcd(ctx context.Context, snapshotPaths map[string]string) error {
+	for role := range snapshotPaths {
+		if err := b.checkSingleMemberEtcd(ctx, role); err != nil {
+			return err
+		}
+	}
+
+	b.Logger.Info("Stopping unit for restoring etcd", "unit", nodeagentconfigv1alpha1.UnitName)
+	if err := b.DBus.Stop(ctx, nil, nil, nodeagentconfigv1alpha1.UnitName); err != nil {
+		return fmt.Errorf("failed stopping unit %s: %w", nodeagentconfigv1alpha1.UnitName, err)
+	}
+
+	b.Logger.Info("Stopping kube-apiserver")
+	if err := b.stashStaticPodManifest(v1beta1constants.DeploymentNameKubeAPIServer); err != nil {
+		return err
+	}
+
+	for _, role := range EtcdRoles {
+		snapshotPath, ok := snapshotPaths[role]
+		if !ok {
+			continue
+		}
+
+		if err := b.restoreEtcd(ctx, role, snapshotPath); err != nil {
+			return fmt.Errorf("failed restoring etcd %s: %w", etcd.Name(role), err)
+		}
+	}
+
+	b.Logger.Info("Starting kube-apiserver")
+	if err := b.unstashStaticPodManifest(v1beta1constants.DeploymentNameKubeAPIServer); err != nil {
+		return err
+	}
+
+	b.Logger.Info("Starting unit again", "unit", nodeagentconfigv1alpha1.UnitName)
+	if err := b.DBus.Start(ctx, nil, nil, nodeagentconfigv1alpha1.UnitName); err != nil {
+		return fmt.Errorf("failed starting unit %s: %w", nodeagentconfigv1alpha1.UnitName, err)
+	}
+
+	return b.FS.RemoveAll(etcdRestoreDir)
+}
+
+func (b *GardenadmBotanist) checkSingleMemberEtcd(ctx context.Context, role string) error {
+	// The etcd might already be stopped by a previous attempt to restore it, hence we skip the check in this case.
+	if exists, err := b.FS.Exists(filepath.Join(etcdRestoreDir, b.etcdPodName(role)+".yaml")); err != nil || exists {
+		return err
+	}
+
+	client, _, err := b.newEtcdClient(role)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	members, err := client.MemberList(ctx)
+	if err != nil {
+		return fmt.Errorf("failed listing members of etcd %s: %w", etcd.Name(role), err)
+	}
+	if len(members.Members) > 1 {
+		return fmt.Errorf("etcd %s has %d members, restoring etcd clusters with multiple members is not supported", etcd.Name(role), len(members.Members))
+	}
+
+	return nil
+}
+
+func (b *GardenadmBotanist) restoreEtcd(ctx context.Context, role, snapshotPath string) error {
+	podName := b.etcdPodName(role)
+	log := b.Logger.WithValues("pod", podName)
+
+	log.Info("Stopping etcd")
+	if err := b.stashStaticPodManifest(podName); err != nil {
+		return err
+	}
+	// A previous attempt might have failed while etcd was started for the restoration. As the original static pod
+	// manifest was stashed already, the manifest for restoring etcd has to be removed explicitly to stop etcd.
+	manifestPath := filepath.Join(kubelet.FilePathKubernetesManifests, podName+".yaml")
+	if err := b.FS.Remove(manifestPath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("failed removing static pod manifest %s of previous restoration: %w", manifestPath, err)
+	}
+	if err := b.waitForEtcd(ctx, role, false); err != nil {
+		return err
+	}
+
+	// etcdutl only restores into an empty data directory, hence the current one is moved aside. This also covers data
+	// directories restored by a previous attempt.
+	dataDir := filepath.Join(staticpod.StatefulSetVolumeClaimTemplateHostPath(etcd.Name(role)), dataDirName)
+	if exists, err := b.FS.DirExists(dataDir); err != nil {
+		return err
+	} else if exists {
+		backupDir := dataDir + ".bak-" + b.Clock.Now().UTC().Format("20060102150405")
+		log.Info("Moving current data directory aside", "path", backupDir)
+		if err := b.FS.Rename(dataDir, backupDir); err != nil {
+			return fmt.Errorf("failed moving data directory %s to %s: %w", dataDir, backupDir, err)
+		}
+	}
+
+	log.Info("Restoring etcd from snapshot", "snapshot", snapshotPath)
+	manifest, err := b.FS.ReadFile(filepath.Join(etcdRestoreDir, podName+".yaml"))
+	if err != nil {
+		return fmt.Errorf("failed reading static pod manifest of %s: %w", podName, err)
+	}
+	restoreManifest, err := etcdRestoreManifest(manifest, role, snapshotPath)
+	if err != nil {
+		return err
+	}
+	if err := b.FS.WriteFile(manifestPath, restoreManifest, 0640); err != nil {
+		return fmt.Errorf("failed writing static pod manifest for restoring %s: %w", podName, err)
+	}
+	if err := b.waitForEtcd(ctx, role, true); err != nil {
+		return err
+	}
+
+	log.Info("Restarting etcd with original static pod manifest")
+	return b.unstashStaticPodManifest(podName)
+}
+
+// etcdPodName returns the name of the static pod of the etcd with the given role. Depending on whether `gardenadm init`
+// has already transitioned to the etcds managed by etcd-druid, this is either the bootstrap etcd or the etcd managed
+// by etcd-druid.
+func (b *GardenadmBotanist) etcdPodName(role string) string {
+	for _, name := range []string{etcd.Name(role), bootstrapetcd.Name(role)} {
+		for _, dir := range []string{kubelet.FilePathKubernetesManifests, etcdRestoreDir} {
+			if exists, err := b.FS.Exists(filepath.Join(dir, name+".yaml")); err == nil && exists {
+				return name
+			}
+		}
+	}
+	return bootstrapetcd.Name(role)
+}
+
+// stashStaticPodManifest moves the static pod manifest with the given name out of the manifests directory which
+// causes kubelet to stop the pod. It does nothing if it was already stashed by a previous attempt.
+func (b *GardenadmBotanist) stashStaticPodManifest(name string) error {
+	var (
+		source = filepath.Join(kubelet.FilePathKubernetesManifests, name+".yaml")
+		target = filepath.Join(etcdRestoreDir, name+".yaml")
+	)
+
+	if exists, err := b.FS.Exists(target); err != nil || exists {
+		return err
+	}
+
+	if err := b.FS.MkdirAll(etcdRestoreDir, 0700); err != nil {
+		return fmt.Errorf("failed creating directory %s: %w", etcdRestoreDir, err)
+	}
+	if err := b.FS.Rename(source, target); err != nil {
+		return fmt.Errorf("failed moving static pod manifest %s to %s: %w", source, target, err)
+	}
+
+	return nil
+}
+
+// unstashStaticPodManifest moves the stashed static pod manifest with the given name back to the manifests directory.
+func (b *GardenadmBotanist) unstashStaticPodManifest(name string) error {
+	var (
+		source = filepath.Join(etcdRestoreDir, name+".yaml")
+		target = filepath.Join(kubelet.FilePathKubernetesManifests, name+".yaml")
+	)
+
+	if err := b.FS.Rename(source, target); err != nil {
+		return fmt.Errorf("failed moving static pod manifest %s to %s: %w", source, target, err)
+	}
+
+	return nil
+}
+
+// waitForEtcd waits until the etcd with the given role is ready or until it is no longer reachable.
+func (b *GardenadmBotanist) waitForEtcd(ctx context.Context, role string, ready bool) error {
+	client, endpoint, err := b.newEtcdClient(role)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, EtcdRestoreTimeout)
+	defer cancel()
+
+	return retry.Until(timeoutCtx, EtcdRestoreInterval, func(ctx context.Context) (done bool, err error) {
+		statusCtx, cancel := context.WithTimeout(ctx, EtcdRestoreInterval)
+		defer cancel()
+
+		_, err = client.Status(statusCtx, endpoint)
+		switch {
+		case ready && err != nil:
+			return retry.MinorError(fmt.Errorf("etcd %s is not ready yet: %w", etcd.Name(role), err))
+		case !ready && err == nil:
+			return retry.MinorError(fmt.Errorf("etcd %s is still running", etcd.Name(role)))
+		}
+
+		return retry.Ok()
+	})
+}
+
+// etcdRestoreManifest adds an init container to the given static pod manifest of etcd which restores the data directory
+// from the snapshot before etcd is started. `etcdutl snapshot restore` fails if the data directory is not empty, hence
+// the caller has to move the current data directory aside before. If the init container fails after writing to the
+// data directory, it does not succeed on subsequent restarts, but a retry of the restoration moves the data directory
+// aside again.
+func etcdRestoreManifest(manifest []byte, role, snapshotPath string) ([]byte, error) {
+	pod := &corev1.Pod{}
+	if err := runtime.DecodeInto(kubernetes.SeedCodec.UniversalDeserializer(), manifest, pod); err != nil {
+		return nil, fmt.Errorf("failed decoding static pod manifest of etcd %s: %w", etcd.Name(role), err)
+	}
+
+	// The image of the bootstrap etcd contains etcdutl, while the image of the etcd managed by etcd-druid might not.
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameEtcd)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching image %s: %w", imagevector.ContainerImageNameEtcd, err)
+	}
+
+	var (
+		name    = pod.Name
+		peerURL = etcdPeerURL(role)
+	)
+	for _, container := range pod.Spec.Containers {
+		for _, arg := range append(container.Command, container.Args...) {
+			if v, ok := strings.CutPrefix(arg, "--name="); ok {
+				name = v
+			}
+			if v, ok := strings.CutPrefix(arg, "--initial-advertise-peer-urls="); ok {
+				peerURL = v
+			}
+		}
+	}
+
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers,
+		corev1.Container{
+			Name:            restoreContainerName,
+			Image:           image.String(),
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			Command: []string{
+				"etcdutl",
+				"snapshot",
+				"restore",
+				restoreVolumeMountPathSnapshot,
+				"--data-dir=" + restoreVolumeMountPathData + "/" + dataDirName,
+				"--name=" + name,
+				"--initial-cluster=" + name + "=" + peerURL,
+				"--initial-advertise-peer-urls=" + peerURL,
+			},
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: restoreVolumeNameData, MountPath: restoreVolumeMountPathData},
+				{Name: restoreVolumeNameSnapshot, MountPath: restoreVolumeMountPathSnapshot, ReadOnly: true},
+			},
+		},
+	)
+	pod.Spec.Volumes = append(pod.Spec.Volumes,
+		corev1.Volume{
+			Name: restoreVolumeNameData,
+			VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{
+				Path: staticpod.StatefulSetVolumeClaimTemplateHostPath(etcd.Name(role)),
+				Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+			}},
+		},
+		corev1.Volume{
+			Name: restoreVolumeNameSnapshot,
+			VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{
+				Path: snapshotPath,
+				Type: ptr.To(corev1.HostPathFile),
+			}},
+		},
+	)
+
+	restoreManifest, err := kubernetesutils.Serialize(pod, kubernetes.SeedScheme)
+	if err != nil {
+		return nil, fmt.Errorf("failed serializing static pod manifest of etcd %s: %w", etcd.Name(role), err)
+	}
+
+	return []byte(restoreManifest), nil
+}
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("EtcdSnapshot", func() {
+	var (
+		ctx      context.Context
+		fs       afero.Afero
+		fakeDBus *fakedbus.DBus
+		clients  map[string]*fakeEtcdClient
+
+		b *GardenadmBotanist
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeDBus = fakedbus.New()
+
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{
+				Logger: logr.Discard(),
+				Clock:  testclock.NewFakeClock(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)),
+			}},
+			DBus: fakeDBus,
+			FS:   fs,
+		}
+
+		writeEtcdClientCertificates(fs)
+
+		clients = map[string]*fakeEtcdClient{
+			"https://localhost:2379": {fs: fs, podName: "etcd-bootstrap-main", snapshot: "main-data", members: 1},
+			"https://localhost:2382": {fs: fs, podName: "etcd-bootstrap-events", snapshot: "events-data", members: 1},
+		}
+		DeferCleanup(test.WithVars(
+			&NewEtcdClient, func(endpoint string, _ *tls.Config) (EtcdClient, error) {
+				return clients[endpoint], nil
+			},
+			&EtcdRestoreInterval, time.Millisecond,
+			&EtcdRestoreTimeout, time.Second,
+		))
+	})
+
+	Describe("#SnapshotEtcd", func() {
+		It("should write the snapshot of the etcd with the given role", func() {
+			buf := &bytes.Buffer{}
+
+			version, err := b.SnapshotEtcd(ctx, "events", buf)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal("3.5.0"))
+			Expect(buf.String()).To(Equal("events-data"))
+		})
+
+		It("should fail if the etcd client certificate does not exist", func() {
+			Expect(fs.Remove("/var/lib/static-pods/kube-apiserver/etcd-client/tls.crt")).To(Succeed())
+
+			_, err := b.SnapshotEtcd(ctx, "main", &bytes.Buffer{})
+			Expect(err).To(MatchError(ContainSubstring("failed reading etcd client certificate")))
+		})
+	})
+
+	Describe("#RestoreEtcd", func() {
+		const originalManifest = `apiVersion: v1
+kind: Pod
+metadata:
+  name: etcd-bootstrap-main
+  namespace: kube-system
+spec:
+  containers:
+  - name: etcd
+    image: etcd
+    command:
+    - etcd
+    - --name=etcd-bootstrap-main
+    - --initial-advertise-peer-urls=https://localhost:2380
+`
+
+		BeforeEach(func() {
+			Expect(fs.WriteFile("/etc/kubernetes/manifests/etcd-bootstrap-main.yaml", []byte(originalManifest), 0640)).To(Succeed())
+			Expect(fs.WriteFile("/etc/kubernetes/manifests/kube-apiserver.yaml", []byte("kube-apiserver"), 0640)).To(Succeed())
+			Expect(fs.WriteFile("/var/lib/etcd-main/data/new.etcd/member/snap/db", []byte("old"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/tmp/etcd-main.db", []byte("main-data"), 0600)).To(Succeed())
+		})
+
+		It("should restore the etcd from the snapshot", func() {
+			Expect(b.RestoreEtcd(ctx, map[string]string{"main": "/tmp/etcd-main.db"})).To(Succeed())
+
+			Expect(clients["https://localhost:2379"].restoreManifest).To(And(
+				ContainSubstring("name: restore"),
+				ContainSubstring("- etcdutl"),
+				ContainSubstring("- /var/etcd/restore/snapshot.db"),
+				ContainSubstring("- --data-dir=/var/etcd/restore/data/new.etcd"),
+				ContainSubstring("- --name=etcd-bootstrap-main"),
+				ContainSubstring("- --initial-cluster=etcd-bootstrap-main=https://localhost:2380"),
+				ContainSubstring("path: /tmp/etcd-main.db"),
+				ContainSubstring("path: /var/lib/etcd-main/data\n"),
+				Not(ContainSubstring("/bin/sh")),
+			))
+
+			Expect(fs.ReadFile("/etc/kubernetes/manifests/etcd-bootstrap-main.yaml")).To(BeEquivalentTo(originalManifest))
+			Expect(fs.ReadFile("/etc/kubernetes/manifests/kube-apiserver.yaml")).To(BeEquivalentTo("kube-apiserver"))
+			Expect(fs.ReadFile("/var/lib/etcd-main/data/new.etcd.bak-20250102030405/member/snap/db")).To(BeEquivalentTo("old"))
+			Expect(fs.DirExists("/var/lib/gardenadm/etcd-restore")).To(BeFalse())
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionStop, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionStart, UnitNames: []string{"gardener-node-agent.service"}},
+			}))
+		})
+
+		It("should continue a previous attempt which stopped etcd already", func() {
+			Expect(fs.MkdirAll("/var/lib/gardenadm/etcd-restore", 0700)).To(Succeed())
+			Expect(fs.Rename("/etc/kubernetes/manifests/etcd-bootstrap-main.yaml", "/var/lib/gardenadm/etcd-restore/etcd-bootstrap-main.yaml")).To(Succeed())
+			Expect(fs.Rename("/etc/kubernetes/manifests/kube-apiserver.yaml", "/var/lib/gardenadm/etcd-restore/kube-apiserver.yaml")).To(Succeed())
+
+			Expect(b.RestoreEtcd(ctx, map[string]string{"main": "/tmp/etcd-main.db"})).To(Succeed())
+
+			Expect(fs.ReadFile("/etc/kubernetes/manifests/etcd-bootstrap-main.yaml")).To(BeEquivalentTo(originalManifest))
+			Expect(fs.ReadFile("/etc/kubernetes/manifests/kube-apiserver.yaml")).To(BeEquivalentTo("kube-apiserver"))
+		})
+
+		It("should stop etcd started for the restoration by a previous attempt", func() {
+			Expect(fs.MkdirAll("/var/lib/gardenadm/etcd-restore", 0700)).To(Succeed())
+			Expect(fs.Rename("/etc/kubernetes/manifests/etcd-bootstrap-main.yaml", "/var/lib/gardenadm/etcd-restore/etcd-bootstrap-main.yaml")).To(Succeed())
+			Expect(fs.WriteFile("/etc/kubernetes/manifests/etcd-bootstrap-main.yaml", []byte("etcdutl"), 0640)).To(Succeed())
+			Expect(fs.WriteFile("/var/lib/etcd-main/data/new.etcd/member/snap/db", []byte("restored"), 0600)).To(Succeed())
+
+			Expect(b.RestoreEtcd(ctx, map[string]string{"main": "/tmp/etcd-main.db"})).To(Succeed())
+
+			Expect(clients["https://localhost:2379"].restoreManifest).To(ContainSubstring("- --data-dir=/var/etcd/restore/data/new.etcd"))
+			Expect(fs.ReadFile("/etc/kubernetes/manifests/etcd-bootstrap-main.yaml")).To(BeEquivalentTo(originalManifest))
+			Expect(fs.ReadFile("/var/lib/etcd-main/data/new.etcd.bak-20250102030405/member/snap/db")).To(BeEquivalentTo("restored"))
+		})
+
+		It("should refuse to restore etcd clusters with multiple members", func() {
+			clients["https://localhost:2379"].members = 3
+
+			Expect(b.RestoreEtcd(ctx, map[string]string{"main": "/tmp/etcd-main.db"})).To(MatchError("etcd etcd-main has 3 members, restoring etcd clusters with multiple members is not supported"))
+
+			Expect(fs.ReadFile("/etc/kubernetes/manifests/etcd-bootstrap-main.yaml")).To(BeEquivalentTo(originalManifest))
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+
+		It("should fail if etcd does not stop", func() {
+			clients["https://localhost:2379"].alwaysReady = true
+
+			Expect(b.RestoreEtcd(ctx, map[string]string{"main": "/tmp/etcd-main.db"})).To(MatchError(ContainSubstring("etcd etcd-main is still running")))
+		})
+	})
+})
+
+func writeEtcdClientCertificates(fs afero.Afero) {
+	ca, err := (&secretsutils.CertificateSecretConfig{Name: "ca-etcd", CommonName: "etcd", CertType: secretsutils.CACert}).GenerateCertificate()
+	Expect(err).NotTo(HaveOccurred())
+	client, err := (&secretsutils.CertificateSecretConfig{Name: "etcd-client", CommonName: "etcd-client", CertType: secretsutils.ClientCert, SigningCA: ca}).GenerateCertificate()
+	Expect(err).NotTo(HaveOccurred())
+
+	Expect(fs.WriteFile("/var/lib/static-pods/kube-apiserver/ca-etcd/bundle.crt", ca.CertificatePEM, 0600)).To(Succeed())
+	Expect(fs.WriteFile("/var/lib/static-pods/kube-apiserver/etcd-client/tls.crt", client.CertificatePEM, 0600)).To(Succeed())
+	Expect(fs.WriteFile("/var/lib/static-pods/kube-apiserver/etcd-client/tls.key", client.PrivateKeyPEM, 0600)).To(Succeed())
+}
+
+// fakeEtcdClient simulates an etcd which runs as long as its static pod manifest exists.
+type fakeEtcdClient struct {
+	fs          afero.Afero
+	podName     string
+	snapshot    string
+	members     int
+	alwaysReady bool
+
+	restoreManifest string
+}
+
+func (f *fakeEtcdClient) SnapshotWithVersion(_ context.Context) (*clientv3.SnapshotResponse, error) {
+	return &clientv3.SnapshotResponse{Snapshot: io.NopCloser(strings.NewReader(f.snapshot)), Version: "3.5.0"}, nil
+}
+
+func (f *fakeEtcdClient) MemberList(_ context.Context, _ ...clientv3.OpOption) (*clientv3.MemberListResponse, error) {
+	resp := &clientv3.MemberListResponse{}
+	for range f.members {
+		resp.Members = append(resp.Members, nil)
+	}
+	return resp, nil
+}
+
+func (f *fakeEtcdClient) Status(_ context.Context, _ string) (*clientv3.StatusResponse, error) {
+	if f.alwaysReady {
+		return &clientv3.StatusResponse{}, nil
+	}
+
+	manifest, err := f.fs.ReadFile("/etc/kubernetes/manifests/" + f.podName + ".yaml")
+	if err != nil {
+		return nil, fmt.Errorf("connection refused")
+	}
+	if strings.Contains(string(manifest), "etcdutl") {
+		f.restoreManifest = string(manifest)
+	}
+	return &clientv3.StatusResponse{}, nil
+}
+
+func (f *fakeEtcdClient) Close() error { return nil }
@@ -0,0 +1,322 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	containerd "github.com/containerd/containerd/v2/client"
+	"github.com/containerd/containerd/v2/core/mount"
+	"github.com/containerd/containerd/v2/pkg/namespaces"
+	"github.com/containerd/errdefs"
+	"github.com/go-logr/logr"
+	"github.com/moby/sys/mountinfo"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	bootstrapetcd "github.com/gardener/gardener/pkg/component/etcd/bootstrap"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	nodeagentcontainerd "github.com/gardener/gardener/pkg/nodeagent/containerd"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	nodeagentdbus "github.com/gardener/gardener/pkg/nodeagent/dbus"
+)
+
+const (
+	etcSystemdSystem = "/etc/systemd/system"
+	// containerdUnitName is the name of the containerd unit. It is typically provided by the operating system, hence it
+	// is only stopped for cleaning up its state but not removed.
+	containerdUnitName = "containerd.service"
+	// containerdStateDir is the directory containing the containers and images of containerd.
+	containerdStateDir = "/var/lib/containerd"
+	// criNamespace is the containerd namespace of the containers managed by the kubelet via CRI.
+	criNamespace = "k8s.io"
+)
+
+var (
+	// RemoveAllContainers stops and removes all containers of the kubelet.
+	// Exposed for testing.
+	RemoveAllContainers = removeAllContainers
+	// UnmountAllBelow unmounts all mounts below and including the given directory.
+	// Exposed for testing.
+	UnmountAllBelow = unmountAllBelow
+)
+
+// ResetActions contains everything `gardenadm reset` removes from a node.
+type ResetActions struct {
+	// Units are the systemd units which are stopped and disabled. gardener-node-agent comes first, so that it does not
+	// restore any of the other units or files while the node is reset.
+	Units []string
+	// RemoveContainers is true if the containers of the kubelet are stopped and removed.
+	RemoveContainers bool
+	// Paths are the files and directories which are removed.
+	Paths []string
+}
+
+// Print writes a human-readable representation of the actions to the given writer.
+func (r *ResetActions) Print(w io.Writer) {
+	fmt.Fprintln(w, "The following systemd units are stopped and disabled:")
+	for _, unit := range r.Units {
+		fmt.Fprintf(w, "  - %s\n", unit)
+	}
+
+	if r.RemoveContainers {
+		fmt.Fprintln(w, "All containers of the kubelet are stopped and removed.")
+	}
+	fmt.Fprintf(w, "All file systems mounted below %s are unmounted.\n", kubelet.PathKubeletDirectory)
+
+	fmt.Fprintln(w, "The following files and directories are removed:")
+	for _, p := range r.Paths {
+		fmt.Fprintf(w, "  - %s\n", p)
+	}
+}
+
+// resetDirectories returns the well-known directories which are populated by `gardenadm init` or `gardenadm join`.
+func resetDirectories() []string {
+	dirs := []string{
+		GardenadmBaseDir,
+		nodeagentconfigv1alpha1.BaseDir,
+		kubelet.PathKubeletDirectory,
+		kubelet.FilePathKubernetesManifests,
+		filepath.Dir(PathKubeconfig),
+		staticpod.HostPath("", ""),
+		containerdStateDir,
+	}
+
+	for _, role := range []string{v1beta1constants.ETCDRoleMain, v1beta1constants.ETCDRoleEvents} {
+		dirs = append(dirs,
+			filepath.Dir(staticpod.StatefulSetVolumeClaimTemplateHostPath(etcd.Name(role))),
+			filepath.Join(string(filepath.Separator), "var", "lib", bootstrapetcd.Name(role)),
+		)
+	}
+
+	return dirs
+}
+
+// ComputeResetActions computes the systemd units and the paths which must be removed for resetting the node. It uses
+// the last OperatingSystemConfig applied by gardener-node-agent to find the units and files it created. Only paths
+// which exist on the node are returned.
+func (b *GardenadmBotanist) ComputeResetActions() (*ResetActions, error) {
+	var (
+		// The kubelet is always stopped before its containers are removed, even if no OperatingSystemConfig was applied.
+		units = []string{nodeagentconfigv1alpha1.UnitName, nodeagentconfigv1alpha1.InitUnitName, kubelet.UnitName}
+		paths = sets.New(resetDirectories()...)
+	)
+
+	for _, unit := range units {
+		paths.Insert(path.Join(etcSystemdSystem, unit))
+	}
+
+	osc, err := b.lastAppliedOperatingSystemConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if osc != nil {
+		for _, file := range operatingsystemconfig.CollectAllFiles(osc, b.HostName) {
+			paths.Insert(file.Path)
+		}
+
+		for _, unit := range append(osc.Spec.Units, osc.Status.ExtensionUnits...) {
+			unitFilePath := path.Join(etcSystemdSystem, unit.Name)
+
+			// Units without content are not created by gardener-node-agent, e.g., containerd.service. Hence, only their
+			// drop-ins are removed.
+			if unit.Content != nil {
+				if !slices.Contains(units, unit.Name) {
+					units = append(units, unit.Name)
+				}
+				paths.Insert(unitFilePath)
+			}
+
+			for _, dropIn := range unit.DropIns {
+				paths.Insert(path.Join(unitFilePath+".d", dropIn.Name))
+			}
+			for _, filePath := range unit.FilePaths {
+				paths.Insert(filePath)
+			}
+		}
+	}
+
+	actions := &ResetActions{Units: units}
+	for _, p := range sets.List(paths) {
+		if exists, err := b.FS.Exists(p); err != nil {
+			return nil, fmt.Errorf("failed checking whether %s exists: %w", p, err)
+		} else if !exists {
+			continue
+		}
+
+		// Paths are sorted, hence a parent directory is always visited before the paths it contains.
+		if slices.ContainsFunc(actions.Paths, func(dir string) bool { return strings.HasPrefix(p, dir+"/") }) {
+			continue
+		}
+		actions.Paths = append(actions.Paths, p)
+	}
+	actions.RemoveContainers = slices.Contains(actions.Paths, containerdStateDir)
+
+	return actions, nil
+}
+
+func (b *GardenadmBotanist) lastAppliedOperatingSystemConfig() (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	oscRaw, err := b.FS.ReadFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) || errors.Is(err, os.ErrNotExist) {
+			b.Logger.Info("No OperatingSystemConfig was applied yet, only well-known files and directories are removed", "path", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading last applied OperatingSystemConfig from %s: %w", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	scheme := runtime.NewScheme()
+	if err := extensionsv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(serializer.NewCodecFactory(scheme).UniversalDeserializer(), oscRaw, osc); err != nil {
+		return nil, fmt.Errorf("failed decoding last applied OperatingSystemConfig from %s: %w", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	return osc, nil
+}
+
+// Reset resets the node in the same order as `kubeadm reset`: It stops and disables the given units (including the
+// kubelet), stops and removes all containers of the kubelet, and unmounts all file systems mounted below the kubelet
+// directory (e.g., the volumes of pods). Only then the given paths are removed, so that no data is deleted through
+// mounted volumes. containerd is stopped while its state is removed and started again afterwards.
+func (b *GardenadmBotanist) Reset(ctx context.Context, actions *ResetActions) error {
+	for _, unit := range actions.Units {
+		b.Logger.Info("Stopping and disabling unit", "unit", unit)
+		// The units might not exist if the node was only partially initialized, e.g., if `gardenadm init` failed early.
+		if err := b.DBus.Stop(ctx, nil, nil, unit); err != nil {
+			if !nodeagentdbus.IsNoSuchUnit(err) {
+				return fmt.Errorf("failed stopping unit %s: %w", unit, err)
+			}
+			b.Logger.Info("Unit is not loaded, nothing to stop", "unit", unit)
+		}
+		if err := b.DBus.Disable(ctx, unit); err != nil {
+			if !nodeagentdbus.IsNoSuchUnit(err) {
+				return fmt.Errorf("failed disabling unit %s: %w", unit, err)
+			}
+			b.Logger.Info("Unit file does not exist, nothing to disable", "unit", unit)
+		}
+	}
+
+	if actions.RemoveContainers {
+		b.Logger.Info("Stopping and removing all containers")
+		if err := RemoveAllContainers(ctx, b.Logger); err != nil {
+			return fmt.Errorf("failed removing containers: %w", err)
+		}
+	}
+
+	b.Logger.Info("Unmounting all file systems below kubelet directory", "path", kubelet.PathKubeletDirectory)
+	if err := UnmountAllBelow(kubelet.PathKubeletDirectory); err != nil {
+		return fmt.Errorf("failed unmounting file systems below %s: %w", kubelet.PathKubeletDirectory, err)
+	}
+
+	if actions.RemoveContainers {
+		b.Logger.Info("Stopping unit for removing its state", "unit", containerdUnitName)
+		if err := b.DBus.Stop(ctx, nil, nil, containerdUnitName); err != nil {
+			return fmt.Errorf("failed stopping unit %s: %w", containerdUnitName, err)
+		}
+	}
+
+	for _, p := range actions.Paths {
+		b.Logger.Info("Removing path", "path", p)
+		if err := b.FS.RemoveAll(p); err != nil {
+			return fmt.Errorf("failed removing %s: %w", p, err)
+		}
+	}
+
+	if err := b.DBus.DaemonReload(ctx); err != nil {
+		return fmt.Errorf("failed reloading systemd daemon: %w", err)
+	}
+
+	if actions.RemoveContainers {
+		b.Logger.Info("Starting unit again", "unit", containerdUnitName)
+		if err := b.DBus.Start(ctx, nil, nil, containerdUnitName); err != nil {
+			return fmt.Errorf("failed starting unit %s: %w", containerdUnitName, err)
+		}
+	}
+
+	return nil
+}
+
+// removeAllContainers kills the tasks of all containers in the CRI namespace of containerd and removes the containers
+// including their snapshots.
+func removeAllContainers(ctx context.Context, log logr.Logger) error {
+	client, err := nodeagentcontainerd.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed connecting to containerd: %w", err)
+	}
+	defer func() { _ = client.Close() }()
+
+	ctx = namespaces.WithNamespace(ctx, criNamespace)
+
+	containers, err := client.Containers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed listing containers: %w", err)
+	}
+
+	for _, container := range containers {
+		log.V(1).Info("Removing container", "container", container.ID())
+
+		task, err := container.Task(ctx, nil)
+		if err != nil && !errdefs.IsNotFound(err) {
+			return fmt.Errorf("failed getting task of container %s: %w", container.ID(), err)
+		}
+		if err == nil {
+			if _, err := task.Delete(ctx, containerd.WithProcessKill); err != nil && !errdefs.IsNotFound(err) {
+				return fmt.Errorf("failed stopping task of container %s: %w", container.ID(), err)
+			}
+		}
+
+		if err := container.Delete(ctx, containerd.WithSnapshotCleanup); err != nil && !errdefs.IsNotFound(err) {
+			return fmt.Errorf("failed removing container %s: %w", container.ID(), err)
+		}
+	}
+
+	return nil
+}
+
+// unmountAllBelow unmounts all mounts below and including the given directory, starting with the deepest mount. In
+// contrast to mount.UnmountRecursive, it fails if any mount cannot be unmounted.
+func unmountAllBelow(dir string) error {
+	mounts, err := mountinfo.GetMounts(mountinfo.PrefixFilter(dir))
+	if err != nil {
+		return fmt.Errorf("failed listing mounts: %w", err)
+	}
+
+	mountPoints := sets.New[string]()
+	for _, m := range mounts {
+		mountPoints.Insert(m.Mountpoint)
+	}
+
+	targets := mountPoints.UnsortedList()
+	slices.SortFunc(targets, func(a, b string) int { return cmp.Compare(len(b), len(a)) })
+
+	for _, target := range targets {
+		if err := mount.UnmountAll(target, 0); err != nil {
+			return fmt.Errorf("failed unmounting %s: %w", target, err)
+		}
+	}
+
+	return nil
+}
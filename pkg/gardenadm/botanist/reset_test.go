@@ -0,0 +1,280 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	godbus "github.com/godbus/dbus/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/spf13/afero"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Reset", func() {
+	var (
+		ctx      context.Context
+		fs       afero.Afero
+		fakeDBus *fakedbus.DBus
+
+		b *GardenadmBotanist
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeDBus = fakedbus.New()
+
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{Logger: logr.Discard()}},
+			HostName: "machine-0",
+			DBus:     fakeDBus,
+			FS:       fs,
+		}
+
+		for _, dir := range []string{
+			"/var/lib/gardener-node-agent/credentials",
+			"/var/lib/kubelet/pki",
+			"/var/lib/containerd",
+			"/var/lib/etcd-main/data",
+			"/etc/kubernetes/manifests",
+			"/var/lib/other",
+		} {
+			Expect(fs.MkdirAll(dir, 0755)).To(Succeed())
+		}
+		Expect(fs.WriteFile("/etc/systemd/system/gardener-node-agent.service", nil, 0600)).To(Succeed())
+	})
+
+	Describe("#ComputeResetActions", func() {
+		It("should only return the well-known units and existing paths if no OSC was applied", func() {
+			actions, err := b.ComputeResetActions()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(actions.Units).To(Equal([]string{"gardener-node-agent.service", "gardener-node-init.service", "kubelet.service"}))
+			Expect(actions.RemoveContainers).To(BeTrue())
+			Expect(actions.Paths).To(Equal([]string{
+				"/etc/kubernetes",
+				"/etc/systemd/system/gardener-node-agent.service",
+				"/var/lib/containerd",
+				"/var/lib/etcd-main",
+				"/var/lib/gardener-node-agent",
+				"/var/lib/kubelet",
+			}))
+		})
+
+		It("should additionally return the units and files of the last applied OSC", func() {
+			Expect(fs.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte(`apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+spec:
+  files:
+  - path: /opt/bin/kubelet
+  - path: /etc/not-existing
+  units:
+  - name: kubelet.service
+    content: foo
+  - name: containerd.service
+    dropIns:
+    - name: 10-override.conf
+    filePaths:
+    - /etc/containerd/config.toml
+`), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/opt/bin/kubelet", nil, 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/systemd/system/kubelet.service", nil, 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/systemd/system/containerd.service", nil, 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/systemd/system/containerd.service.d/10-override.conf", nil, 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/containerd/config.toml", nil, 0600)).To(Succeed())
+
+			actions, err := b.ComputeResetActions()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(actions.Units).To(Equal([]string{"gardener-node-agent.service", "gardener-node-init.service", "kubelet.service"}))
+			Expect(actions.Paths).To(Equal([]string{
+				"/etc/containerd/config.toml",
+				"/etc/kubernetes",
+				"/etc/systemd/system/containerd.service.d/10-override.conf",
+				"/etc/systemd/system/gardener-node-agent.service",
+				"/etc/systemd/system/kubelet.service",
+				"/opt/bin/kubelet",
+				"/var/lib/containerd",
+				"/var/lib/etcd-main",
+				"/var/lib/gardener-node-agent",
+				"/var/lib/kubelet",
+			}))
+		})
+
+		It("should fail if the last applied OSC cannot be decoded", func() {
+			Expect(fs.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte("foo"), 0600)).To(Succeed())
+
+			_, err := b.ComputeResetActions()
+			Expect(err).To(MatchError(ContainSubstring("failed decoding last applied OperatingSystemConfig")))
+		})
+	})
+
+	Describe("#Reset", func() {
+		var (
+			steps          []string
+			removeErr      error
+			unmountErr     error
+			unmountedPaths []string
+		)
+
+		BeforeEach(func() {
+			steps, removeErr, unmountErr, unmountedPaths = nil, nil, nil, nil
+
+			// Record the state of the node whenever containers are removed or file systems are unmounted, so that the order
+			// of the steps can be verified.
+			recordStep := func(step string) {
+				var stoppedUnits []string
+				for _, action := range fakeDBus.Actions {
+					if action.Action == fakedbus.ActionStop {
+						stoppedUnits = append(stoppedUnits, action.UnitNames...)
+					}
+				}
+				kubeletDirExists, err := fs.DirExists("/var/lib/kubelet")
+				Expect(err).NotTo(HaveOccurred())
+
+				steps = append(steps, fmt.Sprintf("%s (stopped units: %v, kubelet directory exists: %t)", step, stoppedUnits, kubeletDirExists))
+			}
+
+			DeferCleanup(test.WithVars(
+				&RemoveAllContainers, func(context.Context, logr.Logger) error {
+					recordStep("remove containers")
+					return removeErr
+				},
+				&UnmountAllBelow, func(dir string) error {
+					recordStep("unmount")
+					unmountedPaths = append(unmountedPaths, dir)
+					return unmountErr
+				},
+			))
+		})
+
+		It("should stop the kubelet, remove the containers and unmount all file systems before removing the paths", func() {
+			actions, err := b.ComputeResetActions()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(b.Reset(ctx, actions)).To(Succeed())
+
+			for _, p := range actions.Paths {
+				Expect(fs.Exists(p)).To(BeFalse(), p)
+			}
+			Expect(fs.DirExists("/var/lib/other")).To(BeTrue())
+
+			Expect(steps).To(Equal([]string{
+				"remove containers (stopped units: [gardener-node-agent.service gardener-node-init.service kubelet.service], kubelet directory exists: true)",
+				"unmount (stopped units: [gardener-node-agent.service gardener-node-init.service kubelet.service], kubelet directory exists: true)",
+			}))
+			Expect(unmountedPaths).To(Equal([]string{"/var/lib/kubelet"}))
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionStop, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"gardener-node-init.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"gardener-node-init.service"}},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"kubelet.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"kubelet.service"}},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"containerd.service"}},
+				{Action: fakedbus.ActionDaemonReload},
+				{Action: fakedbus.ActionStart, UnitNames: []string{"containerd.service"}},
+			}))
+		})
+
+		It("should ignore units which do not exist on partially initialized nodes", func() {
+			fakeDBus.InjectStopFailure(godbus.Error{Name: "org.freedesktop.systemd1.NoSuchUnit"}, "gardener-node-agent.service")
+			fakeDBus.InjectDisableFailure(godbus.Error{Name: "org.freedesktop.systemd1.NoSuchUnit"}, "gardener-node-agent.service")
+			fakeDBus.InjectStopFailure(fmt.Errorf("unable to stop unit kubelet.service: %w", godbus.Error{Name: "org.freedesktop.systemd1.NoSuchUnit"}), "kubelet.service")
+
+			actions, err := b.ComputeResetActions()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(b.Reset(ctx, actions)).To(Succeed())
+
+			for _, p := range actions.Paths {
+				Expect(fs.Exists(p)).To(BeFalse(), p)
+			}
+			Expect(fakeDBus.Actions).To(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionDisable, UnitNames: []string{"kubelet.service"}}))
+		})
+
+		It("should fail if a unit cannot be stopped for other reasons", func() {
+			fakeDBus.InjectStopFailure(godbus.Error{Name: "org.freedesktop.systemd1.TransactionIsDestructive"}, "kubelet.service")
+
+			actions, err := b.ComputeResetActions()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(b.Reset(ctx, actions)).To(MatchError(ContainSubstring("failed stopping unit kubelet.service")))
+
+			for _, p := range actions.Paths {
+				Expect(fs.Exists(p)).To(BeTrue(), p)
+			}
+		})
+
+		It("should not remove containers if containerd has no state", func() {
+			Expect(fs.RemoveAll("/var/lib/containerd")).To(Succeed())
+
+			actions, err := b.ComputeResetActions()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(b.Reset(ctx, actions)).To(Succeed())
+
+			Expect(steps).To(HaveLen(1))
+			Expect(steps[0]).To(HavePrefix("unmount"))
+			Expect(fakeDBus.Actions).NotTo(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionStop, UnitNames: []string{"containerd.service"}}))
+		})
+
+		It("should not remove any path if the containers cannot be removed", func() {
+			removeErr = errors.New("fake")
+
+			actions, err := b.ComputeResetActions()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(b.Reset(ctx, actions)).To(MatchError(ContainSubstring("failed removing containers: fake")))
+
+			for _, p := range actions.Paths {
+				Expect(fs.Exists(p)).To(BeTrue(), p)
+			}
+			Expect(unmountedPaths).To(BeEmpty())
+		})
+
+		It("should not remove any path if a file system cannot be unmounted", func() {
+			unmountErr = errors.New("device or resource busy")
+
+			actions, err := b.ComputeResetActions()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(b.Reset(ctx, actions)).To(MatchError(ContainSubstring("failed unmounting file systems below /var/lib/kubelet: device or resource busy")))
+
+			for _, p := range actions.Paths {
+				Expect(fs.Exists(p)).To(BeTrue(), p)
+			}
+		})
+	})
+
+	Describe("ResetActions", func() {
+		Describe("#Print", func() {
+			It("should print the units and paths", func() {
+				buf := NewBuffer()
+				(&ResetActions{Units: []string{"foo.service"}, RemoveContainers: true, Paths: []string{"/var/lib/foo"}}).Print(buf)
+
+				Expect(buf).To(Say(`The following systemd units are stopped and disabled:
+  - foo.service
+All containers of the kubelet are stopped and removed.
+All file systems mounted below /var/lib/kubelet are unmounted.
+The following files and directories are removed:
+  - /var/lib/foo
+`))
+			})
+		})
+	})
+})
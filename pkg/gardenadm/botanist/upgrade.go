@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// UpgradeNode rolls out the current OperatingSystemConfig of gardener-node-agent to the node with the given name.
+// gardener-node-agent only applies it once the node is marked as ready for update (either because the rollout requires
+// an in-place update or because the Secret requires it, see Shoot.NodeAgentReadyForUpdateRequired). Hence, UpgradeNode
+// marks the node accordingly, waits until the new OperatingSystemConfig has been applied and the node is healthy again.
+// For control plane nodes, it additionally waits until the static control plane pods running on the node are ready.
+func (b *GardenadmBotanist) UpgradeNode(ctx context.Context, nodeName string, controlPlane bool) error {
+	c := b.SeedClientSet.Client()
+
+	node := &corev1.Node{}
+	if err := c.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return fmt.Errorf("failed getting node %s: %w", nodeName, err)
+	}
+
+	secretName, ok := node.Labels[v1beta1constants.LabelWorkerPoolGardenerNodeAgentSecretName]
+	if !ok {
+		return fmt.Errorf("node %s does not have the %s label", nodeName, v1beta1constants.LabelWorkerPoolGardenerNodeAgentSecretName)
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Name: secretName, Namespace: metav1.NamespaceSystem}, secret); err != nil {
+		return fmt.Errorf("failed getting gardener-node-agent secret %s for node %s: %w", secretName, nodeName, err)
+	}
+
+	b.Logger.Info("Marking node as ready for update", "node", nodeName)
+	if err := b.setNodeInPlaceUpdateCondition(ctx, node, machinev1alpha1.ReadyForUpdate); err != nil {
+		return err
+	}
+
+	if err := b.waitForOperatingSystemConfigToBeApplied(ctx, node, secret); err != nil {
+		return err
+	}
+
+	if err := b.waitForNodeReadiness(ctx, node); err != nil {
+		return err
+	}
+
+	if controlPlane {
+		if err := b.waitForStaticPodsReadiness(ctx, nodeName); err != nil {
+			return err
+		}
+	}
+
+	b.Logger.Info("Node was upgraded successfully", "node", nodeName)
+	return b.setNodeInPlaceUpdateCondition(ctx, node, machinev1alpha1.UpdateSuccessful)
+}
+
+// setNodeInPlaceUpdateCondition sets the in-place update condition with the given reason on the node. The update result
+// label is removed so that gardener-node-agent reports the result of the current rollout.
+func (b *GardenadmBotanist) setNodeInPlaceUpdateCondition(ctx context.Context, node *corev1.Node, reason string) error {
+	c := b.SeedClientSet.Client()
+
+	if reason == machinev1alpha1.ReadyForUpdate {
+		patch := client.MergeFrom(node.DeepCopy())
+		delete(node.Labels, machinev1alpha1.LabelKeyNodeUpdateResult)
+		if err := c.Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed removing update result label from node %s: %w", node.Name, err)
+		}
+	}
+
+	patch := client.StrategicMergeFrom(node.DeepCopy())
+	condition := corev1.NodeCondition{
+		Type:               machinev1alpha1.NodeInPlaceUpdate,
+		Status:             corev1.ConditionTrue,
+		Reason:             reason,
+		Message:            "In-place update is triggered by gardenadm upgrade",
+		LastTransitionTime: metav1.NewTime(b.Clock.Now()),
+		LastHeartbeatTime:  metav1.NewTime(b.Clock.Now()),
+	}
+	if reason == machinev1alpha1.UpdateSuccessful {
+		condition.Status = corev1.ConditionFalse
+		condition.Message = "In-place update was completed by gardenadm upgrade"
+	}
+
+	var found bool
+	for i, existing := range node.Status.Conditions {
+		if existing.Type == machinev1alpha1.NodeInPlaceUpdate {
+			node.Status.Conditions[i] = condition
+			found = true
+		}
+	}
+	if !found {
+		node.Status.Conditions = append(node.Status.Conditions, condition)
+	}
+
+	if err := c.Status().Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed setting %s condition with reason %s on node %s: %w", machinev1alpha1.NodeInPlaceUpdate, reason, node.Name, err)
+	}
+
+	return nil
+}
+
+func (b *GardenadmBotanist) waitForOperatingSystemConfigToBeApplied(ctx context.Context, node *corev1.Node, secret *corev1.Secret) error {
+	b.Logger.Info("Waiting for operating system config to be applied", "node", node.Name)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 15*time.Minute)
+	defer cancel()
+
+	return retry.Until(timeoutCtx, 2*time.Second, func(ctx context.Context) (done bool, err error) {
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKeyFromObject(node), node); err != nil {
+			return retry.SevereError(fmt.Errorf("failed to get node %s: %w", node.Name, err))
+		}
+
+		if node.Labels[machinev1alpha1.LabelKeyNodeUpdateResult] == machinev1alpha1.LabelValueNodeUpdateFailed {
+			return retry.SevereError(fmt.Errorf("gardener-node-agent failed updating node %s: %s", node.Name, node.Annotations[machinev1alpha1.AnnotationKeyMachineUpdateFailedReason]))
+		}
+
+		secretChecksum := secret.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig]
+		if nodeChecksum := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]; nodeChecksum != secretChecksum {
+			return retry.MinorError(fmt.Errorf("the last successfully applied operating system config on node %q is outdated (current: %s, desired: %s)", node.Name, nodeChecksum, secretChecksum))
+		}
+
+		return retry.Ok()
+	})
+}
+
+func (b *GardenadmBotanist) waitForNodeReadiness(ctx context.Context, node *corev1.Node) error {
+	b.Logger.Info("Waiting for node to get ready", "node", node.Name)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	return retry.Until(timeoutCtx, 2*time.Second, func(ctx context.Context) (done bool, err error) {
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKeyFromObject(node), node); err != nil {
+			return retry.SevereError(fmt.Errorf("failed to get node %s: %w", node.Name, err))
+		}
+
+		if err := health.CheckNode(node); err != nil {
+			return retry.MinorError(err)
+		}
+
+		return retry.Ok()
+	})
+}
+
+func (b *GardenadmBotanist) waitForStaticPodsReadiness(ctx context.Context, nodeName string) error {
+	b.Logger.Info("Waiting for static control plane pods to get ready", "node", nodeName)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	return retry.Until(timeoutCtx, 2*time.Second, func(ctx context.Context) (done bool, err error) {
+		podList := &corev1.PodList{}
+		if err := b.SeedClientSet.Client().List(ctx, podList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{staticpod.LabelKeyIsStaticPod: staticpod.LabelValueIsStaticPod}); err != nil {
+			return retry.SevereError(fmt.Errorf("failed listing static pods: %w", err))
+		}
+
+		var found bool
+		for _, pod := range podList.Items {
+			if pod.Spec.NodeName != nodeName {
+				continue
+			}
+			found = true
+
+			if !health.IsPodReady(&pod) {
+				return retry.MinorError(fmt.Errorf("static pod %s on node %s is not ready yet", pod.Name, nodeName))
+			}
+		}
+
+		if !found {
+			return retry.MinorError(fmt.Errorf("no static pods found on node %s yet", nodeName))
+		}
+
+		return retry.Ok()
+	})
+}
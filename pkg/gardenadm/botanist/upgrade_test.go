@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+)
+
+var _ = Describe("Upgrade", func() {
+	var (
+		ctx            context.Context
+		fakeSeedClient client.Client
+
+		node   *corev1.Node
+		secret *corev1.Secret
+
+		b *GardenadmBotanist
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeSeedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&corev1.Node{}).Build()
+
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{
+				Operation: &operation.Operation{
+					Logger:        logr.Discard(),
+					Clock:         testclock.NewFakeClock(time.Now()),
+					SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(fakeSeedClient).Build(),
+				},
+			},
+		}
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "gardener-node-agent-worker",
+				Namespace:   "kube-system",
+				Annotations: map[string]string{nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig: "new"},
+			},
+		}
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "node",
+				Labels: map[string]string{
+					v1beta1constants.LabelWorkerPoolGardenerNodeAgentSecretName: secret.Name,
+					machinev1alpha1.LabelKeyNodeUpdateResult:                    machinev1alpha1.LabelValueNodeUpdateSuccessful,
+				},
+				Annotations: map[string]string{nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig: "new"},
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			},
+		}
+
+		Expect(fakeSeedClient.Create(ctx, secret)).To(Succeed())
+	})
+
+	Describe("#UpgradeNode", func() {
+		It("should mark the node as ready for update and complete the update once the OSC was applied", func() {
+			Expect(fakeSeedClient.Create(ctx, node)).To(Succeed())
+
+			Expect(b.UpgradeNode(ctx, node.Name, false)).To(Succeed())
+
+			Expect(fakeSeedClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Labels).NotTo(HaveKey(machinev1alpha1.LabelKeyNodeUpdateResult))
+			Expect(node.Status.Conditions).To(ContainElement(And(
+				HaveField("Type", machinev1alpha1.NodeInPlaceUpdate),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", machinev1alpha1.UpdateSuccessful),
+			)))
+		})
+
+		It("should wait for the static pods on control plane nodes", func() {
+			Expect(fakeSeedClient.Create(ctx, node)).To(Succeed())
+			Expect(fakeSeedClient.Create(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-node", Namespace: "kube-system", Labels: map[string]string{"static-pod": "true"}},
+				Spec:       corev1.PodSpec{NodeName: node.Name},
+				Status:     corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}},
+			})).To(Succeed())
+
+			Expect(b.UpgradeNode(ctx, node.Name, true)).To(Succeed())
+		})
+
+		It("should fail if the static pods on control plane nodes do not get ready", func() {
+			Expect(fakeSeedClient.Create(ctx, node)).To(Succeed())
+			Expect(fakeSeedClient.Create(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-node", Namespace: "kube-system", Labels: map[string]string{"static-pod": "true"}},
+				Spec:       corev1.PodSpec{NodeName: node.Name},
+			})).To(Succeed())
+
+			timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+
+			Expect(b.UpgradeNode(timeoutCtx, node.Name, true)).To(MatchError(ContainSubstring("static pod kube-apiserver-node on node node is not ready yet")))
+		})
+
+		It("should fail if the OSC was not applied", func() {
+			node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] = "old"
+			Expect(fakeSeedClient.Create(ctx, node)).To(Succeed())
+
+			timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+
+			Expect(b.UpgradeNode(timeoutCtx, node.Name, false)).To(MatchError(ContainSubstring("is outdated (current: old, desired: new)")))
+
+			Expect(fakeSeedClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ContainElement(And(
+				HaveField("Type", machinev1alpha1.NodeInPlaceUpdate),
+				HaveField("Reason", machinev1alpha1.ReadyForUpdate),
+			)))
+		})
+
+		It("should fail if the node does not reference a gardener-node-agent secret", func() {
+			delete(node.Labels, v1beta1constants.LabelWorkerPoolGardenerNodeAgentSecretName)
+			Expect(fakeSeedClient.Create(ctx, node)).To(Succeed())
+
+			Expect(b.UpgradeNode(ctx, node.Name, false)).To(MatchError(ContainSubstring("does not have the worker.gardener.cloud/gardener-node-agent-secret-name label")))
+		})
+	})
+})
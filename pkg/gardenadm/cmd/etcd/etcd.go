@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/restore"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/snapshot"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "etcd",
+		Short: "Take snapshots of the etcds of the self-hosted shoot cluster and restore them",
+		Long:  "Take snapshots of the etcds of the self-hosted shoot cluster and restore them",
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	cmd.AddCommand(snapshot.NewCommand(globalOpts))
+	cmd.AddCommand(restore.NewCommand(globalOpts))
+
+	return cmd
+}
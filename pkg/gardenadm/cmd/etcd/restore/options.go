@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package restore
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+
+	// Directory is the local directory containing the snapshots.
+	Directory string
+	// OCIReference is the reference of the OCI artifact containing the snapshots.
+	OCIReference string
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(_ []string) error { return nil }
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if (len(o.Directory) == 0) == (len(o.OCIReference) == 0) {
+		return fmt.Errorf("must provide exactly one of --dir or --oci-ref")
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error {
+	if len(o.Directory) > 0 {
+		// The snapshots are mounted into the etcd static pods, hence we need absolute paths.
+		dir, err := filepath.Abs(o.Directory)
+		if err != nil {
+			return fmt.Errorf("failed computing absolute path of %s: %w", o.Directory, err)
+		}
+		o.Directory = dir
+	}
+
+	return nil
+}
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Directory, "dir", "", "Local directory containing the snapshots written by 'gardenadm etcd snapshot'")
+	fs.StringVar(&o.OCIReference, "oci-ref", "", "Reference of the OCI artifact pushed by 'gardenadm etcd snapshot' (credentials are taken from the docker config)")
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package restore_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/restore"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+	)
+
+	BeforeEach(func() {
+		options = &Options{}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should succeed when a directory is provided", func() {
+			options.Directory = "/var/backups/etcd"
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should succeed when an OCI reference is provided", func() {
+			options.OCIReference = "registry.example.com/backups/etcd:latest"
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail when neither a directory nor an OCI reference is provided", func() {
+			Expect(options.Validate()).To(MatchError("must provide exactly one of --dir or --oci-ref"))
+		})
+
+		It("should fail when both a directory and an OCI reference are provided", func() {
+			options.Directory = "/var/backups/etcd"
+			options.OCIReference = "registry.example.com/backups/etcd:latest"
+			Expect(options.Validate()).To(MatchError("must provide exactly one of --dir or --oci-ref"))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should make the directory absolute", func() {
+			options.Directory = "backups"
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.Directory).To(HavePrefix("/"))
+			Expect(options.Directory).To(HaveSuffix("/backups"))
+		})
+	})
+})
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	etcdutils "github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/utils"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore the main and events etcd running on this control plane node from snapshots",
+		Long: `Restore the main and events etcd running on this control plane node from snapshots.
+
+The snapshots are read from a local directory or pulled from an OCI artifact written by "gardenadm etcd snapshot".
+Only the etcds for which a snapshot is found are restored. kube-apiserver and the etcds are stopped, the current data
+directories are moved aside (suffixed with ".bak-<timestamp>"), and the snapshots are restored before the control
+plane is started again. Restoring etcd clusters with multiple members (i.e., with multiple control plane nodes) is not
+supported.`,
+
+		Example: `# Restore the etcds from snapshots in a local directory
+gardenadm etcd restore --dir /var/backups/etcd
+
+# Restore the etcds from snapshots in an OCI artifact
+gardenadm etcd restore --oci-ref registry.example.com/backups/etcd:2025-01-01`,
+
+		Args: cobra.ExactArgs(0),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+// WaitUntilKubeAPIServerReady waits until kube-apiserver is ready again after the etcds were restored.
+// Exposed for unit testing.
+var WaitUntilKubeAPIServerReady = func(ctx context.Context, b *botanist.GardenadmBotanist) error {
+	return retry.UntilTimeout(ctx, 2*time.Second, 5*time.Minute, func(ctx context.Context) (done bool, err error) {
+		if _, err := b.CreateClientSet(ctx); err != nil {
+			return retry.MinorError(err)
+		}
+		return retry.Ok()
+	})
+}
+
+func run(ctx context.Context, opts *Options) error {
+	b, err := etcdutils.NewBotanist(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	dir := opts.Directory
+	if len(opts.OCIReference) > 0 {
+		dir, err = b.FS.TempDir("", "gardenadm-etcd-restore-")
+		if err != nil {
+			return fmt.Errorf("failed creating temporary directory: %w", err)
+		}
+		defer func() { _ = b.FS.RemoveAll(dir) }()
+
+		if _, err := etcdutils.PullSnapshots(ctx, b.FS, opts.OCIReference, dir); err != nil {
+			return err
+		}
+	}
+
+	snapshotPaths := make(map[string]string)
+	for _, role := range botanist.EtcdRoles {
+		path := filepath.Join(dir, botanist.EtcdSnapshotFileName(role))
+		if exists, err := b.FS.Exists(path); err != nil {
+			return fmt.Errorf("failed checking whether snapshot %s exists: %w", path, err)
+		} else if exists {
+			snapshotPaths[role] = path
+		}
+	}
+
+	if len(snapshotPaths) == 0 {
+		return fmt.Errorf("no etcd snapshots found in %s", dir)
+	}
+
+	if err := b.RestoreEtcd(ctx, snapshotPaths); err != nil {
+		return err
+	}
+
+	b.Logger.Info("Waiting until kube-apiserver is ready")
+	if err := WaitUntilKubeAPIServerReady(ctx, b); err != nil {
+		return fmt.Errorf("failed waiting until kube-apiserver is ready: %w", err)
+	}
+
+	fmt.Fprintln(opts.Out, "The etcds were restored successfully!")
+	return nil
+}
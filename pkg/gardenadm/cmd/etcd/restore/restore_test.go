@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package restore_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/registry"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/restore"
+	etcdutils "github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/utils"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/utils/test"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+)
+
+var _ = Describe("Restore", func() {
+	var (
+		globalOpts *cmd.Options
+		command    *cobra.Command
+
+		fs afero.Afero
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{Log: logr.Discard()}
+		globalOpts.IOStreams, _, _, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+		command.SetContext(context.Background())
+
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		DeferCleanup(test.WithVar(&etcdutils.NewBotanist, func(log logr.Logger) (*botanist.GardenadmBotanist, error) {
+			return &botanist.GardenadmBotanist{
+				Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{Logger: log}},
+				FS:       fs,
+			}, nil
+		}))
+	})
+
+	Describe("#RunE", func() {
+		It("should fail if the directory does not contain snapshots", func() {
+			Expect(fs.WriteFile("/var/backups/etcd/foo.db", nil, 0600)).To(Succeed())
+			Expect(command.Flags().Set("dir", "/var/backups/etcd")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(MatchError("no etcd snapshots found in /var/backups/etcd"))
+		})
+
+		It("should fail if the OCI artifact does not exist", func() {
+			server := httptest.NewServer(registry.New())
+			DeferCleanup(server.Close)
+			Expect(command.Flags().Set("oci-ref", strings.TrimPrefix(server.URL, "http://")+"/backups/etcd:latest")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("failed pulling OCI artifact")))
+		})
+	})
+})
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+
+	// Directory is the local directory to which the snapshots are written.
+	Directory string
+	// OCIReference is the reference of the OCI artifact to which the snapshots are pushed.
+	OCIReference string
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(_ []string) error { return nil }
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if (len(o.Directory) == 0) == (len(o.OCIReference) == 0) {
+		return fmt.Errorf("must provide exactly one of --dir or --oci-ref")
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return nil }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Directory, "dir", "", "Local directory to which the snapshots are written")
+	fs.StringVar(&o.OCIReference, "oci-ref", "", "Reference of the OCI artifact to which the snapshots are pushed, e.g., registry.example.com/backups/etcd:2025-01-01 (credentials are taken from the docker config)")
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	etcdutils "github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/utils"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Take snapshots of the main and events etcd running on this control plane node",
+		Long: `Take snapshots of the main and events etcd running on this control plane node.
+
+The snapshots are written to a local directory or pushed as an OCI artifact to a registry. Each etcd is snapshotted
+separately, i.e., the snapshots are consistent per etcd. The command connects to the etcds with the client certificate
+of kube-apiserver, hence it must be run on a control plane node.`,
+
+		Example: `# Write the snapshots to a local directory
+gardenadm etcd snapshot --dir /var/backups/etcd
+
+# Push the snapshots as an OCI artifact to a registry
+gardenadm etcd snapshot --oci-ref registry.example.com/backups/etcd:2025-01-01`,
+
+		Args: cobra.ExactArgs(0),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	b, err := etcdutils.NewBotanist(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	dir := opts.Directory
+	if len(opts.OCIReference) > 0 {
+		dir, err = b.FS.TempDir("", "gardenadm-etcd-snapshot-")
+		if err != nil {
+			return fmt.Errorf("failed creating temporary directory: %w", err)
+		}
+		defer func() { _ = b.FS.RemoveAll(dir) }()
+	}
+
+	if err := b.FS.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed creating directory %s: %w", dir, err)
+	}
+
+	var paths []string
+	for _, role := range botanist.EtcdRoles {
+		path := filepath.Join(dir, botanist.EtcdSnapshotFileName(role))
+		version, err := snapshotEtcd(ctx, b, role, path)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(opts.Out, "Snapshot of etcd %s (version %s) was written to %s\n", role, version, path)
+		paths = append(paths, path)
+	}
+
+	if len(opts.OCIReference) > 0 {
+		digest, err := etcdutils.PushSnapshots(ctx, b.FS, opts.OCIReference, paths)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(opts.Out, "Snapshots were pushed to %s (digest %s)\n", opts.OCIReference, digest)
+	}
+
+	return nil
+}
+
+func snapshotEtcd(ctx context.Context, b *botanist.GardenadmBotanist, role, path string) (string, error) {
+	file, err := b.FS.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", fmt.Errorf("failed creating snapshot file %s: %w", path, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	version, err := b.SnapshotEtcd(ctx, role, file)
+	if err != nil {
+		// Do not leave incomplete snapshots behind which could be mistaken for valid ones.
+		_ = b.FS.Remove(path)
+		return "", err
+	}
+
+	return version, nil
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot_test
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/registry"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/snapshot"
+	etcdutils "github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/utils"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+)
+
+var _ = Describe("Snapshot", func() {
+	var (
+		globalOpts *cmd.Options
+		stdOut     *Buffer
+		command    *cobra.Command
+
+		fs afero.Afero
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{Log: logr.Discard()}
+		globalOpts.IOStreams, _, stdOut, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+		command.SetContext(context.Background())
+
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		ca, err := (&secretsutils.CertificateSecretConfig{Name: "ca-etcd", CommonName: "etcd", CertType: secretsutils.CACert}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+		client, err := (&secretsutils.CertificateSecretConfig{Name: "etcd-client", CommonName: "etcd-client", CertType: secretsutils.ClientCert, SigningCA: ca}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fs.WriteFile("/var/lib/static-pods/kube-apiserver/ca-etcd/bundle.crt", ca.CertificatePEM, 0600)).To(Succeed())
+		Expect(fs.WriteFile("/var/lib/static-pods/kube-apiserver/etcd-client/tls.crt", client.CertificatePEM, 0600)).To(Succeed())
+		Expect(fs.WriteFile("/var/lib/static-pods/kube-apiserver/etcd-client/tls.key", client.PrivateKeyPEM, 0600)).To(Succeed())
+
+		DeferCleanup(test.WithVars(
+			&etcdutils.NewBotanist, func(log logr.Logger) (*botanist.GardenadmBotanist, error) {
+				return &botanist.GardenadmBotanist{
+					Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{Logger: log}},
+					FS:       fs,
+				}, nil
+			},
+			&botanist.NewEtcdClient, func(endpoint string, _ *tls.Config) (botanist.EtcdClient, error) {
+				return &fakeEtcdClient{snapshot: "data of " + endpoint}, nil
+			},
+		))
+	})
+
+	Describe("#RunE", func() {
+		It("should write the snapshots to the directory", func() {
+			Expect(command.Flags().Set("dir", "/var/backups/etcd")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say(`Snapshot of etcd main \(version 3.5.0\) was written to /var/backups/etcd/etcd-main.db`))
+			Eventually(stdOut).Should(Say(`Snapshot of etcd events \(version 3.5.0\) was written to /var/backups/etcd/etcd-events.db`))
+			Expect(fs.ReadFile("/var/backups/etcd/etcd-main.db")).To(BeEquivalentTo("data of https://localhost:2379"))
+			Expect(fs.ReadFile("/var/backups/etcd/etcd-events.db")).To(BeEquivalentTo("data of https://localhost:2382"))
+		})
+
+		It("should push the snapshots to the OCI registry", func() {
+			server := httptest.NewServer(registry.New())
+			DeferCleanup(server.Close)
+			reference := strings.TrimPrefix(server.URL, "http://") + "/backups/etcd:latest"
+			Expect(command.Flags().Set("oci-ref", reference)).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say(fmt.Sprintf(`Snapshots were pushed to %s \(digest sha256:`, reference)))
+
+			paths, err := etcdutils.PullSnapshots(context.Background(), fs, reference, "/restore")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(paths).To(ConsistOf("/restore/etcd-main.db", "/restore/etcd-events.db"))
+		})
+
+		It("should fail and not leave an incomplete snapshot behind", func() {
+			DeferCleanup(test.WithVar(&botanist.NewEtcdClient, func(string, *tls.Config) (botanist.EtcdClient, error) {
+				return &fakeEtcdClient{err: fmt.Errorf("fake")}, nil
+			}))
+			Expect(command.Flags().Set("dir", "/var/backups/etcd")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("failed taking snapshot of etcd etcd-main: fake")))
+			Expect(fs.Exists("/var/backups/etcd/etcd-main.db")).To(BeFalse())
+		})
+	})
+})
+
+type fakeEtcdClient struct {
+	botanist.EtcdClient
+
+	snapshot string
+	err      error
+}
+
+func (f *fakeEtcdClient) SnapshotWithVersion(_ context.Context) (*clientv3.SnapshotResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &clientv3.SnapshotResponse{Snapshot: io.NopCloser(strings.NewReader(f.snapshot)), Version: "3.5.0"}, nil
+}
+
+func (f *fakeEtcdClient) Close() error { return nil }
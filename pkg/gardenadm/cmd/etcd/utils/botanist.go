@@ -0,0 +1,13 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+)
+
+// NewBotanist creates the botanist for taking and restoring etcd snapshots on this machine.
+// Exposed for unit testing.
+var NewBotanist = botanist.NewGardenadmBotanistWithoutResources
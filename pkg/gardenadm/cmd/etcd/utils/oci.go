@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/stream"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/spf13/afero"
+)
+
+const (
+	// MediaTypeSnapshotConfig is the media type of the config of the OCI artifact containing etcd snapshots.
+	MediaTypeSnapshotConfig types.MediaType = "application/vnd.gardener.gardenadm.etcd-snapshot.config.v1+json"
+	// MediaTypeSnapshotLayer is the media type of the layers of the OCI artifact containing etcd snapshots. Each layer
+	// contains the gzip-compressed snapshot of one etcd.
+	MediaTypeSnapshotLayer types.MediaType = "application/vnd.gardener.gardenadm.etcd-snapshot.layer.v1+gzip"
+
+	// annotationTitle is the annotation of a layer containing its file name, see
+	// https://github.com/opencontainers/image-spec/blob/main/annotations.md.
+	annotationTitle = "org.opencontainers.image.title"
+)
+
+// PushSnapshots pushes the snapshot files with the given paths as an OCI artifact to the given reference. The
+// credentials are taken from the default keychain, i.e., the docker config of the user. It returns the digest of the
+// pushed artifact.
+func PushSnapshots(ctx context.Context, fs afero.Afero, reference string, paths []string) (string, error) {
+	ref, err := name.ParseReference(reference)
+	if err != nil {
+		return "", fmt.Errorf("failed parsing OCI reference %q: %w", reference, err)
+	}
+
+	image := mutate.ConfigMediaType(mutate.MediaType(empty.Image, types.OCIManifestSchema1), MediaTypeSnapshotConfig)
+	for _, path := range paths {
+		file, err := fs.Open(path)
+		if err != nil {
+			return "", fmt.Errorf("failed opening snapshot %s: %w", path, err)
+		}
+
+		// The stream layer closes the file once it has been uploaded.
+		image, err = mutate.Append(image, mutate.Addendum{
+			Layer:       stream.NewLayer(file, stream.WithMediaType(MediaTypeSnapshotLayer)),
+			MediaType:   MediaTypeSnapshotLayer,
+			Annotations: map[string]string{annotationTitle: filepath.Base(path)},
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed adding snapshot %s to OCI artifact: %w", path, err)
+		}
+	}
+
+	if err := remote.Write(ref, image, remote.WithContext(ctx), remote.WithAuthFromKeychain(authn.DefaultKeychain)); err != nil {
+		return "", fmt.Errorf("failed pushing OCI artifact to %s: %w", ref, err)
+	}
+
+	digest, err := image.Digest()
+	if err != nil {
+		return "", fmt.Errorf("failed computing digest of OCI artifact: %w", err)
+	}
+
+	return digest.String(), nil
+}
+
+// PullSnapshots pulls the OCI artifact with the given reference and writes the contained snapshot files to the given
+// directory. It returns the paths of the written files.
+func PullSnapshots(ctx context.Context, fs afero.Afero, reference, dir string) ([]string, error) {
+	ref, err := name.ParseReference(reference)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing OCI reference %q: %w", reference, err)
+	}
+
+	image, err := remote.Image(ref, remote.WithContext(ctx), remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		return nil, fmt.Errorf("failed pulling OCI artifact %s: %w", ref, err)
+	}
+
+	manifest, err := image.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("failed reading manifest of OCI artifact %s: %w", ref, err)
+	}
+	if manifest.Config.MediaType != MediaTypeSnapshotConfig {
+		return nil, fmt.Errorf("OCI artifact %s does not contain etcd snapshots (config media type is %q, expected %q)", ref, manifest.Config.MediaType, MediaTypeSnapshotConfig)
+	}
+
+	var paths []string
+	for _, descriptor := range manifest.Layers {
+		fileName := descriptor.Annotations[annotationTitle]
+		if fileName == "" || fileName != filepath.Base(fileName) {
+			return nil, fmt.Errorf("layer %s of OCI artifact %s has an invalid file name %q", descriptor.Digest, ref, fileName)
+		}
+
+		layer, err := image.LayerByDigest(descriptor.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading layer %s of OCI artifact %s: %w", descriptor.Digest, ref, err)
+		}
+
+		path := filepath.Join(dir, fileName)
+		if err := writeLayer(fs, layer.Uncompressed, path); err != nil {
+			return nil, fmt.Errorf("failed writing snapshot %s: %w", path, err)
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+func writeLayer(fs afero.Afero, open func() (io.ReadCloser, error), path string) error {
+	reader, err := open()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = reader.Close() }()
+
+	file, err := fs.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	_, err = io.Copy(file, reader)
+	return err
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/utils"
+)
+
+var _ = Describe("OCI", func() {
+	var (
+		ctx       context.Context
+		fs        afero.Afero
+		reference string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		server := httptest.NewServer(registry.New())
+		DeferCleanup(server.Close)
+		reference = strings.TrimPrefix(server.URL, "http://") + "/backups/etcd:latest"
+
+		Expect(fs.WriteFile("/snapshots/etcd-main.db", []byte("main-data"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/snapshots/etcd-events.db", []byte("events-data"), 0600)).To(Succeed())
+	})
+
+	It("should push the snapshots and pull them again", func() {
+		digest, err := PushSnapshots(ctx, fs, reference, []string{"/snapshots/etcd-main.db", "/snapshots/etcd-events.db"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(digest).To(HavePrefix("sha256:"))
+
+		paths, err := PullSnapshots(ctx, fs, reference, "/restore")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(paths).To(Equal([]string{"/restore/etcd-main.db", "/restore/etcd-events.db"}))
+		Expect(fs.ReadFile("/restore/etcd-main.db")).To(BeEquivalentTo("main-data"))
+		Expect(fs.ReadFile("/restore/etcd-events.db")).To(BeEquivalentTo("events-data"))
+	})
+
+	It("should fail pulling an artifact which does not contain snapshots", func() {
+		image, err := random.Image(10, 1)
+		Expect(err).NotTo(HaveOccurred())
+		ref, err := name.ParseReference(reference)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(remote.Write(ref, image)).To(Succeed())
+
+		_, err = PullSnapshots(ctx, fs, reference, "/restore")
+		Expect(err).To(MatchError(ContainSubstring("does not contain etcd snapshots")))
+	})
+
+	It("should fail pushing a snapshot which does not exist", func() {
+		_, err := PushSnapshots(ctx, fs, reference, []string{"/snapshots/foo.db"})
+		Expect(err).To(MatchError(ContainSubstring("failed opening snapshot /snapshots/foo.db")))
+	})
+})
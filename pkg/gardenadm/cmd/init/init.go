@@ -11,7 +11,9 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
@@ -21,6 +23,7 @@ import (
 	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/gardenadm/botanist"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
@@ -63,6 +66,14 @@ gardenadm init --config-dir /path/to/manifests --zone zone-a`,
 }
 
 func run(ctx context.Context, opts *Options) error {
+	if err := opts.RunPreflightChecks(ctx, opts.Out, preflight.Options{
+		FS:           afero.Afero{Fs: botanist.NewFs()},
+		Clock:        clock.RealClock{},
+		ControlPlane: true,
+	}); err != nil {
+		return err
+	}
+
 	b, err := bootstrapControlPlane(ctx, opts)
 	if err != nil {
 		return fmt.Errorf("failed bootstrapping control plane: %w", err)
@@ -20,6 +20,7 @@ import (
 type Options struct {
 	*cmd.Options
 	cmd.ManifestOptions
+	cmd.PreflightOptions
 
 	// UseBootstrapEtcd indicates whether to use the bootstrap etcd instead of transitioning to etcd-druid.
 	UseBootstrapEtcd bool
@@ -42,6 +43,10 @@ func (o *Options) Validate() error {
 		return err
 	}
 
+	if err := o.PreflightOptions.Validate(); err != nil {
+		return err
+	}
+
 	return o.validateZone()
 }
 
@@ -85,6 +90,7 @@ func (o *Options) Complete() error {
 
 func (o *Options) addFlags(fs *pflag.FlagSet) {
 	o.ManifestOptions.AddFlags(fs)
+	o.PreflightOptions.AddFlags(fs)
 	fs.BoolVar(&o.UseBootstrapEtcd, "use-bootstrap-etcd", false, "If set, the control plane continues using the bootstrap etcd instead of transitioning to etcd-druid. This is useful for testing purposes to save time.")
 	fs.StringVarP(&o.Zone, "zone", "z", "", "Availability zone for the new node. Required if the control plane worker pool in the Shoot has multiple zones configured. Optional if exactly one zone is configured (applied automatically). Must not be set if no zones are configured.")
 }
@@ -31,6 +31,7 @@ import (
 	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/gardenadm/botanist"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
 	staticpodtranslator "github.com/gardener/gardener/pkg/gardenadm/staticpod"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	"github.com/gardener/gardener/pkg/nodeagent"
@@ -91,6 +92,16 @@ gardenadm join --bootstrap-token <token> --ca-certificate <ca-cert> --zone zone-
 }
 
 func run(ctx context.Context, opts *Options) error {
+	if err := opts.RunPreflightChecks(ctx, opts.Out, preflight.Options{
+		FS:                   afero.Afero{Fs: botanist.NewFs()},
+		Clock:                clock.RealClock{},
+		ControlPlane:         opts.ControlPlane,
+		ControlPlaneAddress:  opts.ControlPlaneAddress,
+		CertificateAuthority: opts.CertificateAuthority,
+	}); err != nil {
+		return err
+	}
+
 	b, err := botanist.NewGardenadmBotanistWithoutResources(opts.Log)
 	if err != nil {
 		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
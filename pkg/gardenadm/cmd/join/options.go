@@ -16,6 +16,7 @@ import (
 // Options contains options for this command.
 type Options struct {
 	*cmd.Options
+	cmd.PreflightOptions
 
 	// ControlPlaneAddress is the address of the control plane to which the node should be joined.
 	ControlPlaneAddress string
@@ -55,13 +56,14 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("cannot provide a worker pool name when joining a control plane node")
 	}
 
-	return nil
+	return o.PreflightOptions.Validate()
 }
 
 // Complete completes the options.
 func (o *Options) Complete() error { return nil }
 
 func (o *Options) addFlags(fs *pflag.FlagSet) {
+	o.PreflightOptions.AddFlags(fs)
 	fs.BytesBase64Var(&o.CertificateAuthority, "ca-certificate", nil, "Base64-encoded certificate authority bundle of the control plane")
 	fs.StringVar(&o.BootstrapToken, "bootstrap-token", "", "Bootstrap token for joining the cluster (create it with 'gardenadm token' on a control plane node)")
 	fs.StringVarP(&o.WorkerPoolName, "worker-pool-name", "w", "", "Name of the worker pool to assign the joining node.")
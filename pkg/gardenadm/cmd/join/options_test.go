@@ -51,6 +51,13 @@ var _ = Describe("Options", func() {
 
 			Expect(options.Validate()).To(MatchError(ContainSubstring("cannot provide a worker pool name when joining a control plane node")))
 		})
+
+		It("should fail when an unknown preflight check should be ignored", func() {
+			options.BootstrapToken = "some-token"
+			options.IgnorePreflightErrors = []string{"Swap", "Foo"}
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`unknown preflight check "Foo"`)))
+		})
 	})
 
 	Describe("#Complete", func() {
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
+)
+
+// PreflightOptions contains options related to the preflight checks.
+type PreflightOptions struct {
+	// IgnorePreflightErrors are the names of the preflight checks whose errors are ignored.
+	IgnorePreflightErrors []string
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *PreflightOptions) ParseArgs(_ []string) error { return nil }
+
+// Validate validates the options.
+func (o *PreflightOptions) Validate() error {
+	validNames := sets.New(append(preflight.AllChecks, preflight.IgnoreAll)...)
+
+	for _, name := range o.IgnorePreflightErrors {
+		if !validNames.Has(name) {
+			return fmt.Errorf("unknown preflight check %q, must be one of %v", name, sets.List(validNames))
+		}
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *PreflightOptions) Complete() error { return nil }
+
+// AddFlags implements Flagger.AddFlags.
+func (o *PreflightOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&o.IgnorePreflightErrors, "ignore-preflight-errors", nil, "Names of the preflight checks whose errors are shown as warnings instead of failing the command. "+
+		"Use '"+preflight.IgnoreAll+"' to ignore the errors of all checks. Available checks: "+strings.Join(preflight.AllChecks, ", "))
+}
+
+// RunPreflightChecks runs the preflight checks, writes the report to the given writer, and returns an error if a check
+// failed whose error is not ignored.
+func (o *PreflightOptions) RunPreflightChecks(ctx context.Context, w io.Writer, opts preflight.Options) error {
+	report := preflight.Run(ctx, preflight.Checks(opts), sets.New(o.IgnorePreflightErrors...))
+
+	fmt.Fprintln(w, "Running preflight checks:")
+	if err := report.Print(w); err != nil {
+		return fmt.Errorf("failed printing preflight report: %w", err)
+	}
+
+	return report.Err()
+}
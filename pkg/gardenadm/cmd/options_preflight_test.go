@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("PreflightOptions", func() {
+	var (
+		options *PreflightOptions
+	)
+
+	BeforeEach(func() {
+		options = &PreflightOptions{}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should pass for known checks", func() {
+			options.IgnorePreflightErrors = []string{"Swap", "Ports"}
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should pass for 'all'", func() {
+			options.IgnorePreflightErrors = []string{"all"}
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail for unknown checks", func() {
+			options.IgnorePreflightErrors = []string{"Foo"}
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`unknown preflight check "Foo"`)))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+
+	Describe("#RunPreflightChecks", func() {
+		var (
+			buf        *bytes.Buffer
+			checksOpts preflight.Options
+		)
+
+		BeforeEach(func() {
+			buf = &bytes.Buffer{}
+			// All checks reading from the file system fail on an empty file system.
+			checksOpts = preflight.Options{FS: afero.Afero{Fs: afero.NewMemMapFs()}, Clock: testclock.NewFakeClock(time.Now())}
+
+			DeferCleanup(test.WithVars(
+				&preflight.Geteuid, func() int { return 0 },
+				&preflight.WorkerPorts, map[string]int32{},
+			))
+		})
+
+		It("should print the report and fail", func() {
+			Expect(options.RunPreflightChecks(context.Background(), buf, checksOpts)).To(MatchError(ContainSubstring("preflight checks failed: CgroupV2, Swap, KernelModules")))
+			Expect(buf.String()).To(And(
+				ContainSubstring("Running preflight checks:"),
+				MatchRegexp(`Root\s+Passed`),
+				MatchRegexp(`CgroupV2\s+Failed`),
+			))
+		})
+
+		It("should succeed if the errors are ignored", func() {
+			options.IgnorePreflightErrors = []string{"all"}
+
+			Expect(options.RunPreflightChecks(context.Background(), buf, checksOpts)).To(Succeed())
+			Expect(buf.String()).To(MatchRegexp(`CgroupV2\s+Ignored`))
+		})
+	})
+})
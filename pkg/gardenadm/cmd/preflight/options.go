@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+var allOutputFormats = []string{outputTable, outputJSON, outputYAML}
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+	cmd.PreflightOptions
+
+	// ControlPlane indicates whether the checks for control plane nodes should be run.
+	ControlPlane bool
+	// ControlPlaneAddress is the address of the control plane of an existing cluster which the node should join.
+	ControlPlaneAddress string
+	// CertificateAuthority is the CA bundle of the control plane.
+	CertificateAuthority []byte
+	// Output is the format in which the report is printed (one of [table,json,yaml]).
+	Output string
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(args []string) error {
+	return o.PreflightOptions.ParseArgs(args)
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if !slices.Contains(allOutputFormats, o.Output) {
+		return fmt.Errorf("output must be one of %v", allOutputFormats)
+	}
+
+	return o.PreflightOptions.Validate()
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error {
+	return o.PreflightOptions.Complete()
+}
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	o.PreflightOptions.AddFlags(fs)
+	fs.BoolVar(&o.ControlPlane, "control-plane", false, "Run the checks for control plane nodes")
+	fs.StringVar(&o.ControlPlaneAddress, "control-plane-address", "", "Address of the control plane of an existing cluster, used for checking the clock of the machine (optional)")
+	fs.BytesBase64Var(&o.CertificateAuthority, "ca-certificate", nil, "Base64-encoded certificate authority bundle of the control plane")
+	fs.StringVarP(&o.Output, "output", "o", outputTable, fmt.Sprintf("Output format of the report (one of %v)", allOutputFormats))
+}
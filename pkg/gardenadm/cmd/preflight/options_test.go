@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/preflight"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+	)
+
+	BeforeEach(func() {
+		options = &Options{Output: "table"}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should succeed for valid options", func() {
+			options.IgnorePreflightErrors = []string{"Swap"}
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail for an unknown output format", func() {
+			options.Output = "xml"
+			Expect(options.Validate()).To(MatchError("output must be one of [table json yaml]"))
+		})
+
+		It("should fail for an unknown preflight check", func() {
+			options.IgnorePreflightErrors = []string{"Foo"}
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`unknown preflight check "Foo"`)))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "preflight",
+		Short: "Check whether the machine is suitable for becoming a node",
+		Long: `Check whether the machine is suitable for becoming a node of a self-hosted shoot cluster.
+
+The command runs the same preflight checks as "gardenadm init" and "gardenadm join" without changing anything on the
+machine. It verifies that it runs as root, cgroup v2 is enabled, swap is disabled, the required kernel modules are
+available, the ports of the node components are free, the clock is in sync with the control plane, and there is enough
+disk space for etcd on control plane nodes. The command fails if any check fails whose errors are not ignored.`,
+
+		Example: `# Check whether the machine is suitable for becoming the first control plane node
+gardenadm preflight --control-plane
+
+# Check whether the machine is suitable for joining an existing cluster as a worker node
+gardenadm preflight --control-plane-address <control-plane-address> --ca-certificate <ca-cert>
+
+# Print the report as JSON and do not fail because of swap being enabled
+gardenadm preflight --ignore-preflight-errors Swap -o json`,
+
+		Args: cobra.ExactArgs(0),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	report := preflight.Run(ctx, preflight.Checks(preflight.Options{
+		FS:                   afero.Afero{Fs: botanist.NewFs()},
+		Clock:                clock.RealClock{},
+		ControlPlane:         opts.ControlPlane,
+		ControlPlaneAddress:  opts.ControlPlaneAddress,
+		CertificateAuthority: opts.CertificateAuthority,
+	}), sets.New(opts.IgnorePreflightErrors...))
+
+	switch opts.Output {
+	case outputJSON:
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed marshalling report to JSON: %w", err)
+		}
+		fmt.Fprintln(opts.Out, string(data))
+	case outputYAML:
+		data, err := yaml.Marshal(report)
+		if err != nil {
+			return fmt.Errorf("failed marshalling report to YAML: %w", err)
+		}
+		fmt.Fprint(opts.Out, string(data))
+	default:
+		if err := report.Print(opts.Out); err != nil {
+			return fmt.Errorf("failed printing report: %w", err)
+		}
+	}
+
+	return report.Err()
+}
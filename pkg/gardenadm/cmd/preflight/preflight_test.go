@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/preflight"
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
+	"github.com/gardener/gardener/pkg/utils/test"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+)
+
+var _ = Describe("Preflight", func() {
+	var (
+		globalOpts *cmd.Options
+		stdOut     *Buffer
+		command    *cobra.Command
+
+		fs afero.Afero
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{Log: logr.Discard()}
+		globalOpts.IOStreams, _, stdOut, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+		command.SetContext(context.Background())
+
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		Expect(fs.WriteFile("/sys/fs/cgroup/cgroup.controllers", []byte("cpu memory"), 0644)).To(Succeed())
+		Expect(fs.WriteFile("/proc/swaps", []byte("Filename\tType\tSize\tUsed\tPriority\n/swap.img\tfile\t1024\t0\t-2\n"), 0644)).To(Succeed())
+		Expect(fs.MkdirAll("/sys/module/overlay", 0755)).To(Succeed())
+		Expect(fs.MkdirAll("/sys/module/br_netfilter", 0755)).To(Succeed())
+
+		DeferCleanup(test.WithVars(
+			&botanist.NewFs, func() afero.Fs { return fs },
+			&preflight.Geteuid, func() int { return 0 },
+			&preflight.WorkerPorts, map[string]int32{},
+		))
+	})
+
+	Describe("#RunE", func() {
+		It("should print the report and fail if a check fails", func() {
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("preflight checks failed: Swap")))
+
+			Eventually(stdOut).Should(Say(`CHECK\s+STATUS\s+MESSAGE`))
+			Eventually(stdOut).Should(Say(`Root\s+Passed`))
+			Eventually(stdOut).Should(Say(`Swap\s+Failed\s+swap is enabled on /swap.img`))
+			Eventually(stdOut).Should(Say(`EtcdDiskSpace\s+Skipped\s+etcd only runs on control plane nodes`))
+		})
+
+		It("should succeed if the errors of the failed checks are ignored", func() {
+			Expect(command.Flags().Set("ignore-preflight-errors", "Swap")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+			Eventually(stdOut).Should(Say(`Swap\s+Ignored`))
+		})
+
+		It("should print the report as JSON", func() {
+			Expect(command.Flags().Set("ignore-preflight-errors", "all")).To(Succeed())
+			Expect(command.Flags().Set("output", "json")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+			Eventually(stdOut).Should(Say(`"name": "Swap",
+\s+"status": "Ignored",
+\s+"message": "swap is enabled on /swap.img`))
+		})
+
+		It("should print the report as YAML", func() {
+			Expect(command.Flags().Set("ignore-preflight-errors", "all")).To(Succeed())
+			Expect(command.Flags().Set("output", "yaml")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+			Eventually(stdOut).Should(Say(`- name: Root
+  status: Passed
+`))
+		})
+	})
+})
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+
+	// DryRun indicates whether the command should only print what it would remove without changing anything.
+	DryRun bool
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs([]string) error { return nil }
+
+// Validate validates the options.
+func (o *Options) Validate() error { return nil }
+
+// Complete completes the options.
+func (o *Options) Complete() error { return nil }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.DryRun, "dry-run", false, "Only print the node, systemd units, files, and directories which would be removed without changing anything")
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/nodeagent"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "reset",
+		Short: "Reset a node by removing everything gardenadm init or gardenadm join set up",
+		Long: `Reset a node by removing everything "gardenadm init" or "gardenadm join" set up on it.
+
+The command stops and disables gardener-node-agent, the kubelet, and all systemd units created by gardener-node-agent.
+Like "kubeadm reset", it then stops and removes all containers and unmounts all file systems mounted below
+/var/lib/kubelet (e.g., volumes of pods), before it removes the unit files, the files written by gardener-node-agent,
+the static pod manifests, the kubelet and gardener-node-agent state, the containerd images, and the etcd data
+directories. The units and files are determined from the last OperatingSystemConfig applied by gardener-node-agent.
+
+If the cluster is still reachable via the admin kubeconfig of the node, the Node object is deleted from the cluster
+before the node is reset. Note that etcd members of control plane nodes are not removed from the etcd cluster.`,
+
+		Example: `# Print what would be removed without changing anything
+gardenadm reset --dry-run
+
+# Reset the node
+gardenadm reset`,
+
+		Args: cobra.ExactArgs(0),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+// NewBotanist creates the botanist for resetting the node.
+// Exposed for unit testing.
+var NewBotanist = botanist.NewGardenadmBotanistWithoutResources
+
+// CreateClientSet creates a client set for the cluster the node belongs to.
+// Exposed for unit testing.
+var CreateClientSet = func(_ context.Context, _ logr.Logger) (kubernetes.Interface, error) {
+	pathKubeconfig := botanist.PathKubeconfig
+	if path := os.Getenv("KUBECONFIG"); path != "" {
+		pathKubeconfig = path
+	}
+
+	return botanist.NewClientSetFromFile(pathKubeconfig, kubernetes.SeedScheme)
+}
+
+func run(ctx context.Context, opts *Options) error {
+	b, err := NewBotanist(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	actions, err := b.ComputeResetActions()
+	if err != nil {
+		return fmt.Errorf("failed computing what to remove from the node: %w", err)
+	}
+
+	// The node must be deleted before the units are stopped, because the kube-apiserver is no longer reachable afterwards
+	// if it runs on this node.
+	c, node := fetchNode(ctx, opts, b.HostName)
+
+	if opts.DryRun {
+		if node != nil {
+			fmt.Fprintf(opts.Out, "Node %q would be deleted from the cluster.\n", node.Name)
+		}
+		actions.Print(opts.Out)
+		fmt.Fprintln(opts.Out, "\nThis was a dry run, nothing was changed.")
+		return nil
+	}
+
+	if node != nil {
+		opts.Log.Info("Deleting node from the cluster", "node", node.Name)
+		if err := c.Delete(ctx, node); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting node %s: %w", node.Name, err)
+		}
+	}
+
+	if err := b.Reset(ctx, actions); err != nil {
+		return fmt.Errorf("failed resetting node: %w", err)
+	}
+
+	fmt.Fprintln(opts.Out, "Your node was reset successfully!")
+	return nil
+}
+
+// fetchNode returns the Node object of this machine if the cluster is reachable. Otherwise, it only logs why the node
+// cannot be deleted, since resetting the node must also work if the cluster is gone or was never set up completely.
+func fetchNode(ctx context.Context, opts *Options, hostName string) (client.Client, *corev1.Node) {
+	clientSet, err := CreateClientSet(ctx, opts.Log)
+	if err != nil {
+		opts.Log.Info("Cluster is not reachable, the node is not deleted from it (delete it manually if required)", "error", err.Error())
+		return nil, nil
+	}
+
+	node, err := nodeagent.FetchNodeByHostName(ctx, clientSet.Client(), hostName)
+	if err != nil {
+		opts.Log.Info("Failed fetching node from the cluster, it is not deleted from it (delete it manually if required)", "error", err.Error())
+		return nil, nil
+	}
+	if node == nil {
+		opts.Log.Info("Node is not registered in the cluster", "hostName", hostName)
+		return nil, nil
+	}
+
+	return clientSet.Client(), node
+}
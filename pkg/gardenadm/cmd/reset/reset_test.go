@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/reset"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reset", func() {
+	var (
+		ctx        context.Context
+		globalOpts *cmd.Options
+		stdOut     *Buffer
+		command    *cobra.Command
+
+		fs         afero.Afero
+		fakeDBus   *fakedbus.DBus
+		fakeClient client.Client
+		node       *corev1.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		globalOpts = &cmd.Options{Log: logr.Discard()}
+		globalOpts.IOStreams, _, stdOut, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+		command.SetContext(ctx)
+
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		Expect(fs.MkdirAll("/var/lib/kubelet", 0755)).To(Succeed())
+		fakeDBus = fakedbus.New()
+
+		DeferCleanup(test.WithVar(&NewBotanist, func(log logr.Logger) (*botanist.GardenadmBotanist, error) {
+			return &botanist.GardenadmBotanist{
+				Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{Logger: log}},
+				HostName: "machine-0",
+				DBus:     fakeDBus,
+				FS:       fs,
+			}, nil
+		}))
+
+		DeferCleanup(test.WithVar(&botanist.UnmountAllBelow, func(string) error { return nil }))
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		DeferCleanup(test.WithVar(&CreateClientSet, func(context.Context, logr.Logger) (kubernetes.Interface, error) {
+			return fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(), nil
+		}))
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-0", Labels: map[string]string{corev1.LabelHostname: "machine-0"}}}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+	})
+
+	Describe("#RunE", func() {
+		It("should only print what would be removed in dry-run mode", func() {
+			Expect(command.Flags().Set("dry-run", "true")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say(`Node "node-0" would be deleted from the cluster.`))
+			Eventually(stdOut).Should(Say(`  - /var/lib/kubelet`))
+			Eventually(stdOut).Should(Say("This was a dry run, nothing was changed."))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(fs.DirExists("/var/lib/kubelet")).To(BeTrue())
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+
+		It("should delete the node and reset the machine", func() {
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say("Your node was reset successfully!"))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(BeNotFoundError())
+			Expect(fs.DirExists("/var/lib/kubelet")).To(BeFalse())
+			Expect(fakeDBus.Actions).NotTo(BeEmpty())
+		})
+
+		It("should reset the machine if the cluster is not reachable", func() {
+			DeferCleanup(test.WithVar(&CreateClientSet, func(context.Context, logr.Logger) (kubernetes.Interface, error) {
+				return nil, fmt.Errorf("fake")
+			}))
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say("Your node was reset successfully!"))
+			Expect(fs.DirExists("/var/lib/kubelet")).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-base/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/gardenadm"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	tokenutils "github.com/gardener/gardener/pkg/gardenadm/cmd/token/utils"
+	upgradeutils "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/utils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Upgrade the cluster to the versions computed by the upgrade plan",
+		Long: `The "apply" command upgrades the cluster to the Kubernetes version specified in the Shoot manifest and to the version
+of gardenadm. It refuses to run if the upgrade plan has blockers (see "gardenadm upgrade plan").
+
+The control plane components are re-rendered as static pods and rolled out to the control plane nodes one at a time.
+The next node is only upgraded once the previous one is healthy again. Afterwards, the worker nodes are upgraded one
+at a time via the in-place update mechanism of gardener-node-agent.`,
+
+		Example: `# Upgrade the cluster using the config directory stored by gardenadm init
+gardenadm upgrade apply
+
+# Upgrade the cluster using updated manifests
+gardenadm upgrade apply --config-dir /path/to/manifests`,
+
+		Args: cobra.ExactArgs(0),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	clientSet, err := tokenutils.CreateClientSet(ctx, opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating client set: %w", err)
+	}
+
+	resources, err := gardenadm.ReadManifests(opts.Log, os.DirFS(opts.ConfigDir))
+	if err != nil {
+		return fmt.Errorf("failed reading Kubernetes resources from config directory %s: %w", opts.ConfigDir, err)
+	}
+
+	plan, err := upgradeutils.ComputePlan(ctx, clientSet.Client(), clientSet.Version(), resources, version.Get().GitVersion)
+	if err != nil {
+		return fmt.Errorf("failed computing upgrade plan: %w", err)
+	}
+
+	if err := plan.Print(opts.Out); err != nil {
+		return fmt.Errorf("failed printing upgrade plan: %w", err)
+	}
+	fmt.Fprintln(opts.Out)
+
+	if !plan.Upgradable() {
+		return fmt.Errorf("the upgrade is blocked, resolve the issues listed above and retry")
+	}
+
+	b, err := botanist.NewGardenadmBotanistFromManifests(ctx, opts.Log, clientSet, opts.ConfigDir, true)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+	// The new OperatingSystemConfig must only be applied by gardener-node-agent once the respective node is upgraded,
+	// see addUpgradeNodeTask. Otherwise, all nodes would roll at the same time.
+	b.Shoot.NodeAgentReadyForUpdateRequired = true
+
+	etcdManagedByDruid, err := isEtcdManagedByDruid(ctx, clientSet.Client())
+	if err != nil {
+		return fmt.Errorf("failed checking whether etcd is managed by etcd-druid: %w", err)
+	}
+
+	var (
+		g = flow.NewGraph("upgrade")
+
+		reconcileCustomResourceDefinitions = g.Add(flow.Task{
+			Name: "Reconciling CustomResourceDefinitions",
+			Fn:   b.ReconcileCustomResourceDefinitions,
+		})
+		ensureCustomResourceDefinitionsReady = g.Add(flow.Task{
+			Name:         "Ensuring CustomResourceDefinitions are ready",
+			Fn:           flow.TaskFn(b.EnsureCustomResourceDefinitionsReady).RetryUntilTimeout(time.Second, time.Minute),
+			Dependencies: flow.NewTaskIDs(reconcileCustomResourceDefinitions),
+		})
+		reconcileClusterResource = g.Add(flow.Task{
+			Name: "Reconciling extensions.gardener.cloud/v1alpha1.Cluster resource",
+			Fn: func(ctx context.Context) error {
+				return gardenerextensions.SyncClusterResourceToSeed(ctx, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, b.Shoot.GetInfo(), b.Shoot.CloudProfile, b.Seed.GetInfo())
+			},
+			Dependencies: flow.NewTaskIDs(ensureCustomResourceDefinitionsReady),
+		})
+		initializeSecretsManagement = g.Add(flow.Task{
+			Name:         "Initializing internal state of Gardener secrets manager",
+			Fn:           b.InitializeSecretsManagement,
+			Dependencies: flow.NewTaskIDs(reconcileClusterResource),
+		})
+		deployGardenerResourceManager = g.Add(flow.Task{
+			Name: "Deploying gardener-resource-manager",
+			Fn: func(ctx context.Context) error {
+				b.Components.RuntimeResourceManager.SetBootstrapControlPlaneNode(false)
+				b.Shoot.Components.ControlPlane.ResourceManager.SetBootstrapControlPlaneNode(false)
+
+				return flow.Parallel(
+					b.Components.RuntimeResourceManager.Deploy,
+					b.Shoot.Components.ControlPlane.ResourceManager.Deploy,
+				)(ctx)
+			},
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
+		})
+		waitUntilGardenerResourceManagerReady = g.Add(flow.Task{
+			Name: "Waiting until gardener-resource-manager reports readiness",
+			Fn: flow.Parallel(
+				b.Components.RuntimeResourceManager.Wait,
+				b.Shoot.Components.ControlPlane.ResourceManager.Wait,
+			),
+			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager),
+		})
+		deployExtensionControllers = g.Add(flow.Task{
+			Name: "Deploying extension controllers",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return b.ReconcileExtensionControllerInstallations(ctx, false)
+			}).RetryUntilTimeout(5*time.Second, 30*time.Second),
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
+		})
+		waitUntilExtensionControllersReady = g.Add(flow.Task{
+			Name:         "Waiting until extension controllers report readiness",
+			Fn:           b.WaitUntilExtensionControllerInstallationsHealthy,
+			Dependencies: flow.NewTaskIDs(deployExtensionControllers),
+		})
+		deployEtcdDruid = g.Add(flow.Task{
+			Name:         "Deploying ETCD Druid",
+			Fn:           b.DeployEtcdDruid,
+			SkipIf:       !etcdManagedByDruid,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
+		})
+		waitUntilEtcdsReady = g.Add(flow.Task{
+			Name:         "Waiting until main and event ETCDs are ready",
+			Fn:           b.WaitUntilEtcdsReconciled,
+			SkipIf:       !etcdManagedByDruid,
+			Dependencies: flow.NewTaskIDs(deployEtcdDruid),
+		})
+		deployControlPlane = g.Add(flow.Task{
+			Name:         "Deploying shoot control plane components",
+			Fn:           b.DeployControlPlane,
+			Dependencies: flow.NewTaskIDs(waitUntilExtensionControllersReady),
+		})
+		waitUntilControlPlaneReady = g.Add(flow.Task{
+			Name:         "Waiting until shoot control plane has been reconciled",
+			Fn:           b.Shoot.Components.Extensions.ControlPlane.Wait,
+			Dependencies: flow.NewTaskIDs(deployControlPlane),
+		})
+		deployControlPlaneDeployments = g.Add(flow.Task{
+			Name:         "Re-rendering static control plane pods and updating gardener-node-agent Secret",
+			Fn:           b.DeployControlPlaneDeployments,
+			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneReady, waitUntilEtcdsReady),
+		})
+		syncPoint = deployControlPlaneDeployments
+	)
+
+	// The nodes are upgraded one at a time, i.e., each node depends on the previous one. The control plane nodes are
+	// upgraded first so that the kube-apiserver is never older than the kubelets.
+	for _, node := range plan.Nodes {
+		if !node.ControlPlane {
+			continue
+		}
+		syncPoint = addUpgradeNodeTask(g, b, node, syncPoint)
+	}
+
+	// A lot of health checks rely on the kube-controller-manager being active, hence we wait for it before upgrading the
+	// worker nodes.
+	syncPoint = g.Add(flow.Task{
+		Name: "Waiting until kube-controller-manager is active",
+		Fn: flow.TaskFn(func(ctx context.Context) error {
+			b.Shoot.Components.ControlPlane.KubeControllerManager.SetShootClient(b.SeedClientSet.Client())
+			return b.Shoot.Components.ControlPlane.KubeControllerManager.WaitForControllerToBeActive(ctx)
+		}).RetryUntilTimeout(time.Second, 5*time.Minute),
+		Dependencies: flow.NewTaskIDs(syncPoint),
+	})
+
+	for _, node := range plan.Nodes {
+		if node.ControlPlane {
+			continue
+		}
+		syncPoint = addUpgradeNodeTask(g, b, node, syncPoint)
+	}
+
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log: opts.Log,
+	}); err != nil {
+		return flow.Errors(err)
+	}
+
+	fmt.Fprintf(opts.Out, "Your cluster was upgraded successfully to Kubernetes version %s and Gardener version %s!\n", plan.KubernetesVersion.Target, plan.GardenerVersion.Target)
+	return nil
+}
+
+func addUpgradeNodeTask(g *flow.Graph, b *botanist.GardenadmBotanist, node upgradeutils.NodePlan, dependency flow.TaskID) flow.TaskID {
+	return g.Add(flow.Task{
+		Name: "Upgrading node " + node.Name,
+		Fn: func(ctx context.Context) error {
+			return b.UpgradeNode(ctx, node.Name, node.ControlPlane)
+		},
+		Dependencies: flow.NewTaskIDs(dependency),
+	})
+}
+
+// isEtcdManagedByDruid checks whether `gardenadm init` has transitioned the control plane from the bootstrap etcds to
+// the etcds managed by etcd-druid, i.e., whether it was run without the --use-bootstrap-etcd flag.
+func isEtcdManagedByDruid(ctx context.Context, c client.Reader) (bool, error) {
+	if err := c.Get(ctx, client.ObjectKey{Name: v1beta1constants.ETCDMain, Namespace: metav1.NamespaceSystem}, &druidcorev1alpha1.Etcd{}); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apply_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/spf13/cobra"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	tokenutils "github.com/gardener/gardener/pkg/gardenadm/cmd/token/utils"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/apply"
+	"github.com/gardener/gardener/pkg/utils/test"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+)
+
+var _ = Describe("Apply", func() {
+	var (
+		globalOpts *cmd.Options
+		stdOut     *Buffer
+		command    *cobra.Command
+
+		apiServerVersion string
+		configDir        string
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{Log: logr.Discard()}
+		globalOpts.IOStreams, _, stdOut, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+
+		apiServerVersion = "v1.33.4"
+		DeferCleanup(test.WithVar(&tokenutils.CreateClientSet, func(context.Context, logr.Logger) (kubernetes.Interface, error) {
+			return fakekubernetes.NewClientSetBuilder().
+				WithClient(fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()).
+				WithVersion(apiServerVersion).
+				Build(), nil
+		}))
+
+		configDir = GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(configDir, "manifests.yaml"), []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: CloudProfile
+metadata:
+  name: local
+spec:
+  type: local
+  kubernetes:
+    versions:
+    - version: 1.34.1
+---
+apiVersion: core.gardener.cloud/v1beta1
+kind: Project
+metadata:
+  name: garden
+spec:
+  namespace: garden
+---
+apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: root
+  namespace: garden
+spec:
+  kubernetes:
+    version: 1.34.1
+  provider:
+    type: local
+    workers:
+    - name: control-plane
+      controlPlane: {}
+`), 0600)).To(Succeed())
+		Expect(command.Flags().Set("config-dir", configDir)).To(Succeed())
+	})
+
+	Describe("#RunE", func() {
+		It("should refuse to apply a blocked upgrade", func() {
+			apiServerVersion = "v1.35.0"
+
+			Expect(command.RunE(command, nil)).To(MatchError("the upgrade is blocked, resolve the issues listed above and retry"))
+
+			Eventually(stdOut).Should(Say(`The upgrade is blocked:
+  - downgrading Kubernetes is not supported \(current version is v1.35.0, target version is 1.34.1\)`))
+		})
+	})
+})
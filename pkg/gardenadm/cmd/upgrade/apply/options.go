@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+	cmd.ManifestOptions
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(args []string) error {
+	return o.ManifestOptions.ParseArgs(args)
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if len(o.ConfigDir) == 0 {
+		// `gardenadm init` stores the path of the config directory in the cmd.ConfigDirLocation file on the machine's
+		// file system. Hence, we can default it to this location if the user does not explicitly provide us with the
+		// config directory.
+		data, err := os.ReadFile(cmd.ConfigDirLocation)
+		if err != nil {
+			return fmt.Errorf("error reading config dir location file %s: %w", cmd.ConfigDirLocation, err)
+		}
+		o.ConfigDir = string(data)
+	}
+
+	return o.ManifestOptions.Validate()
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return o.ManifestOptions.Complete() }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	o.ManifestOptions.AddFlags(fs)
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/component-base/version"
+
+	"github.com/gardener/gardener/pkg/gardenadm"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	tokenutils "github.com/gardener/gardener/pkg/gardenadm/cmd/token/utils"
+	upgradeutils "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/utils"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Check which versions the cluster can be upgraded to",
+		Long: `The "plan" command compares the versions currently running in the cluster with the Kubernetes version specified in
+the Shoot manifest and the version of gardenadm. It prints the planned version changes for the control plane and for
+each node, and lists the reasons that block the upgrade, e.g., downgrades or skipped minor versions.`,
+
+		Example: `# Check the upgrade plan using the config directory stored by gardenadm init
+gardenadm upgrade plan
+
+# Check the upgrade plan using updated manifests
+gardenadm upgrade plan --config-dir /path/to/manifests`,
+
+		Args: cobra.ExactArgs(0),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	clientSet, err := tokenutils.CreateClientSet(ctx, opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating client set: %w", err)
+	}
+
+	resources, err := gardenadm.ReadManifests(opts.Log, os.DirFS(opts.ConfigDir))
+	if err != nil {
+		return fmt.Errorf("failed reading Kubernetes resources from config directory %s: %w", opts.ConfigDir, err)
+	}
+
+	plan, err := upgradeutils.ComputePlan(ctx, clientSet.Client(), clientSet.Version(), resources, version.Get().GitVersion)
+	if err != nil {
+		return fmt.Errorf("failed computing upgrade plan: %w", err)
+	}
+
+	if err := plan.Print(opts.Out); err != nil {
+		return fmt.Errorf("failed printing upgrade plan: %w", err)
+	}
+
+	switch {
+	case !plan.Upgradable():
+		fmt.Fprintln(opts.Out, "\nResolve the issues above before upgrading the cluster.")
+	case !plan.HasChanges():
+		fmt.Fprintln(opts.Out, "\nYour cluster is up-to-date.")
+	default:
+		fmt.Fprintf(opts.Out, "\nYou can now apply the upgrade by running the following command on a control plane node:\n\n  gardenadm upgrade apply --config-dir %s\n", opts.ConfigDir)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/apply"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/plan"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade the self-hosted shoot cluster to a new Kubernetes or Gardener version",
+		Long:  "Upgrade the self-hosted shoot cluster to a new Kubernetes or Gardener version",
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	cmd.AddCommand(plan.NewCommand(globalOpts))
+	cmd.AddCommand(apply.NewCommand(globalOpts))
+
+	return cmd
+}
@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/Masterminds/semver/v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/printers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/gardenadm"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+)
+
+// maxKubeletMinorVersionSkew is the maximum number of minor versions the kubelet may be older than the
+// kube-apiserver, see https://kubernetes.io/releases/version-skew-policy/#kubelet.
+const maxKubeletMinorVersionSkew = 3
+
+// VersionChange is the change from the current to the target version of a component.
+type VersionChange struct {
+	// Current is the currently running version. It is empty if it is unknown.
+	Current string
+	// Target is the version after the upgrade.
+	Target string
+}
+
+// Changed returns true if the target version differs from the current version.
+func (v VersionChange) Changed() bool {
+	return versionutils.Normalize(v.Current) != versionutils.Normalize(v.Target)
+}
+
+func (v VersionChange) current() string {
+	if v.Current == "" {
+		return "<unknown>"
+	}
+	return v.Current
+}
+
+// NodePlan is the planned upgrade of a single node.
+type NodePlan struct {
+	// Name is the name of the node.
+	Name string
+	// ControlPlane is true if the node belongs to the control plane worker pool.
+	ControlPlane bool
+	// WorkerPool is the name of the worker pool the node belongs to.
+	WorkerPool string
+	// KubeletVersion is the change of the kubelet version on the node.
+	KubeletVersion VersionChange
+}
+
+// Plan is the planned upgrade of a self-hosted shoot cluster.
+type Plan struct {
+	// KubernetesVersion is the change of the Kubernetes version of the control plane.
+	KubernetesVersion VersionChange
+	// GardenerVersion is the change of the Gardener version the cluster is managed with.
+	GardenerVersion VersionChange
+	// Nodes contains the planned upgrade for each node. Control plane nodes come first as they are upgraded first.
+	Nodes []NodePlan
+	// Blockers contains the reasons why the upgrade cannot be applied.
+	Blockers []string
+}
+
+// Upgradable returns true if there are no reasons which block the upgrade.
+func (p *Plan) Upgradable() bool {
+	return len(p.Blockers) == 0
+}
+
+// HasChanges returns true if any of the versions in the plan changes.
+func (p *Plan) HasChanges() bool {
+	return p.KubernetesVersion.Changed() || p.GardenerVersion.Changed() || slices.ContainsFunc(p.Nodes, func(node NodePlan) bool {
+		return node.KubeletVersion.Changed()
+	})
+}
+
+// ComputePlan computes the upgrade plan for the cluster from the currently running versions to the versions specified
+// in the given resources. The target Gardener version is the version of gardenadm itself.
+func ComputePlan(ctx context.Context, c client.Reader, apiServerVersion string, resources gardenadm.Resources, gardenerVersion string) (*Plan, error) {
+	if resources.Shoot == nil {
+		return nil, fmt.Errorf("shoot resource is missing in the manifests")
+	}
+
+	plan := &Plan{
+		KubernetesVersion: VersionChange{Current: apiServerVersion, Target: resources.Shoot.Spec.Kubernetes.Version},
+		GardenerVersion:   VersionChange{Target: gardenerVersion},
+	}
+
+	targetKubernetesVersion, err := semver.NewVersion(plan.KubernetesVersion.Target)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing target Kubernetes version %q: %w", plan.KubernetesVersion.Target, err)
+	}
+
+	blockers, err := checkVersionSkew("Kubernetes", plan.KubernetesVersion)
+	if err != nil {
+		return nil, err
+	}
+	plan.Blockers = append(plan.Blockers, blockers...)
+
+	if resources.CloudProfile != nil {
+		if exists, _, err := v1beta1helper.KubernetesVersionExistsInCloudProfile(resources.CloudProfile, plan.KubernetesVersion.Target); err != nil {
+			return nil, fmt.Errorf("failed checking whether Kubernetes version %s exists in CloudProfile: %w", plan.KubernetesVersion.Target, err)
+		} else if !exists {
+			plan.Blockers = append(plan.Blockers, fmt.Sprintf("Kubernetes version %s is not offered by CloudProfile %s", plan.KubernetesVersion.Target, resources.CloudProfile.Name))
+		}
+	}
+
+	// `gardenadm init` stores the shoot in the Cluster resource, hence it reflects the Gardener version the cluster is
+	// currently managed with.
+	cluster, err := gardenerextensions.GetCluster(ctx, c, metav1.NamespaceSystem)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed reading Cluster resource: %w", err)
+	}
+	if cluster != nil && cluster.Shoot != nil {
+		plan.GardenerVersion.Current = cluster.Shoot.Status.Gardener.Version
+	}
+
+	if plan.GardenerVersion.Current != "" {
+		blockers, err := checkVersionSkew("Gardener", plan.GardenerVersion)
+		if err != nil {
+			return nil, err
+		}
+		plan.Blockers = append(plan.Blockers, blockers...)
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := c.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	for _, node := range nodeList.Items {
+		poolName := node.Labels[v1beta1constants.LabelWorkerPool]
+
+		idx := slices.IndexFunc(resources.Shoot.Spec.Provider.Workers, func(worker gardencorev1beta1.Worker) bool {
+			return worker.Name == poolName
+		})
+		if idx == -1 {
+			plan.Blockers = append(plan.Blockers, fmt.Sprintf("node %s belongs to worker pool %q which is not part of the Shoot manifest", node.Name, poolName))
+			continue
+		}
+		worker := resources.Shoot.Spec.Provider.Workers[idx]
+
+		kubeletVersion, err := v1beta1helper.CalculateEffectiveKubernetesVersion(targetKubernetesVersion, worker.Kubernetes)
+		if err != nil {
+			return nil, fmt.Errorf("failed calculating Kubernetes version for worker pool %s: %w", worker.Name, err)
+		}
+
+		nodePlan := NodePlan{
+			Name:           node.Name,
+			ControlPlane:   worker.ControlPlane != nil,
+			WorkerPool:     worker.Name,
+			KubeletVersion: VersionChange{Current: node.Status.NodeInfo.KubeletVersion, Target: kubeletVersion.String()},
+		}
+		plan.Nodes = append(plan.Nodes, nodePlan)
+
+		if kubeletVersion.GreaterThan(targetKubernetesVersion) {
+			plan.Blockers = append(plan.Blockers, fmt.Sprintf("kubelet version %s of worker pool %s must not be newer than the Kubernetes version %s of the control plane", kubeletVersion, worker.Name, targetKubernetesVersion))
+		} else if targetKubernetesVersion.Minor()-kubeletVersion.Minor() > maxKubeletMinorVersionSkew {
+			plan.Blockers = append(plan.Blockers, fmt.Sprintf("kubelet version %s of worker pool %s must not be more than %d minor versions older than the Kubernetes version %s of the control plane", kubeletVersion, worker.Name, maxKubeletMinorVersionSkew, targetKubernetesVersion))
+		}
+	}
+
+	slices.SortFunc(plan.Nodes, func(a, b NodePlan) int {
+		if a.ControlPlane != b.ControlPlane {
+			if a.ControlPlane {
+				return -1
+			}
+			return 1
+		}
+		if c := cmp.Compare(a.WorkerPool, b.WorkerPool); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return plan, nil
+}
+
+// checkVersionSkew returns the reasons why the given version change violates the version skew policy, i.e., whether
+// it is a downgrade or skips a minor version.
+func checkVersionSkew(component string, change VersionChange) ([]string, error) {
+	current, err := semver.NewVersion(versionutils.Normalize(change.Current))
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing current %s version %q: %w", component, change.Current, err)
+	}
+	target, err := semver.NewVersion(versionutils.Normalize(change.Target))
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing target %s version %q: %w", component, change.Target, err)
+	}
+
+	if target.LessThan(current) {
+		return []string{fmt.Sprintf("downgrading %s is not supported (current version is %s, target version is %s)", component, change.Current, change.Target)}, nil
+	}
+
+	if minorVersionSkew := current.IncMinor().IncMinor(); !target.LessThan(&minorVersionSkew) {
+		return []string{fmt.Sprintf("skipping %s minor versions is not supported (current version is %s, target version is %s), please upgrade one minor version at a time", component, change.Current, change.Target)}, nil
+	}
+
+	return nil, nil
+}
+
+// Print writes a human-readable representation of the plan to the given writer.
+func (p *Plan) Print(w io.Writer) error {
+	tw := printers.GetNewTabWriter(w)
+
+	fmt.Fprintln(tw, "COMPONENT\tCURRENT\tTARGET")
+	fmt.Fprintf(tw, "Kubernetes\t%s\t%s\n", p.KubernetesVersion.current(), p.KubernetesVersion.Target)
+	fmt.Fprintf(tw, "Gardener\t%s\t%s\n", p.GardenerVersion.current(), p.GardenerVersion.Target)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(p.Nodes) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(tw, "NODE\tROLE\tWORKER POOL\tKUBELET CURRENT\tKUBELET TARGET")
+		for _, node := range p.Nodes {
+			role := "worker"
+			if node.ControlPlane {
+				role = "control-plane"
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", node.Name, role, node.WorkerPool, node.KubeletVersion.current(), node.KubeletVersion.Target)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if !p.Upgradable() {
+		fmt.Fprintln(w, "\nThe upgrade is blocked:")
+		for _, blocker := range p.Blockers {
+			fmt.Fprintf(w, "  - %s\n", blocker)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"bytes"
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/utils"
+)
+
+var _ = Describe("Plan", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+
+		resources gardenadm.Resources
+	)
+
+	newNode := func(name, pool, kubeletVersion string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"worker.gardener.cloud/pool": pool}},
+			Status:     corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{KubeletVersion: kubeletVersion}},
+		}
+	}
+
+	createCluster := func(gardenerVersion string) {
+		shoot := &gardencorev1beta1.Shoot{
+			TypeMeta: metav1.TypeMeta{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Shoot"},
+			Status:   gardencorev1beta1.ShootStatus{Gardener: gardencorev1beta1.Gardener{Version: gardenerVersion}},
+		}
+		raw, err := runtime.Encode(kubernetes.GardenCodec.LegacyCodec(gardencorev1beta1.SchemeGroupVersion), shoot)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Create(ctx, &extensionsv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-system"},
+			Spec:       extensionsv1alpha1.ClusterSpec{Shoot: runtime.RawExtension{Raw: raw}},
+		})).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		resources = gardenadm.Resources{
+			CloudProfile: &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "local"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					Kubernetes: gardencorev1beta1.KubernetesSettings{Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.33.4"}, {Version: "1.34.1"}}},
+				},
+			},
+			Shoot: &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.34.1"},
+					Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+						{Name: "control-plane", ControlPlane: &gardencorev1beta1.WorkerControlPlane{}},
+						{Name: "worker", Kubernetes: &gardencorev1beta1.WorkerKubernetes{Version: ptr.To("1.33.4")}},
+					}},
+				},
+			},
+		}
+	})
+
+	Describe("#ComputePlan", func() {
+		It("should compute the plan for a minor version upgrade", func() {
+			createCluster("v1.139.0")
+			Expect(fakeClient.Create(ctx, newNode("worker-0", "worker", "v1.33.4"))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newNode("control-plane-1", "control-plane", "v1.33.4"))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newNode("control-plane-0", "control-plane", "v1.33.4"))).To(Succeed())
+
+			plan, err := ComputePlan(ctx, fakeClient, "v1.33.4", resources, "v1.140.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(Equal(&Plan{
+				KubernetesVersion: VersionChange{Current: "v1.33.4", Target: "1.34.1"},
+				GardenerVersion:   VersionChange{Current: "v1.139.0", Target: "v1.140.0"},
+				Nodes: []NodePlan{
+					{Name: "control-plane-0", ControlPlane: true, WorkerPool: "control-plane", KubeletVersion: VersionChange{Current: "v1.33.4", Target: "1.34.1"}},
+					{Name: "control-plane-1", ControlPlane: true, WorkerPool: "control-plane", KubeletVersion: VersionChange{Current: "v1.33.4", Target: "1.34.1"}},
+					{Name: "worker-0", WorkerPool: "worker", KubeletVersion: VersionChange{Current: "v1.33.4", Target: "1.33.4"}},
+				},
+			}))
+			Expect(plan.Upgradable()).To(BeTrue())
+			Expect(plan.HasChanges()).To(BeTrue())
+			Expect(plan.Nodes[2].KubeletVersion.Changed()).To(BeFalse())
+		})
+
+		It("should report no changes if the cluster is up-to-date", func() {
+			createCluster("v1.140.0")
+			resources.Shoot.Spec.Kubernetes.Version = "1.33.4"
+
+			plan, err := ComputePlan(ctx, fakeClient, "v1.33.4", resources, "v1.140.0-dev")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Upgradable()).To(BeTrue())
+			Expect(plan.HasChanges()).To(BeFalse())
+		})
+
+		It("should tolerate an unknown Gardener version", func() {
+			plan, err := ComputePlan(ctx, fakeClient, "v1.33.4", resources, "v1.140.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.GardenerVersion).To(Equal(VersionChange{Target: "v1.140.0"}))
+			Expect(plan.Upgradable()).To(BeTrue())
+		})
+
+		It("should block downgrades", func() {
+			createCluster("v1.141.0")
+
+			plan, err := ComputePlan(ctx, fakeClient, "v1.35.0", resources, "v1.140.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Blockers).To(ConsistOf(
+				"downgrading Kubernetes is not supported (current version is v1.35.0, target version is 1.34.1)",
+				"downgrading Gardener is not supported (current version is v1.141.0, target version is v1.140.0)",
+			))
+		})
+
+		It("should block skipping minor versions", func() {
+			createCluster("v1.138.2")
+
+			plan, err := ComputePlan(ctx, fakeClient, "v1.32.0", resources, "v1.140.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Blockers).To(ConsistOf(
+				"skipping Kubernetes minor versions is not supported (current version is v1.32.0, target version is 1.34.1), please upgrade one minor version at a time",
+				"skipping Gardener minor versions is not supported (current version is v1.138.2, target version is v1.140.0), please upgrade one minor version at a time",
+			))
+		})
+
+		It("should block versions which are not offered by the CloudProfile", func() {
+			resources.Shoot.Spec.Kubernetes.Version = "1.34.0"
+
+			plan, err := ComputePlan(ctx, fakeClient, "v1.33.4", resources, "v1.140.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Blockers).To(ConsistOf("Kubernetes version 1.34.0 is not offered by CloudProfile local"))
+		})
+
+		It("should block unsupported kubelet version skews and unknown worker pools", func() {
+			resources.Shoot.Spec.Provider.Workers[1].Kubernetes.Version = ptr.To("1.30.0")
+			Expect(fakeClient.Create(ctx, newNode("worker-0", "worker", "v1.30.0"))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newNode("other-0", "other", "v1.33.4"))).To(Succeed())
+
+			plan, err := ComputePlan(ctx, fakeClient, "v1.33.4", resources, "v1.140.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Blockers).To(ConsistOf(
+				"node other-0 belongs to worker pool \"other\" which is not part of the Shoot manifest",
+				"kubelet version 1.30.0 of worker pool worker must not be more than 3 minor versions older than the Kubernetes version 1.34.1 of the control plane",
+			))
+		})
+
+		It("should fail if the shoot is missing", func() {
+			resources.Shoot = nil
+
+			Expect(ComputePlan(ctx, fakeClient, "v1.33.4", resources, "v1.140.0")).Error().To(MatchError("shoot resource is missing in the manifests"))
+		})
+	})
+
+	Describe("#Print", func() {
+		It("should print the plan and its blockers", func() {
+			plan := &Plan{
+				KubernetesVersion: VersionChange{Current: "v1.33.4", Target: "1.34.1"},
+				GardenerVersion:   VersionChange{Target: "v1.140.0"},
+				Nodes: []NodePlan{
+					{Name: "control-plane-0", ControlPlane: true, WorkerPool: "control-plane", KubeletVersion: VersionChange{Current: "v1.33.4", Target: "1.34.1"}},
+					{Name: "worker-0", WorkerPool: "worker", KubeletVersion: VersionChange{Current: "v1.33.4", Target: "1.34.1"}},
+				},
+				Blockers: []string{"foo"},
+			}
+
+			out := &bytes.Buffer{}
+			Expect(plan.Print(out)).To(Succeed())
+			Expect(out.String()).To(Equal(`COMPONENT    CURRENT     TARGET
+Kubernetes   v1.33.4     1.34.1
+Gardener     <unknown>   v1.140.0
+
+NODE              ROLE            WORKER POOL     KUBELET CURRENT   KUBELET TARGET
+control-plane-0   control-plane   control-plane   v1.33.4           1.34.1
+worker-0          worker          worker          v1.33.4           1.34.1
+
+The upgrade is blocked:
+  - foo
+`))
+		})
+	})
+})
@@ -0,0 +1,317 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/clock"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+)
+
+const (
+	// CheckRoot is the name of the check verifying that gardenadm runs as root.
+	CheckRoot = "Root"
+	// CheckCgroupV2 is the name of the check verifying that the host uses cgroup v2.
+	CheckCgroupV2 = "CgroupV2"
+	// CheckSwap is the name of the check verifying that swap is disabled.
+	CheckSwap = "Swap"
+	// CheckKernelModules is the name of the check verifying that the required kernel modules are available.
+	CheckKernelModules = "KernelModules"
+	// CheckPorts is the name of the check verifying that the ports of the node components are not in use.
+	CheckPorts = "Ports"
+	// CheckClockSkew is the name of the check verifying that the clock of the host does not deviate from the clock of
+	// the control plane.
+	CheckClockSkew = "ClockSkew"
+	// CheckEtcdDiskSpace is the name of the check verifying that there is enough disk space for etcd.
+	CheckEtcdDiskSpace = "EtcdDiskSpace"
+
+	// maxClockSkew is the maximum tolerated deviation of the clock of the host from the clock of the control plane.
+	// The reference time is taken from the HTTP `Date` header, which has a resolution of one second.
+	maxClockSkew = 10 * time.Second
+	// etcdDir is the directory below which etcd stores its data.
+	etcdDir = "/var/lib"
+)
+
+// AllChecks contains the names of all preflight checks.
+var AllChecks = []string{CheckRoot, CheckCgroupV2, CheckSwap, CheckKernelModules, CheckPorts, CheckClockSkew, CheckEtcdDiskSpace}
+
+var (
+	// Geteuid returns the effective user ID of the process.
+	// Exposed for testing.
+	Geteuid = os.Geteuid
+	// DiskFree returns the available disk space in bytes for the file system containing the given path.
+	// Exposed for testing.
+	DiskFree = func(path string) (uint64, error) {
+		var stat syscall.Statfs_t
+		if err := syscall.Statfs(path, &stat); err != nil {
+			return 0, err
+		}
+		return uint64(stat.Bavail) * uint64(stat.Bsize), nil // #nosec: G115 -- Block size is never negative.
+	}
+	// ControlPlanePorts are the ports used by the components on control plane nodes.
+	// Exposed for testing.
+	ControlPlanePorts = map[string]int32{
+		"kube-apiserver":          443,
+		"etcd-main client":        2379,
+		"etcd-main peer":          2380,
+		"etcd-main metrics":       2381,
+		"etcd-events client":      2382,
+		"etcd-events peer":        2383,
+		"etcd-events metrics":     2384,
+		"kubelet":                 10250,
+		"kube-controller-manager": 10257,
+		"kube-scheduler":          10259,
+	}
+	// WorkerPorts are the ports used by the components on worker nodes.
+	// Exposed for testing.
+	WorkerPorts = map[string]int32{
+		"kubelet": 10250,
+	}
+	// MinimumEtcdDiskSpace is the minimum available disk space for etcd on control plane nodes. It is a bit more than the
+	// default backend quota of etcd (8Gi) to leave room for the write-ahead log and snapshots.
+	MinimumEtcdDiskSpace = resource.MustParse("10Gi")
+	// RequiredKernelModules are the kernel modules required by containerd and kube-proxy/the CNI.
+	RequiredKernelModules = []string{"overlay", "br_netfilter"}
+)
+
+// Options configures the preflight checks.
+type Options struct {
+	// FS is the file system of the host.
+	FS afero.Afero
+	// Clock is the clock of the host.
+	Clock clock.Clock
+	// ControlPlane indicates whether the host should become a control plane node.
+	ControlPlane bool
+	// ControlPlaneAddress is the address of the control plane of an existing cluster. It is used as reference for
+	// checking the clock of the host. If it is empty, the clock is not checked.
+	ControlPlaneAddress string
+	// CertificateAuthority is the CA bundle of the control plane of an existing cluster.
+	CertificateAuthority []byte
+}
+
+// Checks returns the preflight checks for the host.
+func Checks(opts Options) []Check {
+	alreadySetUp, _ := opts.FS.Exists(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+
+	ports := WorkerPorts
+	if opts.ControlPlane {
+		ports = ControlPlanePorts
+	}
+
+	return []Check{
+		{
+			Name: CheckRoot,
+			Fn:   checkRoot,
+		},
+		{
+			Name: CheckCgroupV2,
+			Fn:   func(_ context.Context) error { return checkCgroupV2(opts.FS) },
+		},
+		{
+			Name: CheckSwap,
+			Fn:   func(_ context.Context) error { return checkSwap(opts.FS) },
+		},
+		{
+			Name: CheckKernelModules,
+			Fn:   func(_ context.Context) error { return checkKernelModules(opts.FS) },
+		},
+		{
+			Name: CheckPorts,
+			Fn:   func(_ context.Context) error { return checkPorts(ports) },
+			// When gardenadm is run again on a node which was already set up (e.g., to resume a failed run), the ports are
+			// used by the components started in the previous run.
+			SkipIf:     alreadySetUp,
+			SkipReason: "node was already set up by a previous run",
+		},
+		{
+			Name:       CheckClockSkew,
+			Fn:         func(ctx context.Context) error { return checkClockSkew(ctx, opts) },
+			SkipIf:     opts.ControlPlaneAddress == "",
+			SkipReason: "no control plane address to compare the clock with",
+		},
+		{
+			Name:       CheckEtcdDiskSpace,
+			Fn:         func(_ context.Context) error { return checkEtcdDiskSpace() },
+			SkipIf:     !opts.ControlPlane,
+			SkipReason: "etcd only runs on control plane nodes",
+		},
+	}
+}
+
+func checkRoot(_ context.Context) error {
+	if uid := Geteuid(); uid != 0 {
+		return fmt.Errorf("gardenadm must be run as root (effective user ID is %d)", uid)
+	}
+	return nil
+}
+
+func checkCgroupV2(fs afero.Afero) error {
+	// This file only exists in the root of the unified cgroup v2 hierarchy.
+	exists, err := fs.Exists("/sys/fs/cgroup/cgroup.controllers")
+	if err != nil {
+		return fmt.Errorf("failed checking cgroup version: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("cgroup v2 is not enabled, kubelet does not support cgroup v1")
+	}
+	return nil
+}
+
+func checkSwap(fs afero.Afero) error {
+	swaps, err := fs.ReadFile("/proc/swaps")
+	if err != nil {
+		return fmt.Errorf("failed reading /proc/swaps: %w", err)
+	}
+
+	// The first line is the header of the table.
+	lines := strings.Split(strings.TrimSpace(string(swaps)), "\n")
+	if len(lines) > 1 {
+		var devices []string
+		for _, line := range lines[1:] {
+			if fields := strings.Fields(line); len(fields) > 0 {
+				devices = append(devices, fields[0])
+			}
+		}
+		return fmt.Errorf("swap is enabled on %s, disable it (e.g., with 'swapoff -a')", strings.Join(devices, ", "))
+	}
+
+	return nil
+}
+
+func checkKernelModules(fs afero.Afero) error {
+	var missing []string
+	for _, module := range RequiredKernelModules {
+		available, err := kernelModuleAvailable(fs, module)
+		if err != nil {
+			return fmt.Errorf("failed checking kernel module %s: %w", module, err)
+		}
+		if !available {
+			missing = append(missing, module)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("kernel modules are neither loaded nor available: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// kernelModuleAvailable returns true if the module is loaded or if it is built into the kernel or can be loaded.
+func kernelModuleAvailable(fs afero.Afero, module string) (bool, error) {
+	if loaded, err := fs.DirExists(filepath.Join("/sys/module", module)); err != nil || loaded {
+		return loaded, err
+	}
+
+	release, err := fs.ReadFile("/proc/sys/kernel/osrelease")
+	if err != nil {
+		return false, fmt.Errorf("failed reading kernel release: %w", err)
+	}
+
+	for _, file := range []string{"modules.builtin", "modules.dep"} {
+		content, err := fs.ReadFile(filepath.Join("/lib/modules", strings.TrimSpace(string(release)), file))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return false, err
+		}
+
+		// The lines look like `kernel/net/bridge/br_netfilter.ko.zst: kernel/net/bridge/bridge.ko.zst`.
+		for _, line := range strings.Split(string(content), "\n") {
+			path, _, _ := strings.Cut(line, ":")
+			if name, _, _ := strings.Cut(filepath.Base(path), ".ko"); name == module {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func checkPorts(ports map[string]int32) error {
+	var inUse []string
+	for component, port := range ports {
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+		if err != nil {
+			inUse = append(inUse, fmt.Sprintf("%d (%s)", port, component))
+			continue
+		}
+		_ = listener.Close()
+	}
+
+	if len(inUse) > 0 {
+		slices.Sort(inUse)
+		return fmt.Errorf("ports are already in use: %s", strings.Join(inUse, ", "))
+	}
+	return nil
+}
+
+func checkClockSkew(ctx context.Context, opts Options) error {
+	address := opts.ControlPlaneAddress
+	if !strings.Contains(address, "://") {
+		address = "https://" + address
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(opts.CertificateAuthority) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(opts.CertificateAuthority) {
+			return fmt.Errorf("failed parsing certificate authority of the control plane")
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(address, "/")+"/healthz", nil)
+	if err != nil {
+		return fmt.Errorf("failed creating request to control plane: %w", err)
+	}
+
+	// The `Date` header is also set for responses to unauthenticated requests.
+	response, err := (&http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}).Do(request)
+	if err != nil {
+		return fmt.Errorf("failed reaching control plane at %s: %w", address, err)
+	}
+	_ = response.Body.Close()
+
+	controlPlaneTime, err := http.ParseTime(response.Header.Get("Date"))
+	if err != nil {
+		return fmt.Errorf("failed parsing time of control plane: %w", err)
+	}
+
+	if skew := opts.Clock.Since(controlPlaneTime).Round(time.Second); skew > maxClockSkew || skew < -maxClockSkew {
+		return fmt.Errorf("clock deviates by %s from the clock of the control plane (maximum is %s), synchronize it (e.g., with NTP)", skew, maxClockSkew)
+	}
+	return nil
+}
+
+func checkEtcdDiskSpace() error {
+	free, err := DiskFree(etcdDir)
+	if err != nil {
+		return fmt.Errorf("failed checking available disk space in %s: %w", etcdDir, err)
+	}
+
+	if minimum := uint64(MinimumEtcdDiskSpace.Value()); free < minimum { // #nosec: G115 -- Quantity is positive.
+		return fmt.Errorf("only %s available in %s, etcd requires at least %s", resource.NewQuantity(int64(free), resource.BinarySI), etcdDir, &MinimumEtcdDiskSpace) // #nosec: G115 -- Free disk space fits into int64.
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight_test
+
+import (
+	"context"
+	"encoding/pem"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	testclock "k8s.io/utils/clock/testing"
+
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Checks", func() {
+	var (
+		ctx   context.Context
+		fs    afero.Afero
+		clock *testclock.FakeClock
+		opts  preflight.Options
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		clock = testclock.NewFakeClock(time.Now())
+		opts = preflight.Options{FS: fs, Clock: clock, ControlPlane: true}
+
+		Expect(fs.WriteFile("/sys/fs/cgroup/cgroup.controllers", []byte("cpu memory"), 0644)).To(Succeed())
+		Expect(fs.WriteFile("/proc/swaps", []byte("Filename\tType\tSize\tUsed\tPriority\n"), 0644)).To(Succeed())
+		Expect(fs.MkdirAll("/sys/module/overlay", 0755)).To(Succeed())
+		Expect(fs.MkdirAll("/sys/module/br_netfilter", 0755)).To(Succeed())
+
+		DeferCleanup(test.WithVars(
+			&preflight.Geteuid, func() int { return 0 },
+			&preflight.DiskFree, func(string) (uint64, error) { return 20 << 30, nil },
+			&preflight.ControlPlanePorts, map[string]int32{},
+		))
+	})
+
+	run := func(name string) preflight.Result {
+		for _, result := range preflight.Run(ctx, preflight.Checks(opts), nil).Results {
+			if result.Name == name {
+				return result
+			}
+		}
+		Fail("check " + name + " not found")
+		return preflight.Result{}
+	}
+
+	It("should pass all applicable checks on a suitable host", func() {
+		Expect(preflight.Run(ctx, preflight.Checks(opts), nil).Err()).To(Succeed())
+	})
+
+	It("should contain all checks", func() {
+		var names []string
+		for _, check := range preflight.Checks(opts) {
+			names = append(names, check.Name)
+		}
+		Expect(names).To(Equal(preflight.AllChecks))
+	})
+
+	Describe("Root", func() {
+		It("should fail if not run as root", func() {
+			DeferCleanup(test.WithVar(&preflight.Geteuid, func() int { return 1000 }))
+			Expect(run(preflight.CheckRoot).Message).To(Equal("gardenadm must be run as root (effective user ID is 1000)"))
+		})
+	})
+
+	Describe("CgroupV2", func() {
+		It("should fail if cgroup v2 is not enabled", func() {
+			Expect(fs.Remove("/sys/fs/cgroup/cgroup.controllers")).To(Succeed())
+			Expect(run(preflight.CheckCgroupV2).Status).To(Equal(preflight.StatusFailed))
+		})
+	})
+
+	Describe("Swap", func() {
+		It("should fail if swap is enabled", func() {
+			Expect(fs.WriteFile("/proc/swaps", []byte("Filename\tType\tSize\tUsed\tPriority\n/swap.img\tfile\t1024\t0\t-2\n"), 0644)).To(Succeed())
+			Expect(run(preflight.CheckSwap).Message).To(ContainSubstring("swap is enabled on /swap.img"))
+		})
+	})
+
+	Describe("KernelModules", func() {
+		BeforeEach(func() {
+			Expect(fs.RemoveAll("/sys/module/br_netfilter")).To(Succeed())
+			Expect(fs.WriteFile("/proc/sys/kernel/osrelease", []byte("6.1.0-foo\n"), 0644)).To(Succeed())
+		})
+
+		It("should pass if the module can be loaded", func() {
+			Expect(fs.WriteFile("/lib/modules/6.1.0-foo/modules.dep", []byte("kernel/net/bridge/br_netfilter.ko.zst: kernel/net/bridge/bridge.ko.zst\n"), 0644)).To(Succeed())
+			Expect(run(preflight.CheckKernelModules).Status).To(Equal(preflight.StatusPassed))
+		})
+
+		It("should pass if the module is built into the kernel", func() {
+			Expect(fs.WriteFile("/lib/modules/6.1.0-foo/modules.builtin", []byte("kernel/net/bridge/br_netfilter.ko\n"), 0644)).To(Succeed())
+			Expect(run(preflight.CheckKernelModules).Status).To(Equal(preflight.StatusPassed))
+		})
+
+		It("should fail if the module is not available", func() {
+			Expect(fs.WriteFile("/lib/modules/6.1.0-foo/modules.dep", []byte("kernel/net/bridge/bridge.ko.zst:\n"), 0644)).To(Succeed())
+			Expect(run(preflight.CheckKernelModules).Message).To(Equal("kernel modules are neither loaded nor available: br_netfilter"))
+		})
+	})
+
+	Describe("Ports", func() {
+		var listener net.Listener
+
+		BeforeEach(func() {
+			var err error
+			listener, err = net.Listen("tcp", ":0")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(listener.Close)
+
+			port := int32(listener.Addr().(*net.TCPAddr).Port) // #nosec: G115 -- Port numbers fit into int32.
+			DeferCleanup(test.WithVar(&preflight.ControlPlanePorts, map[string]int32{"kube-apiserver": port}))
+		})
+
+		It("should fail if a port is in use", func() {
+			Expect(run(preflight.CheckPorts).Message).To(MatchRegexp(`ports are already in use: \d+ \(kube-apiserver\)`))
+		})
+
+		It("should be skipped if the node was already set up", func() {
+			Expect(fs.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", nil, 0600)).To(Succeed())
+			Expect(run(preflight.CheckPorts).Status).To(Equal(preflight.StatusSkipped))
+		})
+	})
+
+	Describe("ClockSkew", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+			}))
+			DeferCleanup(server.Close)
+
+			opts.ControlPlaneAddress = server.Listener.Addr().String()
+			opts.CertificateAuthority = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		})
+
+		It("should be skipped if there is no control plane address", func() {
+			opts.ControlPlaneAddress = ""
+			Expect(run(preflight.CheckClockSkew).Status).To(Equal(preflight.StatusSkipped))
+		})
+
+		It("should pass if the clocks are in sync", func() {
+			Expect(run(preflight.CheckClockSkew).Status).To(Equal(preflight.StatusPassed))
+		})
+
+		It("should fail if the clock deviates", func() {
+			clock.Step(time.Minute)
+			Expect(run(preflight.CheckClockSkew).Message).To(ContainSubstring("clock deviates by 1m"))
+		})
+
+		It("should fail if the control plane is not trusted", func() {
+			opts.CertificateAuthority = nil
+			Expect(run(preflight.CheckClockSkew).Message).To(ContainSubstring("failed reaching control plane"))
+		})
+	})
+
+	Describe("EtcdDiskSpace", func() {
+		It("should fail if there is not enough disk space", func() {
+			DeferCleanup(test.WithVar(&preflight.DiskFree, func(string) (uint64, error) { return 1 << 30, nil }))
+			Expect(run(preflight.CheckEtcdDiskSpace).Message).To(Equal("only 1Gi available in /var/lib, etcd requires at least 10Gi"))
+		})
+
+		It("should be skipped on worker nodes", func() {
+			opts.ControlPlane = false
+			DeferCleanup(test.WithVar(&preflight.WorkerPorts, map[string]int32{}))
+			Expect(run(preflight.CheckEtcdDiskSpace).Status).To(Equal(preflight.StatusSkipped))
+		})
+	})
+})
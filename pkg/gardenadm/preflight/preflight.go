@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/cli-runtime/pkg/printers"
+)
+
+// IgnoreAll can be passed as name of an ignored check to ignore the errors of all checks.
+const IgnoreAll = "all"
+
+// Check is a single preflight check which verifies that the host is suitable for running a node of a self-hosted shoot
+// cluster.
+type Check struct {
+	// Name is the name of the check. It is used for ignoring the errors of the check.
+	Name string
+	// Fn runs the check. It returns an error if the host is unsuitable.
+	Fn func(ctx context.Context) error
+	// SkipIf indicates whether the check is not applicable and should be skipped.
+	SkipIf bool
+	// SkipReason is the reason why the check is skipped.
+	SkipReason string
+}
+
+// Status is the status of a preflight check.
+type Status string
+
+const (
+	// StatusPassed means that the check passed.
+	StatusPassed Status = "Passed"
+	// StatusFailed means that the check failed.
+	StatusFailed Status = "Failed"
+	// StatusIgnored means that the check failed but its error is ignored.
+	StatusIgnored Status = "Ignored"
+	// StatusSkipped means that the check is not applicable and was not run.
+	StatusSkipped Status = "Skipped"
+)
+
+// Result is the result of a single preflight check.
+type Result struct {
+	// Name is the name of the check.
+	Name string `json:"name"`
+	// Status is the status of the check.
+	Status Status `json:"status"`
+	// Message contains the error of a failed or ignored check or the reason why the check was skipped.
+	Message string `json:"message,omitempty"`
+}
+
+// Report contains the results of all preflight checks.
+type Report struct {
+	// Results contains the result of each check in the order they were run.
+	Results []Result `json:"results"`
+}
+
+// Run runs the given checks and returns a report of their results. The errors of the checks with the given names are
+// ignored. If the names contain IgnoreAll, the errors of all checks are ignored.
+func Run(ctx context.Context, checks []Check, ignored sets.Set[string]) *Report {
+	report := &Report{}
+
+	for _, check := range checks {
+		result := Result{Name: check.Name, Status: StatusPassed}
+
+		if check.SkipIf {
+			result.Status = StatusSkipped
+			result.Message = check.SkipReason
+		} else if err := check.Fn(ctx); err != nil {
+			result.Status = StatusFailed
+			result.Message = err.Error()
+
+			if ignored.Has(check.Name) || ignored.Has(IgnoreAll) {
+				result.Status = StatusIgnored
+			}
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	return report
+}
+
+// Failed returns the names of the failed checks whose errors are not ignored.
+func (r *Report) Failed() []string {
+	var names []string
+	for _, result := range r.Results {
+		if result.Status == StatusFailed {
+			names = append(names, result.Name)
+		}
+	}
+	return names
+}
+
+// Err returns an error if any check failed whose error is not ignored.
+func (r *Report) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("preflight checks failed: %s (to ignore them, use --ignore-preflight-errors=%s)", strings.Join(failed, ", "), strings.Join(failed, ","))
+}
+
+// Print writes a human-readable representation of the report to the given writer.
+func (r *Report) Print(w io.Writer) error {
+	tw := printers.GetNewTabWriter(w)
+
+	fmt.Fprintln(tw, "CHECK\tSTATUS\tMESSAGE")
+	for _, result := range r.Results {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", result.Name, result.Status, result.Message)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
+)
+
+var _ = Describe("Preflight", func() {
+	var (
+		ctx    context.Context
+		checks []preflight.Check
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		checks = []preflight.Check{
+			{Name: "Good", Fn: func(_ context.Context) error { return nil }},
+			{Name: "Bad", Fn: func(_ context.Context) error { return fmt.Errorf("bad") }},
+			{Name: "Ugly", Fn: func(_ context.Context) error { return fmt.Errorf("ugly") }},
+			{Name: "Irrelevant", Fn: func(_ context.Context) error { panic("must not be called") }, SkipIf: true, SkipReason: "not applicable"},
+		}
+	})
+
+	Describe("#Run", func() {
+		It("should run all checks and report their results", func() {
+			report := preflight.Run(ctx, checks, nil)
+
+			Expect(report.Results).To(Equal([]preflight.Result{
+				{Name: "Good", Status: preflight.StatusPassed},
+				{Name: "Bad", Status: preflight.StatusFailed, Message: "bad"},
+				{Name: "Ugly", Status: preflight.StatusFailed, Message: "ugly"},
+				{Name: "Irrelevant", Status: preflight.StatusSkipped, Message: "not applicable"},
+			}))
+			Expect(report.Failed()).To(ConsistOf("Bad", "Ugly"))
+			Expect(report.Err()).To(MatchError("preflight checks failed: Bad, Ugly (to ignore them, use --ignore-preflight-errors=Bad,Ugly)"))
+		})
+
+		It("should ignore the errors of the given checks", func() {
+			report := preflight.Run(ctx, checks, sets.New("Bad"))
+
+			Expect(report.Results[1]).To(Equal(preflight.Result{Name: "Bad", Status: preflight.StatusIgnored, Message: "bad"}))
+			Expect(report.Err()).To(MatchError(ContainSubstring("preflight checks failed: Ugly (")))
+		})
+
+		It("should ignore the errors of all checks", func() {
+			report := preflight.Run(ctx, checks, sets.New(preflight.IgnoreAll))
+
+			Expect(report.Failed()).To(BeEmpty())
+			Expect(report.Err()).To(Succeed())
+		})
+	})
+
+	Describe("#Print", func() {
+		It("should print the results as table", func() {
+			buf := &bytes.Buffer{}
+
+			Expect(preflight.Run(ctx, checks[:2], nil).Print(buf)).To(Succeed())
+			Expect(buf.String()).To(Equal(`CHECK   STATUS   MESSAGE
+Good    Passed   
+Bad     Failed   bad
+`))
+		})
+	})
+})
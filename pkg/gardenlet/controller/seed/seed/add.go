@@ -23,6 +23,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // ControllerName is the name of this controller.
@@ -72,5 +73,5 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 			predicateutils.HasName(r.Config.SeedConfig.Name),
 			predicate.GenerationChangedPredicate{},
 		)).
-		Complete(r)
+		Complete(tracing.Reconciler(ControllerName, "seed", r))
 }
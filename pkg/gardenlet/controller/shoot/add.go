@@ -26,9 +26,13 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/status"
 	"github.com/gardener/gardener/pkg/healthz"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
 )
 
+// maxFlowTraces is the maximum number of flow traces kept in memory.
+const maxFlowTraces = 100
+
 // AddToManager adds all Shoot controllers to the given manager.
 func AddToManager(
 	ctx context.Context,
@@ -55,6 +59,13 @@ func AddToManager(
 	}
 	shootStateControllerEnabled := responsibleForUnmanagedSeed && ptr.Deref(cfg.Controllers.ShootState.ConcurrentSyncs, 0) > 0
 
+	// Flow traces are served via the debug endpoints of the metrics server, hence they are only recorded if profiling is
+	// enabled.
+	var flowTraces *flow.TraceStore
+	if cfg.Debugging != nil && ptr.Deref(cfg.Debugging.EnableProfiling, false) {
+		flowTraces = flow.NewTraceStore(maxFlowTraces)
+	}
+
 	if err := (&shoot.Reconciler{
 		SeedClientSet:               seedClientSet,
 		ShootClientMap:              shootClientMap,
@@ -62,6 +73,7 @@ func AddToManager(
 		Identity:                    identity,
 		GardenClusterIdentity:       gardenClusterIdentity,
 		ShootStateControllerEnabled: shootStateControllerEnabled,
+		FlowTraces:                  flowTraces,
 	}).AddToManager(mgr, gardenCluster); err != nil {
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	prom "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+
+	gardenlethelper "github.com/gardener/gardener/pkg/api/config/gardenlet/v1alpha1/helper"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+const (
+	// ActivityObservationPeriod is the period in which user activity is observed on the API server of a shoot.
+	ActivityObservationPeriod = 5 * time.Minute
+
+	// userActivityQuery sums up the requests dispatched by the API server for the 'global-default' and 'exempt' flow
+	// schemas within the observation period. The former matches the requests of users, while the requests of nodes,
+	// controllers and service accounts are matched by dedicated flow schemas. The latter matches the requests of the
+	// 'system:masters' group, e.g., of users of admin kubeconfigs. The requests the API server sends to itself are
+	// matched by a dedicated flow schema deployed by the kube-apiserver component.
+	userActivityQuery = `sum(increase(apiserver_flowcontrol_dispatched_requests_total{flow_schema=~"global-default|exempt"}[%s]))`
+)
+
+// UserActivityQuerier is a function type that queries the given Prometheus endpoint whether the API server dispatched
+// requests of users within the given period.
+type UserActivityQuerier func(ctx context.Context, endpoint string, port int, period time.Duration) (bool, error)
+
+// ActivityObservation contains required information for observing user activity on the API server of a shoot.
+type ActivityObservation struct {
+	log             logr.Logger
+	shoot           *shoot.Shoot
+	gardenletConfig *gardenletconfigv1alpha1.GardenletConfiguration
+	query           UserActivityQuerier
+}
+
+// NewActivityObservation creates a new instance for observing user activity.
+func NewActivityObservation(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	gardenletConfig *gardenletconfigv1alpha1.GardenletConfiguration,
+	query UserActivityQuerier,
+) *ActivityObservation {
+	return &ActivityObservation{
+		log:             log,
+		shoot:           shoot,
+		gardenletConfig: gardenletConfig,
+		query:           query,
+	}
+}
+
+// Observe returns whether users made requests to the API server of the shoot within the observation period. User
+// activity can only be observed via the shoot's Prometheus. If it is not deployed, the shoot is considered as active so
+// that it is never hibernated due to inactivity by mistake.
+func (a *ActivityObservation) Observe(ctx context.Context) (bool, error) {
+	if a.shoot.Purpose == gardencorev1beta1.ShootPurposeTesting || !gardenlethelper.IsMonitoringEnabled(a.gardenletConfig) {
+		return true, nil
+	}
+
+	endpoint := fmt.Sprintf("prometheus-shoot.%s.svc.cluster.local", a.shoot.ControlPlaneNamespace)
+
+	active, err := a.query(ctx, endpoint, 80, ActivityObservationPeriod)
+	if err != nil {
+		return false, fmt.Errorf("failed querying user activity from Prometheus %q: %w", endpoint, err)
+	}
+
+	a.log.V(1).Info("Observed user activity", "active", active)
+	return active, nil
+}
+
+// QueryUserActivity queries the given Prometheus endpoint whether the API server dispatched requests of users within
+// the given period.
+func QueryUserActivity(ctx context.Context, endpoint string, port int, period time.Duration) (bool, error) {
+	client, err := prom.NewClient(prom.Config{Address: fmt.Sprintf("http://%s:%d", endpoint, port)})
+	if err != nil {
+		return false, fmt.Errorf("failed to create Prometheus client: %w", err)
+	}
+
+	// set a maximum timeout for the query, but callers can set a shorter timeout via the context
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, warnings, err := promv1.NewAPI(client).Query(ctx, fmt.Sprintf(userActivityQuery, model.Duration(period)), time.Now())
+	if err != nil {
+		return false, fmt.Errorf("query failed: %w", err)
+	}
+
+	if len(warnings) > 0 {
+		return false, fmt.Errorf("query returned warnings: %s", strings.Join(warnings, ", "))
+	}
+
+	vector, ok := result.(model.Vector)
+	if !ok {
+		return false, fmt.Errorf("query returned an unexpected result type: %s", result.Type())
+	}
+
+	for _, sample := range vector {
+		if sample.Value > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("ActivityObservation", func() {
+	var (
+		ctx = context.Background()
+
+		shoot           *shootpkg.Shoot
+		gardenletConfig *gardenletconfigv1alpha1.GardenletConfiguration
+
+		queried  bool
+		endpoint string
+		port     int
+		period   time.Duration
+		active   bool
+		queryErr error
+
+		query UserActivityQuerier
+	)
+
+	BeforeEach(func() {
+		shoot = &shootpkg.Shoot{
+			ControlPlaneNamespace: "shoot--foo--bar",
+			Purpose:               gardencorev1beta1.ShootPurposeProduction,
+		}
+		gardenletConfig = &gardenletconfigv1alpha1.GardenletConfiguration{}
+
+		queried, endpoint, port, period, active, queryErr = false, "", 0, 0, false, nil
+
+		query = func(_ context.Context, e string, p int, d time.Duration) (bool, error) {
+			queried, endpoint, port, period = true, e, p, d
+			return active, queryErr
+		}
+	})
+
+	Describe("#Observe", func() {
+		It("should consider testing shoots as active without querying", func() {
+			shoot.Purpose = gardencorev1beta1.ShootPurposeTesting
+
+			Expect(NewActivityObservation(logr.Discard(), shoot, gardenletConfig, query).Observe(ctx)).To(BeTrue())
+			Expect(queried).To(BeFalse())
+		})
+
+		It("should consider the shoot as active without querying if monitoring is disabled", func() {
+			gardenletConfig.Monitoring = &gardenletconfigv1alpha1.MonitoringConfig{Shoot: &gardenletconfigv1alpha1.ShootMonitoringConfig{Enabled: ptr.To(false)}}
+
+			Expect(NewActivityObservation(logr.Discard(), shoot, gardenletConfig, query).Observe(ctx)).To(BeTrue())
+			Expect(queried).To(BeFalse())
+		})
+
+		It("should query the shoot's Prometheus for user activity", func() {
+			active = true
+
+			Expect(NewActivityObservation(logr.Discard(), shoot, gardenletConfig, query).Observe(ctx)).To(BeTrue())
+			Expect(endpoint).To(Equal("prometheus-shoot.shoot--foo--bar.svc.cluster.local"))
+			Expect(port).To(Equal(80))
+			Expect(period).To(Equal(ActivityObservationPeriod))
+		})
+
+		It("should return that the shoot is inactive", func() {
+			Expect(NewActivityObservation(logr.Discard(), shoot, gardenletConfig, query).Observe(ctx)).To(BeFalse())
+			Expect(queried).To(BeTrue())
+		})
+
+		It("should return an error if the query fails", func() {
+			queryErr = errors.New("fake")
+
+			_, err := NewActivityObservation(logr.Discard(), shoot, gardenletConfig, query).Observe(ctx)
+			Expect(err).To(MatchError(ContainSubstring("fake")))
+		})
+	})
+})
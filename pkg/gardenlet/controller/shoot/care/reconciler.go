@@ -43,6 +43,10 @@ var (
 	NewGarbageCollector = defaultNewGarbageCollector
 	// NewWebhookRemediator is used to create a new webhook remediation instance.
 	NewWebhookRemediator = defaultNewWebhookRemediator
+	// NewActivityObserver is used to create a new activity observation instance.
+	NewActivityObserver = defaultNewActivityObserver
+	// NewUsageObserver is used to create a new usage observation instance.
+	NewUsageObserver = defaultNewUsageObserver
 )
 
 // Reconciler reconciles Shoot resources and executes care operations, e.g. health checks or garbage collection.
@@ -167,6 +171,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		staleExtensionHealthCheckThreshold    = gardenlethelper.StaleExtensionHealthChecksThreshold(r.Config.Controllers.ShootCare.StaleExtensionHealthChecks)
 		initializeShootClients                = shootClientInitializer(careCtx, o)
 		updatedConditions, updatedConstraints []gardencorev1beta1.Condition
+		userActive                            bool
+		usage                                 *gardencorev1beta1.ShootUsage
 	)
 
 	if err := flow.Parallel(
@@ -217,6 +223,37 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			}
 			return nil
 		},
+		// Trigger user activity observation
+		func(ctx context.Context) error {
+			if !mustObserveUserActivity(shoot) {
+				return nil
+			}
+
+			active, err := NewActivityObserver(log, o.Shoot, &r.Config).Observe(ctx)
+			if err != nil {
+				// errors during activity observation are only being logged and the shoot is considered as active, so that
+				// it is not hibernated due to inactivity by mistake
+				log.Error(err, "Failed observing user activity, considering shoot as active")
+				active = true
+			}
+			userActive = active
+			return nil
+		},
+		// Trigger usage observation
+		func(ctx context.Context) error {
+			if !ptr.Deref(r.Config.Controllers.ShootCare.UsageObservationEnabled, false) || !r.mustObserveUsage(shoot) {
+				return nil
+			}
+
+			observedUsage, err := NewUsageObserver(log, o.Shoot, r.SeedClientSet.Client(), initializeShootClients).Observe(ctx)
+			if err != nil {
+				// errors during usage observation are only being logged and do not cause the care operation to fail
+				log.Error(err, "Failed observing resource usage")
+				return nil
+			}
+			usage = observedUsage
+			return nil
+		},
 	)(careCtx); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -226,9 +263,66 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, err
 	}
 
+	if userActive {
+		if err := r.patchLastActivityTime(ctx, log, shoot); err != nil {
+			log.Error(err, "Error when trying to update the last activity time in the shoot status")
+			return reconcile.Result{}, err
+		}
+	}
+
+	if usage != nil {
+		if err := r.patchUsage(ctx, log, shoot, usage); err != nil {
+			log.Error(err, "Error when trying to update the resource usage in the shoot status")
+			return reconcile.Result{}, err
+		}
+	}
+
 	return reconcile.Result{RequeueAfter: r.Config.Controllers.ShootCare.SyncPeriod.Duration}, nil
 }
 
+// mustObserveUsage returns whether the resource usage of the given shoot must be observed. It is only observed once per
+// usage observation period to limit the number of requests and status updates.
+func (r *Reconciler) mustObserveUsage(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Status.Usage == nil || r.Clock.Now().Sub(shoot.Status.Usage.LastUpdateTime.Time) >= UsageObservationPeriod
+}
+
+// patchUsage records the given resource usage in the shoot status.
+func (r *Reconciler) patchUsage(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, usage *gardencorev1beta1.ShootUsage) error {
+	log.V(1).Info("Updating resource usage")
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.Usage = usage
+	shoot.Status.Usage.LastUpdateTime = metav1.Time{Time: r.Clock.Now()}
+	return r.GardenClient.Status().Patch(ctx, shoot, patch)
+}
+
+// patchLastActivityTime records the current time as last activity time in the shoot status. It is only updated once
+// per activity observation period to limit the number of status updates.
+func (r *Reconciler) patchLastActivityTime(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
+	now := r.Clock.Now()
+
+	if hibernation := shoot.Status.Hibernation; hibernation != nil && hibernation.LastActivityTime != nil &&
+		now.Sub(hibernation.LastActivityTime.Time) < ActivityObservationPeriod {
+		return nil
+	}
+
+	log.V(1).Info("Updating last activity time")
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	if shoot.Status.Hibernation == nil {
+		shoot.Status.Hibernation = &gardencorev1beta1.HibernationStatus{}
+	}
+	shoot.Status.Hibernation.LastActivityTime = &metav1.Time{Time: now}
+	return r.GardenClient.Status().Patch(ctx, shoot, patch)
+}
+
+// mustObserveUserActivity returns whether user activity must be observed for the given shoot, i.e., whether it is
+// awake and shall be hibernated due to inactivity.
+func mustObserveUserActivity(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Spec.Hibernation != nil && shoot.Spec.Hibernation.Inactivity != nil &&
+		!v1beta1helper.HibernationIsEnabled(shoot) && !shoot.Status.IsHibernated
+}
+
 func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1beta1.ConditionType]time.Duration {
 	out := make(map[gardencorev1beta1.ConditionType]time.Duration)
 	for _, threshold := range r.Config.Controllers.ShootCare.ConditionThresholds {
@@ -14,6 +14,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
@@ -542,10 +543,195 @@ var _ = Describe("Shoot Care Control", func() {
 					})
 				})
 			})
+
+			Context("when shoot has an inactivity timeout", func() {
+				var (
+					active      bool
+					observeErr  error
+					observed    bool
+					noCondition = func(_ ShootConditions) []gardencorev1beta1.Condition { return nil }
+				)
+
+				BeforeEach(func() {
+					active, observeErr, observed = false, nil, false
+
+					shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Inactivity: &gardencorev1beta1.HibernationInactivity{Timeout: metav1.Duration{Duration: 8 * time.Hour}}}
+
+					DeferCleanup(test.WithVars(
+						&NewHealthCheck, healthCheckFunc(noCondition),
+						&NewConstraintCheck, constraintCheckFunc(func(_ ShootConstraints) []gardencorev1beta1.Condition { return nil }),
+						&NewActivityObserver, activityObserverFunc(func() (bool, error) {
+							observed = true
+							return active, observeErr
+						}),
+					))
+				})
+
+				It("should record the last activity time if user activity was observed", func() {
+					active = true
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Hibernation.LastActivityTime.Time).To(BeTemporally("~", fakeClock.Now(), time.Second))
+				})
+
+				It("should not update the last activity time within the observation period", func() {
+					active = true
+					lastActivityTime := metav1.NewTime(fakeClock.Now().Add(-time.Minute).Round(time.Second))
+					shoot.Status.Hibernation = &gardencorev1beta1.HibernationStatus{LastActivityTime: &lastActivityTime}
+					Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Hibernation.LastActivityTime.Time).To(BeTemporally("==", lastActivityTime.Time))
+				})
+
+				It("should not record the last activity time if no user activity was observed", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Hibernation).To(BeNil())
+				})
+
+				It("should consider the shoot as active if user activity cannot be observed", func() {
+					observeErr = errors.New("fake")
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Hibernation.LastActivityTime.Time).To(BeTemporally("~", fakeClock.Now(), time.Second))
+				})
+
+				It("should not observe user activity if the shoot is hibernated", func() {
+					active = true
+					shoot.Status.IsHibernated = true
+					Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+					Expect(observed).To(BeFalse())
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Hibernation).To(BeNil())
+				})
+			})
+
+			Context("when usage observation is enabled", func() {
+				var (
+					usage      *gardencorev1beta1.ShootUsage
+					observeErr error
+					observed   bool
+				)
+
+				BeforeEach(func() {
+					gardenletConf.Controllers.ShootCare.UsageObservationEnabled = ptr.To(true)
+
+					usage = &gardencorev1beta1.ShootUsage{
+						Workers:      []gardencorev1beta1.WorkerPoolUsage{{Name: "foo", Nodes: 2}},
+						ControlPlane: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("4Gi")},
+					}
+					observeErr = nil
+					observed = false
+
+					DeferCleanup(test.WithVars(
+						&NewHealthCheck, healthCheckFunc(func(_ ShootConditions) []gardencorev1beta1.Condition { return nil }),
+						&NewConstraintCheck, constraintCheckFunc(func(_ ShootConstraints) []gardencorev1beta1.Condition { return nil }),
+						&NewUsageObserver, usageObserverFunc(func() (*gardencorev1beta1.ShootUsage, error) {
+							observed = true
+							return usage.DeepCopy(), observeErr
+						}),
+					))
+				})
+
+				It("should record the observed usage", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Usage.Workers).To(Equal(usage.Workers))
+					Expect(updatedShoot.Status.Usage.ControlPlane).To(HaveLen(2))
+					Expect(updatedShoot.Status.Usage.LastUpdateTime.Time).To(BeTemporally("~", fakeClock.Now(), time.Second))
+				})
+
+				It("should not observe the usage within the observation period", func() {
+					lastUpdateTime := metav1.NewTime(fakeClock.Now().Add(-time.Minute).Round(time.Second))
+					shoot.Status.Usage = usage.DeepCopy()
+					shoot.Status.Usage.Workers[0].Nodes = 1
+					shoot.Status.Usage.LastUpdateTime = lastUpdateTime
+					Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+					Expect(observed).To(BeFalse())
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Usage.Workers[0].Nodes).To(Equal(int32(1)))
+					Expect(updatedShoot.Status.Usage.LastUpdateTime.Time).To(BeTemporally("==", lastUpdateTime.Time))
+				})
+
+				It("should observe and record the usage after the observation period", func() {
+					// metav1.Time is serialized with second precision, i.e., the time might only get earlier
+					lastUpdateTime := metav1.NewTime(fakeClock.Now().Add(-UsageObservationPeriod - time.Second))
+					shoot.Status.Usage = usage.DeepCopy()
+					shoot.Status.Usage.Workers[0].Nodes = 1
+					shoot.Status.Usage.ControlPlane[corev1.ResourceCPU] = resource.MustParse("1")
+					shoot.Status.Usage.LastUpdateTime = lastUpdateTime
+					Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+					Expect(observed).To(BeTrue())
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Usage.Workers).To(Equal(usage.Workers))
+					Expect(updatedShoot.Status.Usage.ControlPlane.Cpu().String()).To(Equal("2"))
+					Expect(updatedShoot.Status.Usage.LastUpdateTime.Time).To(BeTemporally("~", fakeClock.Now(), time.Second))
+				})
+
+				It("should not record the usage if it cannot be observed", func() {
+					observeErr = errors.New("fake")
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Usage).To(BeNil())
+				})
+			})
 		})
 	})
 })
 
+type resultingActivityFunc func() (bool, error)
+
+func (a resultingActivityFunc) Observe(_ context.Context) (bool, error) {
+	return a()
+}
+
+func activityObserverFunc(fn resultingActivityFunc) NewActivityObserverFunc {
+	return func(_ logr.Logger, _ *shootpkg.Shoot, _ *gardenletconfigv1alpha1.GardenletConfiguration) ActivityObserver {
+		return fn
+	}
+}
+
+type resultingUsageFunc func() (*gardencorev1beta1.ShootUsage, error)
+
+func (u resultingUsageFunc) Observe(_ context.Context) (*gardencorev1beta1.ShootUsage, error) {
+	return u()
+}
+
+func usageObserverFunc(fn resultingUsageFunc) NewUsageObserverFunc {
+	return func(_ logr.Logger, _ *shootpkg.Shoot, _ client.Reader, _ ShootClientInit) UsageObserver {
+		return fn
+	}
+}
+
 type resultingConditionFunc func(ShootConditions) []gardencorev1beta1.Condition
 
 func (h resultingConditionFunc) Check(_ context.Context, _ *metav1.Duration, con ShootConditions) []gardencorev1beta1.Condition {
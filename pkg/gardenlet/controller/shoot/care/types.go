@@ -124,6 +124,32 @@ var defaultNewWebhookRemediator = func(log logr.Logger, shoot *gardencorev1beta1
 	return NewWebhookRemediation(log, shoot, init)
 }
 
+// ActivityObserver is an interface used to observe user activity.
+type ActivityObserver interface {
+	Observe(ctx context.Context) (bool, error)
+}
+
+// NewActivityObserverFunc is a function used to create a new instance to observe user activity.
+type NewActivityObserverFunc func(log logr.Logger, shoot *shoot.Shoot, gardenletConfig *gardenletconfigv1alpha1.GardenletConfiguration) ActivityObserver
+
+// defaultNewActivityObserver is the default function to create a new instance to observe user activity.
+var defaultNewActivityObserver NewActivityObserverFunc = func(log logr.Logger, shoot *shoot.Shoot, gardenletConfig *gardenletconfigv1alpha1.GardenletConfiguration) ActivityObserver {
+	return NewActivityObservation(log, shoot, gardenletConfig, QueryUserActivity)
+}
+
+// UsageObserver is an interface used to observe the resources consumed by a shoot.
+type UsageObserver interface {
+	Observe(ctx context.Context) (*gardencorev1beta1.ShootUsage, error)
+}
+
+// NewUsageObserverFunc is a function used to create a new instance to observe the resources consumed by a shoot.
+type NewUsageObserverFunc func(log logr.Logger, shoot *shoot.Shoot, seedClient client.Reader, init ShootClientInit) UsageObserver
+
+// defaultNewUsageObserver is the default function to create a new instance to observe the resources consumed by a shoot.
+var defaultNewUsageObserver NewUsageObserverFunc = func(log logr.Logger, shoot *shoot.Shoot, seedClient client.Reader, init ShootClientInit) UsageObserver {
+	return NewUsageObservation(log, shoot, seedClient, init)
+}
+
 // NewOperationFunc is a function used to create a new `operation.Operation` instance.
 type NewOperationFunc func(
 	ctx context.Context,
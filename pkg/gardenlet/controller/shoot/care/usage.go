@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+// UsageObservationPeriod is the period after which the resources consumed by a shoot are observed again.
+const UsageObservationPeriod = 15 * time.Minute
+
+// UsageObservation contains required information for observing the resources consumed by a shoot.
+type UsageObservation struct {
+	log                    logr.Logger
+	shoot                  *shoot.Shoot
+	seedClient             client.Reader
+	initializeShootClients ShootClientInit
+}
+
+// NewUsageObservation creates a new instance for observing the resources consumed by a shoot.
+func NewUsageObservation(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Reader,
+	shootClientInit ShootClientInit,
+) *UsageObservation {
+	return &UsageObservation{
+		log:                    log,
+		shoot:                  shoot,
+		seedClient:             seedClient,
+		initializeShootClients: shootClientInit,
+	}
+}
+
+// Observe returns the resources currently consumed by the shoot, i.e., the number of nodes per worker pool and the
+// resources requested by the running pods of its control plane. The returned usage does not have a last update time.
+func (u *UsageObservation) Observe(ctx context.Context) (*gardencorev1beta1.ShootUsage, error) {
+	workers, err := u.observeWorkers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	controlPlane, err := u.observeControlPlane(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u.log.V(1).Info("Observed resource usage", "workers", workers, "controlPlane", controlPlane)
+	return &gardencorev1beta1.ShootUsage{
+		Workers:      workers,
+		ControlPlane: controlPlane,
+	}, nil
+}
+
+func (u *UsageObservation) observeWorkers(ctx context.Context) ([]gardencorev1beta1.WorkerPoolUsage, error) {
+	if u.shoot.IsWorkerless {
+		return nil, nil
+	}
+
+	shootObj := u.shoot.GetInfo()
+
+	shootClient, apiServerRunning, err := u.initializeShootClients()
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize shoot client for usage observation: %w", err)
+	}
+
+	if !apiServerRunning {
+		// A hibernated shoot does not have any nodes. Otherwise, the nodes cannot be observed while the API server is
+		// not running, hence the previously observed node counts are kept.
+		if u.shoot.HibernationEnabled && shootObj.Status.IsHibernated {
+			return workerPoolUsages(shootObj, nil), nil
+		}
+		if shootObj.Status.Usage != nil {
+			return shootObj.Status.Usage.DeepCopy().Workers, nil
+		}
+		return nil, nil
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := shootClient.Client().List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed listing nodes of shoot: %w", err)
+	}
+
+	nodesPerPool := make(map[string]int32, len(shootObj.Spec.Provider.Workers))
+	for _, node := range nodeList.Items {
+		if pool, ok := node.Labels[v1beta1constants.LabelWorkerPool]; ok {
+			nodesPerPool[pool]++
+		}
+	}
+
+	return workerPoolUsages(shootObj, nodesPerPool), nil
+}
+
+func (u *UsageObservation) observeControlPlane(ctx context.Context) (corev1.ResourceList, error) {
+	podList := &corev1.PodList{}
+	if err := u.seedClient.List(ctx, podList, client.InNamespace(u.shoot.ControlPlaneNamespace)); err != nil {
+		return nil, fmt.Errorf("failed listing control plane pods of shoot: %w", err)
+	}
+
+	requests := corev1.ResourceList{}
+	for _, pod := range podList.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+
+		for _, container := range pod.Spec.Containers {
+			for name, quantity := range container.Resources.Requests {
+				sum := requests[name]
+				sum.Add(quantity)
+				requests[name] = sum
+			}
+		}
+	}
+
+	return requests, nil
+}
+
+// workerPoolUsages returns the usages of all worker pools of the given shoot in the order of its specification.
+func workerPoolUsages(shoot *gardencorev1beta1.Shoot, nodesPerPool map[string]int32) []gardencorev1beta1.WorkerPoolUsage {
+	usages := make([]gardencorev1beta1.WorkerPoolUsage, 0, len(shoot.Spec.Provider.Workers))
+	for _, worker := range shoot.Spec.Provider.Workers {
+		usages = append(usages, gardencorev1beta1.WorkerPoolUsage{Name: worker.Name, Nodes: nodesPerPool[worker.Name]})
+	}
+	return usages
+}
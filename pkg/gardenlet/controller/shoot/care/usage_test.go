@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("UsageObservation", func() {
+	var (
+		ctx = context.Background()
+
+		seedClient  client.Client
+		shootClient client.Client
+
+		shoot            *shootpkg.Shoot
+		shootObj         *gardencorev1beta1.Shoot
+		apiServerRunning bool
+		initErr          error
+
+		shootClientInit ShootClientInit
+	)
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+		shootObj = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{Name: "pool1"}, {Name: "pool2"}},
+				},
+			},
+		}
+		shoot = &shootpkg.Shoot{ControlPlaneNamespace: "shoot--foo--bar"}
+		shoot.SetInfo(shootObj)
+
+		apiServerRunning, initErr = true, nil
+		shootClientInit = func() (kubernetes.Interface, bool, error) {
+			return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build(), apiServerRunning, initErr
+		}
+	})
+
+	Describe("#Observe", func() {
+		It("should count the nodes per worker pool", func() {
+			for i, pool := range []string{"pool1", "pool1", "pool2", ""} {
+				node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node" + string(rune('a'+i))}}
+				if pool != "" {
+					node.Labels = map[string]string{v1beta1constants.LabelWorkerPool: pool}
+				}
+				Expect(shootClient.Create(ctx, node)).To(Succeed())
+			}
+
+			usage, err := NewUsageObservation(logr.Discard(), shoot, seedClient, shootClientInit).Observe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage.Workers).To(Equal([]gardencorev1beta1.WorkerPoolUsage{
+				{Name: "pool1", Nodes: 2},
+				{Name: "pool2", Nodes: 1},
+			}))
+		})
+
+		It("should sum up the resource requests of the running control plane pods", func() {
+			for i, phase := range []corev1.PodPhase{corev1.PodRunning, corev1.PodRunning, corev1.PodPending} {
+				Expect(seedClient.Create(ctx, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "pod" + string(rune('a'+i)), Namespace: shoot.ControlPlaneNamespace},
+					Spec: corev1.PodSpec{Containers: []corev1.Container{
+						{Name: "c1", Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("1Gi")}}},
+						{Name: "c2", Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")}}},
+					}},
+					Status: corev1.PodStatus{Phase: phase},
+				})).To(Succeed())
+			}
+
+			usage, err := NewUsageObservation(logr.Discard(), shoot, seedClient, shootClientInit).Observe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage.ControlPlane.Cpu().MilliValue()).To(Equal(int64(300)))
+			Expect(usage.ControlPlane.Memory().Value()).To(Equal(int64(2 << 30)))
+		})
+
+		It("should not observe workers of workerless shoots", func() {
+			shoot.IsWorkerless = true
+			initErr = errors.New("fake")
+
+			usage, err := NewUsageObservation(logr.Discard(), shoot, seedClient, shootClientInit).Observe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage.Workers).To(BeNil())
+		})
+
+		It("should record no nodes for hibernated shoots", func() {
+			apiServerRunning = false
+			shoot.HibernationEnabled = true
+			shootObj.Status.IsHibernated = true
+
+			usage, err := NewUsageObservation(logr.Discard(), shoot, seedClient, shootClientInit).Observe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage.Workers).To(Equal([]gardencorev1beta1.WorkerPoolUsage{
+				{Name: "pool1", Nodes: 0},
+				{Name: "pool2", Nodes: 0},
+			}))
+		})
+
+		It("should keep the previously observed node counts if the API server is not running", func() {
+			apiServerRunning = false
+			shootObj.Status.Usage = &gardencorev1beta1.ShootUsage{Workers: []gardencorev1beta1.WorkerPoolUsage{{Name: "pool1", Nodes: 3}}}
+
+			usage, err := NewUsageObservation(logr.Discard(), shoot, seedClient, shootClientInit).Observe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage.Workers).To(Equal([]gardencorev1beta1.WorkerPoolUsage{{Name: "pool1", Nodes: 3}}))
+		})
+
+		It("should return an error if the shoot client cannot be initialized", func() {
+			initErr = errors.New("fake")
+
+			_, err := NewUsageObservation(logr.Discard(), shoot, seedClient, shootClientInit).Observe(ctx)
+			Expect(err).To(MatchError(ContainSubstring("fake")))
+		})
+	})
+})
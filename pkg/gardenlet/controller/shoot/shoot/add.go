@@ -25,10 +25,15 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
-// ControllerName is the name of this controller.
-const ControllerName = "shoot"
+const (
+	// ControllerName is the name of this controller.
+	ControllerName = "shoot"
+	// FlowTracesPath is the path of the endpoint serving the traces of the most recent shoot flow executions.
+	FlowTracesPath = "/debug/flow-traces"
+)
 
 // AddToManager adds Reconciler to the given manager.
 func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Cluster) error {
@@ -42,6 +47,12 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 		r.Clock = clock.RealClock{}
 	}
 
+	if r.FlowTraces != nil {
+		if err := mgr.AddMetricsServerExtraHandler(FlowTracesPath, r.FlowTraces); err != nil {
+			return err
+		}
+	}
+
 	return builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
@@ -52,7 +63,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 			r.EventHandler(mgr.GetLogger().WithValues("controller", ControllerName)),
 			&predicate.GenerationChangedPredicate{},
 		)).
-		Complete(r)
+		Complete(tracing.Reconciler(ControllerName, "shoot", r))
 }
 
 // CalculateControllerInfos is exposed for testing
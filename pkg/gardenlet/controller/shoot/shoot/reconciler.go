@@ -40,6 +40,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
 	gardenletmetrics "github.com/gardener/gardener/pkg/gardenlet/metrics"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
@@ -57,7 +58,12 @@ import (
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
-const taskID = "initializeOperation"
+const (
+	taskID = "initializeOperation"
+	// flowCheckpointConfigMapName is the name of the ConfigMap in the control plane namespace which stores the checkpoint
+	// of the reconcile flow.
+	flowCheckpointConfigMapName = "shoot-reconcile-flow-checkpoint"
+)
 
 // Reconciler implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
 type Reconciler struct {
@@ -70,6 +76,8 @@ type Reconciler struct {
 	GardenClusterIdentity       string
 	Clock                       clock.Clock
 	ShootStateControllerEnabled bool
+	// FlowTraces stores the traces of the most recent flow executions. Tracing is disabled if it is nil.
+	FlowTraces *flow.TraceStore
 }
 
 // Reconcile implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
@@ -596,6 +604,33 @@ func (r *Reconciler) newProgressReporter(reporterFn flow.ProgressReporterFn) flo
 	return flow.NewImmediateProgressReporter(reporterFn)
 }
 
+// newFlowTrace returns a new trace for the execution of the given flow for the shoot of the given operation. It returns
+// nil if tracing is disabled.
+func (r *Reconciler) newFlowTrace(o *operation.Operation, f *flow.Flow) *flow.Trace {
+	return r.FlowTraces.New(client.ObjectKeyFromObject(o.Shoot.GetInfo()).String()+"/"+f.Name(), f)
+}
+
+// newFlowCheckpoint returns the options for resuming the reconcile flow of the shoot of the given operation. The
+// fingerprint covers the generation and annotations of the shoot as well as the gardenlet version, i.e., the flow is
+// only resumed if neither the shoot nor gardenlet changed since the previous execution. It returns nil if the
+// ResumableShootFlows feature gate is disabled.
+func (r *Reconciler) newFlowCheckpoint(o *operation.Operation, operationType gardencorev1beta1.LastOperationType) *flow.CheckpointOptions {
+	if !features.DefaultFeatureGate.Enabled(features.ResumableShootFlows) {
+		return nil
+	}
+
+	shoot := o.Shoot.GetInfo()
+	return &flow.CheckpointOptions{
+		Store: flow.NewConfigMapCheckpointStore(r.SeedClientSet.Client(), o.Shoot.ControlPlaneNamespace, flowCheckpointConfigMapName),
+		Fingerprint: utils.ComputeChecksum(map[string]any{
+			"generation":    shoot.Generation,
+			"annotations":   shoot.Annotations,
+			"operationType": operationType,
+			"version":       r.Identity.Version,
+		}),
+	}
+}
+
 func (r *Reconciler) updateShootStatusOperationStart(
 	ctx context.Context,
 	shoot *gardencorev1beta1.Shoot,
@@ -865,6 +900,15 @@ func (r *Reconciler) patchShootStatusOperationSuccess(
 		if err != nil {
 			return fmt.Errorf("error updating Shoot (%s/%s) after successful reconciliation when checking for active hibernation: %w", shoot.Namespace, shoot.Name, err)
 		}
+
+		// Waking up the shoot is considered as user activity, so that it is not immediately hibernated again due to
+		// inactivity.
+		if shoot.Status.IsHibernated && !isHibernated {
+			if shoot.Status.Hibernation == nil {
+				shoot.Status.Hibernation = &gardencorev1beta1.HibernationStatus{}
+			}
+			shoot.Status.Hibernation.LastActivityTime = &now
+		}
 		shoot.Status.IsHibernated = isHibernated
 	}
 
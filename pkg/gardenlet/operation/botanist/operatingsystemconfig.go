@@ -10,6 +10,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/component-base/version"
@@ -295,6 +296,10 @@ func (b *Botanist) generateOperatingSystemConfigSecretForWorker(
 		return nil, fmt.Errorf("failed computing the OperatingSystemConfig secret for gardener-node-agent for pool %q: %w", worker.Name, err)
 	}
 
+	if b.Shoot.NodeAgentReadyForUpdateRequired {
+		metav1.SetMetaDataAnnotation(&oscSecret.ObjectMeta, v1beta1constants.AnnotationNodeAgentReadyForUpdateRequired, "true")
+	}
+
 	resources, err := managedresources.
 		NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer).
 		AddAllAndSerialize(oscSecret)
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
@@ -436,6 +437,26 @@ var _ = Describe("operatingsystemconfig", func() {
 					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(oldSecret1), oldSecret1)).To(BeNotFoundError())
 					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(oldSecret2), oldSecret2)).To(BeNotFoundError())
 				})
+
+				It("should require the nodes to be ready for update if configured", func() {
+					botanist.Shoot.NodeAgentReadyForUpdateRequired = true
+
+					Expect(botanist.DeployManagedResourceForGardenerNodeAgent(ctx)).To(Succeed())
+
+					secretList := &corev1.SecretList{}
+					Expect(fakeClient.List(ctx, secretList, client.InNamespace(namespace), client.MatchingLabels{"managed-resource": "shoot-gardener-node-agent"})).To(Succeed())
+					Expect(secretList.Items).To(HaveLen(3))
+
+					for _, secret := range secretList.Items {
+						if strings.HasPrefix(secret.Name, "managedresource-shoot-gardener-node-agent-rbac") {
+							continue
+						}
+
+						oscSecretRaw, err := test.BrotliDecompression(secret.Data["data.yaml.br"])
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(oscSecretRaw)).To(ContainSubstring("reconciliation.osc.node-agent.gardener.cloud/require-ready-for-update: \"true\""), secret.Name)
+					}
+				})
 			})
 		})
 	})
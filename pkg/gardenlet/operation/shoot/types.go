@@ -108,6 +108,9 @@ type Shoot struct {
 	ResourcesToEncrypt                      []string
 	EncryptedResources                      []string
 	ServiceAccountIssuerHostname            *string
+	// NodeAgentReadyForUpdateRequired makes gardener-node-agent apply changes of the OperatingSystemConfig only to nodes
+	// which are marked as ready for update. It is set by `gardenadm upgrade apply` which upgrades the nodes one at a time.
+	NodeAgentReadyForUpdateRequired bool
 
 	Components *Components
 }
@@ -250,21 +250,23 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 	}
 
-	if isInPlaceUpdate(oscChanges) {
-		// In case of in-place update, we use retries for certain cases like OS update with higher timeouts,
-		// so we need to overwrite the context to use a longer timeout.
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, 10*time.Minute)
-		defer cancel()
-
+	if isInPlaceUpdate(oscChanges) || (node != nil && readyForUpdateRequired(secret)) {
 		if !nodeHasInPlaceUpdateConditionWithReasonReadyForUpdate(node.Status.Conditions) {
 			if node.Labels[machinev1alpha1.LabelKeyNodeUpdateResult] != machinev1alpha1.LabelValueNodeUpdateFailed {
-				log.Info("Node is not ready for in-place update, will be requeued when the node has the ready-for-update condition", "node", node.Name)
+				log.Info("Node is not ready for update, will be requeued when the node has the ready-for-update condition", "node", node.Name)
 				return reconcile.Result{}, nil
 			}
 
 			log.Info("Node has label update-result with failed value, will continue retrying the update")
 		}
+	}
+
+	if isInPlaceUpdate(oscChanges) {
+		// In case of in-place update, we use retries for certain cases like OS update with higher timeouts,
+		// so we need to overwrite the context to use a longer timeout.
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, 10*time.Minute)
+		defer cancel()
 
 		log.Info("In-place update is in progress", "osUpdate", oscChanges.InPlaceUpdates.OperatingSystem,
 			"kubeletMinorVersionUpdate", oscChanges.InPlaceUpdates.Kubelet.MinorVersion,
@@ -787,6 +789,12 @@ func (r *Reconciler) executeUnitCommands(ctx context.Context, log logr.Logger, n
 	return flow.Parallel(fns...)(ctx)
 }
 
+// readyForUpdateRequired returns true if changes of the OperatingSystemConfig in the given secret may only be applied
+// once the node is marked as ready for update, see v1beta1constants.AnnotationNodeAgentReadyForUpdateRequired.
+func readyForUpdateRequired(secret *corev1.Secret) bool {
+	return secret.Annotations[v1beta1constants.AnnotationNodeAgentReadyForUpdateRequired] == "true"
+}
+
 func isInPlaceUpdate(changes *operatingSystemConfigChanges) bool {
 	return changes.InPlaceUpdates.OperatingSystem ||
 		changes.InPlaceUpdates.Kubelet.MinorVersion ||
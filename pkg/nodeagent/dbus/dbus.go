@@ -6,6 +6,7 @@ package dbus
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/coreos/go-systemd/v22/dbus"
 	"github.com/coreos/go-systemd/v22/login1"
 	"github.com/go-logr/logr"
+	godbus "github.com/godbus/dbus/v5"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/events"
@@ -38,6 +40,17 @@ type DBus interface {
 	Reboot() error
 }
 
+// errorNameNoSuchUnit is the name of the error returned by systemd if a unit is not loaded or its unit file does not
+// exist.
+const errorNameNoSuchUnit = "org.freedesktop.systemd1.NoSuchUnit"
+
+// IsNoSuchUnit returns true if the given error was returned by systemd because the unit is not loaded or its unit file
+// does not exist.
+func IsNoSuchUnit(err error) bool {
+	var dbusErr godbus.Error
+	return errors.As(err, &dbusErr) && dbusErr.Name == errorNameNoSuchUnit
+}
+
 type db struct {
 	log logr.Logger
 }
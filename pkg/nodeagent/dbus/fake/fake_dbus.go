@@ -72,6 +72,24 @@ func (d *DBus) InjectRestartFailure(err error, unitNames ...string) {
 	d.failures[key] = err
 }
 
+// InjectDisableFailure returns the given error the first time a disable is triggered on the given units.
+func (d *DBus) InjectDisableFailure(err error, unitNames ...string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	key := failureKey(SystemdAction{Action: ActionDisable, UnitNames: unitNames})
+	d.failures[key] = err
+}
+
+// InjectStopFailure returns the given error the first time a stop is triggered on the given unit.
+func (d *DBus) InjectStopFailure(err error, unitName string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	key := failureKey(SystemdAction{Action: ActionStop, UnitNames: []string{unitName}})
+	d.failures[key] = err
+}
+
 func (d *DBus) maybeError(action SystemdAction) error {
 	key := failureKey(action)
 	err, ok := d.failures[key]
@@ -99,12 +117,13 @@ func (d *DBus) Disable(_ context.Context, unitNames ...string) error {
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
 
-	d.Actions = append(d.Actions, SystemdAction{
+	action := SystemdAction{
 		Action:    ActionDisable,
 		UnitNames: unitNames,
-	})
+	}
+	d.Actions = append(d.Actions, action)
 
-	return nil
+	return d.maybeError(action)
 }
 
 // Enable implements dbus.DBus.
@@ -181,11 +200,13 @@ func (d *DBus) Stop(_ context.Context, _ events.EventRecorder, _ runtime.Object,
 	d.mutex.Lock()
 	defer d.mutex.Unlock()
 
-	d.Actions = append(d.Actions, SystemdAction{
+	action := SystemdAction{
 		Action:    ActionStop,
 		UnitNames: []string{unitName},
-	})
-	return nil
+	}
+	d.Actions = append(d.Actions, action)
+
+	return d.maybeError(action)
 }
 
 func failureKey(action SystemdAction) string {
@@ -22,6 +22,7 @@ import (
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // ControllerName is the name of this controller.
@@ -76,7 +77,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenClientMap clientmap
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: ptr.Deref(r.Config.Controllers.Garden.ConcurrentSyncs, 0),
 		}).
-		Complete(r)
+		Complete(tracing.Reconciler(ControllerName, "garden", r))
 }
 
 // HasOperationAnnotation returns a predicate which returns true when the object has an operation annotation.
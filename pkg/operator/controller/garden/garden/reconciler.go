@@ -42,6 +42,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	"github.com/gardener/gardener/pkg/utils/secrets/manager/keystore"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
@@ -97,15 +98,22 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed parsing version %q for virtual cluster: %w", garden.Spec.VirtualCluster.Kubernetes.Version, err)
 	}
 
+	secretsManagerOptions := []secretsmanager.NewOption{
+		secretsmanager.WithCASecretAutoRotation(),
+		secretsmanager.WithSecretNamesToTimes(lastSecretRotationStartTimes(garden)),
+		secretsmanager.WithNamespaces(r.GardenNamespace),
+	}
+	if keyStoreConfig := r.Config.Controllers.Garden.KeyStore; keyStoreConfig != nil {
+		secretsManagerOptions = append(secretsManagerOptions, secretsmanager.WithKeyStore(keystore.NewDirectory(keyStoreConfig.Directory)))
+	}
+
 	secretsManager, err := secretsmanager.New(
 		ctx,
 		log.WithName("secretsmanager"),
 		r.Clock,
 		r.RuntimeClientSet.Client(),
 		operatorv1alpha1.SecretManagerIdentityOperator,
-		secretsmanager.WithCASecretAutoRotation(),
-		secretsmanager.WithSecretNamesToTimes(lastSecretRotationStartTimes(garden)),
-		secretsmanager.WithNamespaces(r.GardenNamespace),
+		secretsManagerOptions...,
 	)
 	if err != nil {
 		return reconcile.Result{}, r.updateStatusOperationError(ctx, garden, err, operationType)
@@ -553,6 +561,12 @@ func caCertGenerateOptionsFor(name string, rotationPhase gardencorev1beta1.Crede
 		options = append(options, secretsmanager.IgnoreOldSecrets())
 	}
 
+	if name == v1beta1constants.SecretNameCAClient {
+		// kube-controller-manager signs client certificates with the private key of the client CA, which it reads from
+		// the CA secret.
+		options = append(options, secretsmanager.KeepPrivateKeyInSecret())
+	}
+
 	return options
 }
 
@@ -20,6 +20,7 @@ import (
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/controller/tokenrequestor"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/csrapprover"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/drift"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/health"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/managedresource"
@@ -57,6 +58,15 @@ func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targe
 		return fmt.Errorf("failed adding health controller: %w", err)
 	}
 
+	if cfg.Controllers.Drift.Enabled {
+		if err := (&drift.Reconciler{
+			Config:      cfg.Controllers.Drift,
+			ClassFilter: resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
+		}).AddToManager(mgr, sourceCluster, targetCluster, *cfg.Controllers.ClusterID); err != nil {
+			return fmt.Errorf("failed adding drift controller: %w", err)
+		}
+	}
+
 	if err := (&managedresource.Reconciler{
 		Config:                    cfg.Controllers.ManagedResource,
 		ClassFilter:               resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+// ControllerName is the name of the controller.
+const ControllerName = "drift"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetCluster cluster.Cluster, clusterID string) error {
+	if r.SourceClient == nil {
+		r.SourceClient = sourceCluster.GetClient()
+	}
+	if r.TargetClient == nil {
+		r.TargetClient = targetCluster.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorder(ControllerName + "-controller")
+	}
+	if r.store == nil {
+		r.store = newStore()
+	}
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		Watches(
+			&resourcesv1alpha1.ManagedResource{},
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(
+				predicate.Or(
+					resourcemanagerpredicate.ClassChangedPredicate(),
+					// start watching the objects of new resource kinds after the ManagedResource has been reconciled
+					resourcemanagerpredicate.ConditionStatusChanged(resourcesv1alpha1.ResourcesApplied, resourcemanagerpredicate.DefaultConditionChange),
+					resourcemanagerpredicate.NoLongerIgnored(),
+				),
+				resourcemanagerpredicate.NotIgnored(),
+				r.ClassFilter,
+			),
+		).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	lock := sync.RWMutex{}
+	watchedObjectGVKs := sets.New[schema.GroupVersionKind]()
+	r.ensureWatchForGVK = func(gvk schema.GroupVersionKind) error {
+		// fast-check: have we already added watch for this GVK?
+		lock.RLock()
+		if watchedObjectGVKs.Has(gvk) {
+			lock.RUnlock()
+			return nil
+		}
+		lock.RUnlock()
+
+		// slow-check: two goroutines might concurrently call this func. If neither exited early, the first one added
+		// the watch and the second one should return now.
+		lock.Lock()
+		defer lock.Unlock()
+		if watchedObjectGVKs.Has(gvk) {
+			return nil
+		}
+
+		c.GetLogger().Info("Adding new watch for GroupVersionKind", "groupVersionKind", gvk)
+
+		// The managed fields are part of the metadata, hence metadata-only watches are sufficient for all objects.
+		obj := &metav1.PartialObjectMetadata{}
+		obj.SetGroupVersionKind(gvk)
+
+		if err := c.Watch(source.Kind[client.Object](
+			targetCluster.GetCache(),
+			obj,
+			r.EventHandler(c.GetLogger(), gvk, clusterID),
+		)); err != nil {
+			return fmt.Errorf("error starting watch for GVK %s: %w", gvk.String(), err)
+		}
+
+		watchedObjectGVKs.Insert(gvk)
+		return nil
+	}
+
+	return nil
+}
+
+// EventHandler returns an event handler for objects of the given GroupVersionKind. It records drifts detected in update
+// events and enqueues the origin ManagedResource of the object if drifts were detected before or in the event.
+func (r *Reconciler) EventHandler(log logr.Logger, gvk schema.GroupVersionKind, clusterID string) handler.EventHandler {
+	mapToOriginManagedResource := utils.MapToOriginManagedResource(log, clusterID)
+
+	return &handler.Funcs{
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			requests := mapToOriginManagedResource(ctx, e.ObjectNew)
+			if len(requests) == 0 {
+				return
+			}
+
+			var (
+				key = requests[0].NamespacedName
+				ref = objectReference(gvk, e.ObjectNew.GetNamespace(), e.ObjectNew.GetName())
+			)
+
+			if e.ObjectNew.GetAnnotations()[resourcesv1alpha1.Ignore] != "true" {
+				drifts, err := DetectDrift(e.ObjectOld, e.ObjectNew)
+				if err != nil {
+					log.Error(err, "Failed detecting drift of object", "object", ref)
+					return
+				}
+
+				if len(drifts) > 0 {
+					r.store.record(key, ref, drifts)
+				}
+			}
+
+			// reconcile the ManagedResource to report new drifts or to check whether known drifts have been reverted
+			if r.store.tracks(key, ref) {
+				q.Add(requests[0])
+			}
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			for _, req := range mapToOriginManagedResource(ctx, e.Object) {
+				if r.store.tracks(req.NamespacedName, objectReference(gvk, e.Object.GetNamespace(), e.Object.GetName())) {
+					q.Add(req)
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/managedresource"
+)
+
+// maxFieldsInMessage is the maximum number of fields which are listed in messages about a drift.
+const maxFieldsInMessage = 5
+
+// Drift describes fields of an object which were owned by the resource manager but have been changed or removed by
+// another field manager.
+type Drift struct {
+	// Manager is the name of the field manager which changed the fields.
+	Manager string
+	// Operation is the operation type of the field manager.
+	Operation metav1.ManagedFieldsOperationType
+	// Fields are the changed fields.
+	Fields *fieldpath.Set
+}
+
+// String returns a human-readable description of the drift.
+func (d Drift) String() string {
+	var fields []string
+	d.Fields.Leaves().Iterate(func(path fieldpath.Path) {
+		fields = append(fields, path.String())
+	})
+	slices.Sort(fields)
+
+	if len(fields) > maxFieldsInMessage {
+		fields = append(fields[:maxFieldsInMessage], fmt.Sprintf("and %d more", len(fields)-maxFieldsInMessage))
+	}
+
+	return fmt.Sprintf("%s changed by %q (%s)", strings.Join(fields, ", "), d.Manager, d.Operation)
+}
+
+// DetectDrift compares the managed fields of the old and the new version of an object. It returns the fields which
+// were owned by the resource manager in the old version but are not in the new version, grouped by the field manager
+// which changed them. Changes of the resource manager itself, changes via subresources (e.g. `scale` used by
+// HorizontalPodAutoscalers) and changes of fields which are preserved by the resource manager are not considered as
+// drift.
+func DetectDrift(oldObj, newObj metav1.Object) ([]Drift, error) {
+	oldEntries, newEntries := oldObj.GetManagedFields(), newObj.GetManagedFields()
+
+	// The managed fields were reset or are not tracked for this object, hence no statement about drift is possible.
+	if len(newEntries) == 0 {
+		return nil, nil
+	}
+
+	// The resource manager changed the object itself, e.g. because fields were removed from the desired state.
+	if entryChanged(oldEntries, newEntries, managedresource.FieldManager) {
+		return nil, nil
+	}
+
+	oldOwned, err := fieldsOwnedBy(oldEntries, managedresource.FieldManager)
+	if err != nil {
+		return nil, err
+	}
+	newOwned, err := fieldsOwnedBy(newEntries, managedresource.FieldManager)
+	if err != nil {
+		return nil, err
+	}
+
+	lost := withoutPreservedFields(oldOwned.Difference(newOwned), newObj.GetAnnotations())
+	if lost.Empty() {
+		return nil, nil
+	}
+
+	var (
+		drifts          []Drift
+		changedManagers []metav1.ManagedFieldsEntry
+	)
+
+	for _, entry := range newEntries {
+		if entry.Manager == managedresource.FieldManager {
+			continue
+		}
+
+		fields, err := decodeFields(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		taken := lost.Intersection(fields)
+		lost = lost.Difference(fields)
+
+		if entry.Subresource != "" {
+			continue
+		}
+
+		if !taken.Empty() {
+			drifts = append(drifts, Drift{Manager: entry.Manager, Operation: entry.Operation, Fields: taken})
+		}
+		if entryChanged(oldEntries, []metav1.ManagedFieldsEntry{entry}, entry.Manager) {
+			changedManagers = append(changedManagers, entry)
+		}
+	}
+
+	// The remaining fields are not owned by any field manager anymore, i.e., they were removed from the object. They can
+	// only be attributed to a field manager if it is the only one which changed the object. Otherwise, they might also
+	// have been removed by the resource manager itself, which does not necessarily update the timestamp of its managed
+	// fields entry when only removing fields.
+	if !lost.Empty() && len(changedManagers) == 1 {
+		drifts = mergeDrifts(drifts, Drift{Manager: changedManagers[0].Manager, Operation: changedManagers[0].Operation, Fields: lost})
+	}
+
+	return drifts, nil
+}
+
+var (
+	replicasMatcher = fieldpath.MakePrefixMatcherOrDie("spec", "replicas")
+	// containerResourcesMatcher matches the resource requirements of the containers in the pod templates of the
+	// well-known workload resources.
+	containerResourcesMatcher = fieldpath.MakePrefixMatcherOrDie("spec", "template", "spec", "containers", fieldpath.MatchAnyPathElement(), "resources").Merge(
+		fieldpath.MakePrefixMatcherOrDie("spec", "jobTemplate", "spec", "template", "spec", "containers", fieldpath.MatchAnyPathElement(), "resources"),
+	)
+)
+
+// withoutPreservedFields removes the fields from the given set which the resource manager preserves according to the
+// given annotations of the object, see resourcesv1alpha1.PreserveReplicas and resourcesv1alpha1.PreserveResources.
+func withoutPreservedFields(fields *fieldpath.Set, annotations map[string]string) *fieldpath.Set {
+	if annotations[resourcesv1alpha1.PreserveReplicas] == "true" {
+		fields = fields.Difference(fields.FilterIncludeMatches(replicasMatcher))
+	}
+	if annotations[resourcesv1alpha1.PreserveResources] == "true" {
+		fields = fields.Difference(fields.FilterIncludeMatches(containerResourcesMatcher))
+	}
+	return fields
+}
+
+// mergeDrifts adds the given drifts to the list of drifts. The fields of drifts of the same field manager and operation
+// are merged.
+func mergeDrifts(drifts []Drift, add ...Drift) []Drift {
+	for _, drift := range add {
+		i := slices.IndexFunc(drifts, func(d Drift) bool {
+			return d.Manager == drift.Manager && d.Operation == drift.Operation
+		})
+		if i < 0 {
+			drifts = append(drifts, drift)
+			continue
+		}
+		drifts[i].Fields = drifts[i].Fields.Union(drift.Fields)
+	}
+	return drifts
+}
+
+// fieldsOwnedBy returns the fields of the main resource which are owned by the given field manager.
+func fieldsOwnedBy(entries []metav1.ManagedFieldsEntry, manager string) (*fieldpath.Set, error) {
+	owned := &fieldpath.Set{}
+	for _, entry := range entries {
+		if entry.Manager != manager || entry.Subresource != "" {
+			continue
+		}
+
+		fields, err := decodeFields(entry)
+		if err != nil {
+			return nil, err
+		}
+		owned = owned.Union(fields)
+	}
+	return owned, nil
+}
+
+// entryChanged returns true if the given field manager changed the main resource according to newEntries compared to
+// oldEntries. Only the timestamps are compared because the fields of an entry also change if other field managers take
+// over the ownership of some of them.
+func entryChanged(oldEntries, newEntries []metav1.ManagedFieldsEntry, manager string) bool {
+	for _, newEntry := range newEntries {
+		if newEntry.Manager != manager || newEntry.Subresource != "" {
+			continue
+		}
+
+		i := slices.IndexFunc(oldEntries, func(oldEntry metav1.ManagedFieldsEntry) bool {
+			return oldEntry.Manager == newEntry.Manager && oldEntry.Operation == newEntry.Operation && oldEntry.Subresource == newEntry.Subresource
+		})
+		if i < 0 {
+			return true
+		}
+
+		if !oldEntries[i].Time.Equal(newEntry.Time) {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeFields(entry metav1.ManagedFieldsEntry) (*fieldpath.Set, error) {
+	fields := &fieldpath.Set{}
+	if entry.FieldsV1 == nil {
+		return fields, nil
+	}
+
+	if err := fields.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+		return nil, fmt.Errorf("failed decoding managed fields of field manager %q: %w", entry.Manager, err)
+	}
+	return fields, nil
+}
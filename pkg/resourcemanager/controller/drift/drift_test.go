@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/drift"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/managedresource"
+)
+
+var _ = Describe("Drift", func() {
+	Describe("#DetectDrift", func() {
+		var (
+			ctx        = context.Background()
+			fakeClient client.Client
+
+			configMap *corev1.ConfigMap
+			old       *corev1.ConfigMap
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithReturnManagedFields().Build()
+
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default", Labels: map[string]string{"foo": "bar"}},
+				Data:       map[string]string{"foo": "bar", "bar": "baz"},
+			}
+			Expect(fakeClient.Create(ctx, configMap, client.FieldOwner(managedresource.FieldManager))).To(Succeed())
+			old = configMap.DeepCopy()
+		})
+
+		fieldsOf := func(drift Drift) []string {
+			var fields []string
+			for path := range drift.Fields.Leaves().All() {
+				fields = append(fields, path.String())
+			}
+			return fields
+		}
+
+		It("should detect fields changed by other field managers", func() {
+			configMap.Data["foo"] = "changed"
+			Expect(fakeClient.Update(ctx, configMap, client.FieldOwner("kubectl-edit"))).To(Succeed())
+
+			drifts, err := DetectDrift(old, configMap)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).To(HaveLen(1))
+			Expect(drifts[0].Manager).To(Equal("kubectl-edit"))
+			Expect(drifts[0].Operation).To(Equal(metav1.ManagedFieldsOperationUpdate))
+			Expect(fieldsOf(drifts[0])).To(ConsistOf(".data.foo"))
+			Expect(drifts[0].String()).To(Equal(`.data.foo changed by "kubectl-edit" (Update)`))
+		})
+
+		It("should attribute removed fields to the only field manager which changed the object", func() {
+			configMap.Data["foo"] = "changed"
+			delete(configMap.Labels, "foo")
+			Expect(fakeClient.Update(ctx, configMap, client.FieldOwner("kubectl-edit"))).To(Succeed())
+
+			drifts, err := DetectDrift(old, configMap)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drifts).To(HaveLen(1))
+			Expect(drifts[0].Manager).To(Equal("kubectl-edit"))
+			Expect(fieldsOf(drifts[0])).To(ConsistOf(".data.foo", ".metadata.labels.foo"))
+		})
+
+		It("should not report removed fields if the field manager cannot be determined", func() {
+			delete(configMap.Labels, "foo")
+			Expect(fakeClient.Update(ctx, configMap, client.FieldOwner("kubectl-edit"))).To(Succeed())
+
+			Expect(DetectDrift(old, configMap)).To(BeEmpty())
+		})
+
+		It("should not detect a drift if other field managers add fields", func() {
+			configMap.Data["new"] = "value"
+			Expect(fakeClient.Update(ctx, configMap, client.FieldOwner("kubectl-edit"))).To(Succeed())
+
+			Expect(DetectDrift(old, configMap)).To(BeEmpty())
+		})
+
+		It("should not detect a drift if the resource manager changes the object", func() {
+			configMap.Data["foo"] = "changed"
+			delete(configMap.Data, "bar")
+			Expect(fakeClient.Update(ctx, configMap, client.FieldOwner(managedresource.FieldManager))).To(Succeed())
+
+			Expect(DetectDrift(old, configMap)).To(BeEmpty())
+		})
+
+		It("should not detect a drift if the managed fields are not tracked", func() {
+			configMap.ManagedFields = nil
+
+			Expect(DetectDrift(old, configMap)).To(BeEmpty())
+		})
+
+		Context("workload resources", func() {
+			var (
+				grmEntry = metav1.ManagedFieldsEntry{
+					Manager:    managedresource.FieldManager,
+					Operation:  metav1.ManagedFieldsOperationUpdate,
+					Time:       &metav1.Time{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+					FieldsType: "FieldsV1",
+					FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{},"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"foo\"}":{".":{},"f:image":{},"f:name":{},"f:resources":{"f:requests":{"f:cpu":{}}}}}}}}}}`)},
+				}
+				otherEntry = metav1.ManagedFieldsEntry{
+					Manager:    "other",
+					Operation:  metav1.ManagedFieldsOperationUpdate,
+					Time:       &metav1.Time{Time: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+					FieldsType: "FieldsV1",
+					FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{},"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"foo\"}":{"f:resources":{"f:requests":{"f:cpu":{}}}}}}}}}`)},
+				}
+				grmEntryAfterChange = metav1.ManagedFieldsEntry{
+					Manager:    managedresource.FieldManager,
+					Operation:  metav1.ManagedFieldsOperationUpdate,
+					Time:       grmEntry.Time,
+					FieldsType: "FieldsV1",
+					FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"foo\"}":{".":{},"f:image":{},"f:name":{}}}}}}}`)},
+				}
+
+				oldDeployment, newDeployment *metav1.PartialObjectMetadata
+			)
+
+			BeforeEach(func() {
+				oldDeployment = &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{ManagedFields: []metav1.ManagedFieldsEntry{grmEntry}}}
+				newDeployment = &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{ManagedFields: []metav1.ManagedFieldsEntry{grmEntryAfterChange, otherEntry}}}
+			})
+
+			It("should detect changes of the replicas and resources", func() {
+				drifts, err := DetectDrift(oldDeployment, newDeployment)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(drifts).To(HaveLen(1))
+				Expect(fieldsOf(drifts[0])).To(ConsistOf(".spec.replicas", `.spec.template.spec.containers[name="foo"].resources.requests.cpu`))
+			})
+
+			It("should not detect changes of preserved fields", func() {
+				newDeployment.Annotations = map[string]string{
+					"resources.gardener.cloud/preserve-replicas":  "true",
+					"resources.gardener.cloud/preserve-resources": "true",
+				}
+
+				Expect(DetectDrift(oldDeployment, newDeployment)).To(BeEmpty())
+			})
+
+			It("should not detect changes via subresources", func() {
+				newDeployment.ManagedFields[1].Subresource = "scale"
+
+				Expect(DetectDrift(oldDeployment, newDeployment)).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/managedresource"
+	resourcemanagermetrics "github.com/gardener/gardener/pkg/resourcemanager/metrics"
+	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+// maxDriftsInMessage is the maximum number of drifts which are listed in the message of the ResourcesDrifted condition.
+const maxDriftsInMessage = 10
+
+// Reconciler reports drifts of resources managed as part of ManagedResources, i.e., changes of other field managers to
+// fields owned by the resource manager.
+type Reconciler struct {
+	SourceClient client.Client
+	TargetClient client.Client
+	Config       resourcemanagerconfigv1alpha1.DriftControllerConfig
+	Clock        clock.Clock
+	Recorder     events.EventRecorder
+	ClassFilter  *resourcemanagerpredicate.ClassFilter
+
+	// ensureWatchForGVK ensures that the controller is watching the objects of the given GroupVersionKind to detect
+	// drifts.
+	ensureWatchForGVK func(gvk schema.GroupVersionKind) error
+	store             *store
+}
+
+// Reconcile reports the detected drifts and updates the ResourcesDrifted condition of the ManagedResource.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	mr := &resourcesv1alpha1.ManagedResource{}
+	if err := r.SourceClient.Get(ctx, req.NamespacedName, mr); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			r.store.forget(req.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if utils.IsIgnored(mr) {
+		log.Info("Skipping drift detection since ManagedResource is ignored")
+		r.store.forget(req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	if responsible := r.ClassFilter.Responsible(mr); !responsible {
+		log.Info("Stopping drift detection as the responsibility changed")
+		r.store.forget(req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	if !mr.DeletionTimestamp.IsZero() {
+		log.Info("Stopping drift detection for ManagedResource, as it is marked for deletion")
+		r.store.forget(req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	managedObjects := make(map[corev1.ObjectReference]struct{}, len(mr.Status.Resources))
+	for _, ref := range mr.Status.Resources {
+		if err := r.ensureWatchForGVK(ref.GroupVersionKind()); err != nil {
+			return reconcile.Result{}, err
+		}
+		managedObjects[objectReference(ref.GroupVersionKind(), ref.Namespace, ref.Name)] = struct{}{}
+	}
+
+	drifted := r.store.getDrifted(req.NamespacedName)
+	for ref, drifts := range r.store.popDetected(req.NamespacedName) {
+		for _, drift := range drifts {
+			log.Info("Detected drift of object", "object", ref, "manager", drift.Manager, "operation", drift.Operation)
+			r.Recorder.Eventf(mr, nil, corev1.EventTypeWarning, "ResourceDrifted", gardencorev1beta1.EventActionReconcile, "%s: %s", objectString(ref), drift)
+			resourcemanagermetrics.DriftedObjectsTotal.WithLabelValues(mr.Namespace, mr.Name, ref.Kind, drift.Manager).Inc()
+		}
+		drifted[ref] = mergeDrifts(drifted[ref], drifts...)
+	}
+
+	if err := r.removeRevertedDrifts(ctx, log, drifted, managedObjects); err != nil {
+		return reconcile.Result{}, err
+	}
+	r.store.setDrifted(req.NamespacedName, drifted)
+
+	return reconcile.Result{}, r.updateCondition(ctx, mr, drifted)
+}
+
+// removeRevertedDrifts removes the drifts whose fields are owned by the resource manager again, e.g. because the
+// ManagedResource controller reverted the changes. Drifts of objects which are gone or no longer managed are removed
+// as well.
+func (r *Reconciler) removeRevertedDrifts(ctx context.Context, log logr.Logger, drifted driftedObjects, managedObjects map[corev1.ObjectReference]struct{}) error {
+	for ref, drifts := range drifted {
+		if _, ok := managedObjects[ref]; !ok {
+			delete(drifted, ref)
+			continue
+		}
+
+		obj := &metav1.PartialObjectMetadata{}
+		obj.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+		if err := r.TargetClient.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, obj); err != nil {
+			if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+				return fmt.Errorf("failed reading object %s: %w", objectString(ref), err)
+			}
+			delete(drifted, ref)
+			continue
+		}
+
+		if obj.GetAnnotations()[resourcesv1alpha1.Ignore] == "true" {
+			delete(drifted, ref)
+			continue
+		}
+
+		owned, err := fieldsOwnedBy(obj.GetManagedFields(), managedresource.FieldManager)
+		if err != nil {
+			return fmt.Errorf("failed determining fields owned by the resource manager for object %s: %w", objectString(ref), err)
+		}
+
+		var remaining []Drift
+		for _, drift := range drifts {
+			if drift.Fields = drift.Fields.Difference(owned); !drift.Fields.Empty() {
+				remaining = append(remaining, drift)
+			}
+		}
+
+		if len(remaining) == 0 {
+			log.Info("Drift of object was reverted", "object", ref)
+			delete(drifted, ref)
+			continue
+		}
+		drifted[ref] = remaining
+	}
+
+	return nil
+}
+
+func (r *Reconciler) updateCondition(ctx context.Context, mr *resourcesv1alpha1.ManagedResource, drifted driftedObjects) error {
+	// Only add the condition once a drift was detected to not bother all ManagedResources with an additional condition.
+	if len(drifted) == 0 && v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.ResourcesDrifted) == nil {
+		return nil
+	}
+
+	var (
+		conditionResourcesDrifted = v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)
+		oldCondition              = conditionResourcesDrifted.DeepCopy()
+	)
+
+	if len(drifted) == 0 {
+		conditionResourcesDrifted = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesDrifted, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionNoDriftDetected, "No changes of other field managers are pending to be reverted.")
+	} else {
+		conditionResourcesDrifted = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesDrifted, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionDriftDetected, driftMessage(drifted))
+	}
+
+	if apiequality.Semantic.DeepEqual(oldCondition, conditionResourcesDrifted) {
+		return nil
+	}
+
+	mr.Status.Conditions = v1beta1helper.MergeConditions(mr.Status.Conditions, conditionResourcesDrifted)
+	if err := r.SourceClient.Status().Update(ctx, mr); err != nil {
+		return fmt.Errorf("could not update the ManagedResource status: %w", err)
+	}
+	return nil
+}
+
+func driftMessage(drifted driftedObjects) string {
+	refs := make([]corev1.ObjectReference, 0, len(drifted))
+	for ref := range drifted {
+		refs = append(refs, ref)
+	}
+	slices.SortFunc(refs, func(a, b corev1.ObjectReference) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	var lines []string
+	for _, ref := range refs {
+		for _, drift := range drifted[ref] {
+			lines = append(lines, fmt.Sprintf("%s: %s", objectString(ref), drift))
+		}
+	}
+
+	if len(lines) > maxDriftsInMessage {
+		lines = append(lines[:maxDriftsInMessage], fmt.Sprintf("... and %d more", len(lines)-maxDriftsInMessage))
+	}
+
+	return "Fields of resources have been changed by other field managers:\n" + strings.Join(lines, "\n")
+}
+
+func objectReference(gvk schema.GroupVersionKind, namespace, name string) corev1.ObjectReference {
+	apiVersion, kind := gvk.ToAPIVersionAndKind()
+	return corev1.ObjectReference{APIVersion: apiVersion, Kind: kind, Namespace: namespace, Name: name}
+}
+
+func objectString(ref corev1.ObjectReference) string {
+	return fmt.Sprintf("%s %q", ref.Kind, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}.String())
+}
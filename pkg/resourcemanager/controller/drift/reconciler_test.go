@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcemanagerclient "github.com/gardener/gardener/pkg/resourcemanager/client"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/managedresource"
+	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.Background()
+
+		sourceClient client.Client
+		targetClient client.Client
+		recorder     *events.FakeRecorder
+		watchedGVKs  []schema.GroupVersionKind
+		reconciler   *Reconciler
+
+		managedResource *resourcesv1alpha1.ManagedResource
+		configMap       *corev1.ConfigMap
+		configMapRef    corev1.ObjectReference
+		request         reconcile.Request
+	)
+
+	BeforeEach(func() {
+		sourceClient = fakeclient.NewClientBuilder().WithScheme(resourcemanagerclient.SourceScheme).WithStatusSubresource(&resourcesv1alpha1.ManagedResource{}).Build()
+		targetClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithReturnManagedFields().Build()
+		recorder = events.NewFakeRecorder(10)
+		watchedGVKs = nil
+
+		reconciler = &Reconciler{
+			SourceClient: sourceClient,
+			TargetClient: targetClient,
+			Clock:        testclock.NewFakeClock(time.Now()),
+			Recorder:     recorder,
+			ClassFilter:  resourcemanagerpredicate.NewClassFilter(""),
+			ensureWatchForGVK: func(gvk schema.GroupVersionKind) error {
+				watchedGVKs = append(watchedGVKs, gvk)
+				return nil
+			},
+			store: newStore(),
+		}
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{Name: "mr", Namespace: "garden"},
+		}
+		Expect(sourceClient.Create(ctx, managedResource)).To(Succeed())
+		managedResource.Status.Resources = []resourcesv1alpha1.ObjectReference{{
+			ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "foo"},
+		}}
+		Expect(sourceClient.Status().Update(ctx, managedResource)).To(Succeed())
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(managedResource)}
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+			Data:       map[string]string{"foo": "bar"},
+		}
+		Expect(targetClient.Create(ctx, configMap, client.FieldOwner(managedresource.FieldManager))).To(Succeed())
+		configMapRef = corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "foo"}
+	})
+
+	changeConfigMap := func(manager, value string) {
+		old := configMap.DeepCopy()
+		configMap.Data["foo"] = value
+		ExpectWithOffset(1, targetClient.Update(ctx, configMap, client.FieldOwner(manager))).To(Succeed())
+
+		drifts, err := DetectDrift(old, configMap)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		if len(drifts) > 0 {
+			reconciler.store.record(request.NamespacedName, configMapRef, drifts)
+		}
+	}
+
+	driftedCondition := func() *gardencorev1beta1.Condition {
+		ExpectWithOffset(1, sourceClient.Get(ctx, request.NamespacedName, managedResource)).To(Succeed())
+		return v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)
+	}
+
+	It("should ensure the watches and not add the condition if no drift was detected", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(watchedGVKs).To(ConsistOf(corev1.SchemeGroupVersion.WithKind("ConfigMap")))
+		Expect(driftedCondition()).To(BeNil())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should report a detected drift", func() {
+		changeConfigMap("kubectl-edit", "changed")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(recorder.Events).To(Receive(Equal(`Warning ResourceDrifted ConfigMap "default/foo": .data.foo changed by "kubectl-edit" (Update)`)))
+		condition := driftedCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(resourcesv1alpha1.ConditionDriftDetected))
+		Expect(condition.Message).To(Equal("Fields of resources have been changed by other field managers:\n" + `ConfigMap "default/foo": .data.foo changed by "kubectl-edit" (Update)`))
+		Expect(reconciler.store.tracks(request.NamespacedName, configMapRef)).To(BeTrue())
+	})
+
+	It("should reset the condition once the drift was reverted", func() {
+		changeConfigMap("kubectl-edit", "changed")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(driftedCondition().Status).To(Equal(gardencorev1beta1.ConditionTrue))
+
+		changeConfigMap(managedresource.FieldManager, "bar")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		condition := driftedCondition()
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(resourcesv1alpha1.ConditionNoDriftDetected))
+		Expect(reconciler.store.tracks(request.NamespacedName, configMapRef)).To(BeFalse())
+	})
+
+	It("should forget drifts of objects which are no longer managed", func() {
+		changeConfigMap("kubectl-edit", "changed")
+		managedResource.Status.Resources = nil
+		Expect(sourceClient.Status().Update(ctx, managedResource)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(driftedCondition()).To(BeNil())
+		Expect(reconciler.store.tracks(request.NamespacedName, configMapRef)).To(BeFalse())
+	})
+
+	It("should forget drifts if the ManagedResource is gone", func() {
+		changeConfigMap("kubectl-edit", "changed")
+		Expect(sourceClient.Delete(ctx, managedResource)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(reconciler.store.tracks(request.NamespacedName, configMapRef)).To(BeFalse())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// driftedObjects maps references of objects to their drifts.
+type driftedObjects map[corev1.ObjectReference][]Drift
+
+// store keeps track of the drifts of the objects of ManagedResources. The information is only kept in memory since it
+// can only be derived from changes of the objects which are observed by the watches of the controller.
+type store struct {
+	lock sync.Mutex
+	// detected contains the drifts which were detected since the last reconciliation of the respective ManagedResource.
+	detected map[types.NamespacedName]driftedObjects
+	// drifted contains the drifts which were not reverted yet, i.e., the changed fields are not owned by the resource
+	// manager again.
+	drifted map[types.NamespacedName]driftedObjects
+}
+
+func newStore() *store {
+	return &store{
+		detected: make(map[types.NamespacedName]driftedObjects),
+		drifted:  make(map[types.NamespacedName]driftedObjects),
+	}
+}
+
+// record records the detected drifts of the given object of the given ManagedResource.
+func (s *store) record(key types.NamespacedName, ref corev1.ObjectReference, drifts []Drift) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.detected[key] == nil {
+		s.detected[key] = make(driftedObjects)
+	}
+	s.detected[key][ref] = mergeDrifts(s.detected[key][ref], drifts...)
+}
+
+// tracks returns true if drifts of the given object of the given ManagedResource are known.
+func (s *store) tracks(key types.NamespacedName, ref corev1.ObjectReference) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, detected := s.detected[key][ref]
+	_, drifted := s.drifted[key][ref]
+	return detected || drifted
+}
+
+// popDetected returns and removes the drifts which were detected since the last call for the given ManagedResource.
+func (s *store) popDetected(key types.NamespacedName) driftedObjects {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	detected := s.detected[key]
+	delete(s.detected, key)
+	return detected
+}
+
+// getDrifted returns a copy of the drifts which were not reverted yet for the given ManagedResource.
+func (s *store) getDrifted(key types.NamespacedName) driftedObjects {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	drifted := make(driftedObjects, len(s.drifted[key]))
+	for ref, drifts := range s.drifted[key] {
+		drifted[ref] = append([]Drift(nil), drifts...)
+	}
+	return drifted
+}
+
+// setDrifted stores the drifts which were not reverted yet for the given ManagedResource.
+func (s *store) setDrifted(key types.NamespacedName, drifted driftedObjects) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(drifted) == 0 {
+		delete(s.drifted, key)
+		return
+	}
+	s.drifted[key] = drifted
+}
+
+// forget removes all information about the given ManagedResource.
+func (s *store) forget(key types.NamespacedName) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.detected, key)
+	delete(s.drifted, key)
+}
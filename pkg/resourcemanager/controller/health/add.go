@@ -14,21 +14,29 @@ import (
 	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/health"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/progressing"
+	"github.com/gardener/gardener/pkg/resourcemanager/customhealthcheck"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
 )
 
 // AddToManager adds all health controllers to the given manager.
 func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targetCluster cluster.Cluster, cfg resourcemanagerconfigv1alpha1.ResourceManagerConfiguration) error {
+	customHealthChecks, err := customhealthcheck.New(cfg.Controllers.Health.CustomHealthChecks)
+	if err != nil {
+		return fmt.Errorf("failed compiling custom health checks: %w", err)
+	}
+
 	if err := (&health.Reconciler{
-		Config:      cfg.Controllers.Health,
-		ClassFilter: resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
+		Config:             cfg.Controllers.Health,
+		ClassFilter:        resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
+		CustomHealthChecks: customHealthChecks,
 	}).AddToManager(mgr, sourceCluster, targetCluster, *cfg.Controllers.ClusterID); err != nil {
 		return fmt.Errorf("failed adding health reconciler: %w", err)
 	}
 
 	if err := (&progressing.Reconciler{
-		Config:      cfg.Controllers.Health,
-		ClassFilter: resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
+		Config:             cfg.Controllers.Health,
+		ClassFilter:        resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
+		CustomHealthChecks: customHealthChecks,
 	}).AddToManager(ctx, mgr, sourceCluster, targetCluster, *cfg.Controllers.ClusterID); err != nil {
 		return fmt.Errorf("failed adding progressing reconciler: %w", err)
 	}
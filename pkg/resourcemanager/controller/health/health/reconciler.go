@@ -13,6 +13,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/clock"
@@ -26,6 +27,7 @@ import (
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+	"github.com/gardener/gardener/pkg/resourcemanager/customhealthcheck"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
 )
 
@@ -37,6 +39,8 @@ type Reconciler struct {
 	Config       resourcemanagerconfigv1alpha1.HealthControllerConfig
 	Clock        clock.Clock
 	ClassFilter  *resourcemanagerpredicate.ClassFilter
+	// CustomHealthChecks are the configured health checks for resources without a built-in health check.
+	CustomHealthChecks *customhealthcheck.Checks
 
 	// ensureWatchForGVK ensures that the controller is watching the given object to reconcile corresponding
 	// ManagedResources on health status changes.
@@ -101,7 +105,7 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			objectLog = log.WithValues("object", objectKey, "objectGVK", objectGVK)
 		)
 
-		obj, err := newObjectForHealthCheck(objectLog, r.TargetScheme, objectGVK)
+		obj, err := r.newObjectForHealthCheck(objectLog, objectGVK)
 		if err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to construct new object for reference: %w", err)
 		}
@@ -134,7 +138,7 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 		}
 
-		if checked, err := utils.CheckHealth(obj); err != nil {
+		if checked, err := utils.CheckHealthWithCustomChecks(obj, r.CustomHealthChecks); err != nil {
 			var (
 				reason  = ref.Kind + "Unhealthy"
 				message = fmt.Sprintf("%s %q is unhealthy: %v", ref.Kind, objectKey.String(), err)
@@ -180,14 +184,22 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
 
-func newObjectForHealthCheck(log logr.Logger, scheme *runtime.Scheme, gvk schema.GroupVersionKind) (client.Object, error) {
+func (r *Reconciler) newObjectForHealthCheck(log logr.Logger, gvk schema.GroupVersionKind) (client.Object, error) {
+	// Custom health checks are performed on unstructured objects, so they can be configured for arbitrary resources
+	// without registering them in the target scheme. This also allows overriding the built-in health checks.
+	if r.CustomHealthChecks.Has(gvk.GroupKind()) {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		return obj, nil
+	}
+
 	// Create a typed object if GVK is registered in scheme. This object will be fully watched in the target cluster.
 	// If we don't know the GVK, we definitely don't have a dedicated health check for it.
 	// I.e., we only care about whether the object is present or not.
 	// Hence, we can use metadata-only requests/watches instead of watching the entire object, which saves bandwidth and
 	// memory.
 	// If the target cache is disabled, no watches will be started.
-	typedObject, err := scheme.New(gvk)
+	typedObject, err := r.TargetScheme.New(gvk)
 	if err != nil {
 		if !runtime.IsNotRegisteredError(err) {
 			return nil, err
@@ -7,6 +7,7 @@ package progressing
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
 	"github.com/go-logr/logr"
@@ -15,6 +16,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -122,6 +125,42 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 		}
 	}
 
+	// Resources with custom health checks might be served by CRDs which are installed only after the controller was
+	// started, hence they are watched once they are referenced by a ManagedResource.
+	lock := sync.RWMutex{}
+	watchedObjectGVKs := sets.New[schema.GroupVersionKind]()
+	r.ensureWatchForGVK = func(gvk schema.GroupVersionKind, obj client.Object) error {
+		// fast-check: have we already added watch for this GVK?
+		lock.RLock()
+		if watchedObjectGVKs.Has(gvk) {
+			lock.RUnlock()
+			return nil
+		}
+		lock.RUnlock()
+
+		// slow-check: two goroutines might concurrently call this func. If neither exited early, the first one added
+		// the watch and the second one should return now.
+		lock.Lock()
+		defer lock.Unlock()
+		if watchedObjectGVKs.Has(gvk) {
+			return nil
+		}
+
+		c.GetLogger().Info("Adding new watch for GroupVersionKind with custom health check", "groupVersionKind", gvk)
+
+		if err := c.Watch(source.Kind[client.Object](
+			targetCluster.GetCache(),
+			obj,
+			handler.EnqueueRequestsFromMapFunc(utils.MapToOriginManagedResource(c.GetLogger(), clusterID)),
+			r.ProgressingStatusChanged(ctx),
+		)); err != nil {
+			return fmt.Errorf("error starting watch for GVK %s: %w", gvk.String(), err)
+		}
+
+		watchedObjectGVKs.Insert(gvk)
+		return nil
+	}
+
 	return nil
 }
 
@@ -15,6 +15,8 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -27,6 +29,7 @@ import (
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+	"github.com/gardener/gardener/pkg/resourcemanager/customhealthcheck"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 )
@@ -38,6 +41,12 @@ type Reconciler struct {
 	Config       resourcemanagerconfigv1alpha1.HealthControllerConfig
 	Clock        clock.Clock
 	ClassFilter  *resourcemanagerpredicate.ClassFilter
+	// CustomHealthChecks are the configured health checks for resources without a built-in health check.
+	CustomHealthChecks *customhealthcheck.Checks
+
+	// ensureWatchForGVK ensures that the controller is watching the given object with a custom health check to reconcile
+	// corresponding ManagedResources on progressing status changes.
+	ensureWatchForGVK func(gvk schema.GroupVersionKind, obj client.Object) error
 }
 
 // Reconcile performs the progressing checks.
@@ -90,29 +99,26 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	conditionResourcesProgressing := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesProgressing)
 
 	for _, ref := range mr.Status.Resources {
+		var obj client.Object
+
+		switch {
+		case r.CustomHealthChecks.Has(ref.GroupVersionKind().GroupKind()):
+			// custom health checks are performed on unstructured objects, see health controller
+			u := &unstructured.Unstructured{}
+			u.SetGroupVersionKind(ref.GroupVersionKind())
+			obj = u
+
+			if err := r.ensureWatchForGVK(ref.GroupVersionKind(), obj); err != nil {
+				return reconcile.Result{}, err
+			}
 		// Skip API groups that are irrelevant for progressing checks.
-		if !sets.New(appsv1.GroupName, monitoring.GroupName, certv1alpha1.GroupName).Has(ref.GroupVersionKind().Group) {
+		case !sets.New(appsv1.GroupName, monitoring.GroupName, certv1alpha1.GroupName).Has(ref.GroupVersionKind().Group):
 			continue
+		default:
+			obj = newObjectForProgressingCheck(ref.Kind)
 		}
 
-		var obj client.Object
-
-		switch ref.Kind {
-		case "Deployment":
-			obj = &appsv1.Deployment{}
-		case "StatefulSet":
-			obj = &appsv1.StatefulSet{}
-		case "DaemonSet":
-			obj = &appsv1.DaemonSet{}
-		case "Prometheus":
-			obj = &monitoringv1.Prometheus{}
-		case "Alertmanager":
-			obj = &monitoringv1.Alertmanager{}
-		case "Certificate":
-			obj = &certv1alpha1.Certificate{}
-		case "Issuer":
-			obj = &certv1alpha1.Issuer{}
-		default:
+		if obj == nil {
 			continue
 		}
 
@@ -171,6 +177,27 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
 
+// newObjectForProgressingCheck returns a new object of the given kind if there is a built-in progressing check for it.
+func newObjectForProgressingCheck(kind string) client.Object {
+	switch kind {
+	case "Deployment":
+		return &appsv1.Deployment{}
+	case "StatefulSet":
+		return &appsv1.StatefulSet{}
+	case "DaemonSet":
+		return &appsv1.DaemonSet{}
+	case "Prometheus":
+		return &monitoringv1.Prometheus{}
+	case "Alertmanager":
+		return &monitoringv1.Alertmanager{}
+	case "Certificate":
+		return &certv1alpha1.Certificate{}
+	case "Issuer":
+		return &certv1alpha1.Issuer{}
+	}
+	return nil
+}
+
 // checkProgressing checks whether the given object is progressing. It returns a bool indicating whether the object is
 // progressing, a reason for it if so and an error if the check failed.
 func (r *Reconciler) checkProgressing(ctx context.Context, obj client.Object) (bool, string, error) {
@@ -217,6 +244,9 @@ func (r *Reconciler) checkProgressing(ctx context.Context, obj client.Object) (b
 
 	case *certv1alpha1.Issuer:
 		progressing, reason = health.IsCertificateIssuerProgressing(o)
+
+	default:
+		return r.CustomHealthChecks.CheckProgressing(obj)
 	}
 
 	return progressing, reason, nil
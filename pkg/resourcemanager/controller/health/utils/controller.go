@@ -16,10 +16,12 @@ import (
 
 	resourcesv1alpha1helper "github.com/gardener/gardener/pkg/api/resources/v1alpha1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/customhealthcheck"
 )
 
 // HealthStatusChanged returns a predicate that filters for events that indicate a change in the object's health status.
-func HealthStatusChanged(log logr.Logger) predicate.Predicate {
+// Objects without a built-in health check are checked with the given custom health checks.
+func HealthStatusChanged(log logr.Logger, customHealthChecks *customhealthcheck.Checks) predicate.Predicate {
 	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
 			return e.Object.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] != "true"
@@ -34,7 +36,7 @@ func HealthStatusChanged(log logr.Logger) predicate.Predicate {
 			}
 
 			var oldHealthy, newHealthy bool
-			checked, oldErr := CheckHealth(e.ObjectOld)
+			checked, oldErr := CheckHealthWithCustomChecks(e.ObjectOld, customHealthChecks)
 			if !checked {
 				if oldErr != nil {
 					log.Error(oldErr, "Error determining health status of old object", "object", e.ObjectOld)
@@ -43,7 +45,7 @@ func HealthStatusChanged(log logr.Logger) predicate.Predicate {
 			}
 			oldHealthy = oldErr != nil
 
-			checked, newErr := CheckHealth(e.ObjectNew)
+			checked, newErr := CheckHealthWithCustomChecks(e.ObjectNew, customHealthChecks)
 			if !checked {
 				if newErr != nil {
 					log.Error(newErr, "Error determining health status of new object", "object", e.ObjectNew)
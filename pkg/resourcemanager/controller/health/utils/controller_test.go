@@ -13,14 +13,17 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
 	. "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+	"github.com/gardener/gardener/pkg/resourcemanager/customhealthcheck"
 )
 
 var _ = Describe("HealthStatusChanged", func() {
@@ -31,7 +34,14 @@ var _ = Describe("HealthStatusChanged", func() {
 
 	BeforeEach(func() {
 		log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(GinkgoWriter))
-		p = HealthStatusChanged(log)
+		customHealthChecks, err := customhealthcheck.New([]resourcemanagerconfigv1alpha1.CustomHealthCheck{{
+			Group:      "example.com",
+			Kind:       "Foo",
+			Conditions: &resourcemanagerconfigv1alpha1.ConditionsHealthCheck{HealthyConditions: []string{"Ready"}},
+		}})
+		Expect(err).NotTo(HaveOccurred())
+
+		p = HealthStatusChanged(log, customHealthChecks)
 	})
 
 	Context("metadata-only events", func() {
@@ -151,6 +161,41 @@ var _ = Describe("HealthStatusChanged", func() {
 		})
 	})
 
+	Context("events of resources with custom health checks", func() {
+		var (
+			healthy, unhealthy *unstructured.Unstructured
+		)
+
+		BeforeEach(func() {
+			newObject := func(status string) *unstructured.Unstructured {
+				obj := &unstructured.Unstructured{Object: map[string]any{
+					"status": map[string]any{"conditions": []any{map[string]any{"type": "Ready", "status": status}}},
+				}}
+				obj.SetAPIVersion("example.com/v1")
+				obj.SetKind("Foo")
+				obj.SetResourceVersion("1")
+				return obj
+			}
+
+			healthy = newObject("True")
+			unhealthy = newObject("False")
+		})
+
+		It("should return true for Update, if the health status has changed", func() {
+			healthyOld := healthy.DeepCopy()
+			healthyOld.SetResourceVersion("2")
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: healthyOld, ObjectNew: unhealthy})).To(BeTrue())
+		})
+
+		It("should ignore Update, if the health status has not changed", func() {
+			healthyOld := healthy.DeepCopy()
+			healthyOld.SetResourceVersion("2")
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: healthyOld, ObjectNew: healthy})).To(BeFalse())
+		})
+	})
+
 	Describe("#MapToOriginManagedResource", func() {
 		var (
 			ctx = context.TODO()
@@ -21,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/customhealthcheck"
 	"github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 )
@@ -95,6 +96,17 @@ func CheckHealth(obj client.Object) (bool, error) {
 	return false, nil
 }
 
+// CheckHealthWithCustomChecks checks whether the given object is healthy. Objects without a built-in health check are
+// checked with the given custom health checks.
+// It returns a bool indicating whether the object was actually checked and an error if any health check failed.
+func CheckHealthWithCustomChecks(obj client.Object, customHealthChecks *customhealthcheck.Checks) (bool, error) {
+	if checked, err := CheckHealth(obj); checked || err != nil {
+		return checked, err
+	}
+
+	return customHealthChecks.CheckHealth(obj)
+}
+
 // FetchAdditionalFailureMessage fetches warning event messages for some objects as additional failure information.
 func FetchAdditionalFailureMessage(ctx context.Context, c client.Client, obj client.Object) (string, error) {
 	switch obj.(type) {
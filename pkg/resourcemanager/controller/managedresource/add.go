@@ -25,6 +25,7 @@ import (
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // ControllerName is the name of the controller.
@@ -91,11 +92,11 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 			// See https://github.com/kubernetes-sigs/controller-runtime/pull/3406 for more information.
 			builder.WithPredicates(predicateutils.ForEventTypes(predicateutils.Update)),
 		).
-		Complete(reconcilerutils.OperationAnnotationWrapper(
+		Complete(tracing.Reconciler(ControllerName, "managedresource", reconcilerutils.OperationAnnotationWrapper(
 			mgr,
 			func() client.Object { return &resourcesv1alpha1.ManagedResource{} },
 			r,
-		))
+		)))
 }
 
 // MapSecretToManagedResources maps secrets to relevant ManagedResources.
@@ -0,0 +1,397 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/pmezard/go-difflib/difflib"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+const (
+	// maxDiffLength is the maximum length of the diff of a single pending change. Longer diffs are truncated.
+	maxDiffLength = 4096
+	// maxTotalDiffLength is the maximum total length of the diffs of all pending changes of a ManagedResource. The diffs
+	// exceeding it are omitted to keep the size of the ManagedResource reasonable.
+	maxTotalDiffLength = 256 * 1024
+)
+
+// dryRun computes the changes which would be made to the objects in the target cluster and records them in the status
+// of the ManagedResource without applying them.
+func (r *Reconciler) dryRun(
+	ctx context.Context,
+	log logr.Logger,
+	mr *resourcesv1alpha1.ManagedResource,
+	origin string,
+	newResourcesObjects []object,
+	equivalences Equivalences,
+	existingResourcesIndex *objectIndex,
+	decodingErrors []*decodingError,
+	conditionResourcesApplied gardencorev1beta1.Condition,
+) (
+	reconcile.Result,
+	error,
+) {
+	log.Info("Computing pending changes because ManagedResource is in dry-run mode")
+
+	labelsToInject := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
+
+	pendingChanges, err := r.computePendingChanges(ctx, origin, newResourcesObjects, labelsToInject, equivalences, existingResourcesIndex)
+	if err != nil {
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionDryRun, fmt.Sprintf("Could not compute pending changes: %s", err))
+		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+
+		return reconcile.Result{}, fmt.Errorf("could not compute pending changes: %w", err)
+	}
+	limitDiffs(pendingChanges)
+
+	switch {
+	case len(decodingErrors) != 0:
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionDecodingFailed, fmt.Sprintf("Could not decode all new resources: %v", decodingErrors))
+	case len(pendingChanges) != 0:
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionDryRun, fmt.Sprintf("Dry-run mode is enabled, %d changes to objects in the target cluster are pending.", len(pendingChanges)))
+	default:
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionDryRun, "Dry-run mode is enabled, no changes to objects in the target cluster are pending.")
+	}
+
+	mr.Status.Conditions = v1beta1helper.MergeConditions(mr.Status.Conditions, conditionResourcesApplied)
+	mr.Status.PendingChanges = pendingChanges
+	mr.Status.ObservedGeneration = mr.Generation
+	if err := r.SourceClient.Status().Update(ctx, mr); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+	}
+
+	log.Info("Finished to compute pending changes", "pendingChanges", len(pendingChanges))
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// computePendingChanges computes the changes which would be made when applying the given objects and deleting the old
+// objects which are no longer part of the ManagedResource.
+func (r *Reconciler) computePendingChanges(ctx context.Context, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences, index *objectIndex) ([]resourcesv1alpha1.PendingChange, error) {
+	horizontallyScaledObjects, err := computeHorizontallyScaledObjectKeys(ctx, r.TargetClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
+	}
+
+	var pendingChanges []resourcesv1alpha1.PendingChange
+
+	for _, obj := range sortByKind(newResourcesObjects) {
+		pendingChange, err := r.pendingChangeForObject(ctx, origin, obj, labelsToInject, isScaled(obj.obj, horizontallyScaledObjects, equivalences))
+		if err != nil {
+			return nil, fmt.Errorf("error computing pending change for object %q: %w", unstructuredToString(obj.obj), err)
+		}
+		if pendingChange != nil {
+			pendingChanges = append(pendingChanges, *pendingChange)
+		}
+	}
+
+	var deletions []resourcesv1alpha1.PendingChange
+
+	for _, ref := range index.Objects() {
+		if index.Found(ref) {
+			continue
+		}
+
+		current := &unstructured.Unstructured{}
+		current.SetAPIVersion(ref.APIVersion)
+		current.SetKind(ref.Kind)
+		if err := r.TargetClient.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, current); err != nil {
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("error reading old object %q: %w", unstructuredToString(current), err)
+		}
+
+		if keepObject(current) || (r.GarbageCollectorActivated && isGarbageCollectableResource(current)) {
+			continue
+		}
+
+		diff, err := unifiedDiff(current, nil)
+		if err != nil {
+			return nil, err
+		}
+		deletions = append(deletions, newPendingChange(current, resourcesv1alpha1.ChangeOperationDelete, diff, ""))
+	}
+
+	slices.SortFunc(deletions, func(a, b resourcesv1alpha1.PendingChange) int {
+		return strings.Compare(pendingChangeKey(a), pendingChangeKey(b))
+	})
+
+	return append(pendingChanges, deletions...), nil
+}
+
+// pendingChangeForObject computes the change which would be made when applying the given object. It returns nil if the
+// object would not be changed. The desired state of existing objects is computed with a dry-run request, so that it
+// contains the changes of defaulting and admission webhooks.
+func (r *Reconciler) pendingChangeForObject(ctx context.Context, origin string, obj object, labelsToInject map[string]string, scaledHorizontally bool) (*resourcesv1alpha1.PendingChange, error) {
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(obj.obj.GroupVersionKind())
+	if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(obj.obj), current); err != nil {
+		if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return nil, err
+		}
+		current = nil
+	}
+
+	// if the ignore annotation is set to true, the object is only created but never updated
+	if current != nil && ignore(obj.obj) {
+		return nil, nil
+	}
+
+	var (
+		desired *unstructured.Unstructured
+		err     error
+	)
+
+	if obj.serverSideApply {
+		desired, err = desiredObjectForServerSideApply(origin, obj, current, labelsToInject, scaledHorizontally)
+	} else {
+		desired, err = desiredObjectForClientSideApply(origin, obj, current, labelsToInject, scaledHorizontally)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if current == nil {
+		diff, err := unifiedDiff(nil, desired)
+		if err != nil {
+			return nil, err
+		}
+		change := newPendingChange(desired, resourcesv1alpha1.ChangeOperationCreate, diff, "")
+		return &change, nil
+	}
+
+	var message string
+	if obj.serverSideApply {
+		if err := r.TargetClient.Patch(ctx, desired, client.Apply, client.FieldOwner(FieldManager), client.DryRunAll); err != nil {
+			if !apierrors.IsConflict(err) {
+				return nil, err
+			}
+
+			// Compute the diff nevertheless to show the conflicting changes.
+			message = err.Error()
+			if err := r.TargetClient.Patch(ctx, desired, client.Apply, client.FieldOwner(FieldManager), client.ForceOwnership, client.DryRunAll); err != nil {
+				return nil, err
+			}
+		}
+	} else if err := r.TargetClient.Update(ctx, desired, client.DryRunAll); err != nil {
+		if !apierrors.IsInvalid(err) {
+			return nil, err
+		}
+
+		change := newPendingChange(current, resourcesv1alpha1.ChangeOperationUpdate, "", err.Error())
+		return &change, nil
+	}
+
+	diff, err := unifiedDiff(current, desired)
+	if err != nil {
+		return nil, err
+	}
+	if diff == "" && message == "" {
+		return nil, nil
+	}
+
+	change := newPendingChange(current, resourcesv1alpha1.ChangeOperationUpdate, diff, message)
+	return &change, nil
+}
+
+// desiredObjectForClientSideApply returns the object to be updated for the given object of the ManagedResource.
+// current is the object in the target cluster, or nil if it does not exist.
+func desiredObjectForClientSideApply(origin string, obj object, current *unstructured.Unstructured, labelsToInject map[string]string, scaledHorizontally bool) (*unstructured.Unstructured, error) {
+	desired := obj.obj.DeepCopy()
+	if err := injectLabels(desired, labelsToInject); err != nil {
+		return nil, fmt.Errorf("error injecting labels: %w", err)
+	}
+
+	merged := desired.DeepCopy()
+	if current != nil {
+		merged = current.DeepCopy()
+	}
+
+	if err := merge(origin, desired, merged, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally); err != nil {
+		return nil, err
+	}
+
+	return merged, nil
+}
+
+// ignoredFieldsForDiff are the fields which are not considered when computing the diff between the current and the
+// desired state of an object since they are not managed by the resource manager.
+var ignoredFieldsForDiff = [][]string{
+	{"metadata", "creationTimestamp"},
+	{"metadata", "generation"},
+	{"metadata", "managedFields"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "uid"},
+	{"status"},
+}
+
+// unifiedDiff returns a unified diff between the YAML representations of the given objects. Either of them can be nil
+// to compute the diff for a creation or deletion. It returns an empty string if the objects do not differ. The data of
+// secrets is redacted, see redactSecretData.
+func unifiedDiff(current, desired *unstructured.Unstructured) (string, error) {
+	current, desired = redactSecretData(current, desired)
+
+	currentYAML, err := diffableYAML(current)
+	if err != nil {
+		return "", err
+	}
+
+	desiredYAML, err := diffableYAML(desired)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(currentYAML),
+		B:        splitLines(desiredYAML),
+		FromFile: "current",
+		ToFile:   "desired",
+		Context:  3,
+	})
+}
+
+const (
+	redactedValue        = "<redacted>"
+	redactedChangedValue = "<redacted, changed>"
+)
+
+// redactSecretData returns copies of the given objects in which the values of the data and stringData fields are
+// replaced if the objects are secrets, so that the diff does not leak them into the status of the ManagedResource.
+// Values of the desired object which differ from the values of an existing current object are replaced differently, so
+// that the diff still shows which keys are changed.
+func redactSecretData(current, desired *unstructured.Unstructured) (*unstructured.Unstructured, *unstructured.Unstructured) {
+	if !isSecret(current) && !isSecret(desired) {
+		return current, desired
+	}
+
+	var redactedCurrent, redactedDesired *unstructured.Unstructured
+
+	for _, field := range []string{"data", "stringData"} {
+		var currentValues map[string]any
+		if current != nil {
+			if redactedCurrent == nil {
+				redactedCurrent = current.DeepCopy()
+			}
+			currentValues, _, _ = unstructured.NestedMap(current.Object, field)
+			redactValues(redactedCurrent, field, func(string, any) string { return redactedValue })
+		}
+
+		if desired != nil {
+			if redactedDesired == nil {
+				redactedDesired = desired.DeepCopy()
+			}
+			redactValues(redactedDesired, field, func(key string, value any) string {
+				if currentValue, ok := currentValues[key]; current != nil && (!ok || currentValue != value) {
+					return redactedChangedValue
+				}
+				return redactedValue
+			})
+		}
+	}
+
+	return redactedCurrent, redactedDesired
+}
+
+func redactValues(obj *unstructured.Unstructured, field string, redact func(key string, value any) string) {
+	values, ok := obj.Object[field].(map[string]any)
+	if !ok {
+		return
+	}
+
+	for key, value := range values {
+		values[key] = redact(key, value)
+	}
+}
+
+func isSecret(obj *unstructured.Unstructured) bool {
+	return obj != nil && obj.GroupVersionKind().GroupKind() == corev1.SchemeGroupVersion.WithKind("Secret").GroupKind()
+}
+
+// splitLines splits the given text into lines including their line breaks. In contrast to difflib.SplitLines, it does
+// not add an empty line at the end.
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func diffableYAML(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+
+	obj = obj.DeepCopy()
+	for _, field := range ignoredFieldsForDiff {
+		unstructured.RemoveNestedField(obj.Object, field...)
+	}
+
+	out, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed marshalling object %q: %w", unstructuredToString(obj), err)
+	}
+	return string(out), nil
+}
+
+// limitDiffs truncates the diffs of the given pending changes which exceed maxDiffLength and omits the diffs once their
+// total length exceeds maxTotalDiffLength.
+func limitDiffs(pendingChanges []resourcesv1alpha1.PendingChange) {
+	var totalLength int
+
+	for i := range pendingChanges {
+		if len(pendingChanges[i].Diff) > maxDiffLength {
+			diff := pendingChanges[i].Diff[:maxDiffLength]
+			if j := strings.LastIndex(diff, "\n"); j > 0 {
+				diff = diff[:j+1]
+			}
+			pendingChanges[i].Diff = diff + "... (truncated)\n"
+		}
+
+		totalLength += len(pendingChanges[i].Diff)
+		if totalLength > maxTotalDiffLength {
+			pendingChanges[i].Diff = ""
+			if pendingChanges[i].Message == "" {
+				pendingChanges[i].Message = "Diff is omitted because the diffs of all pending changes are too long."
+			}
+		}
+	}
+}
+
+func newPendingChange(obj *unstructured.Unstructured, operation resourcesv1alpha1.ChangeOperation, diff, message string) resourcesv1alpha1.PendingChange {
+	return resourcesv1alpha1.PendingChange{
+		ObjectReference: corev1.ObjectReference{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		},
+		Operation: operation,
+		Diff:      diff,
+		Message:   message,
+	}
+}
+
+func pendingChangeKey(c resourcesv1alpha1.PendingChange) string {
+	return objectKeyByReference(resourcesv1alpha1.ObjectReference{ObjectReference: c.ObjectReference})
+}
@@ -0,0 +1,280 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+var _ = Describe("dry-run", func() {
+	const origin = "test:foo/bar"
+
+	newConfigMap := func(name string, data map[string]any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": name, "namespace": "default"},
+			"data":       data,
+		}}
+	}
+
+	Describe("#unifiedDiff", func() {
+		It("should return an empty diff for equal objects", func() {
+			current := newConfigMap("foo", map[string]any{"foo": "bar"})
+			current.SetResourceVersion("42")
+			current.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "foo"}})
+
+			Expect(unifiedDiff(current, newConfigMap("foo", map[string]any{"foo": "bar"}))).To(BeEmpty())
+		})
+
+		It("should compute the diff for an update", func() {
+			Expect(unifiedDiff(newConfigMap("foo", map[string]any{"foo": "bar"}), newConfigMap("foo", map[string]any{"foo": "baz"}))).To(Equal(`--- current
++++ desired
+@@ -1,6 +1,6 @@
+ apiVersion: v1
+ data:
+-  foo: bar
++  foo: baz
+ kind: ConfigMap
+ metadata:
+   name: foo
+`))
+		})
+
+		It("should compute the diff for a creation", func() {
+			Expect(unifiedDiff(nil, newConfigMap("foo", nil))).To(Equal(`--- current
++++ desired
+@@ -0,0 +1,6 @@
++apiVersion: v1
++data: null
++kind: ConfigMap
++metadata:
++  name: foo
++  namespace: default
+`))
+		})
+
+		It("should compute the diff for a deletion", func() {
+			Expect(unifiedDiff(newConfigMap("foo", nil), nil)).To(HavePrefix(`--- current
++++ desired
+@@ -1,6 +0,0 @@
+-apiVersion: v1
+`))
+		})
+	})
+
+	Describe("#unifiedDiff for secrets", func() {
+		newSecret := func(data map[string]any) *unstructured.Unstructured {
+			return &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]any{"name": "foo", "namespace": "default"},
+				"data":       data,
+			}}
+		}
+
+		It("should redact the data of secrets and show which keys are changed", func() {
+			current := newSecret(map[string]any{"password": "c2VjcmV0", "username": "YWRtaW4="})
+			desired := newSecret(map[string]any{"password": "bmV3LXNlY3JldA==", "username": "YWRtaW4=", "token": "dG9rZW4="})
+			desired.Object["stringData"] = map[string]any{"key": "plain-secret"}
+
+			Expect(unifiedDiff(current, desired)).To(Equal(`--- current
++++ desired
+@@ -1,8 +1,11 @@
+ apiVersion: v1
+ data:
+-  password: <redacted>
++  password: <redacted, changed>
++  token: <redacted, changed>
+   username: <redacted>
+ kind: Secret
+ metadata:
+   name: foo
+   namespace: default
++stringData:
++  key: <redacted, changed>
+`))
+			Expect(current.Object["data"]).To(HaveKeyWithValue("password", "c2VjcmV0"))
+			Expect(desired.Object["stringData"]).To(HaveKeyWithValue("key", "plain-secret"))
+		})
+
+		It("should redact the data of secrets to be created", func() {
+			diff, err := unifiedDiff(nil, newSecret(map[string]any{"password": "c2VjcmV0"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diff).To(ContainSubstring("+  password: <redacted>\n"))
+			Expect(diff).NotTo(ContainSubstring("c2VjcmV0"))
+		})
+
+		It("should redact the data of secrets to be deleted", func() {
+			diff, err := unifiedDiff(newSecret(map[string]any{"password": "c2VjcmV0"}), nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diff).To(ContainSubstring("-  password: <redacted>\n"))
+			Expect(diff).NotTo(ContainSubstring("c2VjcmV0"))
+		})
+
+		It("should not redact the data of other objects", func() {
+			Expect(unifiedDiff(nil, newConfigMap("foo", map[string]any{"password": "secret"}))).To(ContainSubstring("+  password: secret\n"))
+		})
+	})
+
+	Describe("#limitDiffs", func() {
+		It("should truncate long diffs at line boundaries", func() {
+			pendingChanges := []resourcesv1alpha1.PendingChange{
+				{Diff: "short\n"},
+				{Diff: strings.Repeat("+ some line\n", maxDiffLength)},
+			}
+
+			limitDiffs(pendingChanges)
+
+			Expect(pendingChanges[0].Diff).To(Equal("short\n"))
+			Expect(len(pendingChanges[1].Diff)).To(BeNumerically("<=", maxDiffLength+len("... (truncated)\n")))
+			Expect(pendingChanges[1].Diff).To(And(HavePrefix("+ some line\n"), HaveSuffix("+ some line\n... (truncated)\n")))
+		})
+
+		It("should omit the diffs exceeding the total length", func() {
+			pendingChanges := make([]resourcesv1alpha1.PendingChange, maxTotalDiffLength/maxDiffLength+1)
+			for i := range pendingChanges {
+				pendingChanges[i].Diff = strings.Repeat("x", maxDiffLength-1) + "\n"
+			}
+
+			limitDiffs(pendingChanges)
+
+			Expect(pendingChanges[len(pendingChanges)-2].Diff).NotTo(BeEmpty())
+			Expect(pendingChanges[len(pendingChanges)-1].Diff).To(BeEmpty())
+			Expect(pendingChanges[len(pendingChanges)-1].Message).To(ContainSubstring("Diff is omitted"))
+		})
+	})
+
+	Describe("#computePendingChanges", func() {
+		var (
+			ctx        = context.Background()
+			fakeClient client.Client
+			r          *Reconciler
+
+			labelsToInject = map[string]string{resourcesv1alpha1.ManagedBy: "gardener"}
+			appliedMeta    = map[string]any{
+				"name":        "",
+				"namespace":   "default",
+				"labels":      map[string]any{resourcesv1alpha1.ManagedBy: "gardener"},
+				"annotations": map[string]any{descriptionAnnotation: descriptionAnnotationText, resourcesv1alpha1.OriginAnnotation: origin},
+			}
+		)
+
+		applied := func(obj *unstructured.Unstructured) *unstructured.Unstructured {
+			metadata := make(map[string]any, len(appliedMeta))
+			for k, v := range appliedMeta {
+				metadata[k] = v
+			}
+			metadata["name"] = obj.GetName()
+			obj.Object["metadata"] = metadata
+			return obj
+		}
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+			r = &Reconciler{TargetClient: fakeClient}
+		})
+
+		It("should compute the pending changes", func() {
+			Expect(fakeClient.Create(ctx, applied(newConfigMap("unchanged", map[string]any{"foo": "bar"})))).To(Succeed())
+			Expect(fakeClient.Create(ctx, applied(newConfigMap("changed", map[string]any{"foo": "bar"})))).To(Succeed())
+			Expect(fakeClient.Create(ctx, applied(newConfigMap("ignored", map[string]any{"foo": "bar"})))).To(Succeed())
+			Expect(fakeClient.Create(ctx, applied(newConfigMap("old", map[string]any{"foo": "bar"})))).To(Succeed())
+			kept := applied(newConfigMap("kept", nil))
+			kept.SetAnnotations(map[string]string{resourcesv1alpha1.KeepObject: "true"})
+			Expect(fakeClient.Create(ctx, kept)).To(Succeed())
+
+			ignored := newConfigMap("ignored", map[string]any{"foo": "baz"})
+			ignored.SetAnnotations(map[string]string{resourcesv1alpha1.Ignore: "true"})
+
+			index := NewObjectIndex([]resourcesv1alpha1.ObjectReference{
+				{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "changed"}},
+				{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "old"}},
+				{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "kept"}},
+				{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "gone"}},
+			}, nil)
+
+			var newObjects []object
+			for _, obj := range []*unstructured.Unstructured{
+				newConfigMap("unchanged", map[string]any{"foo": "bar"}),
+				newConfigMap("changed", map[string]any{"foo": "baz"}),
+				newConfigMap("new", map[string]any{"foo": "bar"}),
+				ignored,
+			} {
+				o := object{obj: obj}
+				o.oldInformation, _ = index.Lookup(resourcesv1alpha1.ObjectReference{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: obj.GetName()}})
+				newObjects = append(newObjects, o)
+			}
+
+			pendingChanges, err := r.computePendingChanges(ctx, origin, newObjects, labelsToInject, nil, index)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pendingChanges).To(HaveLen(3))
+
+			Expect(pendingChanges[0].Name).To(Equal("changed"))
+			Expect(pendingChanges[0].Operation).To(Equal(resourcesv1alpha1.ChangeOperationUpdate))
+			Expect(pendingChanges[0].Diff).To(ContainSubstring("-  foo: bar\n+  foo: baz\n"))
+
+			Expect(pendingChanges[1].Name).To(Equal("new"))
+			Expect(pendingChanges[1].Operation).To(Equal(resourcesv1alpha1.ChangeOperationCreate))
+			Expect(pendingChanges[1].Diff).To(And(
+				ContainSubstring("+    "+resourcesv1alpha1.OriginAnnotation+": "+origin+"\n"),
+				ContainSubstring("+    "+resourcesv1alpha1.ManagedBy+": gardener\n"),
+			))
+
+			Expect(pendingChanges[2].Name).To(Equal("old"))
+			Expect(pendingChanges[2].Operation).To(Equal(resourcesv1alpha1.ChangeOperationDelete))
+			Expect(pendingChanges[2].Diff).To(ContainSubstring("-  name: old\n"))
+		})
+
+		It("should compute the pending changes for server-side apply", func() {
+			pendingChanges, err := r.computePendingChanges(ctx, origin, []object{{obj: newConfigMap("new", map[string]any{"foo": "bar"}), serverSideApply: true}}, labelsToInject, nil, NewObjectIndex(nil, nil))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pendingChanges).To(ConsistOf(And(
+				HaveField("Name", "new"),
+				HaveField("Operation", resourcesv1alpha1.ChangeOperationCreate),
+				HaveField("Diff", ContainSubstring("+  foo: bar\n")),
+			)))
+		})
+
+		It("should not report pending changes if all objects are up to date", func() {
+			Expect(fakeClient.Create(ctx, applied(newConfigMap("unchanged", map[string]any{"foo": "bar"})))).To(Succeed())
+
+			pendingChanges, err := r.computePendingChanges(ctx, origin, []object{{obj: newConfigMap("unchanged", map[string]any{"foo": "bar"})}}, labelsToInject, nil, NewObjectIndex(nil, nil))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pendingChanges).To(BeEmpty())
+		})
+	})
+
+	Describe("#desiredObjectForClientSideApply", func() {
+		It("should merge the desired state into the current object", func() {
+			current := newConfigMap("foo", map[string]any{"foo": "bar"})
+			current.SetLabels(map[string]string{"other": "label"})
+			current.SetResourceVersion("42")
+
+			desired, err := desiredObjectForClientSideApply(origin, object{obj: newConfigMap("foo", map[string]any{"foo": "baz"})}, current, map[string]string{"injected": "label"}, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(desired.GetResourceVersion()).To(Equal("42"))
+			Expect(desired.GetLabels()).To(Equal(map[string]string{"other": "label", "injected": "label"}))
+			Expect(desired.GetAnnotations()).To(HaveKeyWithValue(resourcesv1alpha1.OriginAnnotation, origin))
+			Expect(desired.Object["data"]).To(Equal(map[string]any{"foo": "baz"}))
+			Expect(current.Object["data"]).To(Equal(map[string]any{"foo": "bar"}))
+		})
+	})
+})
@@ -138,6 +138,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 
 		forceOverwriteLabels      bool
 		forceOverwriteAnnotations bool
+		serverSideApply           = ptr.Deref(mr.Spec.ApplyMode, resourcesv1alpha1.ApplyModeClientSide) == resourcesv1alpha1.ApplyModeServerSide
 
 		decodingErrors []*decodingError
 
@@ -242,6 +243,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 						obj:                       obj,
 						forceOverwriteLabels:      forceOverwriteLabels,
 						forceOverwriteAnnotations: forceOverwriteAnnotations,
+						serverSideApply:           serverSideApply,
 					}
 					objectReference = resourcesv1alpha1.ObjectReference{
 						ObjectReference: corev1.ObjectReference{
@@ -284,6 +286,10 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	// (otherwise, the order will be different on each update)
 	sortObjectReferences(newResourcesObjectReferences)
 
+	if ptr.Deref(mr.Spec.DryRun, false) {
+		return r.dryRun(ctx, log, mr, origin, newResourcesObjects, equivalences, existingResourcesIndex, decodingErrors, conditionResourcesApplied)
+	}
+
 	// invalidate conditions, if resources have been added/removed from the managed resource
 	if !apiequality.Semantic.DeepEqual(mr.Status.Resources, newResourcesObjectReferences) || mr.Status.SecretsDataChecksum == nil || *mr.Status.SecretsDataChecksum != secretsDataChecksum {
 		conditionResourcesHealthy := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesHealthy)
@@ -296,7 +302,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		reason := resourcesv1alpha1.ConditionApplyProgressing
 		msg := "The resources are currently being reconciled."
 		switch conditionResourcesApplied.Reason {
-		case resourcesv1alpha1.ConditionApplyFailed, resourcesv1alpha1.ConditionDeletionFailed, resourcesv1alpha1.ConditionDeletionPending:
+		case resourcesv1alpha1.ConditionApplyFailed, resourcesv1alpha1.ConditionApplyConflict, resourcesv1alpha1.ConditionDeletionFailed, resourcesv1alpha1.ConditionDeletionPending:
 			// keep condition reason and message if last reconciliation failed
 			reason = conditionResourcesApplied.Reason
 			msg = conditionResourcesApplied.Message
@@ -346,7 +352,12 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 
 	injectLabels := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
 	if err := r.applyNewResources(ctx, log, origin, newResourcesObjects, injectLabels, equivalences); err != nil {
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionApplyFailed, err.Error())
+		reason := resourcesv1alpha1.ConditionApplyFailed
+		if isConflictError(err) {
+			reason = resourcesv1alpha1.ConditionApplyConflict
+		}
+
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, reason, err.Error())
 		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
 			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
 		}
@@ -471,6 +482,9 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 		return fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
 	}
 
+	// conflicts of server-side apply are collected to report them for all objects at once
+	var conflictErrs []error
+
 	for _, obj := range newResourcesObjects {
 		var (
 			current            = obj.obj.DeepCopy()
@@ -480,6 +494,23 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 
 		resourceLogger := log.WithValues("resource", resource)
 
+		if obj.serverSideApply {
+			resourceLogger.V(1).Info("Applying server-side")
+
+			operationResult, err := r.applyServerSide(ctx, origin, obj, labelsToInject, scaledHorizontally)
+			if err != nil {
+				if isConflictError(err) {
+					resourceLogger.Info("Fields of resource are owned by other field managers", "err", err)
+					conflictErrs = append(conflictErrs, err)
+					continue
+				}
+				return fmt.Errorf("error during server-side apply of object %q: %w", resource, err)
+			}
+
+			logOperationResult(resourceLogger, operationResult)
+			continue
+		}
+
 		resourceLogger.V(1).Info("Applying")
 
 		operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
@@ -518,17 +549,21 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 			return fmt.Errorf("error during apply of object %q: %s", resource, err)
 		}
 
-		switch operationResult {
-		case controllerutil.OperationResultCreated:
-			resourceLogger.Info("Created resource because it was not existing before")
-		case controllerutil.OperationResultUpdated:
-			resourceLogger.Info("Updated resource because its actual state differed from the desired state")
-		case controllerutil.OperationResultNone:
-			resourceLogger.V(1).Info("Resource was neither created nor updated because its actual state matches with the desired state")
-		}
+		logOperationResult(resourceLogger, operationResult)
 	}
 
-	return nil
+	return errors.Join(conflictErrs...)
+}
+
+func logOperationResult(log logr.Logger, operationResult controllerutil.OperationResult) {
+	switch operationResult {
+	case controllerutil.OperationResultCreated:
+		log.Info("Created resource because it was not existing before")
+	case controllerutil.OperationResultUpdated:
+		log.Info("Updated resource because its actual state differed from the desired state")
+	case controllerutil.OperationResultNone:
+		log.V(1).Info("Resource was neither created nor updated because its actual state matches with the desired state")
+	}
 }
 
 // computeHorizontallyScaledObjectKeys returns a set of object keys (in the form `Group/Kind/Namespace/Name`)
@@ -860,6 +895,7 @@ func updateManagedResourceStatus(
 	mr.Status.Conditions = v1beta1helper.MergeConditions(mr.Status.Conditions, updatedConditions...)
 	mr.Status.SecretsDataChecksum = secretsDataChecksum
 	mr.Status.Resources = resources
+	mr.Status.PendingChanges = nil
 	mr.Status.ObservedGeneration = mr.Generation
 	return c.Status().Update(ctx, mr)
 }
@@ -973,6 +1009,7 @@ type object struct {
 	oldInformation            resourcesv1alpha1.ObjectReference
 	forceOverwriteLabels      bool
 	forceOverwriteAnnotations bool
+	serverSideApply           bool
 }
 
 type decodingError struct {
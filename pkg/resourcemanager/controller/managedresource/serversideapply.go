@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// FieldManager is the name of the field manager used for server-side apply. It matches the field manager which the
+// API server derives from the user agent of the resource manager for its client-side updates, so that fields owned by
+// client-side updates can be taken over when switching a ManagedResource to server-side apply.
+const FieldManager = "gardener-resource-manager"
+
+// conflictError is returned if an object could not be applied server-side because some of its fields are owned by other
+// field managers.
+type conflictError struct {
+	resource string
+	err      error
+}
+
+func (e *conflictError) Error() string {
+	return fmt.Sprintf("conflict during apply of object %q: %s", e.resource, e.err)
+}
+
+func (e *conflictError) Unwrap() error {
+	return e.err
+}
+
+func isConflictError(err error) bool {
+	var conflictErr *conflictError
+	return errors.As(err, &conflictErr)
+}
+
+// applyServerSide applies the given object with server-side apply. It does not force the ownership of fields owned by
+// other field managers but returns a conflictError instead. Like for client-side updates, an existing object is deleted
+// if the update is invalid and the object is annotated with 'delete-on-invalid-update' or is an immutable
+// ConfigMap/Secret, so that it is recreated with the next attempt.
+func (r *Reconciler) applyServerSide(ctx context.Context, origin string, obj object, labelsToInject map[string]string, scaledHorizontally bool) (controllerutil.OperationResult, error) {
+	resource := unstructuredToString(obj.obj)
+
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(obj.obj.GroupVersionKind())
+	if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(obj.obj), current); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return controllerutil.OperationResultNone, fmt.Errorf("error reading object %q: %w", resource, err)
+		}
+		current = nil
+	}
+
+	if current != nil {
+		// if the ignore annotation is set to true, the object is only created but never updated
+		if ignore(obj.obj) {
+			return controllerutil.OperationResultNone, nil
+		}
+
+		if err := upgradeManagedFields(ctx, r.TargetClient, current); err != nil {
+			return controllerutil.OperationResultNone, fmt.Errorf("error upgrading managed fields of object %q: %w", resource, err)
+		}
+	}
+
+	desired, err := desiredObjectForServerSideApply(origin, obj, current, labelsToInject, scaledHorizontally)
+	if err != nil {
+		return controllerutil.OperationResultNone, fmt.Errorf("error computing desired state of object %q: %w", resource, err)
+	}
+
+	if err := r.TargetClient.Patch(ctx, desired, client.Apply, client.FieldOwner(FieldManager)); err != nil {
+		if apierrors.IsConflict(err) {
+			return controllerutil.OperationResultNone, &conflictError{resource: resource, err: err}
+		}
+
+		if apierrors.IsInvalid(err) && current != nil && deleteOnInvalidUpdate(desired, err) {
+			if deleteErr := r.TargetClient.Delete(ctx, current); client.IgnoreNotFound(deleteErr) != nil {
+				return controllerutil.OperationResultNone, fmt.Errorf("error deleting object %q after 'invalid' update error: %w", resource, deleteErr)
+			}
+			// return error directly, so that the create after delete will be retried
+			return controllerutil.OperationResultNone, fmt.Errorf("deleted object %q because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %w", resource, err)
+		}
+
+		return controllerutil.OperationResultNone, err
+	}
+
+	switch {
+	case current == nil:
+		return controllerutil.OperationResultCreated, nil
+	case current.GetResourceVersion() != desired.GetResourceVersion():
+		return controllerutil.OperationResultUpdated, nil
+	default:
+		return controllerutil.OperationResultNone, nil
+	}
+}
+
+// desiredObjectForServerSideApply returns the object to be applied server-side for the given object of the
+// ManagedResource. current is the object in the target cluster, or nil if it does not exist.
+func desiredObjectForServerSideApply(origin string, obj object, current *unstructured.Unstructured, labelsToInject map[string]string, scaledHorizontally bool) (*unstructured.Unstructured, error) {
+	desired := obj.obj.DeepCopy()
+	if err := injectLabels(desired, labelsToInject); err != nil {
+		return nil, fmt.Errorf("error injecting labels: %w", err)
+	}
+
+	annotations := desired.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[descriptionAnnotation] = descriptionAnnotationText
+	annotations[resourcesv1alpha1.OriginAnnotation] = origin
+	desired.SetAnnotations(annotations)
+
+	// The status is managed by the respective controllers and ignored by the API server for resources with a status
+	// subresource anyway.
+	delete(desired.Object, "status")
+
+	if current != nil {
+		preserveReplicas := scaledHorizontally || obj.obj.GetAnnotations()[resourcesv1alpha1.PreserveReplicas] == "true"
+		preserveResources := obj.obj.GetAnnotations()[resourcesv1alpha1.PreserveResources] == "true"
+		if err := preserveFields(desired, current, preserveReplicas, preserveResources); err != nil {
+			return nil, fmt.Errorf("error preserving fields: %w", err)
+		}
+	}
+
+	return desired, nil
+}
+
+// upgradeManagedFields transfers the ownership of the fields which were set by client-side updates of the resource
+// manager to its field manager for server-side apply. Otherwise, changing these fields would result in conflicts with
+// the former client-side updates, and removing fields from the desired state would not remove them from the object.
+func upgradeManagedFields(ctx context.Context, c client.Client, obj *unstructured.Unstructured) error {
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(obj, sets.New(FieldManager), FieldManager)
+	if err != nil || patch == nil {
+		return err
+	}
+
+	return c.Patch(ctx, obj, client.RawPatch(types.JSONPatchType, patch))
+}
+
+// podTemplateContainersPaths are the paths of the containers of the pod templates in the well-known workload resources.
+var podTemplateContainersPaths = [][]string{
+	{"spec", "template", "spec", "containers"},
+	{"spec", "jobTemplate", "spec", "template", "spec", "containers"},
+}
+
+// preserveFields sets the replicas and the resource requirements of the containers in the desired object to the values
+// of the current object if they should be preserved, e.g. because the object is scaled by an HPA. Server-side apply
+// would remove the fields from the object if they were just omitted from the desired object.
+func preserveFields(desired, current *unstructured.Unstructured, preserveReplicas, preserveResources bool) error {
+	if preserveReplicas {
+		replicas, found, err := unstructured.NestedFieldCopy(current.Object, "spec", "replicas")
+		if err != nil {
+			return err
+		}
+		if found {
+			if err := unstructured.SetNestedField(desired.Object, replicas, "spec", "replicas"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if preserveResources {
+		for _, path := range podTemplateContainersPaths {
+			if err := preserveContainerResources(desired.Object, current.Object, path...); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func preserveContainerResources(desired, current map[string]any, path ...string) error {
+	desiredContainers, found, err := unstructured.NestedSlice(desired, path...)
+	if err != nil || !found {
+		return err
+	}
+
+	currentContainers, _, err := unstructured.NestedSlice(current, path...)
+	if err != nil {
+		return err
+	}
+
+	for i, desiredContainer := range desiredContainers {
+		desiredContainerMap, ok := desiredContainer.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, currentContainer := range currentContainers {
+			currentContainerMap, ok := currentContainer.(map[string]any)
+			if !ok || currentContainerMap["name"] != desiredContainerMap["name"] {
+				continue
+			}
+
+			for _, field := range []string{"requests", "limits"} {
+				for _, resourceName := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+					value, found, err := unstructured.NestedFieldCopy(currentContainerMap, "resources", field, string(resourceName))
+					if err != nil {
+						return err
+					}
+					if !found {
+						continue
+					}
+					if err := unstructured.SetNestedField(desiredContainerMap, value, "resources", field, string(resourceName)); err != nil {
+						return err
+					}
+				}
+			}
+			break
+		}
+
+		desiredContainers[i] = desiredContainerMap
+	}
+
+	return unstructured.SetNestedSlice(desired, desiredContainers, path...)
+}
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("server-side apply", func() {
+	Describe("#applyServerSide", func() {
+		const origin = "test:foo/bar"
+
+		var (
+			ctx        = context.Background()
+			fakeClient client.Client
+			r          *Reconciler
+
+			current, obj *unstructured.Unstructured
+			invalidErr   error
+		)
+
+		newConfigMap := func(data map[string]any) *unstructured.Unstructured {
+			return &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]any{"name": "foo", "namespace": "default"},
+				"immutable":  true,
+				"data":       data,
+			}}
+		}
+
+		BeforeEach(func() {
+			invalidErr = apierrors.NewInvalid(schema.GroupKind{Kind: "ConfigMap"}, "foo", field.ErrorList{field.Forbidden(field.NewPath("data"), "field is immutable when `immutable` is set")})
+
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetesscheme.Scheme).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						if patch.Type() == client.Apply.Type() {
+							return invalidErr
+						}
+						return c.Patch(ctx, obj, patch, opts...)
+					},
+				}).
+				Build()
+			r = &Reconciler{TargetClient: fakeClient}
+
+			current = newConfigMap(map[string]any{"foo": "bar"})
+			Expect(fakeClient.Create(ctx, current)).To(Succeed())
+			obj = newConfigMap(map[string]any{"foo": "baz"})
+		})
+
+		It("should delete an immutable ConfigMap if the update is invalid", func() {
+			operationResult, err := r.applyServerSide(ctx, origin, object{obj: obj, serverSideApply: true}, nil, false)
+			Expect(err).To(MatchError(And(ContainSubstring(`deleted object "v1/ConfigMap/default/foo" because of 'invalid' update error`), ContainSubstring("field is immutable"))))
+			Expect(operationResult).To(Equal(controllerutil.OperationResultNone))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(current), current)).To(BeNotFoundError())
+		})
+
+		It("should delete an object annotated with 'delete-on-invalid-update' if the update is invalid", func() {
+			invalidErr = apierrors.NewInvalid(schema.GroupKind{Kind: "ConfigMap"}, "foo", field.ErrorList{field.Invalid(field.NewPath("data"), nil, "invalid")})
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.DeleteOnInvalidUpdate: "true"})
+
+			_, err := r.applyServerSide(ctx, origin, object{obj: obj, serverSideApply: true}, nil, false)
+			Expect(err).To(MatchError(ContainSubstring("because of 'invalid' update error")))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(current), current)).To(BeNotFoundError())
+		})
+
+		It("should not delete the object if the update is invalid for another reason", func() {
+			invalidErr = apierrors.NewInvalid(schema.GroupKind{Kind: "ConfigMap"}, "foo", field.ErrorList{field.Invalid(field.NewPath("data"), nil, "invalid")})
+
+			_, err := r.applyServerSide(ctx, origin, object{obj: obj, serverSideApply: true}, nil, false)
+			Expect(err).To(Equal(invalidErr))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(current), current)).To(Succeed())
+		})
+
+		It("should not delete the object on other errors", func() {
+			invalidErr = apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "foo", errors.New("forbidden"))
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.DeleteOnInvalidUpdate: "true"})
+
+			_, err := r.applyServerSide(ctx, origin, object{obj: obj, serverSideApply: true}, nil, false)
+			Expect(err).To(Equal(invalidErr))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(current), current)).To(Succeed())
+		})
+	})
+
+	Describe("#isConflictError", func() {
+		It("should detect conflict errors", func() {
+			err := &conflictError{resource: "apps/v1/Deployment/foo/bar", err: apierrors.NewConflict(schema.GroupResource{Group: "apps", Resource: "deployments"}, "bar", errors.New("conflict with \"kubectl\""))}
+
+			Expect(isConflictError(err)).To(BeTrue())
+			Expect(isConflictError(fmt.Errorf("wrapped: %w", err))).To(BeTrue())
+			Expect(isConflictError(errors.Join(errors.New("foo"), err))).To(BeTrue())
+			Expect(err.Error()).To(And(ContainSubstring(`conflict during apply of object "apps/v1/Deployment/foo/bar"`), ContainSubstring(`conflict with "kubectl"`)))
+		})
+
+		It("should not detect other errors", func() {
+			Expect(isConflictError(errors.New("foo"))).To(BeFalse())
+			Expect(isConflictError(apierrors.NewConflict(schema.GroupResource{Group: "apps", Resource: "deployments"}, "bar", errors.New("foo")))).To(BeFalse())
+		})
+	})
+
+	Describe("#preserveFields", func() {
+		var desired, current *unstructured.Unstructured
+
+		nestedField := func(obj *unstructured.Unstructured, fields ...string) any {
+			value, _, err := unstructured.NestedFieldNoCopy(obj.Object, fields...)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return value
+		}
+
+		newDeployment := func(replicas int64, cpuRequest, memoryLimit string) *unstructured.Unstructured {
+			return &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"spec": map[string]any{
+					"replicas": replicas,
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{
+								map[string]any{
+									"name":  "foo",
+									"image": "foo:v1",
+									"resources": map[string]any{
+										"requests": map[string]any{"cpu": cpuRequest},
+										"limits":   map[string]any{"memory": memoryLimit},
+									},
+								},
+							},
+						},
+					},
+				},
+			}}
+		}
+
+		BeforeEach(func() {
+			desired = newDeployment(1, "10m", "100Mi")
+			current = newDeployment(3, "50m", "200Mi")
+		})
+
+		It("should not change the desired object if nothing is preserved", func() {
+			expected := desired.DeepCopy()
+
+			Expect(preserveFields(desired, current, false, false)).To(Succeed())
+			Expect(desired).To(Equal(expected))
+		})
+
+		It("should preserve the replicas", func() {
+			Expect(preserveFields(desired, current, true, false)).To(Succeed())
+
+			Expect(nestedField(desired, "spec", "replicas")).To(BeEquivalentTo(3))
+			Expect(nestedField(desired, "spec", "template", "spec", "containers")).To(Equal(
+				[]any{map[string]any{"name": "foo", "image": "foo:v1", "resources": map[string]any{"requests": map[string]any{"cpu": "10m"}, "limits": map[string]any{"memory": "100Mi"}}}},
+			))
+		})
+
+		It("should not set the replicas if they are not set on the current object", func() {
+			unstructured.RemoveNestedField(current.Object, "spec", "replicas")
+
+			Expect(preserveFields(desired, current, true, false)).To(Succeed())
+			Expect(nestedField(desired, "spec", "replicas")).To(BeEquivalentTo(1))
+		})
+
+		It("should preserve the resources of matching containers", func() {
+			Expect(unstructured.SetNestedSlice(desired.Object, []any{
+				map[string]any{"name": "foo", "image": "foo:v2"},
+				map[string]any{"name": "bar", "image": "bar:v1", "resources": map[string]any{"requests": map[string]any{"cpu": "5m"}}},
+			}, "spec", "template", "spec", "containers")).To(Succeed())
+
+			Expect(preserveFields(desired, current, false, true)).To(Succeed())
+
+			Expect(nestedField(desired, "spec", "replicas")).To(BeEquivalentTo(1))
+			Expect(nestedField(desired, "spec", "template", "spec", "containers")).To(Equal([]any{
+				map[string]any{"name": "foo", "image": "foo:v2", "resources": map[string]any{"requests": map[string]any{"cpu": "50m"}, "limits": map[string]any{"memory": "200Mi"}}},
+				map[string]any{"name": "bar", "image": "bar:v1", "resources": map[string]any{"requests": map[string]any{"cpu": "5m"}}},
+			}))
+		})
+
+		It("should preserve the resources of CronJobs", func() {
+			newCronJob := func(cpuRequest string) *unstructured.Unstructured {
+				return &unstructured.Unstructured{Object: map[string]any{
+					"apiVersion": "batch/v1",
+					"kind":       "CronJob",
+					"spec": map[string]any{
+						"jobTemplate": map[string]any{"spec": map[string]any{"template": map[string]any{"spec": map[string]any{
+							"containers": []any{map[string]any{"name": "foo", "resources": map[string]any{"requests": map[string]any{"cpu": cpuRequest}}}},
+						}}}},
+					},
+				}}
+			}
+			desired, current = newCronJob("10m"), newCronJob("50m")
+
+			Expect(preserveFields(desired, current, false, true)).To(Succeed())
+			Expect(desired).To(Equal(newCronJob("50m")))
+		})
+	})
+})
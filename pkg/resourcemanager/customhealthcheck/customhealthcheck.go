@@ -0,0 +1,313 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package customhealthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types/ref"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	celutils "github.com/gardener/gardener/pkg/utils/cel"
+)
+
+// VariableObject is the name of the variable containing the checked object in the CEL expressions.
+const VariableObject = "object"
+
+// Checks contains the custom health checks for kinds of resources without a built-in health check. A nil Checks
+// contains no checks.
+type Checks struct {
+	checks map[schema.GroupKind]check
+}
+
+type check interface {
+	health(obj map[string]any) error
+	progressing(obj map[string]any) (bool, string, error)
+}
+
+// New compiles the given custom health check configurations.
+func New(configs []resourcemanagerconfigv1alpha1.CustomHealthCheck) (*Checks, error) {
+	checks := make(map[schema.GroupKind]check, len(configs))
+
+	for _, config := range configs {
+		groupKind := schema.GroupKind{Group: config.Group, Kind: config.Kind}
+
+		switch {
+		case config.Conditions != nil:
+			checks[groupKind] = &conditionsCheck{config: *config.Conditions}
+		case config.CEL != nil:
+			c, err := newCELCheck(*config.CEL)
+			if err != nil {
+				return nil, fmt.Errorf("failed compiling health check for %s: %w", groupKind, err)
+			}
+			checks[groupKind] = c
+		default:
+			return nil, fmt.Errorf("health check for %s neither configures conditions nor CEL expressions", groupKind)
+		}
+	}
+
+	return &Checks{checks: checks}, nil
+}
+
+// Has returns true if a custom health check is configured for the given kind of resources.
+func (c *Checks) Has(groupKind schema.GroupKind) bool {
+	if c == nil {
+		return false
+	}
+
+	_, ok := c.checks[groupKind]
+	return ok
+}
+
+// CheckHealth checks whether the given object is healthy.
+// It returns a bool indicating whether the object was actually checked and an error if the health check failed.
+func (c *Checks) CheckHealth(obj client.Object) (bool, error) {
+	check, content, err := c.prepare(obj)
+	if check == nil || err != nil {
+		return false, err
+	}
+
+	return true, check.health(content)
+}
+
+// CheckProgressing checks whether the given object is progressing. It returns a bool indicating whether the object is
+// progressing, a description of the progress if so and an error if the check could not be performed.
+func (c *Checks) CheckProgressing(obj client.Object) (bool, string, error) {
+	check, content, err := c.prepare(obj)
+	if check == nil || err != nil {
+		return false, "", err
+	}
+
+	return check.progressing(content)
+}
+
+func (c *Checks) prepare(obj client.Object) (check, map[string]any, error) {
+	if c == nil || obj.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] == "true" {
+		return nil, nil, nil
+	}
+
+	check, ok := c.checks[obj.GetObjectKind().GroupVersionKind().GroupKind()]
+	if !ok {
+		return nil, nil, nil
+	}
+
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return check, u.UnstructuredContent(), nil
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed converting object: %w", err)
+	}
+	return check, content, nil
+}
+
+type conditionsCheck struct {
+	config resourcemanagerconfigv1alpha1.ConditionsHealthCheck
+}
+
+func (c *conditionsCheck) health(obj map[string]any) error {
+	outdated, message, err := observedGenerationOutdated(obj)
+	if err != nil {
+		return err
+	}
+	if outdated {
+		return errors.New(message)
+	}
+
+	conditions, err := conditionsByType(obj)
+	if err != nil {
+		return err
+	}
+
+	for _, conditionType := range c.config.UnhealthyConditions {
+		if condition, ok := conditions[conditionType]; ok && condition.status == "True" {
+			return fmt.Errorf("condition %q has status %q: %s", conditionType, condition.status, condition.message)
+		}
+	}
+
+	for _, conditionType := range c.config.HealthyConditions {
+		condition, ok := conditions[conditionType]
+		if !ok {
+			return fmt.Errorf("condition %q is missing", conditionType)
+		}
+		if condition.status != "True" {
+			return fmt.Errorf("condition %q has status %q: %s", conditionType, condition.status, condition.message)
+		}
+	}
+
+	return nil
+}
+
+func (c *conditionsCheck) progressing(obj map[string]any) (bool, string, error) {
+	if outdated, message, err := observedGenerationOutdated(obj); err != nil || outdated {
+		return outdated, message, err
+	}
+
+	conditions, err := conditionsByType(obj)
+	if err != nil {
+		return false, "", err
+	}
+
+	for _, conditionType := range c.config.ProgressingConditions {
+		if condition, ok := conditions[conditionType]; ok && condition.status == "True" {
+			return true, fmt.Sprintf("condition %q has status %q: %s", conditionType, condition.status, condition.message), nil
+		}
+	}
+
+	return false, "", nil
+}
+
+func observedGenerationOutdated(obj map[string]any) (bool, string, error) {
+	observedGeneration, found, err := unstructured.NestedInt64(obj, "status", "observedGeneration")
+	if err != nil || !found {
+		return false, "", err
+	}
+
+	generation, _, err := unstructured.NestedInt64(obj, "metadata", "generation")
+	if err != nil {
+		return false, "", err
+	}
+
+	if observedGeneration < generation {
+		return true, fmt.Sprintf("observed generation outdated (%d/%d)", observedGeneration, generation), nil
+	}
+	return false, "", nil
+}
+
+type condition struct {
+	status  string
+	message string
+}
+
+func conditionsByType(obj map[string]any) (map[string]condition, error) {
+	list, _, err := unstructured.NestedSlice(obj, "status", "conditions")
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := make(map[string]condition, len(list))
+	for _, item := range list {
+		c, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		conditionType, _, _ := unstructured.NestedString(c, "type")
+		status, _, _ := unstructured.NestedString(c, "status")
+		message, _, _ := unstructured.NestedString(c, "message")
+		conditions[conditionType] = condition{status: status, message: message}
+	}
+	return conditions, nil
+}
+
+type celCheck struct {
+	healthyProgram     cel.Program
+	progressingProgram cel.Program
+	messageProgram     cel.Program
+}
+
+func newCELCheck(config resourcemanagerconfigv1alpha1.CELHealthCheck) (*celCheck, error) {
+	var (
+		c   = &celCheck{}
+		err error
+	)
+
+	if c.healthyProgram, err = Compile(config.Healthy, cel.BoolType); err != nil {
+		return nil, fmt.Errorf("invalid healthy expression: %w", err)
+	}
+	if config.Progressing != nil {
+		if c.progressingProgram, err = Compile(*config.Progressing, cel.BoolType); err != nil {
+			return nil, fmt.Errorf("invalid progressing expression: %w", err)
+		}
+	}
+	if config.Message != nil {
+		if c.messageProgram, err = Compile(*config.Message, cel.StringType); err != nil {
+			return nil, fmt.Errorf("invalid message expression: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+func (c *celCheck) health(obj map[string]any) error {
+	healthy, err := evalBool(c.healthyProgram, obj)
+	if err != nil {
+		return fmt.Errorf("failed evaluating healthy expression: %w", err)
+	}
+	if healthy {
+		return nil
+	}
+
+	return errors.New(c.describe(obj, "healthy expression evaluated to false"))
+}
+
+func (c *celCheck) progressing(obj map[string]any) (bool, string, error) {
+	if c.progressingProgram == nil {
+		return false, "", nil
+	}
+
+	progressing, err := evalBool(c.progressingProgram, obj)
+	if err != nil {
+		return false, "", fmt.Errorf("failed evaluating progressing expression: %w", err)
+	}
+	if !progressing {
+		return false, "", nil
+	}
+
+	return true, c.describe(obj, "progressing expression evaluated to true"), nil
+}
+
+// describe returns the result of the message expression, or the given default message if no message expression is
+// configured or if it cannot be evaluated.
+func (c *celCheck) describe(obj map[string]any, defaultMessage string) string {
+	if c.messageProgram == nil {
+		return defaultMessage
+	}
+
+	out, err := eval(c.messageProgram, obj)
+	if err != nil {
+		return fmt.Sprintf("%s (failed evaluating message expression: %v)", defaultMessage, err)
+	}
+
+	message, ok := out.Value().(string)
+	if !ok {
+		return defaultMessage
+	}
+	return message
+}
+
+func evalBool(program cel.Program, obj map[string]any) (bool, error) {
+	out, err := eval(program, obj)
+	if err != nil {
+		return false, err
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %v instead of bool", out.Value())
+	}
+	return result, nil
+}
+
+// eval evaluates the given program for the given object. Health checks are performed in watch predicates without a
+// context, hence the evaluation is only bounded by the timeout of celutils.Eval.
+func eval(program cel.Program, obj map[string]any) (ref.Val, error) {
+	return celutils.Eval(context.Background(), program, map[string]any{VariableObject: obj})
+}
+
+// Compile compiles the given CEL expression for a custom health check. It returns an error if the expression is
+// invalid or does not evaluate to the given type.
+func Compile(expression string, outputType *cel.Type) (cel.Program, error) {
+	return celutils.Compile(expression, outputType, VariableObject)
+}
@@ -0,0 +1,249 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package customhealthcheck_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
+	. "github.com/gardener/gardener/pkg/resourcemanager/customhealthcheck"
+)
+
+var _ = Describe("CustomHealthCheck", func() {
+	var (
+		checks *Checks
+		obj    *unstructured.Unstructured
+	)
+
+	BeforeEach(func() {
+		var err error
+		checks, err = New([]resourcemanagerconfigv1alpha1.CustomHealthCheck{
+			{
+				Group: "example.com",
+				Kind:  "Foo",
+				Conditions: &resourcemanagerconfigv1alpha1.ConditionsHealthCheck{
+					HealthyConditions:     []string{"Ready"},
+					UnhealthyConditions:   []string{"Stalled"},
+					ProgressingConditions: []string{"Reconciling"},
+				},
+			},
+			{
+				Group: "example.com",
+				Kind:  "Bar",
+				CEL: &resourcemanagerconfigv1alpha1.CELHealthCheck{
+					Healthy:     "object.status.phase == 'Running'",
+					Progressing: ptr.To("object.status.phase == 'Pending'"),
+					Message:     ptr.To("'phase is ' + object.status.phase"),
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		obj = &unstructured.Unstructured{Object: map[string]any{}}
+		obj.SetGeneration(2)
+	})
+
+	setConditions := func(conditions ...map[string]any) {
+		list := make([]any, 0, len(conditions))
+		for _, c := range conditions {
+			list = append(list, c)
+		}
+		Expect(unstructured.SetNestedSlice(obj.Object, list, "status", "conditions")).To(Succeed())
+	}
+
+	Describe("#New", func() {
+		It("should fail for invalid CEL expressions", func() {
+			_, err := New([]resourcemanagerconfigv1alpha1.CustomHealthCheck{{
+				Kind: "Foo",
+				CEL:  &resourcemanagerconfigv1alpha1.CELHealthCheck{Healthy: "object.status.phase =="},
+			}})
+			Expect(err).To(MatchError(ContainSubstring("invalid healthy expression")))
+		})
+
+		It("should fail for checks without conditions and CEL expressions", func() {
+			_, err := New([]resourcemanagerconfigv1alpha1.CustomHealthCheck{{Kind: "Foo"}})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Has", func() {
+		It("should return whether a check is configured", func() {
+			Expect(checks.Has(schema.GroupKind{Group: "example.com", Kind: "Foo"})).To(BeTrue())
+			Expect(checks.Has(schema.GroupKind{Group: "example.org", Kind: "Foo"})).To(BeFalse())
+		})
+
+		It("should return false for nil checks", func() {
+			var checks *Checks
+			Expect(checks.Has(schema.GroupKind{Kind: "Foo"})).To(BeFalse())
+		})
+	})
+
+	Context("conditions", func() {
+		BeforeEach(func() {
+			obj.SetAPIVersion("example.com/v1")
+			obj.SetKind("Foo")
+		})
+
+		Describe("#CheckHealth", func() {
+			It("should not check objects without custom health check", func() {
+				Expect(checks.CheckHealth(&corev1.ConfigMap{})).To(BeFalse())
+			})
+
+			It("should not check objects with skip-health-check annotation", func() {
+				obj.SetAnnotations(map[string]string{"resources.gardener.cloud/skip-health-check": "true"})
+				Expect(checks.CheckHealth(obj)).To(BeFalse())
+			})
+
+			It("should consider the object healthy", func() {
+				setConditions(map[string]any{"type": "Ready", "status": "True"})
+
+				checked, err := checks.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should consider the object unhealthy if the healthy condition is missing", func() {
+				checked, err := checks.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError(`condition "Ready" is missing`))
+			})
+
+			It("should consider the object unhealthy if the healthy condition is not true", func() {
+				setConditions(map[string]any{"type": "Ready", "status": "False", "message": "not ready"})
+
+				checked, err := checks.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError(`condition "Ready" has status "False": not ready`))
+			})
+
+			It("should consider the object unhealthy if an unhealthy condition is true", func() {
+				setConditions(
+					map[string]any{"type": "Ready", "status": "True"},
+					map[string]any{"type": "Stalled", "status": "True", "message": "stuck"},
+				)
+
+				checked, err := checks.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError(`condition "Stalled" has status "True": stuck`))
+			})
+
+			It("should consider the object unhealthy if the observed generation is outdated", func() {
+				setConditions(map[string]any{"type": "Ready", "status": "True"})
+				Expect(unstructured.SetNestedField(obj.Object, int64(1), "status", "observedGeneration")).To(Succeed())
+
+				checked, err := checks.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError("observed generation outdated (1/2)"))
+			})
+		})
+
+		Describe("#CheckProgressing", func() {
+			It("should consider the object progressing if a progressing condition is true", func() {
+				setConditions(map[string]any{"type": "Reconciling", "status": "True", "message": "updating"})
+
+				progressing, description, err := checks.CheckProgressing(obj)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progressing).To(BeTrue())
+				Expect(description).To(Equal(`condition "Reconciling" has status "True": updating`))
+			})
+
+			It("should consider the object progressing if the observed generation is outdated", func() {
+				Expect(unstructured.SetNestedField(obj.Object, int64(1), "status", "observedGeneration")).To(Succeed())
+
+				progressing, description, err := checks.CheckProgressing(obj)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progressing).To(BeTrue())
+				Expect(description).To(Equal("observed generation outdated (1/2)"))
+			})
+
+			It("should not consider the object progressing", func() {
+				setConditions(map[string]any{"type": "Reconciling", "status": "False"})
+				Expect(unstructured.SetNestedField(obj.Object, int64(2), "status", "observedGeneration")).To(Succeed())
+
+				progressing, _, err := checks.CheckProgressing(obj)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progressing).To(BeFalse())
+			})
+		})
+	})
+
+	Context("CEL", func() {
+		BeforeEach(func() {
+			obj.SetAPIVersion("example.com/v1")
+			obj.SetKind("Bar")
+		})
+
+		setPhase := func(phase string) {
+			Expect(unstructured.SetNestedField(obj.Object, phase, "status", "phase")).To(Succeed())
+		}
+
+		It("should consider the object healthy", func() {
+			setPhase("Running")
+
+			checked, err := checks.CheckHealth(obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+
+			progressing, _, err := checks.CheckProgressing(obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeFalse())
+		})
+
+		It("should consider the object unhealthy and progressing", func() {
+			setPhase("Pending")
+
+			checked, err := checks.CheckHealth(obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError("phase is Pending"))
+
+			progressing, description, err := checks.CheckProgressing(obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeTrue())
+			Expect(description).To(Equal("phase is Pending"))
+		})
+
+		It("should return an error if the expression cannot be evaluated", func() {
+			checked, err := checks.CheckHealth(obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("failed evaluating healthy expression")))
+		})
+
+		It("should return an error if the evaluation exceeds the cost limit", func() {
+			checks, err := New([]resourcemanagerconfigv1alpha1.CustomHealthCheck{{
+				Group: "example.com",
+				Kind:  "Bar",
+				CEL: &resourcemanagerconfigv1alpha1.CELHealthCheck{
+					Healthy: "object.spec.items.all(x, object.spec.items.all(y, object.spec.items.all(z, x + y + z >= 0)))",
+				},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			items := make([]any, 200)
+			for i := range items {
+				items[i] = int64(i)
+			}
+			Expect(unstructured.SetNestedSlice(obj.Object, items, "spec", "items")).To(Succeed())
+
+			checked, err := checks.CheckHealth(obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("cost limit exceeded")))
+		})
+
+		It("should check typed objects", func() {
+			typed := &metav1.PartialObjectMetadata{}
+			typed.SetGroupVersionKind(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Bar"})
+
+			checked, err := checks.CheckHealth(typed)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("failed evaluating healthy expression")))
+		})
+	})
+})
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Namespace is the metric namespace for the gardener-resource-manager.
+const Namespace = "gardener_resource_manager"
+
+var (
+	// Factory is used for registering metrics in the controller-runtime metrics registry.
+	factory = promauto.With(runtimemetrics.Registry)
+	// DriftedObjectsTotal defines the counter drifted_objects_total.
+	DriftedObjectsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "drifted_objects_total",
+			Help:      "Number of detected changes of other field managers to objects managed by ManagedResources.",
+		},
+		[]string{
+			"managedresource_namespace",
+			"managedresource_name",
+			"kind",
+			"manager",
+		},
+	)
+)
@@ -5,7 +5,20 @@
 package shoot
 
 import (
+	"fmt"
+	"net/http"
+	"time"
+
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/apis/apiserver"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/authenticatorfactory"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/authorization/authorizerfactory"
+	authenticationv1 "k8s.io/client-go/kubernetes/typed/authentication/v1"
+	authorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/rest"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -13,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -36,6 +50,16 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		r.GardenNamespace = v1beta1constants.GardenNamespace
 	}
 
+	if err := r.CompileSeedFilters(); err != nil {
+		return fmt.Errorf("failed compiling seed filters: %w", err)
+	}
+
+	if r.Config.SchedulingPreview != nil && r.Config.SchedulingPreview.Enabled {
+		if err := r.addSchedulingPreviewServer(mgr); err != nil {
+			return err
+		}
+	}
+
 	return builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
@@ -52,6 +76,64 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		Complete(r)
 }
 
+// addSchedulingPreviewServer adds an HTTPS server serving the scheduling preview handler to the manager. Requests are
+// authenticated and authorized by delegating to the garden cluster, i.e., clients need to be allowed to create the
+// previewed shoot in its namespace.
+func (r *Reconciler) addSchedulingPreviewServer(mgr manager.Manager) error {
+	log := mgr.GetLogger().WithValues("controller", ControllerName)
+
+	authn, authz, err := newDelegatingAuthenticatorAndAuthorizer(mgr.GetConfig(), mgr.GetHTTPClient())
+	if err != nil {
+		return fmt.Errorf("failed creating authenticator and authorizer for scheduling preview: %w", err)
+	}
+
+	serverConfig := r.Config.SchedulingPreview.Server
+	server := webhook.NewServer(webhook.Options{
+		Host:    serverConfig.BindAddress,
+		Port:    serverConfig.Port,
+		CertDir: serverConfig.TLS.ServerCertDir,
+	})
+	server.Register(SchedulingPreviewPath, r.SchedulingPreviewHandler(log, authn, authz))
+
+	return mgr.Add(server)
+}
+
+func newDelegatingAuthenticatorAndAuthorizer(config *rest.Config, httpClient *http.Client) (authenticator.Request, authorizer.Authorizer, error) {
+	authenticationClient, err := authenticationv1.NewForConfigAndClient(config, httpClient)
+	if err != nil {
+		return nil, nil, err
+	}
+	authorizationClient, err := authorizationv1.NewForConfigAndClient(config, httpClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	retryBackoff := &wait.Backoff{Duration: 500 * time.Millisecond, Factor: 1.5, Jitter: 0.2, Steps: 5}
+
+	authn, _, err := authenticatorfactory.DelegatingAuthenticatorConfig{
+		Anonymous:                &apiserver.AnonymousAuthConfig{Enabled: false},
+		CacheTTL:                 time.Minute,
+		TokenAccessReviewClient:  authenticationClient,
+		TokenAccessReviewTimeout: 10 * time.Second,
+		WebhookRetryBackoff:      retryBackoff,
+	}.New()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating authenticator: %w", err)
+	}
+
+	authz, err := authorizerfactory.DelegatingAuthorizerConfig{
+		SubjectAccessReviewClient: authorizationClient,
+		AllowCacheTTL:             5 * time.Minute,
+		DenyCacheTTL:              30 * time.Second,
+		WebhookRetryBackoff:       retryBackoff,
+	}.New()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating authorizer: %w", err)
+	}
+
+	return authn, authz, nil
+}
+
 // ShootUnassignedPredicate is a predicate that returns true if a shoot is not assigned to a seed
 // and the default scheduler is configured.
 func (r *Reconciler) ShootUnassignedPredicate() predicate.Predicate {
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/scheduler/seedfilter"
+)
+
+// SeedFilter decides whether a seed is eligible for hosting a shoot. Seed filters are evaluated after the built-in
+// filters and before the candidate determination strategy.
+type SeedFilter interface {
+	// Name returns the name of the filter. It is reported for seeds rejected by the filter.
+	Name() string
+	// Filter returns an error describing why the given seed is not eligible for hosting the shoot of the given input.
+	// It returns nil if the seed is eligible.
+	Filter(ctx context.Context, input *SeedFilterInput, seed *gardencorev1beta1.Seed, usage SeedUsage) error
+}
+
+// SeedFilterInput contains the objects seed filters decide on, besides the seed itself.
+type SeedFilterInput struct {
+	// Shoot is the shoot to be scheduled.
+	Shoot *gardencorev1beta1.Shoot
+	// Project is the project of the shoot.
+	Project *gardencorev1beta1.Project
+
+	// celInput is the shoot, project and seeds converted for evaluating CEL expressions. It is computed on first use and
+	// shared by all seed filters of a scheduling decision, so that each seed is converted only once.
+	celInput *seedfilter.Input
+}
+
+// NewSeedFilters returns the seed filters for the given filter rules of the scheduler configuration.
+func NewSeedFilters(rules []schedulerconfigv1alpha1.SeedFilterRule) ([]SeedFilter, error) {
+	filters := make([]SeedFilter, 0, len(rules))
+
+	for _, rule := range rules {
+		program, err := seedfilter.Compile(rule.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid seed filter rule %q: %w", rule.Name, err)
+		}
+
+		message := ptr.Deref(rule.Message, fmt.Sprintf("seed does not satisfy expression %q", rule.Expression))
+		filters = append(filters, &celSeedFilter{name: rule.Name, message: message, program: program})
+	}
+
+	return filters, nil
+}
+
+// celSeedFilter rejects seeds for which a CEL expression does not evaluate to true.
+type celSeedFilter struct {
+	name    string
+	message string
+	program *seedfilter.Program
+}
+
+func (f *celSeedFilter) Name() string {
+	return f.name
+}
+
+func (f *celSeedFilter) Filter(ctx context.Context, input *SeedFilterInput, seed *gardencorev1beta1.Seed, usage SeedUsage) error {
+	if input.celInput == nil {
+		celInput, err := seedfilter.NewInput(input.Shoot, input.Project)
+		if err != nil {
+			return err
+		}
+		input.celInput = celInput
+	}
+
+	eligible, err := f.program.Eval(ctx, input.celInput, seed, seedfilter.Usage{
+		Shoots:          usage.Shoots,
+		ShootsByPurpose: usage.ShootsByPurpose,
+		Cost:            usage.Cost,
+	})
+	if err != nil {
+		// Seeds are rejected if the expression cannot be evaluated, e.g. because it accesses a field which is not set.
+		return err
+	}
+	if !eligible {
+		return errors.New(f.message)
+	}
+
+	return nil
+}
+
+// ReschedulingSeedFilter is a seed filter for rescheduling a shoot whose control plane is already hosted by a seed,
+// i.e., for determining the destination seed of a control plane migration. It rejects the current seed of the shoot as
+// well as seeds which do not fulfil the preconditions of a control plane migration.
+type ReschedulingSeedFilter struct {
+	// SourceSeed is the seed currently hosting the control plane of the shoot.
+	SourceSeed *gardencorev1beta1.Seed
+}
+
+// Name returns the name of the filter.
+func (f *ReschedulingSeedFilter) Name() string {
+	return "Rescheduling"
+}
+
+// Filter returns an error if the given seed cannot be the destination of a control plane migration from the source
+// seed.
+func (f *ReschedulingSeedFilter) Filter(_ context.Context, _ *SeedFilterInput, seed *gardencorev1beta1.Seed, _ SeedUsage) error {
+	if seed.Name == f.SourceSeed.Name {
+		return errors.New("seed is the current seed of the shoot")
+	}
+
+	if seed.Spec.Backup == nil {
+		return errors.New("backup is not configured for seed")
+	}
+
+	if sourceDomain, domain := internalDomain(f.SourceSeed), internalDomain(seed); sourceDomain != domain {
+		return fmt.Errorf("internal domain %q differs from internal domain %q of the current seed", domain, sourceDomain)
+	}
+
+	return nil
+}
+
+func internalDomain(seed *gardencorev1beta1.Seed) string {
+	if seed.Spec.DNS.Internal == nil {
+		return ""
+	}
+	return seed.Spec.DNS.Internal.Domain
+}
+
+// NewReschedulingReconciler returns a reconciler for determining the destination seeds of control plane migrations,
+// e.g., when seeds are drained or rebalanced. Seeds are determined with the given shoot scheduler configuration, i.e.,
+// with the same candidate determination strategy and seed filter rules as in gardener-scheduler. Use ForSourceSeed to
+// obtain a reconciler for the shoots of a particular seed.
+func NewReschedulingReconciler(c client.Client, config *schedulerconfigv1alpha1.ShootSchedulerConfiguration) (*Reconciler, error) {
+	r := &Reconciler{
+		Client:          c,
+		Config:          config,
+		GardenNamespace: v1beta1constants.GardenNamespace,
+	}
+
+	if err := r.CompileSeedFilters(); err != nil {
+		return nil, fmt.Errorf("failed compiling seed filters: %w", err)
+	}
+
+	return r, nil
+}
+
+// ForSourceSeed returns a copy of the reconciler which additionally rejects seeds that cannot be the destination of a
+// control plane migration from the given source seed, see ReschedulingSeedFilter.
+func (r *Reconciler) ForSourceSeed(sourceSeed *gardencorev1beta1.Seed) *Reconciler {
+	out := *r
+	out.SeedFilters = append(slices.Clone(r.SeedFilters), &ReschedulingSeedFilter{SourceSeed: sourceSeed})
+	return &out
+}
+
+// CompileSeedFilters compiles the seed filter rules of the scheduler configuration once, so that they are not compiled
+// again for every scheduling decision. It must be called before the reconciler is used concurrently.
+func (r *Reconciler) CompileSeedFilters() error {
+	filters, err := NewSeedFilters(r.Config.Filters)
+	if err != nil {
+		return err
+	}
+
+	r.configuredSeedFilters = filters
+	return nil
+}
+
+// seedFilters returns the seed filters configured in the scheduler configuration, followed by the additional seed
+// filters of the reconciler, as steps of the filter chain.
+func (r *Reconciler) seedFilters(ctx context.Context, shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project, usage map[string]SeedUsage) ([]seedFilter, error) {
+	filters := r.configuredSeedFilters
+	if filters == nil && len(r.Config.Filters) > 0 {
+		// The seed filter rules were not compiled upfront, e.g. because the reconciler was not added to a manager.
+		var err error
+		if filters, err = NewSeedFilters(r.Config.Filters); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		input = &SeedFilterInput{Shoot: shoot, Project: project}
+		steps = make([]seedFilter, 0, len(filters)+len(r.SeedFilters))
+	)
+
+	for _, f := range slices.Concat(filters, r.SeedFilters) {
+		seedNameToErr := make(map[string]error)
+
+		steps = append(steps, seedFilter{
+			name: f.Name(),
+			filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				var eligibleSeeds []gardencorev1beta1.Seed
+				for _, seed := range seeds {
+					if err := f.Filter(ctx, input, &seed, usage[seed.Name]); err != nil {
+						seedNameToErr[seed.Name] = err
+						continue
+					}
+					eligibleSeeds = append(eligibleSeeds, seed)
+				}
+
+				if len(eligibleSeeds) == 0 {
+					return nil, fmt.Errorf("none of the %d seeds is eligible according to filter %q: %s", len(seeds), f.Name(), errorMapToString(seedNameToErr))
+				}
+				return eligibleSeeds, nil
+			},
+			reason: func(seed *gardencorev1beta1.Seed) string {
+				if err, ok := seedNameToErr[seed.Name]; ok {
+					return err.Error()
+				}
+				return ""
+			},
+		})
+	}
+
+	return steps, nil
+}
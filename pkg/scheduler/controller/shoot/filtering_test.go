@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("Filtering", func() {
+	Describe("#NewSeedFilters", func() {
+		var (
+			ctx   = context.Background()
+			input *SeedFilterInput
+			seed  *gardencorev1beta1.Seed
+		)
+
+		BeforeEach(func() {
+			input = &SeedFilterInput{
+				Shoot:   &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot"}},
+				Project: &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "project"}},
+			}
+			seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}
+		})
+
+		It("should return filters for the configured rules", func() {
+			filters, err := NewSeedFilters([]schedulerconfigv1alpha1.SeedFilterRule{
+				{Name: "accept", Expression: "usage.shoots < 10"},
+				{Name: "reject", Expression: `seed.metadata.name == shoot.metadata.name`},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(2))
+
+			Expect(filters[0].Name()).To(Equal("accept"))
+			Expect(filters[0].Filter(ctx, input, seed, SeedUsage{Shoots: 9})).To(Succeed())
+
+			Expect(filters[1].Name()).To(Equal("reject"))
+			Expect(filters[1].Filter(ctx, input, seed, SeedUsage{})).To(MatchError(`seed does not satisfy expression "seed.metadata.name == shoot.metadata.name"`))
+		})
+
+		It("should fail for invalid rules", func() {
+			_, err := NewSeedFilters([]schedulerconfigv1alpha1.SeedFilterRule{{Name: "foo", Expression: "usage.shoots <"}})
+			Expect(err).To(MatchError(ContainSubstring(`invalid seed filter rule "foo"`)))
+		})
+	})
+
+	Describe("#CompileSeedFilters", func() {
+		It("should compile the configured rules once", func() {
+			r := &Reconciler{Config: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Filters: []schedulerconfigv1alpha1.SeedFilterRule{{Name: "foo", Expression: "usage.shoots < 10"}},
+			}}
+
+			Expect(r.CompileSeedFilters()).To(Succeed())
+			Expect(r.configuredSeedFilters).To(HaveLen(1))
+			Expect(r.configuredSeedFilters[0].Name()).To(Equal("foo"))
+		})
+
+		It("should fail for invalid rules", func() {
+			r := &Reconciler{Config: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Filters: []schedulerconfigv1alpha1.SeedFilterRule{{Name: "foo", Expression: "usage.shoots <"}},
+			}}
+
+			Expect(r.CompileSeedFilters()).To(MatchError(ContainSubstring(`invalid seed filter rule "foo"`)))
+		})
+	})
+
+	Describe("#ReschedulingSeedFilter", func() {
+		var (
+			ctx        = context.Background()
+			sourceSeed *gardencorev1beta1.Seed
+			seed       *gardencorev1beta1.Seed
+			filter     *ReschedulingSeedFilter
+		)
+
+		BeforeEach(func() {
+			sourceSeed = &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "source"},
+				Spec: gardencorev1beta1.SeedSpec{
+					Backup: &gardencorev1beta1.Backup{Provider: "local"},
+					DNS:    gardencorev1beta1.SeedDNS{Internal: &gardencorev1beta1.SeedDNSProviderConfig{Domain: "internal.example.com"}},
+				},
+			}
+			seed = sourceSeed.DeepCopy()
+			seed.Name = "destination"
+
+			filter = &ReschedulingSeedFilter{SourceSeed: sourceSeed}
+		})
+
+		It("should accept seeds fulfilling the preconditions of a control plane migration", func() {
+			Expect(filter.Name()).To(Equal("Rescheduling"))
+			Expect(filter.Filter(ctx, nil, seed, SeedUsage{})).To(Succeed())
+		})
+
+		It("should reject the source seed", func() {
+			Expect(filter.Filter(ctx, nil, sourceSeed, SeedUsage{})).To(MatchError("seed is the current seed of the shoot"))
+		})
+
+		It("should reject seeds without backup", func() {
+			seed.Spec.Backup = nil
+			Expect(filter.Filter(ctx, nil, seed, SeedUsage{})).To(MatchError("backup is not configured for seed"))
+		})
+
+		It("should reject seeds with a different internal domain", func() {
+			seed.Spec.DNS.Internal = nil
+			Expect(filter.Filter(ctx, nil, seed, SeedUsage{})).To(MatchError(`internal domain "" differs from internal domain "internal.example.com" of the current seed`))
+		})
+	})
+
+	Describe("#NewReschedulingReconciler", func() {
+		It("should return a reconciler with the given configuration", func() {
+			config := &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.MinimalDistance}
+
+			reconciler, err := NewReschedulingReconciler(nil, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reconciler.Config).To(Equal(config))
+			Expect(reconciler.GardenNamespace).To(Equal("garden"))
+		})
+
+		It("should fail if a seed filter rule cannot be compiled", func() {
+			_, err := NewReschedulingReconciler(nil, &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Filters: []schedulerconfigv1alpha1.SeedFilterRule{{Name: "invalid", Expression: "seed."}},
+			})
+			Expect(err).To(MatchError(ContainSubstring("failed compiling seed filters")))
+		})
+
+		It("should add the rescheduling seed filter for the source seed without modifying the reconciler", func() {
+			sourceSeed := &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "source"}}
+
+			reconciler, err := NewReschedulingReconciler(nil, &schedulerconfigv1alpha1.ShootSchedulerConfiguration{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.ForSourceSeed(sourceSeed).SeedFilters).To(ConsistOf(&ReschedulingSeedFilter{SourceSeed: sourceSeed}))
+			Expect(reconciler.SeedFilters).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,252 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/utils/ptr"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+const (
+	// SchedulingPreviewPath is the path of the scheduling preview endpoint.
+	SchedulingPreviewPath = "/scheduling/preview"
+	// maxSchedulingPreviewRequestBytes is the maximum size of the request body of the scheduling preview endpoint.
+	maxSchedulingPreviewRequestBytes = 3 * 1024 * 1024
+)
+
+// SchedulingPreview is the result of a scheduling dry-run for a shoot.
+type SchedulingPreview struct {
+	// Seeds contains the verdict for each seed.
+	Seeds []SeedVerdict `json:"seeds"`
+	// Ranking contains the eligible seed candidates ordered by preference, i.e. the first entry is the seed the shoot
+	// would be scheduled to.
+	Ranking []SeedRanking `json:"ranking,omitempty"`
+	// SeedName is the name of the seed the shoot would be scheduled to.
+	SeedName *string `json:"seedName,omitempty"`
+	// Error is the reason why no seed could be determined for the shoot.
+	Error string `json:"error,omitempty"`
+}
+
+// SeedVerdict is the verdict of the scheduler for a single seed.
+type SeedVerdict struct {
+	// Name is the name of the seed.
+	Name string `json:"name"`
+	// Eligible is true if the seed passed all filters.
+	Eligible bool `json:"eligible"`
+	// Filter is the name of the filter which rejected the seed.
+	Filter string `json:"filter,omitempty"`
+	// Reason is the reason why the seed was rejected.
+	Reason string `json:"reason,omitempty"`
+}
+
+// SeedRanking is the rank of an eligible seed candidate.
+type SeedRanking struct {
+	// Name is the name of the seed.
+	Name string `json:"name"`
+	// Shoots is the number of shoots hosted by the seed.
+	Shoots int `json:"shoots"`
+	// Score is the sum of the weighted scores of the seed. It is only set for the CapacityAware strategy.
+	Score *float64 `json:"score,omitempty"`
+}
+
+// seedFilter is a named step of the filter chain used for determining a seed.
+type seedFilter struct {
+	name string
+	// description is the reason reported for seeds rejected by this filter if the filter does not provide a reason
+	// function.
+	description string
+	filter      func([]gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error)
+	// reason returns the reason why the given seed was rejected by this filter. It is optional.
+	reason func(*gardencorev1beta1.Seed) string
+}
+
+// recordFilterResult records the verdicts for all seeds rejected by the given filter. It is a no-op for nil previews.
+func (p *SchedulingPreview) recordFilterResult(f seedFilter, before, after []gardencorev1beta1.Seed, err error) {
+	if p == nil {
+		return
+	}
+
+	if err != nil {
+		p.Error = err.Error()
+		after = nil
+	}
+
+	remaining := sets.New[string]()
+	for _, seed := range after {
+		remaining.Insert(seed.Name)
+	}
+
+	for _, seed := range before {
+		if remaining.Has(seed.Name) {
+			continue
+		}
+
+		var reason string
+		if f.reason != nil {
+			reason = f.reason(&seed)
+		}
+		if reason == "" {
+			reason = f.description
+		}
+		if reason == "" && err != nil {
+			reason = err.Error()
+		}
+
+		p.Seeds = append(p.Seeds, SeedVerdict{Name: seed.Name, Filter: f.name, Reason: reason})
+	}
+}
+
+// PreviewScheduling runs the seed determination for the given shoot without binding it to a seed. It returns the
+// verdicts of all filters per seed and the final ranking of the seed candidates. Errors which are not caused by the
+// filters, e.g. a missing CloudProfile or project, are returned as error.
+func (r *Reconciler) PreviewScheduling(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*SchedulingPreview, error) {
+	preview := &SchedulingPreview{}
+
+	seed, err := r.determineSeed(ctx, log, shoot, preview)
+	if err != nil {
+		if preview.Error == "" {
+			return nil, err
+		}
+	} else {
+		preview.SeedName = &seed.Name
+	}
+
+	for _, ranking := range preview.Ranking {
+		preview.Seeds = append(preview.Seeds, SeedVerdict{Name: ranking.Name, Eligible: true})
+	}
+	slices.SortFunc(preview.Seeds, func(a, b SeedVerdict) int { return cmp.Compare(a.Name, b.Name) })
+
+	return preview, nil
+}
+
+// rankCandidates orders the given seed candidates by preference, in the same way as they are chosen by the configured
+// strategy.
+func (r *Reconciler) rankCandidates(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot) ([]SeedRanking, error) {
+	var ranking []SeedRanking
+
+	if r.Config.Strategy != schedulerconfigv1alpha1.CapacityAware {
+		seedUsage := v1beta1helper.CalculateSeedUsage(shootList)
+		for _, seed := range seedList {
+			ranking = append(ranking, SeedRanking{Name: seed.Name, Shoots: seedUsage[seed.Name]})
+		}
+
+		slices.SortStableFunc(ranking, func(a, b SeedRanking) int { return cmp.Compare(a.Shoots, b.Shoots) })
+		return ranking, nil
+	}
+
+	scorers, shootCost, err := r.seedScorers()
+	if err != nil {
+		return nil, err
+	}
+
+	usage := calculateSeedUsage(shootList, shootCost)
+	for _, seed := range seedList {
+		ranking = append(ranking, SeedRanking{
+			Name:   seed.Name,
+			Shoots: usage[seed.Name].Shoots,
+			Score:  ptr.To(seedScore(shoot, &seed, usage[seed.Name], scorers)),
+		})
+	}
+
+	slices.SortStableFunc(ranking, func(a, b SeedRanking) int {
+		if c := cmp.Compare(*b.Score, *a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Shoots, b.Shoots)
+	})
+	return ranking, nil
+}
+
+// SchedulingPreviewHandler returns an HTTP handler which previews the scheduling of the Shoot contained in the body of
+// POST requests. The response contains the SchedulingPreview as JSON. Requests are authenticated with the given
+// authenticator, and the user must be authorized to create the Shoot in its namespace.
+func (r *Reconciler) SchedulingPreviewHandler(log logr.Logger, authn authenticator.Request, authz authorizer.Authorizer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(w, fmt.Sprintf("method %s is not allowed", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		authResponse, ok, err := authn.AuthenticateRequest(req)
+		if err != nil {
+			log.Error(err, "Authentication failed")
+			http.Error(w, "Authentication failed", http.StatusInternalServerError)
+			return
+		}
+		if !ok {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxSchedulingPreviewRequestBytes))
+		if err != nil {
+			if maxBytesErr := (&http.MaxBytesError{}); errors.As(err, &maxBytesErr) {
+				http.Error(w, fmt.Sprintf("request body must not exceed %d bytes", maxBytesErr.Limit), http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, fmt.Sprintf("failed reading request body: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		shoot := &gardencorev1beta1.Shoot{}
+		if _, _, err := kubernetes.GardenCodec.UniversalDeserializer().Decode(body, nil, shoot); err != nil {
+			http.Error(w, fmt.Sprintf("failed decoding shoot: %v", err), http.StatusBadRequest)
+			return
+		}
+		if shoot.Namespace == "" {
+			http.Error(w, "shoot namespace must be set", http.StatusBadRequest)
+			return
+		}
+
+		decision, _, err := authz.Authorize(req.Context(), authorizer.AttributesRecord{
+			User:            authResponse.User,
+			Verb:            "create",
+			Namespace:       shoot.Namespace,
+			APIGroup:        gardencorev1beta1.SchemeGroupVersion.Group,
+			APIVersion:      gardencorev1beta1.SchemeGroupVersion.Version,
+			Resource:        "shoots",
+			ResourceRequest: true,
+		})
+		if err != nil {
+			log.Error(err, "Authorization failed", "user", authResponse.User.GetName())
+			http.Error(w, "Authorization failed", http.StatusInternalServerError)
+			return
+		}
+		if decision != authorizer.DecisionAllow {
+			http.Error(w, fmt.Sprintf("user %s is not allowed to create shoots in namespace %s", authResponse.User.GetName(), shoot.Namespace), http.StatusForbidden)
+			return
+		}
+
+		kubernetes.GardenScheme.Default(shoot)
+
+		preview, err := r.PreviewScheduling(req.Context(), log.WithValues("shoot", shoot.Namespace+"/"+shoot.Name), shoot)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed previewing scheduling: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(preview); err != nil {
+			log.Error(err, "Failed writing scheduling preview response")
+		}
+	})
+}
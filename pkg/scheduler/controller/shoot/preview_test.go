@@ -0,0 +1,282 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Scheduling preview", func() {
+	var (
+		ctx              = context.Background()
+		log              = logr.Discard()
+		fakeGardenClient client.Client
+		reconciler       *Reconciler
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		project      *gardencorev1beta1.Project
+		seed1        *gardencorev1beta1.Seed
+		seed2        *gardencorev1beta1.Seed
+		seed3        *gardencorev1beta1.Seed
+		shoot        *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeGardenClient = fakeclient.
+			NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Project{}, gardencore.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: fakeGardenClient,
+			Config: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.SameRegion},
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}}
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "project"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-project")},
+		}
+
+		newSeed := func(name, region string) *gardencorev1beta1.Seed {
+			return &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: gardencorev1beta1.SeedSpec{
+					Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: region},
+					Networks: gardencorev1beta1.SeedNetworks{
+						Nodes:    ptr.To("10.10.0.0/16"),
+						Pods:     "10.20.0.0/16",
+						Services: "10.30.0.0/16",
+					},
+					Settings: &gardencorev1beta1.SeedSettings{
+						Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+					},
+				},
+				Status: gardencorev1beta1.SeedStatus{
+					Conditions:    []gardencorev1beta1.Condition{{Type: gardencorev1beta1.GardenletReady, Status: gardencorev1beta1.ConditionTrue}},
+					LastOperation: &gardencorev1beta1.LastOperation{},
+				},
+			}
+		}
+
+		seed1 = newSeed("seed-1", "europe")
+		seed2 = newSeed("seed-2", "europe")
+		seed3 = newSeed("seed-3", "asia")
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-project"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: ptr.To(cloudProfile.Name),
+				Region:           "europe",
+				Provider: gardencorev1beta1.Provider{
+					Type:    "foo",
+					Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
+				},
+				Networking: &gardencorev1beta1.Networking{
+					Nodes:    ptr.To("10.40.0.0/16"),
+					Pods:     ptr.To("10.50.0.0/16"),
+					Services: ptr.To("10.60.0.0/16"),
+				},
+			},
+		}
+
+		Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+	})
+
+	Describe("#PreviewScheduling", func() {
+		It("should return the verdicts for all seeds and the ranking of the candidates", func() {
+			seed2.Status.Conditions[0].Status = gardencorev1beta1.ConditionFalse
+			Expect(fakeGardenClient.Create(ctx, seed1)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed2)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed3)).To(Succeed())
+
+			preview, err := reconciler.PreviewScheduling(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(preview).To(Equal(&SchedulingPreview{
+				Seeds: []SeedVerdict{
+					{Name: "seed-1", Eligible: true},
+					{Name: "seed-2", Filter: "UsableSeeds", Reason: "seed is deleting, draining, invisible or not ready"},
+					{Name: "seed-3", Filter: "Strategy", Reason: `seed is not a candidate of the "SameRegion" strategy`},
+				},
+				Ranking:  []SeedRanking{{Name: "seed-1"}},
+				SeedName: ptr.To("seed-1"),
+			}))
+		})
+
+		It("should report the individual reasons of rejected candidates", func() {
+			seed1.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+			seed2.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("0")}
+			Expect(fakeGardenClient.Create(ctx, seed1)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed2)).To(Succeed())
+
+			preview, err := reconciler.PreviewScheduling(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(preview.SeedName).To(BeNil())
+			Expect(preview.Ranking).To(BeEmpty())
+			Expect(preview.Error).To(ContainSubstring("0/2 seed cluster candidate(s) are eligible for scheduling"))
+			Expect(preview.Seeds).To(Equal([]SeedVerdict{
+				{Name: "seed-1", Filter: "Candidates", Reason: "shoot does not tolerate the seed's taints"},
+				{Name: "seed-2", Filter: "Candidates", Reason: "seed does not have available capacity for shoots"},
+			}))
+		})
+
+		It("should report the seeds rejected by seed filter rules", func() {
+			reconciler.Config.Filters = []schedulerconfigv1alpha1.SeedFilterRule{{
+				Name:       "no-seed-2",
+				Expression: `seed.metadata.name != "seed-2"`,
+				Message:    ptr.To("seed-2 is reserved"),
+			}}
+			Expect(fakeGardenClient.Create(ctx, seed1)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed2)).To(Succeed())
+
+			preview, err := reconciler.PreviewScheduling(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(preview.SeedName).To(PointTo(Equal("seed-1")))
+			Expect(preview.Seeds).To(ConsistOf(
+				SeedVerdict{Name: "seed-1", Eligible: true},
+				SeedVerdict{Name: "seed-2", Filter: "no-seed-2", Reason: "seed-2 is reserved"},
+			))
+		})
+
+		It("should rank the candidates by score for the CapacityAware strategy", func() {
+			reconciler.Config.Strategy = schedulerconfigv1alpha1.CapacityAware
+
+			otherShoot := shoot.DeepCopy()
+			otherShoot.Name = "other"
+			otherShoot.Spec.SeedName = ptr.To(seed1.Name)
+
+			Expect(fakeGardenClient.Create(ctx, seed1)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed2)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, otherShoot)).To(Succeed())
+
+			preview, err := reconciler.PreviewScheduling(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(preview.SeedName).To(PointTo(Equal("seed-2")))
+			Expect(preview.Ranking).To(HaveLen(2))
+			Expect(preview.Ranking[0].Name).To(Equal("seed-2"))
+			Expect(preview.Ranking[0].Score).To(PointTo(BeNumerically(">", *preview.Ranking[1].Score)))
+			Expect(preview.Ranking[1]).To(MatchFields(IgnoreExtras, Fields{"Name": Equal("seed-1"), "Shoots": Equal(1)}))
+		})
+
+		It("should return an error if the project cannot be found", func() {
+			shoot.Namespace = "unknown"
+
+			_, err := reconciler.PreviewScheduling(ctx, log, shoot)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#SchedulingPreviewHandler", func() {
+		var (
+			handler       http.Handler
+			authenticated bool
+		)
+
+		BeforeEach(func() {
+			authenticated = true
+
+			authn := authenticator.RequestFunc(func(*http.Request) (*authenticator.Response, bool, error) {
+				return &authenticator.Response{User: &user.DefaultInfo{Name: "foo"}}, authenticated, nil
+			})
+			authz := authorizer.AuthorizerFunc(func(_ context.Context, attributes authorizer.Attributes) (authorizer.Decision, string, error) {
+				if attributes.GetUser().GetName() == "foo" &&
+					attributes.GetVerb() == "create" &&
+					attributes.GetAPIGroup() == "core.gardener.cloud" &&
+					attributes.GetResource() == "shoots" &&
+					attributes.GetNamespace() == "garden-project" {
+					return authorizer.DecisionAllow, "", nil
+				}
+				return authorizer.DecisionNoOpinion, "", nil
+			})
+
+			handler = reconciler.SchedulingPreviewHandler(log, authn, authz)
+			Expect(fakeGardenClient.Create(ctx, seed1)).To(Succeed())
+		})
+
+		It("should return the scheduling preview", func() {
+			body, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, SchedulingPreviewPath, strings.NewReader(`{"apiVersion":"core.gardener.cloud/v1beta1","kind":"Shoot",`+string(body[1:]))))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			preview := &SchedulingPreview{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), preview)).To(Succeed())
+			Expect(preview.SeedName).To(PointTo(Equal("seed-1")))
+		})
+
+		It("should reject unauthenticated requests", func() {
+			authenticated = false
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, SchedulingPreviewPath, strings.NewReader(`{"apiVersion":"core.gardener.cloud/v1beta1","kind":"Shoot","metadata":{"name":"foo","namespace":"garden-project"}}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("should reject shoots in namespaces in which the user must not create shoots", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, SchedulingPreviewPath, strings.NewReader(`{"apiVersion":"core.gardener.cloud/v1beta1","kind":"Shoot","metadata":{"name":"foo","namespace":"garden-other"}}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			Expect(recorder.Body.String()).To(ContainSubstring("user foo is not allowed to create shoots in namespace garden-other"))
+		})
+
+		It("should reject other methods than POST", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, SchedulingPreviewPath, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+		})
+
+		It("should reject invalid shoots", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, SchedulingPreviewPath, strings.NewReader("foo")))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should reject too large request bodies", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, SchedulingPreviewPath, strings.NewReader(`{"apiVersion":"core.gardener.cloud/v1beta1","kind":"Shoot","metadata":{"name":"`+strings.Repeat("a", 3*1024*1024)+`"}}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusRequestEntityTooLarge))
+		})
+
+		It("should reject shoots without namespace", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, SchedulingPreviewPath, strings.NewReader(`{"apiVersion":"core.gardener.cloud/v1beta1","kind":"Shoot","metadata":{"name":"foo"}}`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
@@ -40,6 +40,15 @@ type Reconciler struct {
 	Config          *schedulerconfigv1alpha1.ShootSchedulerConfiguration
 	GardenNamespace string
 	Recorder        events.EventRecorder
+	// SeedScorers are additional seed scorers which are combined with the seed scorers configured for the
+	// CapacityAware strategy.
+	SeedScorers []WeightedSeedScorer
+	// SeedFilters are additional seed filters which are evaluated after the seed filter rules configured in the
+	// scheduler configuration.
+	SeedFilters []SeedFilter
+
+	// configuredSeedFilters are the compiled seed filter rules of the scheduler configuration, see CompileSeedFilters.
+	configuredSeedFilters []SeedFilter
 }
 
 // Reconcile schedules shoots to seeds.
@@ -125,6 +134,20 @@ func (r *Reconciler) DetermineSeed(
 ) (
 	*gardencorev1beta1.Seed,
 	error,
+) {
+	return r.determineSeed(ctx, log, shoot, nil)
+}
+
+// determineSeed returns an appropriate Seed cluster (or nil). If a preview is given, the verdicts of all filters and the
+// final ranking of the seed candidates are recorded in it.
+func (r *Reconciler) determineSeed(
+	ctx context.Context,
+	log logr.Logger,
+	shoot *gardencorev1beta1.Shoot,
+	preview *SchedulingPreview,
+) (
+	*gardencorev1beta1.Seed,
+	error,
 ) {
 	seedList := &gardencorev1beta1.SeedList{}
 	if err := r.Client.List(ctx, seedList); err != nil {
@@ -150,51 +173,151 @@ func (r *Reconciler) DetermineSeed(
 		return nil, err
 	}
 
-	filteredSeeds, err := filterUsableSeeds(seedList.Items)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
-	if err != nil {
-		return nil, err
+	seedUsage := v1beta1helper.CalculateSeedUsage(shootList)
+
+	filters := []seedFilter{
+		{
+			name:        "UsableSeeds",
+			description: "seed is deleting, draining, invisible or not ready",
+			filter:      filterUsableSeeds,
+		},
+		{
+			name:        "CloudProfileSeedSelector",
+			description: "seed does not match the seed selector of the CloudProfile",
+			filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsMatchingLabelSelector(seeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
+			},
+		},
+		{
+			name:        "ShootSeedSelector",
+			description: "seed does not match the seed selector of the Shoot",
+			filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsMatchingLabelSelector(seeds, shoot.Spec.SeedSelector, "Shoot")
+			},
+		},
+		{
+			name:        "Providers",
+			description: "seed provider does not match the shoot provider",
+			filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsMatchingProviders(cloudProfile, shoot, seeds)
+			},
+		},
+		{
+			name:        "ZonalShootControlPlanes",
+			description: "seed has less than 3 zones for hosting a shoot control plane with failure tolerance type 'zone'",
+			filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsForZonalShootControlPlanes(seeds, shoot)
+			},
+		},
+		{
+			name:        "ZoneSelection",
+			description: "seed has no zone overlap with the shoot's worker pool zones or other seeds are preferred by zone selection",
+			filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsForZoneSelection(seeds, shoot)
+			},
+		},
+		{
+			name:        "AccessRestrictions",
+			description: "seed does not support the access restrictions configured in the shoot specification",
+			filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsForAccessRestrictions(seeds, shoot)
+			},
+		},
+		{
+			name:        "Domain",
+			description: "seed does not support the domain configured in the shoot specification",
+			filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsMatchingDomain(seeds, shoot, project.Name)
+			},
+		},
+		{
+			name:        "ShootReconciliationsDisabled",
+			description: "seed has disabled shoot reconciliations currently",
+			filter:      filterSeedsWithDisabledShootReconciliations,
+		},
+		{
+			name: "Candidates",
+			filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterCandidates(shoot, shootList, seeds)
+			},
+			reason: func(seed *gardencorev1beta1.Seed) string {
+				if err := checkCandidate(seed, shoot, seedUsage); err != nil {
+					return err.Error()
+				}
+				return ""
+			},
+		},
 	}
-	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
+
+	customFilters, err := r.seedFilters(ctx, shoot, project, calculateSeedUsage(shootList, NewShootCostFunc(r.capacityAwareConfig().ShootCost)))
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsForZoneSelection(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
+	filters = append(filters, customFilters...)
+
+	filters = append(filters, seedFilter{
+		name:        "Strategy",
+		description: fmt.Sprintf("seed is not a candidate of the %q strategy", r.Config.Strategy),
+		filter: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return applyStrategy(log, shoot, seeds, r.Config.Strategy, regionConfig)
+		},
+	})
+
+	filteredSeeds := seedList.Items
+	for _, f := range filters {
+		seeds, err := f.filter(filteredSeeds)
+		preview.recordFilterResult(f, filteredSeeds, seeds, err)
+		if err != nil {
+			return nil, err
+		}
+		filteredSeeds = seeds
 	}
-	filteredSeeds, err = filterSeedsForAccessRestrictions(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
+
+	if preview != nil {
+		ranking, err := r.rankCandidates(filteredSeeds, shoot, shootList)
+		if err != nil {
+			return nil, err
+		}
+		preview.Ranking = ranking
 	}
-	filteredSeeds, err = filterSeedsMatchingDomain(filteredSeeds, shoot, project.Name)
-	if err != nil {
-		return nil, err
+
+	if r.Config.Strategy == schedulerconfigv1alpha1.CapacityAware {
+		return r.getSeedWithBestScore(filteredSeeds, shoot, shootList)
 	}
-	filteredSeeds, err = filterSeedsWithDisabledShootReconciliations(filteredSeeds)
+	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
+}
+
+func (r *Reconciler) getSeedWithBestScore(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
+	scorers, shootCost, err := r.seedScorers()
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
+
+	return getSeedWithBestScore(seedList, shoot, calculateSeedUsage(shootList, shootCost), scorers)
+}
+
+func (r *Reconciler) seedScorers() ([]WeightedSeedScorer, ShootCostFunc, error) {
+	config := r.capacityAwareConfig()
+
+	scorers, err := NewSeedScorers(config)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
-	if err != nil {
-		return nil, err
+
+	return append(scorers, r.SeedScorers...), NewShootCostFunc(config.ShootCost), nil
+}
+
+// capacityAwareConfig returns the configuration of the CapacityAware strategy. If it is not configured, the defaults are
+// returned.
+func (r *Reconciler) capacityAwareConfig() *schedulerconfigv1alpha1.CapacityAwareConfiguration {
+	if r.Config.CapacityAware != nil {
+		return r.Config.CapacityAware
 	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
+
+	config := &schedulerconfigv1alpha1.CapacityAwareConfiguration{}
+	schedulerconfigv1alpha1.SetDefaults_CapacityAwareConfiguration(config)
+	schedulerconfigv1alpha1.SetDefaults_ShootCostConfiguration(config.ShootCost)
+	return config
 }
 
 func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
@@ -226,7 +349,10 @@ func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cl
 }
 
 func isUsableSeed(seed *gardencorev1beta1.Seed) bool {
-	return seed.DeletionTimestamp == nil && seed.Spec.Settings.Scheduling.Visible && verifySeedReadiness(seed)
+	return seed.DeletionTimestamp == nil &&
+		!v1beta1helper.TaintsHave(seed.Spec.Taints, gardencorev1beta1.SeedTaintDraining) &&
+		seed.Spec.Settings.Scheduling.Visible &&
+		verifySeedReadiness(seed)
 }
 
 func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
@@ -239,7 +365,7 @@ func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.S
 	}
 
 	if len(matchingSeeds) == 0 {
-		return nil, fmt.Errorf("none of the %d seeds is valid for scheduling (not deleting, visible and ready)", len(seedList))
+		return nil, fmt.Errorf("none of the %d seeds is valid for scheduling (not deleting, not draining, visible and ready)", len(seedList))
 	}
 	return matchingSeeds, nil
 }
@@ -441,7 +567,7 @@ func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []g
 		candidates = determineCandidatesOfSameProvider(seedList, shoot)
 	case strategy == schedulerconfigv1alpha1.SameRegion:
 		candidates = determineCandidatesWithSameRegionStrategy(seedList, shoot)
-	case strategy == schedulerconfigv1alpha1.MinimalDistance:
+	case strategy == schedulerconfigv1alpha1.MinimalDistance, strategy == schedulerconfigv1alpha1.CapacityAware:
 		var err error
 		candidates, err = determineCandidatesWithMinimalDistanceStrategy(log, shoot, seedList, regionConfig)
 		if err != nil {
@@ -471,20 +597,8 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 	)
 
 	for _, seed := range seedList {
-		if shoot.Spec.Networking != nil {
-			if disjointed, err := networksAreDisjointed(&seed, shoot); !disjointed {
-				seedNameToErr[seed.Name] = err
-				continue
-			}
-		}
-
-		if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
-			seedNameToErr[seed.Name] = errors.New("shoot does not tolerate the seed's taints")
-			continue
-		}
-
-		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
-			seedNameToErr[seed.Name] = errors.New("seed does not have available capacity for shoots")
+		if err := checkCandidate(&seed, shoot, seedUsage); err != nil {
+			seedNameToErr[seed.Name] = err
 			continue
 		}
 
@@ -497,6 +611,25 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 	return candidates, nil
 }
 
+// checkCandidate returns an error if the given seed is not eligible for hosting the given shoot.
+func checkCandidate(seed *gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, seedUsage map[string]int) error {
+	if shoot.Spec.Networking != nil {
+		if disjointed, err := networksAreDisjointed(seed, shoot); !disjointed {
+			return err
+		}
+	}
+
+	if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
+		return errors.New("shoot does not tolerate the seed's taints")
+	}
+
+	if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
+		return errors.New("seed does not have available capacity for shoots")
+	}
+
+	return nil
+}
+
 // getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
 func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []*gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
 	var (
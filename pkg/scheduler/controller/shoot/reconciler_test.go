@@ -634,6 +634,198 @@ var _ = Describe("Scheduler_Control", func() {
 		})
 	})
 
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using 'Capacity Aware' seed determination strategy", func() {
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			project = projectBase.DeepCopy()
+			seed = seedBase.DeepCopy()
+			shoot = shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			schedulerConfiguration.Schedulers.Shoot.Strategy = schedulerconfigv1alpha1.CapacityAware
+		})
+
+		It("should find a seed cluster from other region with minimal distance", func() {
+			seed.Spec.Provider.Region = "europe-north1"
+
+			secondSeed := seedBase
+			secondSeed.Name = "seed-2"
+			secondSeed.Spec.Provider.Region = "europe-west1"
+
+			shoot.Spec.Region = "europe-west3"
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should pick the candidate with the lowest weighted usage instead of the least shoots deployed", func() {
+			seed.Status.Allocatable = corev1.ResourceList{
+				gardencorev1beta1.ResourceShoots: resource.MustParse("10"),
+			}
+
+			secondSeed := seedBase
+			secondSeed.Name = "seed-2"
+			secondSeed.Status.Allocatable = corev1.ResourceList{
+				gardencorev1beta1.ResourceShoots: resource.MustParse("10"),
+			}
+
+			// seed-1 hosts one highly available production shoot, seed-2 hosts two small development shoots
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &seed.Name
+			secondShoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeProduction)
+			secondShoot.Spec.ControlPlane = &gardencorev1beta1.ControlPlane{
+				HighAvailability: &gardencorev1beta1.HighAvailability{
+					FailureTolerance: gardencorev1beta1.FailureTolerance{Type: gardencorev1beta1.FailureToleranceTypeZone},
+				},
+			}
+
+			thirdShoot := shootBase
+			thirdShoot.Name = "shoot-3"
+			thirdShoot.Spec.SeedName = &secondSeed.Name
+			thirdShoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeDevelopment)
+
+			fourthShoot := shootBase
+			fourthShoot.Name = "shoot-4"
+			fourthShoot.Spec.SeedName = &secondSeed.Name
+			fourthShoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeDevelopment)
+
+			schedulerConfiguration.Schedulers.Shoot.CapacityAware = &schedulerconfigv1alpha1.CapacityAwareConfiguration{
+				Scorers: []schedulerconfigv1alpha1.SeedScorer{{Name: schedulerconfigv1alpha1.SeedScorerLeastAllocated}},
+				ShootCost: &schedulerconfigv1alpha1.ShootCostConfiguration{
+					Purposes:             map[string]int32{"production": 200, "development": 50},
+					HighAvailabilityZone: ptr.To[int32](100),
+				},
+			}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &thirdShoot)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &fourthShoot)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should consider additional seed scorers", func() {
+			secondSeed := seedBase
+			secondSeed.Name = "seed-2"
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
+
+			reconciler.SeedScorers = []WeightedSeedScorer{{SeedScorer: seedNameScorer(secondSeed.Name), Weight: 10}}
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+	})
+
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using seed filters", func() {
+		var secondSeed *gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			project = projectBase.DeepCopy()
+			seed = seedBase.DeepCopy()
+			shoot = shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+
+			secondSeed = seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+			secondSeed.Labels = map[string]string{"tier": "gold"}
+
+			schedulerConfiguration.Schedulers.Shoot.Filters = []schedulerconfigv1alpha1.SeedFilterRule{{
+				Name:       "gold-tier",
+				Expression: `project.metadata.name != "project-1" || shoot.spec.?purpose.orValue("") == "evaluation" || seed.metadata.?labels.tier.orValue("") == "gold"`,
+				Message:    ptr.To("shoots of project-1 require gold tier seeds"),
+			}}
+		})
+
+		JustBeforeEach(func() {
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+		})
+
+		It("should only consider seeds matching the configured filter rules", func() {
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should consider all seeds if the filter rules allow it", func() {
+			shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeEvaluation)
+			secondShoot := shootBase.DeepCopy()
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &secondSeed.Name
+			Expect(fakeGardenClient.Create(ctx, secondShoot)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should fail if no seed matches the configured filter rules", func() {
+			secondSeed.Labels = nil
+			Expect(fakeGardenClient.Update(ctx, secondSeed)).To(Succeed())
+
+			_, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(`none of the 2 seeds is eligible according to filter "gold-tier": {seed-1 => shoots of project-1 require gold tier seeds, seed-2 => shoots of project-1 require gold tier seeds}`))
+		})
+
+		It("should consider the usage of the seeds", func() {
+			schedulerConfiguration.Schedulers.Shoot.Filters = []schedulerconfigv1alpha1.SeedFilterRule{{
+				Name:       "max-production-shoots",
+				Expression: `usage.shootsByPurpose.?production.orValue(0) < 1`,
+			}}
+			shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeProduction)
+
+			secondShoot := shootBase.DeepCopy()
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &secondSeed.Name
+			secondShoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeProduction)
+			Expect(fakeGardenClient.Create(ctx, secondShoot)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should fail if the configured filter rules are invalid", func() {
+			schedulerConfiguration.Schedulers.Shoot.Filters[0].Expression = "foo +"
+
+			_, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring(`invalid seed filter rule "gold-tier"`)))
+		})
+
+		It("should consider additional seed filters", func() {
+			schedulerConfiguration.Schedulers.Shoot.Filters = nil
+			reconciler.SeedFilters = []SeedFilter{seedNameFilter(seed.Name)}
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+	})
+
 	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using default seed determination strategy", func() {
 		BeforeEach(func() {
 			cloudProfile = cloudProfileBase.DeepCopy()
@@ -656,6 +848,18 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed.Name).To(Equal(seedName))
 		})
 
+		It("should not consider draining seeds", func() {
+			seed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: gardencorev1beta1.SeedTaintDraining}}
+			shoot.Spec.Tolerations = []gardencorev1beta1.Toleration{{Key: gardencorev1beta1.SeedTaintDraining}}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			_, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError("none of the 1 seeds is valid for scheduling (not deleting, not draining, visible and ready)"))
+		})
+
 		It("should find a seed cluster 1) referencing the same profile 2) same region 3) indicating availability 4) using shoot default networks", func() {
 			seed.Spec.Networks.ShootDefaults = &gardencorev1beta1.ShootNetworks{
 				Pods:     ptr.To("10.50.0.0/16"),
@@ -1315,3 +1519,26 @@ var _ = Describe("filterSeedsForZoneSelection", func() {
 		),
 	)
 })
+
+type seedNameScorer string
+
+func (s seedNameScorer) Score(_ *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, _ SeedUsage) float64 {
+	if seed.Name == string(s) {
+		return 1
+	}
+	return 0
+}
+
+// seedNameFilter only accepts the seed with the given name.
+type seedNameFilter string
+
+func (f seedNameFilter) Name() string {
+	return "SeedName"
+}
+
+func (f seedNameFilter) Filter(_ context.Context, _ *SeedFilterInput, seed *gardencorev1beta1.Seed, _ SeedUsage) error {
+	if seed.Name != string(f) {
+		return fmt.Errorf("seed is not %s", string(f))
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// defaultPurposeCost is the cost of a shoot whose purpose is not configured in the shoot cost configuration. It is
+// equivalent to one unit of the allocatable capacity for shoots of a seed.
+const defaultPurposeCost = 100
+
+// SeedScorer computes scores for seed candidates of the CapacityAware strategy.
+type SeedScorer interface {
+	// Score returns the score of the given seed for hosting the given shoot. Scores must be in the range [0, 1], higher
+	// scores are preferred.
+	Score(shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, usage SeedUsage) float64
+}
+
+// WeightedSeedScorer is a SeedScorer whose scores are multiplied with the given weight.
+type WeightedSeedScorer struct {
+	SeedScorer
+	// Weight is the weight of the scores computed by the SeedScorer.
+	Weight int32
+}
+
+// SeedUsage describes the shoots currently hosted by a seed.
+type SeedUsage struct {
+	// Shoots is the number of shoots hosted by the seed.
+	Shoots int
+	// ShootsByPurpose is the number of shoots hosted by the seed per purpose.
+	ShootsByPurpose map[string]int
+	// Cost is the sum of the costs of the shoots hosted by the seed.
+	Cost int64
+}
+
+// ShootCostFunc computes the cost of hosting the control plane of the given shoot.
+type ShootCostFunc func(shoot *gardencorev1beta1.Shoot) int64
+
+// NewShootCostFunc returns a ShootCostFunc for the given shoot cost configuration.
+func NewShootCostFunc(config *schedulerconfigv1alpha1.ShootCostConfiguration) ShootCostFunc {
+	if config == nil {
+		config = &schedulerconfigv1alpha1.ShootCostConfiguration{}
+	}
+
+	return func(shoot *gardencorev1beta1.Shoot) int64 {
+		cost := int64(defaultPurposeCost)
+		if purposeCost, ok := config.Purposes[string(ptr.Deref(shoot.Spec.Purpose, ""))]; ok {
+			cost = int64(purposeCost)
+		}
+
+		if shoot.Spec.ControlPlane != nil && shoot.Spec.ControlPlane.HighAvailability != nil {
+			switch shoot.Spec.ControlPlane.HighAvailability.FailureTolerance.Type {
+			case gardencorev1beta1.FailureToleranceTypeNode:
+				cost += int64(ptr.Deref(config.HighAvailabilityNode, 0))
+			case gardencorev1beta1.FailureToleranceTypeZone:
+				cost += int64(ptr.Deref(config.HighAvailabilityZone, 0))
+			}
+		}
+
+		return cost + int64(len(shoot.Spec.Provider.Workers))*int64(ptr.Deref(config.WorkerPool, 0))
+	}
+}
+
+// NewSeedScorers returns the weighted seed scorers for the given configuration of the CapacityAware strategy.
+func NewSeedScorers(config *schedulerconfigv1alpha1.CapacityAwareConfiguration) ([]WeightedSeedScorer, error) {
+	var (
+		shootCost = NewShootCostFunc(config.ShootCost)
+		scorers   []WeightedSeedScorer
+	)
+
+	for _, scorerConfig := range config.Scorers {
+		var scorer SeedScorer
+
+		switch scorerConfig.Name {
+		case schedulerconfigv1alpha1.SeedScorerLeastAllocated:
+			scorer = &leastAllocatedScorer{shootCost: shootCost}
+		case schedulerconfigv1alpha1.SeedScorerLeastShoots:
+			scorer = &leastShootsScorer{}
+		default:
+			return nil, fmt.Errorf("unknown seed scorer %q, valid seed scorers are: %v", scorerConfig.Name, schedulerconfigv1alpha1.SeedScorers)
+		}
+
+		scorers = append(scorers, WeightedSeedScorer{SeedScorer: scorer, Weight: ptr.Deref(scorerConfig.Weight, 1)})
+	}
+
+	return scorers, nil
+}
+
+// calculateSeedUsage computes the usage of all seeds by the given shoots. Like v1beta1helper.CalculateSeedUsage, shoots
+// which are currently being migrated are accounted to both the source and the destination seed.
+func calculateSeedUsage(shootList []*gardencorev1beta1.Shoot, shootCost ShootCostFunc) map[string]SeedUsage {
+	usage := make(map[string]SeedUsage)
+
+	add := func(seedName, purpose string, cost int64) {
+		u := usage[seedName]
+		u.Shoots++
+		u.Cost += cost
+		if purpose != "" {
+			if u.ShootsByPurpose == nil {
+				u.ShootsByPurpose = make(map[string]int)
+			}
+			u.ShootsByPurpose[purpose]++
+		}
+		usage[seedName] = u
+	}
+
+	for _, shoot := range shootList {
+		var (
+			specSeed   = ptr.Deref(shoot.Spec.SeedName, "")
+			statusSeed = ptr.Deref(shoot.Status.SeedName, "")
+			purpose    = string(ptr.Deref(shoot.Spec.Purpose, ""))
+			cost       = shootCost(shoot)
+		)
+
+		if specSeed != "" {
+			add(specSeed, purpose, cost)
+		}
+		if statusSeed != "" && specSeed != statusSeed {
+			add(statusSeed, purpose, cost)
+		}
+	}
+
+	return usage
+}
+
+// leastAllocatedScorer prefers seeds with the lowest cost of hosted shoots relative to their capacity for shoots. For
+// seeds which do not report a capacity for shoots, the score decreases with the absolute cost of the hosted shoots.
+type leastAllocatedScorer struct {
+	shootCost ShootCostFunc
+}
+
+func (s *leastAllocatedScorer) Score(shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, usage SeedUsage) float64 {
+	cost := float64(usage.Cost + s.shootCost(shoot))
+
+	if capacity := seedCapacityForShoots(seed); capacity > 0 {
+		return 1 - min(1, cost/float64(capacity*defaultPurposeCost))
+	}
+
+	return 1 / (1 + cost/defaultPurposeCost)
+}
+
+func seedCapacityForShoots(seed *gardencorev1beta1.Seed) int64 {
+	if allocatable, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok {
+		return allocatable.Value()
+	}
+	if capacity, ok := seed.Status.Capacity[gardencorev1beta1.ResourceShoots]; ok {
+		return capacity.Value()
+	}
+	return 0
+}
+
+// leastShootsScorer prefers seeds with the least number of hosted shoots, similar to the default seed selection of the
+// other strategies.
+type leastShootsScorer struct{}
+
+func (s *leastShootsScorer) Score(_ *gardencorev1beta1.Shoot, _ *gardencorev1beta1.Seed, usage SeedUsage) float64 {
+	return 1 / float64(1+usage.Shoots)
+}
+
+// getSeedWithBestScore finds the best candidate according to the given seed scorers, i.e. the one with the highest sum
+// of weighted scores. Ties are broken by choosing the seed with the least number of hosted shoots.
+func getSeedWithBestScore(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, usage map[string]SeedUsage, scorers []WeightedSeedScorer) (*gardencorev1beta1.Seed, error) {
+	var (
+		bestCandidate *gardencorev1beta1.Seed
+		bestScore     float64
+	)
+
+	for i, seed := range seedList {
+		score := seedScore(shoot, &seed, usage[seed.Name], scorers)
+
+		if bestCandidate == nil || score > bestScore || (score == bestScore && usage[seed.Name].Shoots < usage[bestCandidate.Name].Shoots) {
+			bestCandidate = &seedList[i]
+			bestScore = score
+		}
+	}
+
+	if bestCandidate == nil {
+		return nil, fmt.Errorf("none of the %d seed candidates could be scored", len(seedList))
+	}
+	return bestCandidate, nil
+}
+
+// seedScore returns the sum of the weighted scores of all given seed scorers.
+func seedScore(shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, usage SeedUsage, scorers []WeightedSeedScorer) float64 {
+	var score float64
+	for _, scorer := range scorers {
+		score += float64(scorer.Weight) * scorer.Score(shoot, seed, usage)
+	}
+	return score
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("Scoring", func() {
+	var (
+		costConfig *schedulerconfigv1alpha1.ShootCostConfiguration
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		costConfig = &schedulerconfigv1alpha1.ShootCostConfiguration{
+			Purposes:             map[string]int32{"production": 200, "evaluation": 50},
+			HighAvailabilityNode: ptr.To[int32](50),
+			HighAvailabilityZone: ptr.To[int32](100),
+			WorkerPool:           ptr.To[int32](5),
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{Name: "a"}, {Name: "b"}},
+				},
+			},
+		}
+	})
+
+	Describe("#NewShootCostFunc", func() {
+		It("should use the default cost for shoots without configured purpose", func() {
+			Expect(NewShootCostFunc(costConfig)(shoot)).To(Equal(int64(110)))
+		})
+
+		It("should use the configured cost for the shoot purpose", func() {
+			shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeProduction)
+
+			Expect(NewShootCostFunc(costConfig)(shoot)).To(Equal(int64(210)))
+		})
+
+		DescribeTable("should add the cost for highly available control planes",
+			func(failureToleranceType gardencorev1beta1.FailureToleranceType, expectedCost int64) {
+				shoot.Spec.ControlPlane = &gardencorev1beta1.ControlPlane{
+					HighAvailability: &gardencorev1beta1.HighAvailability{
+						FailureTolerance: gardencorev1beta1.FailureTolerance{Type: failureToleranceType},
+					},
+				}
+
+				Expect(NewShootCostFunc(costConfig)(shoot)).To(Equal(expectedCost))
+			},
+
+			Entry("failure tolerance type node", gardencorev1beta1.FailureToleranceTypeNode, int64(160)),
+			Entry("failure tolerance type zone", gardencorev1beta1.FailureToleranceTypeZone, int64(210)),
+		)
+
+		It("should only use the default purpose cost if no configuration is given", func() {
+			Expect(NewShootCostFunc(nil)(shoot)).To(Equal(int64(100)))
+		})
+	})
+
+	Describe("#NewSeedScorers", func() {
+		It("should return the configured scorers with their weights", func() {
+			scorers, err := NewSeedScorers(&schedulerconfigv1alpha1.CapacityAwareConfiguration{
+				Scorers: []schedulerconfigv1alpha1.SeedScorer{
+					{Name: schedulerconfigv1alpha1.SeedScorerLeastAllocated, Weight: ptr.To[int32](3)},
+					{Name: schedulerconfigv1alpha1.SeedScorerLeastShoots},
+				},
+				ShootCost: costConfig,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scorers).To(HaveLen(2))
+			Expect(scorers[0].SeedScorer).To(BeAssignableToTypeOf(&leastAllocatedScorer{}))
+			Expect(scorers[0].Weight).To(Equal(int32(3)))
+			Expect(scorers[1].SeedScorer).To(BeAssignableToTypeOf(&leastShootsScorer{}))
+			Expect(scorers[1].Weight).To(Equal(int32(1)))
+		})
+
+		It("should fail for unknown scorers", func() {
+			_, err := NewSeedScorers(&schedulerconfigv1alpha1.CapacityAwareConfiguration{
+				Scorers: []schedulerconfigv1alpha1.SeedScorer{{Name: "foo"}},
+			})
+			Expect(err).To(MatchError(ContainSubstring(`unknown seed scorer "foo"`)))
+		})
+	})
+
+	Describe("#calculateSeedUsage", func() {
+		It("should account shoots to the seeds in spec and status", func() {
+			shoot1 := shoot.DeepCopy()
+			shoot1.Spec.SeedName = ptr.To("seed-1")
+
+			shoot2 := shoot.DeepCopy()
+			shoot2.Spec.SeedName = ptr.To("seed-2")
+			shoot2.Status.SeedName = ptr.To("seed-1")
+			shoot2.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeProduction)
+
+			shoot3 := shoot.DeepCopy()
+
+			Expect(calculateSeedUsage([]*gardencorev1beta1.Shoot{shoot1, shoot2, shoot3}, NewShootCostFunc(costConfig))).To(Equal(map[string]SeedUsage{
+				"seed-1": {Shoots: 2, ShootsByPurpose: map[string]int{"production": 1}, Cost: 320},
+				"seed-2": {Shoots: 1, ShootsByPurpose: map[string]int{"production": 1}, Cost: 210},
+			}))
+		})
+	})
+
+	Describe("#leastAllocatedScorer", func() {
+		var (
+			scorer *leastAllocatedScorer
+			seed   *gardencorev1beta1.Seed
+		)
+
+		BeforeEach(func() {
+			scorer = &leastAllocatedScorer{shootCost: NewShootCostFunc(costConfig)}
+			seed = &gardencorev1beta1.Seed{}
+		})
+
+		It("should score relative to the allocatable capacity for shoots", func() {
+			seed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("10")}
+
+			Expect(scorer.Score(shoot, seed, SeedUsage{Cost: 390})).To(BeNumerically("~", 0.5))
+		})
+
+		It("should fall back to the capacity for shoots", func() {
+			seed.Status.Capacity = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("5")}
+
+			Expect(scorer.Score(shoot, seed, SeedUsage{Cost: 390})).To(BeNumerically("~", 0))
+		})
+
+		It("should not return negative scores for overcommitted seeds", func() {
+			seed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("1")}
+
+			Expect(scorer.Score(shoot, seed, SeedUsage{Cost: 1000})).To(BeZero())
+		})
+
+		It("should score by absolute cost if the seed does not report a capacity for shoots", func() {
+			Expect(scorer.Score(shoot, seed, SeedUsage{Cost: 190})).To(BeNumerically("~", 0.25))
+		})
+	})
+
+	Describe("#getSeedWithBestScore", func() {
+		var seeds []gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			seeds = []gardencorev1beta1.Seed{
+				{ObjectMeta: metav1.ObjectMeta{Name: "seed-1"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "seed-2"}},
+			}
+		})
+
+		It("should combine the weighted scores of all scorers", func() {
+			usage := map[string]SeedUsage{
+				"seed-1": {Shoots: 1, Cost: 400},
+				"seed-2": {Shoots: 3, Cost: 300},
+			}
+
+			bestSeed, err := getSeedWithBestScore(seeds, shoot, usage, []WeightedSeedScorer{{SeedScorer: &leastAllocatedScorer{shootCost: NewShootCostFunc(costConfig)}, Weight: 1}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal("seed-2"))
+
+			bestSeed, err = getSeedWithBestScore(seeds, shoot, usage, []WeightedSeedScorer{
+				{SeedScorer: &leastAllocatedScorer{shootCost: NewShootCostFunc(costConfig)}, Weight: 1},
+				{SeedScorer: &leastShootsScorer{}, Weight: 5},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal("seed-1"))
+		})
+
+		It("should prefer the seed with less shoots in case of equal scores", func() {
+			usage := map[string]SeedUsage{
+				"seed-1": {Shoots: 2, Cost: 200},
+				"seed-2": {Shoots: 1, Cost: 200},
+			}
+
+			bestSeed, err := getSeedWithBestScore(seeds, shoot, usage, []WeightedSeedScorer{{SeedScorer: &leastAllocatedScorer{shootCost: NewShootCostFunc(costConfig)}, Weight: 1}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal("seed-2"))
+		})
+
+		It("should fail if there are no candidates", func() {
+			_, err := getSeedWithBestScore(nil, shoot, nil, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
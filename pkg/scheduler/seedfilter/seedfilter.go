@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seedfilter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	celutils "github.com/gardener/gardener/pkg/utils/cel"
+)
+
+const (
+	// VariableShoot is the name of the variable containing the Shoot to be scheduled.
+	VariableShoot = "shoot"
+	// VariableSeed is the name of the variable containing the Seed which is checked.
+	VariableSeed = "seed"
+	// VariableProject is the name of the variable containing the Project of the Shoot.
+	VariableProject = "project"
+	// VariableUsage is the name of the variable containing the usage of the Seed.
+	VariableUsage = "usage"
+)
+
+// Usage describes the shoots currently hosted by a seed.
+type Usage struct {
+	// Shoots is the number of shoots hosted by the seed.
+	Shoots int
+	// ShootsByPurpose is the number of shoots hosted by the seed per purpose.
+	ShootsByPurpose map[string]int
+	// Cost is the sum of the costs of the shoots hosted by the seed.
+	Cost int64
+}
+
+// Program is a compiled seed filter expression.
+type Program struct {
+	expression string
+	program    cel.Program
+}
+
+// Compile compiles the given CEL expression. It returns an error if the expression is invalid or does not evaluate to
+// a boolean.
+func Compile(expression string) (*Program, error) {
+	program, err := celutils.Compile(expression, cel.BoolType, VariableShoot, VariableSeed, VariableProject, VariableUsage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Program{expression: expression, program: program}, nil
+}
+
+// Input contains the objects the expression is evaluated against. Shoot and project are converted once and can be
+// reused for evaluating expressions against multiple seeds. Seeds are converted on first use and reused for evaluating
+// further expressions against them. An Input must not be used concurrently.
+type Input struct {
+	shoot   map[string]any
+	project map[string]any
+	seeds   map[string]map[string]any
+}
+
+// NewInput converts the given Shoot and Project for evaluating expressions against them.
+func NewInput(shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project) (*Input, error) {
+	shootObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(shoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed converting shoot: %w", err)
+	}
+
+	projectObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(project)
+	if err != nil {
+		return nil, fmt.Errorf("failed converting project: %w", err)
+	}
+
+	return &Input{shoot: shootObj, project: projectObj, seeds: make(map[string]map[string]any)}, nil
+}
+
+func (i *Input) seed(seed *gardencorev1beta1.Seed) (map[string]any, error) {
+	if seedObj, ok := i.seeds[seed.Name]; ok {
+		return seedObj, nil
+	}
+
+	seedObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(seed)
+	if err != nil {
+		return nil, fmt.Errorf("failed converting seed: %w", err)
+	}
+	i.seeds[seed.Name] = seedObj
+	return seedObj, nil
+}
+
+// Eval evaluates the expression for the given seed and its usage. It returns true if the seed is eligible.
+func (p *Program) Eval(ctx context.Context, input *Input, seed *gardencorev1beta1.Seed, usage Usage) (bool, error) {
+	seedObj, err := input.seed(seed)
+	if err != nil {
+		return false, err
+	}
+
+	shootsByPurpose := make(map[string]any, len(usage.ShootsByPurpose))
+	for purpose, shoots := range usage.ShootsByPurpose {
+		shootsByPurpose[purpose] = int64(shoots)
+	}
+
+	out, err := celutils.Eval(ctx, p.program, map[string]any{
+		VariableShoot:   input.shoot,
+		VariableSeed:    seedObj,
+		VariableProject: input.project,
+		VariableUsage: map[string]any{
+			"shoots":          int64(usage.Shoots),
+			"shootsByPurpose": shootsByPurpose,
+			"cost":            usage.Cost,
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed evaluating expression %q: %w", p.expression, err)
+	}
+
+	eligible, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression %q evaluated to %v instead of bool", p.expression, out.Value())
+	}
+	return eligible, nil
+}
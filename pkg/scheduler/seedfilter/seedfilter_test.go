@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seedfilter_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/scheduler/seedfilter"
+)
+
+var _ = Describe("SeedFilter", func() {
+	var (
+		ctx     = context.Background()
+		shoot   *gardencorev1beta1.Shoot
+		project *gardencorev1beta1.Project
+		seed    *gardencorev1beta1.Seed
+		usage   Usage
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+			Spec:       gardencorev1beta1.ShootSpec{Purpose: ptr.To(gardencorev1beta1.ShootPurposeProduction)},
+		}
+		project = &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{"cost-center": "1234"}}}
+		seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed", Labels: map[string]string{"tier": "gold"}}}
+		usage = Usage{Shoots: 3, ShootsByPurpose: map[string]int{"production": 2, "evaluation": 1}, Cost: 500}
+	})
+
+	Describe("#Compile", func() {
+		It("should fail for invalid expressions", func() {
+			_, err := Compile("seed.metadata.name ==")
+			Expect(err).To(MatchError(ContainSubstring("failed compiling expression")))
+		})
+
+		It("should fail for expressions which do not evaluate to bool", func() {
+			_, err := Compile("usage.shoots + 1")
+			Expect(err).To(MatchError(ContainSubstring("expression must evaluate to bool")))
+		})
+
+		It("should fail for unknown variables", func() {
+			_, err := Compile("cluster.name == 'foo'")
+			Expect(err).To(MatchError(ContainSubstring("undeclared reference to 'cluster'")))
+		})
+	})
+
+	Describe("#Eval", func() {
+		eval := func(expression string) (bool, error) {
+			program, err := Compile(expression)
+			Expect(err).NotTo(HaveOccurred())
+
+			input, err := NewInput(shoot, project)
+			Expect(err).NotTo(HaveOccurred())
+
+			return program.Eval(ctx, input, seed, usage)
+		}
+
+		DescribeTable("should evaluate the expression against shoot, seed, project and usage",
+			func(expression string, expected bool) {
+				Expect(eval(expression)).To(Equal(expected))
+			},
+
+			Entry("shoot", `shoot.spec.purpose == "production"`, true),
+			Entry("seed", `seed.metadata.labels.tier == "gold"`, true),
+			Entry("project", `project.metadata.labels["cost-center"] == "5678"`, false),
+			Entry("usage", `usage.shoots == 3 && usage.cost == 500`, true),
+			Entry("usage by purpose", `usage.shootsByPurpose.production < 2`, false),
+			Entry("usage by missing purpose", `usage.shootsByPurpose.?development.orValue(0) == 0`, true),
+			Entry("Kubernetes libraries", `quantity("10Gi").isGreaterThan(quantity("1Gi"))`, true),
+		)
+
+		It("should fail if the expression cannot be evaluated", func() {
+			_, err := eval(`seed.metadata.labels.region == "eu"`)
+			Expect(err).To(MatchError(ContainSubstring(`failed evaluating expression "seed.metadata.labels.region == \"eu\"": no such key: region`)))
+		})
+	})
+})
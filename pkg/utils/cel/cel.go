@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types/ref"
+	"k8s.io/apimachinery/pkg/util/version"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"k8s.io/apiserver/pkg/cel/environment"
+)
+
+// PerCallCostLimit is the maximum cost of evaluating an expression once. It is the same limit as the one of the
+// kube-apiserver for CEL expressions.
+const PerCallCostLimit = celconfig.PerCallLimit
+
+// EvalTimeout is the maximum duration of evaluating an expression once.
+// Exposed for testing.
+var EvalTimeout = time.Second
+
+// Compile compiles the given CEL expression in an environment with the given variables, all of which are of dynamic
+// type. It returns an error if the expression is invalid or does not evaluate to the given type. The cost of evaluating
+// the returned program is limited to PerCallCostLimit.
+func Compile(expression string, outputType *cel.Type, variables ...string) (cel.Program, error) {
+	envOptions := make([]cel.EnvOption, 0, len(variables))
+	for _, variable := range variables {
+		envOptions = append(envOptions, cel.Variable(variable, cel.DynType))
+	}
+
+	envSet, err := environment.MustBaseEnvSet(environment.DefaultCompatibilityVersion()).Extend(environment.VersionedOptions{
+		IntroducedVersion: version.MajorMinor(1, 0),
+		EnvOptions:        envOptions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating CEL environment: %w", err)
+	}
+
+	env, err := envSet.Env(environment.NewExpressions)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating CEL environment: %w", err)
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed compiling expression: %w", issues.Err())
+	}
+	if actualType := ast.OutputType(); !actualType.IsExactType(outputType) && actualType != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to %s but evaluates to %s", outputType, actualType)
+	}
+
+	program, err := env.Program(ast,
+		cel.CostLimit(PerCallCostLimit),
+		cel.InterruptCheckFrequency(celconfig.CheckFrequency),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating program for expression: %w", err)
+	}
+
+	return program, nil
+}
+
+// Eval evaluates the given program with the given variables. The evaluation is interrupted if it takes longer than
+// EvalTimeout or if the given context is cancelled.
+func Eval(ctx context.Context, program cel.Program, variables map[string]any) (ref.Val, error) {
+	ctx, cancel := context.WithTimeout(ctx, EvalTimeout)
+	defer cancel()
+
+	out, _, err := program.ContextEval(ctx, variables)
+	return out, err
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cel_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/cel"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("CEL", func() {
+	var ctx = context.Background()
+
+	Describe("#Compile", func() {
+		It("should compile expressions using the given variables", func() {
+			program, err := Compile(`foo.bar == "baz"`, cel.BoolType, "foo")
+			Expect(err).NotTo(HaveOccurred())
+
+			out, err := Eval(ctx, program, map[string]any{"foo": map[string]any{"bar": "baz"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Value()).To(BeTrue())
+		})
+
+		It("should fail for invalid expressions", func() {
+			_, err := Compile(`foo ==`, cel.BoolType, "foo")
+			Expect(err).To(MatchError(ContainSubstring("failed compiling expression")))
+		})
+
+		It("should fail for undeclared variables", func() {
+			_, err := Compile(`bar == 1`, cel.BoolType, "foo")
+			Expect(err).To(MatchError(ContainSubstring("undeclared reference to 'bar'")))
+		})
+
+		It("should fail for expressions of another type", func() {
+			_, err := Compile(`"foo"`, cel.BoolType)
+			Expect(err).To(MatchError("expression must evaluate to bool but evaluates to string"))
+		})
+	})
+
+	Describe("#Eval", func() {
+		const expensiveExpression = `foo.all(x, foo.all(y, foo.all(z, x + y + z >= 0)))`
+
+		var foo []any
+
+		BeforeEach(func() {
+			foo = make([]any, 200)
+			for i := range foo {
+				foo[i] = int64(i)
+			}
+		})
+
+		It("should abort evaluations exceeding the cost limit", func() {
+			program, err := Compile(expensiveExpression, cel.BoolType, "foo")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = Eval(ctx, program, map[string]any{"foo": foo})
+			Expect(err).To(MatchError(ContainSubstring("actual cost limit exceeded")))
+		})
+
+		It("should abort evaluations exceeding the timeout", func() {
+			DeferCleanup(test.WithVar(&EvalTimeout, time.Nanosecond))
+
+			program, err := Compile(`foo.all(x, x >= 0)`, cel.BoolType, "foo")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = Eval(ctx, program, map[string]any{"foo": foo})
+			Expect(err).To(MatchError(ContainSubstring("operation interrupted")))
+		})
+	})
+})
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"context"
+)
+
+// Checkpoint is the persisted progress of a flow execution.
+type Checkpoint struct {
+	// Fingerprint identifies the inputs of the flow execution which created the checkpoint. The succeeded tasks of a
+	// checkpoint are only skipped if its fingerprint matches the fingerprint of the current execution.
+	Fingerprint string `json:"fingerprint"`
+	// Succeeded are the IDs of the resumable tasks which succeeded.
+	Succeeded []TaskID `json:"succeeded,omitempty"`
+}
+
+// CheckpointStore persists the Checkpoint of a flow.
+type CheckpointStore interface {
+	// Load returns the stored Checkpoint. It returns nil if no Checkpoint is stored.
+	Load(ctx context.Context) (*Checkpoint, error)
+	// Save stores the given Checkpoint.
+	Save(ctx context.Context, checkpoint *Checkpoint) error
+	// Delete deletes the stored Checkpoint.
+	Delete(ctx context.Context) error
+}
+
+// CheckpointOptions are options for resuming a flow execution.
+type CheckpointOptions struct {
+	// Store is used to persist the progress of the flow execution.
+	Store CheckpointStore
+	// Fingerprint identifies the inputs of the flow execution, e.g. a hash of the generation of the reconciled object.
+	Fingerprint string
+}
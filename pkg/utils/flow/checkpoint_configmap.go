@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// checkpointDataKey is the key of the ConfigMap data containing the Checkpoint.
+const checkpointDataKey = "checkpoint"
+
+type configMapCheckpointStore struct {
+	client    client.Client
+	namespace string
+	name      string
+}
+
+// NewConfigMapCheckpointStore returns a CheckpointStore which stores the Checkpoint as JSON in the ConfigMap with the
+// given namespace and name.
+func NewConfigMapCheckpointStore(c client.Client, namespace, name string) CheckpointStore {
+	return &configMapCheckpointStore{client: c, namespace: namespace, name: name}
+}
+
+func (s *configMapCheckpointStore) Load(ctx context.Context) (*Checkpoint, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := s.client.Get(ctx, client.ObjectKey{Namespace: s.namespace, Name: s.name}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := configMap.Data[checkpointDataKey]
+	if !ok {
+		return nil, nil
+	}
+
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal([]byte(data), checkpoint); err != nil {
+		return nil, fmt.Errorf("failed decoding checkpoint: %w", err)
+	}
+	return checkpoint, nil
+}
+
+func (s *configMapCheckpointStore) Save(ctx context.Context, checkpoint *Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: s.namespace, Name: s.name}}
+	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, s.client, configMap, func() error {
+		configMap.Data = map[string]string{checkpointDataKey: string(data)}
+		return nil
+	})
+	return err
+}
+
+func (s *configMapCheckpointStore) Delete(ctx context.Context) error {
+	return client.IgnoreNotFound(s.client.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: s.namespace, Name: s.name}}))
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+type memoryCheckpointStore struct {
+	checkpoint *flow.Checkpoint
+}
+
+func (m *memoryCheckpointStore) Load(_ context.Context) (*flow.Checkpoint, error) {
+	return m.checkpoint, nil
+}
+
+func (m *memoryCheckpointStore) Save(_ context.Context, checkpoint *flow.Checkpoint) error {
+	m.checkpoint = checkpoint
+	return nil
+}
+
+func (m *memoryCheckpointStore) Delete(_ context.Context) error {
+	m.checkpoint = nil
+	return nil
+}
+
+var _ = Describe("Checkpoint", func() {
+	var (
+		ctx   = context.Background()
+		store *memoryCheckpointStore
+		list  *AtomicStringList
+		fail  bool
+		f     *flow.Flow
+	)
+
+	BeforeEach(func() {
+		store = &memoryCheckpointStore{}
+		list = NewAtomicStringList()
+		fail = true
+
+		mkListAppender := func(value string) flow.TaskFn {
+			return func(_ context.Context) error {
+				list.Append(value)
+				return nil
+			}
+		}
+
+		var (
+			g          = flow.NewGraph("foo")
+			initialize = g.Add(flow.Task{Name: "init", Fn: mkListAppender("init")})
+			deploy     = g.Add(flow.Task{Name: "deploy", Fn: mkListAppender("deploy"), Dependencies: flow.NewTaskIDs(initialize), Resumable: true})
+			wait       = g.Add(flow.Task{Name: "wait", Fn: mkListAppender("wait"), Dependencies: flow.NewTaskIDs(deploy), Resumable: true})
+			_          = g.Add(flow.Task{Name: "last", Dependencies: flow.NewTaskIDs(wait), Resumable: true, Fn: func(_ context.Context) error {
+				list.Append("last")
+				if fail {
+					return errors.New("fail")
+				}
+				return nil
+			}})
+			check = g.Add(flow.Task{Name: "check", Fn: mkListAppender("check"), Resumable: true})
+			_     = g.Add(flow.Task{Name: "verify", Fn: mkListAppender("verify"), Dependencies: flow.NewTaskIDs(check), Resumable: true})
+		)
+		f = g.Compile()
+	})
+
+	It("should persist the succeeded resumable tasks if the flow fails", func() {
+		Expect(f.Run(ctx, flow.Opts{Checkpoint: &flow.CheckpointOptions{Store: store, Fingerprint: "1"}})).To(HaveOccurred())
+
+		Expect(list.Values()).To(ConsistOf("init", "deploy", "wait", "last", "check", "verify"))
+		Expect(store.checkpoint).To(Equal(&flow.Checkpoint{Fingerprint: "1", Succeeded: []flow.TaskID{"check", "deploy", "verify", "wait"}}))
+	})
+
+	It("should skip resumable tasks which succeeded in the previous execution when the flow is restarted", func() {
+		Expect(f.Run(ctx, flow.Opts{Checkpoint: &flow.CheckpointOptions{Store: store, Fingerprint: "1"}})).To(HaveOccurred())
+		list = NewAtomicStringList()
+
+		Expect(f.Run(ctx, flow.Opts{Checkpoint: &flow.CheckpointOptions{Store: store, Fingerprint: "1"}})).To(HaveOccurred())
+
+		Expect(list.Values()).To(Equal([]string{"init", "last"}))
+		Expect(store.checkpoint).To(Equal(&flow.Checkpoint{Fingerprint: "1", Succeeded: []flow.TaskID{"check", "deploy", "verify", "wait"}}))
+	})
+
+	It("should not skip resumable tasks whose resumable dependencies ran in this execution", func() {
+		store.checkpoint = &flow.Checkpoint{Fingerprint: "1", Succeeded: []flow.TaskID{"verify", "wait"}}
+
+		Expect(f.Run(ctx, flow.Opts{Checkpoint: &flow.CheckpointOptions{Store: store, Fingerprint: "1"}})).To(HaveOccurred())
+
+		Expect(list.Values()).To(ConsistOf("init", "deploy", "wait", "last", "check", "verify"))
+		Expect(store.checkpoint).To(Equal(&flow.Checkpoint{Fingerprint: "1", Succeeded: []flow.TaskID{"check", "deploy", "verify", "wait"}}))
+	})
+
+	It("should run all tasks if the fingerprint changed", func() {
+		store.checkpoint = &flow.Checkpoint{Fingerprint: "1", Succeeded: []flow.TaskID{"check", "deploy", "verify", "wait"}}
+
+		Expect(f.Run(ctx, flow.Opts{Checkpoint: &flow.CheckpointOptions{Store: store, Fingerprint: "2"}})).To(HaveOccurred())
+
+		Expect(list.Values()).To(ConsistOf("init", "deploy", "wait", "last", "check", "verify"))
+		Expect(store.checkpoint).To(Equal(&flow.Checkpoint{Fingerprint: "2", Succeeded: []flow.TaskID{"check", "deploy", "verify", "wait"}}))
+	})
+
+	It("should delete the checkpoint if the flow succeeds", func() {
+		store.checkpoint = &flow.Checkpoint{Fingerprint: "1", Succeeded: []flow.TaskID{"check", "deploy", "verify", "wait"}}
+		fail = false
+
+		Expect(f.Run(ctx, flow.Opts{Checkpoint: &flow.CheckpointOptions{Store: store, Fingerprint: "1"}})).To(Succeed())
+
+		Expect(list.Values()).To(Equal([]string{"init", "last"}))
+		Expect(store.checkpoint).To(BeNil())
+	})
+
+	Describe("#NewConfigMapCheckpointStore", func() {
+		It("should save, load and delete the checkpoint", func() {
+			var (
+				checkpoint = &flow.Checkpoint{Fingerprint: "1", Succeeded: []flow.TaskID{"a", "b"}}
+				store      = flow.NewConfigMapCheckpointStore(fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build(), "namespace", "checkpoint")
+			)
+
+			Expect(store.Load(ctx)).To(BeNil())
+			Expect(store.Save(ctx, checkpoint)).To(Succeed())
+			Expect(store.Load(ctx)).To(Equal(checkpoint))
+			Expect(store.Delete(ctx)).To(Succeed())
+			Expect(store.Load(ctx)).To(BeNil())
+			Expect(store.Delete(ctx)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// GraphExport is a serializable representation of a compiled Flow.
+type GraphExport struct {
+	// Name is the name of the flow.
+	Name string `json:"name"`
+	// Tasks are the tasks of the flow, sorted by their IDs.
+	Tasks []TaskExport `json:"tasks"`
+}
+
+// TaskExport is a serializable representation of a task of a compiled Flow.
+type TaskExport struct {
+	// ID is the ID of the task.
+	ID TaskID `json:"id"`
+	// Skip is true if the task is skipped when the flow is run.
+	Skip bool `json:"skip,omitempty"`
+	// Dependencies are the IDs of the tasks which must be completed before this task is started, sorted by their IDs.
+	Dependencies []TaskID `json:"dependencies,omitempty"`
+}
+
+// dependencies returns a mapping from the TaskIDs of the flow to the TaskIDs of their dependencies.
+func (f *Flow) dependencies() map[TaskID]TaskIDs {
+	dependencies := make(map[TaskID]TaskIDs, len(f.nodes))
+	for id := range f.nodes {
+		dependencies[id] = NewTaskIDs()
+	}
+
+	for id, node := range f.nodes {
+		for target := range node.targetIDs {
+			dependencies[target].Insert(id)
+		}
+	}
+
+	return dependencies
+}
+
+// Export returns a serializable representation of the flow, e.g. for marshalling it to JSON.
+func (f *Flow) Export() *GraphExport {
+	var (
+		dependencies = f.dependencies()
+		export       = &GraphExport{Name: f.name}
+	)
+
+	for _, id := range slices.Sorted(maps.Keys(f.nodes)) {
+		task := TaskExport{ID: id, Skip: f.nodes[id].skip}
+		if dependencies[id].Len() > 0 {
+			task.Dependencies = dependencies[id].List()
+		}
+		export.Tasks = append(export.Tasks, task)
+	}
+
+	return export
+}
+
+// DOT returns a representation of the flow in the DOT graph description language. Skipped tasks are drawn with dashed
+// lines.
+func (f *Flow) DOT() string {
+	var (
+		export = f.Export()
+		out    strings.Builder
+	)
+
+	fmt.Fprintf(&out, "digraph %q {\n", export.Name)
+	for _, task := range export.Tasks {
+		if task.Skip {
+			fmt.Fprintf(&out, "\t%q [style=dashed];\n", task.ID)
+			continue
+		}
+		fmt.Fprintf(&out, "\t%q;\n", task.ID)
+	}
+	for _, task := range export.Tasks {
+		for _, dependency := range task.Dependencies {
+			fmt.Fprintf(&out, "\t%q -> %q;\n", dependency, task.ID)
+		}
+	}
+	out.WriteString("}\n")
+
+	return out.String()
+}
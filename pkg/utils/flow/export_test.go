@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Export", func() {
+	var f *flow.Flow
+
+	BeforeEach(func() {
+		var (
+			g = flow.NewGraph("foo")
+			a = g.Add(flow.Task{Name: "a"})
+			b = g.Add(flow.Task{Name: "b", SkipIf: true})
+			_ = g.Add(flow.Task{Name: "c", Dependencies: flow.NewTaskIDs(a, b)})
+		)
+		f = g.Compile()
+	})
+
+	Describe("#Export", func() {
+		It("should export the tasks and their dependencies", func() {
+			Expect(f.Export()).To(Equal(&flow.GraphExport{
+				Name: "foo",
+				Tasks: []flow.TaskExport{
+					{ID: "a"},
+					{ID: "b", Skip: true},
+					{ID: "c", Dependencies: []flow.TaskID{"a", "b"}},
+				},
+			}))
+		})
+	})
+
+	Describe("#DOT", func() {
+		It("should render the flow as DOT graph", func() {
+			Expect(f.DOT()).To(Equal(`digraph "foo" {
+	"a";
+	"b" [style=dashed];
+	"c";
+	"a" -> "c";
+	"b" -> "c";
+}
+`))
+		})
+	})
+})
@@ -13,11 +13,14 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/utils/clock"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/gardener/gardener/pkg/utils"
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 const (
@@ -75,6 +78,7 @@ type node struct {
 	required  int
 	fn        TaskFn
 	skip      bool
+	resumable bool
 }
 
 func (n *node) String() string {
@@ -102,6 +106,11 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
+	// Trace is used to record the execution of the flow's tasks.
+	Trace *Trace
+	// Checkpoint is used to persist the progress of the flow execution. Resumable tasks which already succeeded in a
+	// previous execution with the same fingerprint are skipped.
+	Checkpoint *CheckpointOptions
 }
 
 // Run starts an execution of a Flow.
@@ -114,6 +123,7 @@ type nodeResult struct {
 	TaskID  TaskID
 	Error   error
 	skipped bool
+	resumed bool
 
 	delay    time.Duration
 	duration time.Duration
@@ -185,6 +195,12 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
+		opts.Trace,
+		opts.Checkpoint,
+		NewTaskIDs(),
+		NewTaskIDs(),
+		NewTaskIDs(),
+		nil,
 		make(chan *nodeResult),
 		make(map[TaskID]int),
 	}
@@ -200,6 +216,17 @@ type execution struct {
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
+	trace            *Trace
+	checkpoint       *CheckpointOptions
+	// resumed are the tasks which are skipped because they succeeded according to the loaded checkpoint.
+	resumed TaskIDs
+	// checkpointed are the resumable tasks which succeeded, either in this or in the previous execution.
+	checkpointed TaskIDs
+	// dirty are the resumable tasks which ran in this execution and the tasks which (transitively) depend on such tasks.
+	// Resumable tasks are only skipped if none of their dependencies is dirty.
+	dirty TaskIDs
+	// dependencies maps the tasks to their dependencies. It is only computed if tasks can be resumed.
+	dependencies map[TaskID]TaskIDs
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
@@ -210,9 +237,17 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	taskStartDelay := e.flow.clock.Now().UTC().Sub(e.flow.start.UTC())
 
 	node := e.flow.nodes[id]
+	dependenciesDirty := e.hasDirtyDependencies(id)
 	if node.skip {
+		if dependenciesDirty {
+			e.dirty.Insert(id)
+		}
+
 		log.V(1).Info("Skipped")
 		e.stats.Skipped.Insert(id)
+		if e.trace != nil {
+			e.trace.taskSkipped(id, e.flow.clock.Now().UTC())
+		}
 
 		go func() {
 			e.done <- &nodeResult{TaskID: id, Error: nil, skipped: true, delay: taskStartDelay}
@@ -221,19 +256,51 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 		return
 	}
 
+	e.stats.Pending.Delete(id)
+	e.stats.Running.Insert(id)
+
+	if e.resumed.Has(id) && !dependenciesDirty {
+		log.Info("Skipped because it already succeeded in the previous execution")
+		if e.trace != nil {
+			e.trace.taskSkipped(id, e.flow.clock.Now().UTC())
+		}
+
+		go func() {
+			e.done <- &nodeResult{TaskID: id, Error: nil, resumed: true, delay: taskStartDelay}
+		}()
+
+		return
+	}
+
+	if e.resumed.Has(id) {
+		log.Info("Not skipped although it already succeeded in the previous execution because its dependencies ran again")
+	}
+	if node.resumable || dependenciesDirty {
+		e.dirty.Insert(id)
+	}
+
 	if e.errorContext != nil {
 		e.errorContext.AddErrorID(string(id))
 	}
 
-	e.stats.Pending.Delete(id)
-	e.stats.Running.Insert(id)
-
 	go func() {
+		taskCtx, span := tracing.Tracer().Start(ctx, string(id), trace.WithAttributes(attribute.String(logKeyTask, string(id))))
+		defer span.End()
+
 		start := e.flow.clock.Now().UTC()
 		log.V(1).Info("Started")
-		err := node.fn(ctx)
-		duration := e.flow.clock.Now().UTC().Sub(start)
+		if e.trace != nil {
+			e.trace.taskStarted(id, start)
+			taskCtx = contextWithTaskTrace(taskCtx, e.trace, id)
+		}
+		err := node.fn(taskCtx)
+		tracing.RecordError(span, err)
+		end := e.flow.clock.Now().UTC()
+		duration := end.Sub(start)
 		log.V(1).Info("Finished", "duration", duration)
+		if e.trace != nil {
+			e.trace.taskFinished(id, end, err)
+		}
 
 		if err != nil {
 			log.Error(err, "Error")
@@ -272,16 +339,90 @@ func (e *execution) cleanErrors(ctx context.Context, taskID TaskID) {
 	}
 }
 
+// loadCheckpoint loads the checkpoint of the previous execution and determines the tasks which can be skipped.
+func (e *execution) loadCheckpoint(ctx context.Context) error {
+	if e.checkpoint == nil {
+		return nil
+	}
+
+	checkpoint, err := e.checkpoint.Store.Load(ctx)
+	if err != nil {
+		return err
+	}
+	if checkpoint == nil || checkpoint.Fingerprint != e.checkpoint.Fingerprint {
+		return nil
+	}
+
+	for _, id := range checkpoint.Succeeded {
+		if node, ok := e.flow.nodes[id]; ok && node.resumable && !node.skip {
+			e.resumed.Insert(id)
+			e.checkpointed.Insert(id)
+		}
+	}
+
+	if e.resumed.Len() > 0 {
+		e.log.Info("Resuming from checkpoint", "succeededTasks", e.resumed.Len())
+		e.dependencies = e.flow.dependencies()
+	}
+	return nil
+}
+
+// hasDirtyDependencies returns true if any dependency of the given task is a resumable task which ran in this execution
+// or depends on such a task. A resumable task must not be skipped in this case, e.g., because it waits for the
+// readiness of an object which was deployed again.
+func (e *execution) hasDirtyDependencies(id TaskID) bool {
+	for dependency := range e.dependencies[id] {
+		if e.dirty.Has(dependency) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateCheckpoint persists that the given task succeeded if it is resumable.
+func (e *execution) updateCheckpoint(ctx context.Context, id TaskID) {
+	if e.checkpoint == nil || !e.flow.nodes[id].resumable || e.checkpointed.Has(id) {
+		return
+	}
+
+	e.checkpointed.Insert(id)
+	if err := e.checkpoint.Store.Save(ctx, &Checkpoint{Fingerprint: e.checkpoint.Fingerprint, Succeeded: e.checkpointed.List()}); err != nil {
+		e.log.Error(err, "Failed saving checkpoint", logKeyTask, id)
+	}
+}
+
+// deleteCheckpoint deletes the checkpoint after the flow succeeded, so that the next execution runs all tasks again.
+func (e *execution) deleteCheckpoint(ctx context.Context) {
+	if e.checkpoint == nil {
+		return
+	}
+
+	if err := e.checkpoint.Store.Delete(ctx); err != nil {
+		e.log.Error(err, "Failed deleting checkpoint")
+	}
+}
+
 func (e *execution) reportProgress(ctx context.Context) {
 	if e.progressReporter != nil {
 		e.progressReporter.Report(ctx, e.stats.Copy())
 	}
 }
 
-func (e *execution) run(ctx context.Context) error {
+func (e *execution) run(ctx context.Context) (err error) {
 	e.flow.start = e.flow.clock.Now()
 	defer close(e.done)
 
+	ctx, span := tracing.Tracer().Start(ctx, e.flow.name, trace.WithAttributes(attribute.String(logKeyFlow, e.flow.name)))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	if e.trace != nil {
+		e.trace.begin(e.flow, e.flow.start.UTC())
+		defer func() { e.trace.finish(e.flow.clock.Now().UTC()) }()
+	}
+
 	if e.progressReporter != nil {
 		if err := e.progressReporter.Start(ctx); err != nil {
 			return err
@@ -290,6 +431,9 @@ func (e *execution) run(ctx context.Context) error {
 	}
 
 	e.log.Info("Starting")
+	if err := e.loadCheckpoint(ctx); err != nil {
+		e.log.Error(err, "Failed loading checkpoint, running all tasks")
+	}
 	e.reportProgress(ctx)
 
 	var (
@@ -306,7 +450,9 @@ func (e *execution) run(ctx context.Context) error {
 
 	for e.stats.Running.Len() > 0 || e.stats.Skipped.Len() > 0 {
 		result := <-e.done
-		e.reportTaskMetrics(result)
+		if !result.resumed {
+			e.reportTaskMetrics(result)
+		}
 		if result.skipped {
 			e.stats.Skipped.Delete(result.TaskID)
 			if cancelErr = ctx.Err(); cancelErr == nil {
@@ -318,6 +464,7 @@ func (e *execution) run(ctx context.Context) error {
 				e.updateFailure(result.TaskID)
 			} else {
 				e.updateSuccess(result.TaskID)
+				e.updateCheckpoint(ctx, result.TaskID)
 				if e.errorContext != nil && e.errorContext.HasLastErrorWithID(string(result.TaskID)) {
 					e.cleanErrors(ctx, result.TaskID)
 				}
@@ -331,6 +478,9 @@ func (e *execution) run(ctx context.Context) error {
 	}
 
 	e.log.Info("Finished")
+	if cancelErr == nil && len(e.taskErrors) == 0 {
+		e.deleteCheckpoint(ctx)
+	}
 	return e.result(cancelErr)
 }
 
@@ -11,6 +11,10 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
 
@@ -169,6 +173,34 @@ var _ = Describe("Flow", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(flow.WasCanceled(err)).To(BeTrue())
 		})
+		It("should record a span for the flow and each task", func() {
+			oldTracerProvider := otel.GetTracerProvider()
+			defer otel.SetTracerProvider(oldTracerProvider)
+
+			spanRecorder := tracetest.NewSpanRecorder()
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+
+			var (
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return errors.New("err") }, Dependencies: flow.NewTaskIDs(x)})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{})).To(HaveOccurred())
+
+			spans := map[string]sdktrace.ReadOnlySpan{}
+			for _, span := range spanRecorder.Ended() {
+				spans[span.Name()] = span
+			}
+
+			Expect(spans).To(HaveLen(3))
+			Expect(spans["foo"].Status().Code).To(Equal(codes.Error))
+			Expect(spans["x"].Status().Code).To(Equal(codes.Unset))
+			Expect(spans["x"].Parent().SpanID()).To(Equal(spans["foo"].SpanContext().SpanID()))
+			Expect(spans["y"].Status()).To(Equal(sdktrace.Status{Code: codes.Error, Description: "err"}))
+			Expect(spans["y"].Parent().SpanID()).To(Equal(spans["foo"].SpanContext().SpanID()))
+		})
 	})
 
 	Describe("#Sequential", func() {
@@ -17,6 +17,13 @@ type Task struct {
 	Fn           TaskFn
 	SkipIf       bool
 	Dependencies TaskIDs
+	// Resumable marks the task as safe to be skipped if it already succeeded in a previous execution of the flow with
+	// the same inputs, see Opts.Checkpoint. Only tasks which don't populate any state that is used by other tasks should
+	// be marked as resumable. A resumable task is not skipped if any resumable task it (transitively) depends on ran in
+	// the current execution. Non-resumable tasks (e.g., the initialization of clients) run in every execution and don't
+	// prevent skipping their dependents. Hence, all tasks which might invalidate the result of a resumable task (e.g.,
+	// the deployment of an object whose readiness it checks) must be resumable as well.
+	Resumable bool
 }
 
 // Spec returns the TaskSpec of a task.
@@ -25,6 +32,7 @@ func (t *Task) Spec() *TaskSpec {
 		t.Fn,
 		t.SkipIf,
 		t.Dependencies.Copy(),
+		t.Resumable,
 	}
 }
 
@@ -34,6 +42,7 @@ type TaskSpec struct {
 	Fn           TaskFn
 	Skip         bool
 	Dependencies TaskIDs
+	Resumable    bool
 }
 
 // Tasks is a mapping from TaskID to TaskSpec.
@@ -91,6 +100,7 @@ func (g *Graph) Compile() *Flow {
 		node := nodes.getOrCreate(taskName)
 		node.fn = taskSpec.Fn
 		node.skip = taskSpec.Skip
+		node.resumable = taskSpec.Resumable
 		node.required = taskSpec.Dependencies.Len()
 	}
 
@@ -42,7 +42,11 @@ func (t TaskFn) RetryUntilTimeout(interval, timeout time.Duration) TaskFn {
 		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 
+		attempt := 0
 		return retry.Until(ctx, interval, func(ctx context.Context) (done bool, err error) {
+			if attempt++; attempt > 1 {
+				recordRetry(ctx)
+			}
 			if err := t(ctx); err != nil {
 				return retry.MinorError(err)
 			}
@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Trace records the execution of a Flow, i.e. the start and end time, the retries and the error of each task. A Trace
+// can be passed to Flow.Run via Opts.Trace. It is safe for concurrent use, hence it can be read while the flow is
+// still running.
+type Trace struct {
+	lock sync.RWMutex
+
+	flowName     string
+	start        time.Time
+	end          time.Time
+	tasks        map[TaskID]*TaskTrace
+	dependencies map[TaskID]TaskIDs
+}
+
+// TaskTrace is the recorded execution of a single task.
+type TaskTrace struct {
+	// ID is the ID of the task.
+	ID TaskID `json:"id"`
+	// Start is the time when the task was started.
+	Start time.Time `json:"start"`
+	// End is the time when the task was finished. It is zero while the task is running.
+	End time.Time `json:"end,omitzero"`
+	// Duration is the duration of the task.
+	Duration time.Duration `json:"duration,omitempty"`
+	// Retries is the number of retries of the task, see TaskFn.RetryUntilTimeout.
+	Retries int `json:"retries,omitempty"`
+	// Skipped is true if the task was skipped.
+	Skipped bool `json:"skipped,omitempty"`
+	// Error is the error returned by the task.
+	Error string `json:"error,omitempty"`
+}
+
+// NewTrace returns a new, empty Trace.
+func NewTrace() *Trace {
+	return &Trace{tasks: make(map[TaskID]*TaskTrace)}
+}
+
+// FlowName returns the name of the traced flow.
+func (t *Trace) FlowName() string {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.flowName
+}
+
+// Tasks returns the recorded executions of all tasks sorted by their start time.
+func (t *Trace) Tasks() []TaskTrace {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.sortedTasks()
+}
+
+func (t *Trace) sortedTasks() []TaskTrace {
+	out := make([]TaskTrace, 0, len(t.tasks))
+	for _, task := range t.tasks {
+		out = append(out, *task)
+	}
+
+	slices.SortFunc(out, func(a, b TaskTrace) int {
+		if c := a.Start.Compare(b.Start); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return out
+}
+
+// CriticalPath returns the chain of finished tasks which determined the duration of the flow. It starts with the task
+// which finished last and walks back via the dependency which finished last, i.e. the one which gated the start of its
+// dependent task. Skipped tasks are not part of the result. The tasks are returned in execution order.
+func (t *Trace) CriticalPath() []TaskTrace {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.criticalPath()
+}
+
+func (t *Trace) criticalPath() []TaskTrace {
+	lastFinished := func(ids []TaskID) *TaskTrace {
+		var last *TaskTrace
+		for _, id := range ids {
+			task, ok := t.tasks[id]
+			if !ok || task.End.IsZero() {
+				continue
+			}
+			if last == nil || finishedLater(task, last) {
+				last = task
+			}
+		}
+		return last
+	}
+
+	var (
+		path    []TaskTrace
+		current = lastFinished(slices.Sorted(maps.Keys(t.tasks)))
+	)
+
+	for current != nil {
+		if !current.Skipped {
+			path = append(path, *current)
+		}
+		current = lastFinished(t.dependencies[current.ID].List())
+	}
+
+	slices.Reverse(path)
+	return path
+}
+
+// finishedLater returns true if task a finished later than task b. If both tasks finished at the same time, tasks which
+// were not skipped and tasks which started later are preferred, i.e. the task with a negligible duration which was
+// waiting for the other one.
+func finishedLater(a, b *TaskTrace) bool {
+	if !a.End.Equal(b.End) {
+		return a.End.After(b.End)
+	}
+	if a.Skipped != b.Skipped {
+		return !a.Skipped
+	}
+	return a.Start.After(b.Start)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t *Trace) MarshalJSON() ([]byte, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	out := struct {
+		Flow         string        `json:"flow"`
+		Start        time.Time     `json:"start"`
+		End          time.Time     `json:"end,omitzero"`
+		Duration     time.Duration `json:"duration,omitempty"`
+		Tasks        []TaskTrace   `json:"tasks"`
+		CriticalPath []TaskID      `json:"criticalPath,omitempty"`
+	}{
+		Flow:  t.flowName,
+		Start: t.start,
+		End:   t.end,
+		Tasks: t.sortedTasks(),
+	}
+
+	if !t.end.IsZero() {
+		out.Duration = t.end.Sub(t.start)
+	}
+	for _, task := range t.criticalPath() {
+		out.CriticalPath = append(out.CriticalPath, task.ID)
+	}
+
+	return json.Marshal(out)
+}
+
+// chromeTraceEvent is an event of the Chrome trace event format, see
+// https://docs.google.com/document/d/1CvAClvFfyA5R-PhYUmn5OOQtYMH4h6I0nSsKchNAySU.
+type chromeTraceEvent struct {
+	Name      string         `json:"name"`
+	Category  string         `json:"cat"`
+	Phase     string         `json:"ph"`
+	Timestamp int64          `json:"ts"`
+	Duration  int64          `json:"dur"`
+	ProcessID int            `json:"pid"`
+	ThreadID  int            `json:"tid"`
+	Args      map[string]any `json:"args,omitempty"`
+}
+
+// ChromeTraceEvents returns the trace in the Chrome trace event format, which can be loaded into chrome://tracing or
+// https://ui.perfetto.dev. Each task is rendered in its own row, tasks on the critical path are marked with the
+// "critical" category.
+func (t *Trace) ChromeTraceEvents() ([]byte, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	critical := NewTaskIDs()
+	for _, task := range t.criticalPath() {
+		critical.Insert(task.ID)
+	}
+
+	events := []chromeTraceEvent{}
+	for i, task := range t.sortedTasks() {
+		if task.Skipped {
+			continue
+		}
+
+		event := chromeTraceEvent{
+			Name:      string(task.ID),
+			Category:  "task",
+			Phase:     "X",
+			Timestamp: task.Start.Sub(t.start).Microseconds(),
+			Duration:  task.Duration.Microseconds(),
+			ProcessID: 1,
+			ThreadID:  i + 1,
+			Args:      map[string]any{},
+		}
+
+		if critical.Has(task.ID) {
+			event.Category = "task,critical"
+		}
+		if task.End.IsZero() {
+			event.Args["running"] = true
+		}
+		if task.Retries > 0 {
+			event.Args["retries"] = task.Retries
+		}
+		if task.Error != "" {
+			event.Args["error"] = task.Error
+		}
+
+		events = append(events, event)
+	}
+
+	return json.Marshal(struct {
+		TraceEvents     []chromeTraceEvent `json:"traceEvents"`
+		DisplayTimeUnit string             `json:"displayTimeUnit"`
+		OtherData       map[string]string  `json:"otherData"`
+	}{
+		TraceEvents:     events,
+		DisplayTimeUnit: "ms",
+		OtherData:       map[string]string{"flow": t.flowName},
+	})
+}
+
+func (t *Trace) begin(flow *Flow, now time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.flowName = flow.name
+	t.start = now
+	t.end = time.Time{}
+	t.tasks = make(map[TaskID]*TaskTrace, len(flow.nodes))
+	t.dependencies = flow.dependencies()
+}
+
+func (t *Trace) finish(now time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.end = now
+}
+
+func (t *Trace) taskStarted(id TaskID, now time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.tasks[id] = &TaskTrace{ID: id, Start: now}
+}
+
+func (t *Trace) taskSkipped(id TaskID, now time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.tasks[id] = &TaskTrace{ID: id, Start: now, End: now, Skipped: true}
+}
+
+func (t *Trace) taskFinished(id TaskID, now time.Time, err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	task, ok := t.tasks[id]
+	if !ok {
+		return
+	}
+
+	task.End = now
+	task.Duration = now.Sub(task.Start)
+	if err != nil {
+		task.Error = err.Error()
+	}
+}
+
+func (t *Trace) taskRetried(id TaskID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if task, ok := t.tasks[id]; ok {
+		task.Retries++
+	}
+}
+
+type taskTraceContextKey struct{}
+
+type taskTraceContextValue struct {
+	trace *Trace
+	id    TaskID
+}
+
+// contextWithTaskTrace returns a context which allows recording retries of the given task in the given trace.
+func contextWithTaskTrace(ctx context.Context, trace *Trace, id TaskID) context.Context {
+	return context.WithValue(ctx, taskTraceContextKey{}, taskTraceContextValue{trace: trace, id: id})
+}
+
+// recordRetry records a retry of the task which is traced in the given context, if any.
+func recordRetry(ctx context.Context) {
+	if v, ok := ctx.Value(taskTraceContextKey{}).(taskTraceContextValue); ok {
+		v.trace.taskRetried(v.id)
+	}
+}
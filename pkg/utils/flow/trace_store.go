@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+// TraceStore keeps the Traces of the most recent flow executions in memory. It is bounded, i.e. the oldest Traces are
+// evicted once the configured number of Traces is exceeded.
+type TraceStore struct {
+	lock sync.RWMutex
+
+	maxTraces int
+	keys      []string
+	traces    map[string]*Trace
+	graphs    map[string]*Flow
+}
+
+// NewTraceStore returns a new TraceStore which keeps at most maxTraces Traces.
+func NewTraceStore(maxTraces int) *TraceStore {
+	return &TraceStore{
+		maxTraces: maxTraces,
+		traces:    make(map[string]*Trace),
+		graphs:    make(map[string]*Flow),
+	}
+}
+
+// New returns a new Trace for the execution of the given flow and stores it under the given key. A previously stored
+// Trace with the same key is replaced. It is safe to call New on a nil TraceStore, in this case nil is returned which
+// disables tracing of the flow execution.
+func (s *TraceStore) New(key string, f *Flow) *Trace {
+	if s == nil {
+		return nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.traces[key]; ok {
+		s.keys = slices.DeleteFunc(s.keys, func(k string) bool { return k == key })
+	}
+
+	for len(s.keys) > 0 && len(s.keys) >= s.maxTraces {
+		delete(s.traces, s.keys[0])
+		delete(s.graphs, s.keys[0])
+		s.keys = s.keys[1:]
+	}
+
+	trace := NewTrace()
+	s.keys = append(s.keys, key)
+	s.traces[key] = trace
+	s.graphs[key] = f
+	return trace
+}
+
+// Get returns the Trace and the flow stored under the given key.
+func (s *TraceStore) Get(key string) (*Trace, *Flow, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	trace, ok := s.traces[key]
+	return trace, s.graphs[key], ok
+}
+
+// Keys returns the keys of all stored Traces, sorted from oldest to newest.
+func (s *TraceStore) Keys() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return slices.Clone(s.keys)
+}
+
+// ServeHTTP implements http.Handler. Without the `key` query parameter, it responds with the keys of all stored Traces.
+// Otherwise, it responds with the Trace stored under the key in the format given by the `format` query parameter:
+// `json` (default) for the recorded tasks and the critical path, `chrome` for the Chrome trace event format or `dot`
+// for the graph of the flow in the DOT graph description language.
+func (s *TraceStore) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	key := req.URL.Query().Get("key")
+	if key == "" {
+		writeResponse(w, "application/json")(json.Marshal(s.Keys()))
+		return
+	}
+
+	trace, f, ok := s.Get(key)
+	if !ok {
+		http.Error(w, fmt.Sprintf("no trace found for key %q", key), http.StatusNotFound)
+		return
+	}
+
+	switch format := req.URL.Query().Get("format"); format {
+	case "", "json":
+		writeResponse(w, "application/json")(json.Marshal(trace))
+	case "chrome":
+		writeResponse(w, "application/json")(trace.ChromeTraceEvents())
+	case "dot":
+		writeResponse(w, "text/vnd.graphviz")([]byte(f.DOT()), nil)
+	default:
+		http.Error(w, fmt.Sprintf("unsupported format %q, supported formats are json, chrome and dot", format), http.StatusBadRequest)
+	}
+}
+
+func writeResponse(w http.ResponseWriter, contentType string) func([]byte, error) {
+	return func(data []byte, err error) {
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		_, _ = w.Write(data)
+	}
+}
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	testclock "k8s.io/utils/clock/testing"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Trace", func() {
+	var (
+		ctx       = context.Background()
+		fakeClock *testclock.FakeClock
+		trace     *flow.Trace
+		f         *flow.Flow
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+		trace = flow.NewTrace()
+
+		finished := func(id flow.TaskID) bool {
+			for _, task := range trace.Tasks() {
+				if task.ID == id && !task.End.IsZero() {
+					return true
+				}
+			}
+			return false
+		}
+
+		attempts := 0
+
+		g := flow.NewGraph("foo")
+		g.Clock = fakeClock
+		var (
+			a = g.Add(flow.Task{Name: "a", Fn: func(_ context.Context) error { return nil }})
+			b = g.Add(flow.Task{Name: "b", Fn: func(_ context.Context) error {
+				// make sure that b finishes after a
+				for !finished("a") {
+					time.Sleep(time.Millisecond)
+				}
+				fakeClock.Step(2 * time.Second)
+				return nil
+			}})
+			s = g.Add(flow.Task{Name: "s", SkipIf: true, Dependencies: flow.NewTaskIDs(a)})
+			c = g.Add(flow.Task{Name: "c", Dependencies: flow.NewTaskIDs(a, b, s), Fn: flow.TaskFn(func(_ context.Context) error {
+				if attempts++; attempts < 3 {
+					return errors.New("retry")
+				}
+				fakeClock.Step(time.Second)
+				return nil
+			}).RetryUntilTimeout(time.Millisecond, time.Minute)})
+			_ = g.Add(flow.Task{Name: "d", Dependencies: flow.NewTaskIDs(c), Fn: func(_ context.Context) error { return errors.New("fail") }})
+		)
+		f = g.Compile()
+	})
+
+	It("should record the execution of the flow", func() {
+		Expect(f.Run(ctx, flow.Opts{Trace: trace})).To(MatchError(ContainSubstring("fail")))
+
+		Expect(trace.FlowName()).To(Equal("foo"))
+		Expect(trace.Tasks()).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{"ID": Equal(flow.TaskID("a")), "Duration": BeZero()}),
+			MatchFields(IgnoreExtras, Fields{"ID": Equal(flow.TaskID("b")), "Duration": Equal(2 * time.Second)}),
+			MatchFields(IgnoreExtras, Fields{"ID": Equal(flow.TaskID("s")), "Skipped": BeTrue()}),
+			MatchFields(IgnoreExtras, Fields{"ID": Equal(flow.TaskID("c")), "Duration": Equal(time.Second), "Retries": Equal(2)}),
+			MatchFields(IgnoreExtras, Fields{"ID": Equal(flow.TaskID("d")), "Error": Equal("fail")}),
+		))
+	})
+
+	It("should determine the critical path", func() {
+		Expect(f.Run(ctx, flow.Opts{Trace: trace})).To(HaveOccurred())
+
+		var ids []flow.TaskID
+		for _, task := range trace.CriticalPath() {
+			ids = append(ids, task.ID)
+		}
+		Expect(ids).To(Equal([]flow.TaskID{"b", "c", "d"}))
+	})
+
+	It("should marshal the trace to JSON", func() {
+		Expect(f.Run(ctx, flow.Opts{Trace: trace})).To(HaveOccurred())
+
+		data, err := json.Marshal(trace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(ContainSubstring(`"flow":"foo"`))
+		Expect(data).To(ContainSubstring(`"criticalPath":["b","c","d"]`))
+	})
+
+	It("should render the trace in the Chrome trace event format", func() {
+		Expect(f.Run(ctx, flow.Opts{Trace: trace})).To(HaveOccurred())
+
+		data, err := trace.ChromeTraceEvents()
+		Expect(err).NotTo(HaveOccurred())
+
+		events := struct {
+			TraceEvents []map[string]any `json:"traceEvents"`
+		}{}
+		Expect(json.Unmarshal(data, &events)).To(Succeed())
+		Expect(events.TraceEvents).To(HaveLen(4))
+		Expect(events.TraceEvents).To(ContainElement(And(
+			HaveKeyWithValue("name", "c"),
+			HaveKeyWithValue("cat", "task,critical"),
+			HaveKeyWithValue("ph", "X"),
+			HaveKeyWithValue("ts", BeEquivalentTo(2000000)),
+			HaveKeyWithValue("dur", BeEquivalentTo(1000000)),
+			HaveKeyWithValue("args", HaveKeyWithValue("retries", BeEquivalentTo(2))),
+		)))
+		Expect(events.TraceEvents).To(ContainElement(And(
+			HaveKeyWithValue("name", "a"),
+			HaveKeyWithValue("cat", "task"),
+		)))
+	})
+
+	Describe("TraceStore", func() {
+		var store *flow.TraceStore
+
+		BeforeEach(func() {
+			store = flow.NewTraceStore(2)
+		})
+
+		It("should evict the oldest traces", func() {
+			Expect(store.New("a", f)).NotTo(BeNil())
+			Expect(store.New("b", f)).NotTo(BeNil())
+			Expect(store.New("a", f)).NotTo(BeNil())
+			Expect(store.New("c", f)).NotTo(BeNil())
+
+			Expect(store.Keys()).To(Equal([]string{"a", "c"}))
+		})
+
+		It("should return nil traces for nil stores", func() {
+			var nilStore *flow.TraceStore
+			Expect(nilStore.New("a", f)).To(BeNil())
+		})
+
+		It("should serve the traces", func() {
+			trace = store.New("shoot/foo", f)
+			Expect(f.Run(ctx, flow.Opts{Trace: trace})).To(HaveOccurred())
+
+			serve := func(query string) *httptest.ResponseRecorder {
+				recorder := httptest.NewRecorder()
+				store.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/flow-traces"+query, nil))
+				return recorder
+			}
+
+			Expect(serve("").Body.String()).To(Equal(`["shoot/foo"]`))
+			Expect(serve("?key=shoot/foo").Body.String()).To(ContainSubstring(`"criticalPath":["b","c","d"]`))
+			Expect(serve("?key=shoot/foo&format=chrome").Body.String()).To(HavePrefix(`{"traceEvents":`))
+			Expect(serve("?key=shoot/foo&format=dot").Body.String()).To(HavePrefix(`digraph "foo"`))
+			Expect(serve("?key=shoot/foo&format=foo").Code).To(Equal(http.StatusBadRequest))
+			Expect(serve("?key=shoot/bar").Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gardener/gardener/pkg/api/core/helper"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// QuotaMetricNames are the names of the resources which can be constrained by Quotas.
+var QuotaMetricNames = []corev1.ResourceName{
+	core.QuotaMetricCPU,
+	core.QuotaMetricGPU,
+	core.QuotaMetricMemory,
+	core.QuotaMetricStorageStandard,
+	core.QuotaMetricStoragePremium,
+	core.QuotaMetricLoadbalancer,
+}
+
+// QuotaResourcesForShoot returns the resources the given Shoot allocates with respect to Quotas. The resources of the
+// worker pools are computed based on their maximum number of nodes and the machine and volume types in the given
+// CloudProfile spec.
+func QuotaResourcesForShoot(shoot *core.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) (corev1.ResourceList, error) {
+	var (
+		countLB      int64 = 1
+		resources          = make(corev1.ResourceList)
+		workers            = getShootWorkerResources(shoot, cloudProfileSpec)
+		machineTypes       = cloudProfileSpec.MachineTypes
+		volumeTypes        = cloudProfileSpec.VolumeTypes
+	)
+
+	for _, worker := range workers {
+		var (
+			machineType *gardencorev1beta1.MachineType
+			volumeType  *gardencorev1beta1.VolumeType
+		)
+
+		// Get the proper machineType
+		for _, e := range machineTypes {
+			element := e
+			if element.Name == worker.Machine.Type {
+				machineType = &element
+				break
+			}
+		}
+		if machineType == nil {
+			return nil, fmt.Errorf("machineType %s not found in CloudProfile", worker.Machine.Type)
+		}
+
+		if worker.Volume != nil {
+			if machineType.Storage != nil {
+				volumeType = &gardencorev1beta1.VolumeType{
+					Class: machineType.Storage.Class,
+				}
+			} else {
+				// Get the proper VolumeType
+				for _, e := range volumeTypes {
+					element := e
+					if worker.Volume.Type != nil && element.Name == *worker.Volume.Type {
+						volumeType = &element
+						break
+					}
+				}
+			}
+		}
+		if volumeType == nil {
+			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile", worker.Machine.Type)
+		}
+
+		// For now we always use the max. amount of resources for quota calculation
+		addQuantity(resources, core.QuotaMetricCPU, multiplyQuantity(machineType.CPU, worker.Maximum))
+		addQuantity(resources, core.QuotaMetricGPU, multiplyQuantity(machineType.GPU, worker.Maximum))
+		addQuantity(resources, core.QuotaMetricMemory, multiplyQuantity(machineType.Memory, worker.Maximum))
+
+		size, _ := resource.ParseQuantity("0Gi")
+		if worker.Volume != nil {
+			var err error
+			size, err = resource.ParseQuantity(worker.Volume.VolumeSize)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		switch volumeType.Class {
+		case core.VolumeClassStandard:
+			addQuantity(resources, core.QuotaMetricStorageStandard, multiplyQuantity(size, worker.Maximum))
+		case core.VolumeClassPremium:
+			addQuantity(resources, core.QuotaMetricStoragePremium, multiplyQuantity(size, worker.Maximum))
+		default:
+			return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
+		}
+	}
+
+	if helper.NginxIngressEnabled(shoot.Spec.Addons) {
+		countLB++
+	}
+	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
+
+	return resources, nil
+}
+
+func getShootWorkerResources(shoot *core.Shoot, cloudProfile *gardencorev1beta1.CloudProfileSpec) []core.Worker {
+	workers := make([]core.Worker, 0, len(shoot.Spec.Provider.Workers))
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		workerCopy := worker.DeepCopy()
+
+		if worker.Volume == nil {
+			for _, machineType := range cloudProfile.MachineTypes {
+				if worker.Machine.Type == machineType.Name && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
+					workerCopy.Volume = &core.Volume{
+						Type:       &machineType.Storage.Type,
+						VolumeSize: machineType.Storage.StorageSize.String(),
+					}
+				}
+			}
+		}
+
+		workers = append(workers, *workerCopy)
+	}
+
+	return workers
+}
+
+func addQuantity(resources corev1.ResourceList, name corev1.ResourceName, quantity resource.Quantity) {
+	sum := resources[name]
+	sum.Add(quantity)
+	resources[name] = sum
+}
+
+func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
+	res := resource.Quantity{}
+	for i := 0; i < int(multiplier); i++ {
+		res.Add(quantity)
+	}
+	return res
+}
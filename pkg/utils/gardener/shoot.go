@@ -152,6 +152,67 @@ func EffectiveShootMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot) *timewi
 	return EffectiveMaintenanceTimeWindow(timeWindow)
 }
 
+// EffectiveWorkerMaintenanceTimeWindow returns the effective MaintenanceTimeWindow of the given worker pool of the given
+// Shoot. If the worker pool does not specify its own time window, the one of the Shoot is returned.
+func EffectiveWorkerMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot, worker gardencorev1beta1.Worker) *timewindow.MaintenanceTimeWindow {
+	if worker.Maintenance == nil || worker.Maintenance.TimeWindow == nil {
+		return EffectiveShootMaintenanceTimeWindow(shoot)
+	}
+
+	timeWindow, err := timewindow.ParseMaintenanceTimeWindow(worker.Maintenance.TimeWindow.Begin, worker.Maintenance.TimeWindow.End)
+	if err != nil {
+		return timewindow.AlwaysTimeWindow
+	}
+
+	return EffectiveMaintenanceTimeWindow(timeWindow)
+}
+
+// IsNowInEffectiveWorkerMaintenanceTimeWindow checks if the current time is in the effective maintenance time window of
+// the given worker pool and if this time window began on one of the weekdays allowed for the worker pool. The weekday is
+// evaluated in the time zone in which the beginning of the time window is specified.
+func IsNowInEffectiveWorkerMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot, worker gardencorev1beta1.Worker, clock clock.Clock) bool {
+	var (
+		now        = clock.Now().UTC()
+		timeWindow = EffectiveWorkerMaintenanceTimeWindow(shoot, worker)
+	)
+
+	if !timeWindow.Contains(now) {
+		return false
+	}
+
+	if worker.Maintenance == nil || len(worker.Maintenance.Weekdays) == 0 {
+		return true
+	}
+
+	// A time window spanning midnight which contains the current time might have begun on the previous day.
+	begin := timeWindow.AdjustedBegin(now)
+	if begin.After(now) {
+		begin = begin.AddDate(0, 0, -1)
+	}
+
+	return slices.Contains(worker.Maintenance.Weekdays, begin.In(workerMaintenanceTimeWindowLocation(shoot, worker)).Weekday().String())
+}
+
+// workerMaintenanceTimeWindowLocation returns the time zone in which the beginning of the effective maintenance time
+// window of the given worker pool is specified. It falls back to UTC if it cannot be determined.
+func workerMaintenanceTimeWindowLocation(shoot *gardencorev1beta1.Shoot, worker gardencorev1beta1.Worker) *time.Location {
+	var begin string
+	switch {
+	case worker.Maintenance != nil && worker.Maintenance.TimeWindow != nil:
+		begin = worker.Maintenance.TimeWindow.Begin
+	case shoot.Spec.Maintenance != nil && shoot.Spec.Maintenance.TimeWindow != nil:
+		begin = shoot.Spec.Maintenance.TimeWindow.Begin
+	default:
+		return time.UTC
+	}
+
+	location, err := timewindow.ParseMaintenanceTimeLocation(begin)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
 // GetShootNameFromOwnerReferences attempts to get the name of the Shoot object which owns the passed in object.
 // If it is not owned by a Shoot, an empty string is returned.
 func GetShootNameFromOwnerReferences(objectMeta metav1.Object) string {
@@ -26,6 +26,7 @@ import (
 	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
 	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"k8s.io/component-base/version"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -261,6 +262,84 @@ var _ = Describe("Shoot", func() {
 				timewindow.NewMaintenanceTime(1, 45, 0))),
 	)
 
+	Describe("#EffectiveWorkerMaintenanceTimeWindow", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "010000+0000", End: "020000+0000"},
+					},
+				},
+			}
+		})
+
+		It("should return the time window of the shoot if the worker pool does not specify one", func() {
+			Expect(EffectiveWorkerMaintenanceTimeWindow(shoot, gardencorev1beta1.Worker{Maintenance: &gardencorev1beta1.WorkerMaintenance{Weekdays: []string{"Sunday"}}})).To(Equal(
+				timewindow.NewMaintenanceTimeWindow(timewindow.NewMaintenanceTime(1, 0, 0), timewindow.NewMaintenanceTime(1, 45, 0))))
+		})
+
+		It("should return the time window of the worker pool", func() {
+			worker := gardencorev1beta1.Worker{Maintenance: &gardencorev1beta1.WorkerMaintenance{
+				TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+			}}
+
+			Expect(EffectiveWorkerMaintenanceTimeWindow(shoot, worker)).To(Equal(
+				timewindow.NewMaintenanceTimeWindow(timewindow.NewMaintenanceTime(22, 0, 0), timewindow.NewMaintenanceTime(22, 45, 0))))
+		})
+	})
+
+	DescribeTable("#IsNowInEffectiveWorkerMaintenanceTimeWindow",
+		func(now time.Time, weekdays []string, expected bool) {
+			worker := gardencorev1beta1.Worker{Maintenance: &gardencorev1beta1.WorkerMaintenance{
+				TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "230000+0000", End: "020000+0000"},
+				Weekdays:   weekdays,
+			}}
+
+			Expect(IsNowInEffectiveWorkerMaintenanceTimeWindow(&gardencorev1beta1.Shoot{}, worker, testclock.NewFakeClock(now))).To(Equal(expected))
+		},
+
+		// 2024-06-02 is a Sunday
+		Entry("outside of the time window", time.Date(2024, 6, 2, 12, 0, 0, 0, time.UTC), nil, false),
+		Entry("inside of the time window without weekdays", time.Date(2024, 6, 2, 23, 30, 0, 0, time.UTC), nil, true),
+		Entry("inside of the time window on an allowed weekday", time.Date(2024, 6, 2, 23, 30, 0, 0, time.UTC), []string{"Sunday"}, true),
+		Entry("inside of the time window on another weekday", time.Date(2024, 6, 2, 23, 30, 0, 0, time.UTC), []string{"Saturday"}, false),
+		Entry("after midnight of a time window which began on an allowed weekday", time.Date(2024, 6, 3, 1, 0, 0, 0, time.UTC), []string{"Sunday"}, true),
+		Entry("after midnight of a time window which began on another weekday", time.Date(2024, 6, 2, 1, 0, 0, 0, time.UTC), []string{"Sunday"}, false),
+	)
+
+	Describe("#IsNowInEffectiveWorkerMaintenanceTimeWindow with time windows in other time zones", func() {
+		// 2024-06-02 23:30 UTC is a Sunday in UTC, but already a Monday in the time zone +0200
+		now := time.Date(2024, 6, 2, 23, 30, 0, 0, time.UTC)
+
+		It("should evaluate the weekdays in the time zone of the time window of the worker pool", func() {
+			worker := gardencorev1beta1.Worker{Maintenance: &gardencorev1beta1.WorkerMaintenance{
+				TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "010000+0200", End: "030000+0200"},
+				Weekdays:   []string{"Monday"},
+			}}
+
+			Expect(IsNowInEffectiveWorkerMaintenanceTimeWindow(&gardencorev1beta1.Shoot{}, worker, testclock.NewFakeClock(now))).To(BeTrue())
+
+			worker.Maintenance.Weekdays = []string{"Sunday"}
+			Expect(IsNowInEffectiveWorkerMaintenanceTimeWindow(&gardencorev1beta1.Shoot{}, worker, testclock.NewFakeClock(now))).To(BeFalse())
+		})
+
+		It("should evaluate the weekdays in the time zone of the time window of the Shoot if the worker pool has none", func() {
+			shoot := &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Maintenance: &gardencorev1beta1.Maintenance{
+				TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "010000+0200", End: "030000+0200"},
+			}}}
+			worker := gardencorev1beta1.Worker{Maintenance: &gardencorev1beta1.WorkerMaintenance{
+				Weekdays: []string{"Monday"},
+			}}
+
+			Expect(IsNowInEffectiveWorkerMaintenanceTimeWindow(shoot, worker, testclock.NewFakeClock(now))).To(BeTrue())
+
+			worker.Maintenance.Weekdays = []string{"Sunday"}
+			Expect(IsNowInEffectiveWorkerMaintenanceTimeWindow(shoot, worker, testclock.NewFakeClock(now))).To(BeFalse())
+		})
+	})
+
 	DescribeTable("#GetShootNameFromOwnerReferences",
 		func(ownerRefs []metav1.OwnerReference, expectedName string) {
 			obj := &gardencorev1beta1.BackupEntry{
@@ -268,7 +268,7 @@ func (h *HealthChecker) checkManagedResourceConditions(
 
 		// check if MangedResource `ResourcesApplied` condition is in failed state
 		conditionResourcesApplied := v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
-		if conditionResourcesApplied != nil && conditionResourcesApplied.Status == gardencorev1beta1.ConditionFalse && conditionResourcesApplied.Reason == resourcesv1alpha1.ConditionApplyFailed {
+		if conditionResourcesApplied != nil && conditionResourcesApplied.Status == gardencorev1beta1.ConditionFalse && (conditionResourcesApplied.Reason == resourcesv1alpha1.ConditionApplyFailed || conditionResourcesApplied.Reason == resourcesv1alpha1.ConditionApplyConflict) {
 			c = v1beta1helper.FailedCondition(h.clock, h.lastOperation, h.conditionThresholds, condition, conditionResourcesApplied.Reason, conditionResourcesApplied.Message)
 		}
 
@@ -5,10 +5,12 @@
 package secrets
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"fmt"
 	"math/big"
 	"net"
 	"os"
@@ -84,6 +86,9 @@ type Certificate struct {
 
 	PrivateKey    *rsa.PrivateKey
 	PrivateKeyPEM []byte
+	// Signer is used for signing certificates instead of PrivateKey if the private key is not available, e.g. because
+	// it is kept in an external key store.
+	Signer crypto.Signer `hash:"ignore"`
 
 	Certificate    *x509.Certificate
 	CertificatePEM []byte
@@ -119,15 +124,15 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 		var (
 			certificate       = s.generateCertificateTemplate()
 			certificateSigner = certificate
-			privateKeySigner  = privateKey
+			privateKeySigner  = crypto.Signer(privateKey)
 		)
 
 		if s.SigningCA != nil {
 			certificateSigner = s.SigningCA.Certificate
-			privateKeySigner = s.SigningCA.PrivateKey
+			privateKeySigner = s.SigningCA.signer()
 		}
 
-		certificatePEM, err := signCertificate(certificate, privateKey, certificateSigner, privateKeySigner)
+		certificatePEM, err := signCertificate(certificate, &privateKey.PublicKey, certificateSigner, privateKeySigner)
 		if err != nil {
 			return nil, err
 		}
@@ -153,6 +158,52 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 	return certificateObj, nil
 }
 
+// GenerateCertificateWithSigner is the same as GenerateCertificate but uses the given signer instead of generating a
+// new private key. The private key is not part of the returned *Certificate, i.e., only the signer can be used for
+// signing further certificates with it.
+func (s *CertificateSecretConfig) GenerateCertificateWithSigner(signer crypto.Signer) (*Certificate, error) {
+	if s.CertType == "" {
+		return nil, fmt.Errorf("certificate type must be specified when generating certificate %q with a signer", s.Name)
+	}
+
+	var (
+		certificate       = s.generateCertificateTemplate()
+		certificateSigner = certificate
+		privateKeySigner  = signer
+	)
+
+	if s.SigningCA != nil {
+		certificateSigner = s.SigningCA.Certificate
+		privateKeySigner = s.SigningCA.signer()
+	}
+
+	certificatePEM, err := signCertificate(certificate, signer.Public(), certificateSigner, privateKeySigner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Certificate{
+		Name:                              s.Name,
+		CA:                                s.SigningCA,
+		CertType:                          s.CertType,
+		SkipPublishingCACertificate:       s.SkipPublishingCACertificate,
+		IncludeCACertificateInServerChain: s.IncludeCACertificateInServerChain,
+
+		Signer: signer,
+
+		Certificate:    certificate,
+		CertificatePEM: certificatePEM,
+	}, nil
+}
+
+// signer returns the signer for signing certificates with this certificate's private key.
+func (c *Certificate) signer() crypto.Signer {
+	if c.Signer != nil {
+		return c.Signer
+	}
+	return c.PrivateKey
+}
+
 // SecretData computes the data map which can be used in a Kubernetes secret.
 func (c *Certificate) SecretData() map[string][]byte {
 	data := map[string][]byte{}
@@ -162,7 +213,9 @@ func (c *Certificate) SecretData() map[string][]byte {
 		// The certificate is a CA certificate itself, so we use different keys in the secret data (for backwards-
 		// compatibility).
 		data[DataKeyCertificateCA] = c.CertificatePEM
-		data[DataKeyPrivateKeyCA] = c.PrivateKeyPEM
+		if c.PrivateKeyPEM != nil {
+			data[DataKeyPrivateKeyCA] = c.PrivateKeyPEM
+		}
 
 	case c.CA != nil:
 		cert := c.CertificatePEM
@@ -252,10 +305,10 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 }
 
 // SignCertificate takes a <certificateTemplate> and a <certificateTemplateSigner> which is used to sign
-// the first. It also requires the corresponding private keys of both certificates. The created certificate
-// is returned as byte slice.
-func signCertificate(certificateTemplate *x509.Certificate, privateKey *rsa.PrivateKey, certificateTemplateSigner *x509.Certificate, privateKeySigner *rsa.PrivateKey) ([]byte, error) {
-	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, &privateKey.PublicKey, privateKeySigner)
+// the first. It also requires the public key of the first and the signer for the private key of the second
+// certificate. The created certificate is returned as byte slice.
+func signCertificate(certificateTemplate *x509.Certificate, publicKey crypto.PublicKey, certificateTemplateSigner *x509.Certificate, privateKeySigner crypto.Signer) ([]byte, error) {
+	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, publicKey, privateKeySigner)
 	if err != nil {
 		return nil, err
 	}
@@ -5,9 +5,13 @@
 package secrets_test
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/gardener/gardener/pkg/utils"
 	. "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
@@ -38,6 +42,41 @@ var _ = Describe("Certificate Secrets", func() {
 				Expect(certificate.CA).To(BeNil())
 			})
 		})
+
+		Describe("#GenerateCertificateWithSigner", func() {
+			It("should generate a CA certificate without private key and sign certificates with the signer", func() {
+				privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+				Expect(err).NotTo(HaveOccurred())
+
+				ca, err := certificateConfig.GenerateCertificateWithSigner(privateKey)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ca.PrivateKey).To(BeNil())
+				Expect(ca.PrivateKeyPEM).To(BeNil())
+				Expect(ca.SecretData()).To(Equal(map[string][]byte{"ca.crt": ca.CertificatePEM}))
+
+				caCertificate, err := utils.DecodeCertificate(ca.CertificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(caCertificate.PublicKey).To(Equal(&privateKey.PublicKey))
+
+				certificate, err := (&CertificateSecretConfig{
+					Name:       "server",
+					CommonName: "server",
+					CertType:   ServerCert,
+					SigningCA:  ca,
+				}).GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+				serverCertificate, err := utils.DecodeCertificate(certificate.CertificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serverCertificate.CheckSignatureFrom(caCertificate)).To(Succeed())
+			})
+
+			It("should fail if no certificate type is specified", func() {
+				certificateConfig.CertType = ""
+
+				_, err := certificateConfig.GenerateCertificateWithSigner(nil)
+				Expect(err).To(HaveOccurred())
+			})
+		})
 	})
 
 	Describe("Certificate Object", func() {
@@ -6,6 +6,7 @@ package manager
 
 import (
 	"context"
+	"fmt"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -34,6 +35,12 @@ func (m *manager) Cleanup(ctx context.Context) error {
 		}
 
 		fns = append(fns, func(ctx context.Context) error {
+			// The private key is deleted first, so that the secret keeps the reference to it until it is gone and the
+			// deletion can be retried with the next cleanup if it fails.
+			if err := m.deleteKey(ctx, &secret); err != nil {
+				return fmt.Errorf("failed deleting private key referenced by secret %s: %w", client.ObjectKeyFromObject(&secret), err)
+			}
+
 			m.logger.Info("Deleting stale secret", "secret", client.ObjectKeyFromObject(&secret))
 			return client.IgnoreNotFound(m.client.Delete(ctx, &secret))
 		})
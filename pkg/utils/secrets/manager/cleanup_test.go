@@ -6,6 +6,7 @@ package manager
 
 import (
 	"context"
+	"errors"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -17,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/utils/secrets/manager/keystore"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -108,6 +110,56 @@ var _ = Describe("Cleanup", func() {
 			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secretsInNamespace2[7]), &corev1.Secret{})).To(BeNotFoundError())
 		})
 
+		It("should delete the private keys of stale secrets from the key store", func() {
+			keyStore := keystore.NewInMemory()
+			mgr, err := New(ctx, logr.Discard(), clock.RealClock{}, fakeClient, testIdentity, WithNamespaces(namespace), WithKeyStore(keyStore))
+			Expect(err).NotTo(HaveOccurred())
+			m = mgr.(*manager)
+
+			ref, err := keyStore.CreateKey(ctx, "ca")
+			Expect(err).NotTo(HaveOccurred())
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ca",
+					Namespace: namespace,
+					Labels:    map[string]string{"name": "ca", "managed-by": "secrets-manager", "manager-identity": testIdentity},
+				},
+				Data: map[string][]byte{"ca.crt": []byte("cert"), "ca.key.ref": []byte(ref)},
+			}
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+
+			Expect(m.Cleanup(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(BeNotFoundError())
+			Expect(keyStore.Has(ref)).To(BeFalse())
+		})
+
+		It("should keep stale secrets if their private keys cannot be deleted from the key store", func() {
+			keyStore := &failingDeleteKeyStore{InMemory: keystore.NewInMemory()}
+			mgr, err := New(ctx, logr.Discard(), clock.RealClock{}, fakeClient, testIdentity, WithNamespaces(namespace), WithKeyStore(keyStore))
+			Expect(err).NotTo(HaveOccurred())
+			m = mgr.(*manager)
+
+			ref, err := keyStore.CreateKey(ctx, "ca")
+			Expect(err).NotTo(HaveOccurred())
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ca",
+					Namespace: namespace,
+					Labels:    map[string]string{"name": "ca", "managed-by": "secrets-manager", "manager-identity": testIdentity},
+				},
+				Data: map[string][]byte{"ca.crt": []byte("cert"), "ca.key.ref": []byte(ref)},
+			}
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+
+			Expect(m.Cleanup(ctx)).To(MatchError(ContainSubstring("key store unavailable")))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+			Expect(keyStore.Has(ref)).To(BeTrue())
+		})
+
 		It("should not touch secrets from other manager instance", func() {
 			secrets := secretList(testIdentity, "other")
 			for i := range secrets {
@@ -122,3 +174,11 @@ var _ = Describe("Cleanup", func() {
 		})
 	})
 })
+
+type failingDeleteKeyStore struct {
+	*keystore.InMemory
+}
+
+func (f *failingDeleteKeyStore) DeleteKey(_ context.Context, _ string) error {
+	return errors.New("key store unavailable")
+}
@@ -64,7 +64,7 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 			return nil, fmt.Errorf("failed reading secret %s for config %s: %w", client.ObjectKeyFromObject(secret), config.GetName(), err)
 		}
 
-		secret, err = m.generateAndCreate(ctx, config, objectMeta)
+		secret, err = m.generateAndCreate(ctx, config, objectMeta, options)
 		if err != nil {
 			return nil, fmt.Errorf("failed generating and creating new secret %s for config %s: %w", client.ObjectKey{Name: objectMeta.Name, Namespace: objectMeta.Namespace}, config.GetName(), err)
 		}
@@ -99,24 +99,34 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 	return secret, nil
 }
 
-func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta) (*corev1.Secret, error) {
+func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta, options *GenerateOptions) (*corev1.Secret, error) {
 	// Use secret name as common name to make sure the x509 subject names in the CA certificates are always unique.
 	if certConfig := certificateSecretConfig(config); certConfig != nil && certConfig.CertType == secretsutils.CACert {
 		certConfig.CommonName = objectMeta.Name
 	}
 
-	data, err := config.Generate()
+	dataMap, err := m.existingSecretData(ctx, config.GetName(), objectMeta.Namespace)
 	if err != nil {
-		return nil, fmt.Errorf("failed generating data: %w", err)
+		return nil, fmt.Errorf("failed taking over data from existing secret when needed: %w", err)
 	}
 
-	dataMap, err := m.keepExistingSecretsIfNeeded(ctx, config.GetName(), data.SecretData(), objectMeta.Namespace)
-	if err != nil {
-		return nil, fmt.Errorf("failed taking over data from existing secret when needed: %w", err)
+	generated := dataMap == nil
+	if generated {
+		if dataMap, err = m.generateData(ctx, config, objectMeta.Name, options); err != nil {
+			return nil, fmt.Errorf("failed generating data: %w", err)
+		}
 	}
 
 	secret := Secret(objectMeta, dataMap)
 	if err := m.client.Create(ctx, secret); err != nil {
+		// A newly created private key in the key store is not referenced by any secret if the secret could not be
+		// created.
+		if generated {
+			if deleteErr := m.deleteKey(ctx, secret); deleteErr != nil {
+				m.logger.Error(deleteErr, "Failed deleting unused private key from key store", "secret", client.ObjectKeyFromObject(secret))
+			}
+		}
+
 		if !apierrors.IsAlreadyExists(err) {
 			return nil, fmt.Errorf("failed creating new secret: %w", err)
 		}
@@ -130,7 +140,22 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 	return secret, nil
 }
 
-func (m *manager) keepExistingSecretsIfNeeded(ctx context.Context, configName string, newData map[string][]byte, namespace string) (map[string][]byte, error) {
+func (m *manager) generateData(ctx context.Context, config secretsutils.ConfigInterface, name string, options *GenerateOptions) (map[string][]byte, error) {
+	if caConfig, ok := config.(*secretsutils.CertificateSecretConfig); ok && caConfig.CertType == secretsutils.CACert &&
+		m.opts.KeyStore != nil && !options.KeepPrivateKeyInSecret {
+		return m.generateCAWithKeyStore(ctx, caConfig, name)
+	}
+
+	data, err := config.Generate()
+	if err != nil {
+		return nil, err
+	}
+	return data.SecretData(), nil
+}
+
+// existingSecretData returns the data of an existing secret which should be used instead of generating a fresh secret
+// for the given config name. It returns nil if there is no such secret.
+func (m *manager) existingSecretData(ctx context.Context, configName string, namespace string) (map[string][]byte, error) {
 	existingSecrets := &corev1.SecretList{}
 	if err := m.client.List(ctx, existingSecrets, client.InNamespace(namespace), client.MatchingLabels{LabelKeyUseDataForName: configName}); err != nil {
 		return nil, err
@@ -144,7 +169,7 @@ func (m *manager) keepExistingSecretsIfNeeded(ctx context.Context, configName st
 		return existingSecrets.Items[0].Data, nil
 	}
 
-	return newData, nil
+	return nil, nil
 }
 
 func (m *manager) shouldIgnoreOldSecrets(issuedAt string, options *GenerateOptions) (bool, error) {
@@ -394,6 +419,9 @@ type GenerateOptions struct {
 	Namespace string
 	// Labels are additional labels that should be added to the secret.
 	Labels map[string]string
+	// KeepPrivateKeyInSecret specifies that the private key of a CA should be stored in the secret even if a key store
+	// is configured. This is required for CAs whose private key is consumed by other components.
+	KeepPrivateKeyInSecret bool
 
 	signingCAChecksum *string
 	isBundleSecret    bool
@@ -519,7 +547,7 @@ func SignedByCA(name string, opts ...SignedByCAOption) GenerateOption {
 			}
 		}
 
-		ca, err := secretsutils.LoadCertificate(name, secret.obj.Data[secretsutils.DataKeyPrivateKeyCA], secret.obj.Data[secretsutils.DataKeyCertificateCA])
+		ca, err := mgr.loadCA(name, secret.obj.Data)
 		if err != nil {
 			return err
 		}
@@ -629,6 +657,14 @@ func Namespace(namespace string) GenerateOption {
 	}
 }
 
+// KeepPrivateKeyInSecret returns a function which sets the 'KeepPrivateKeyInSecret' field to true.
+func KeepPrivateKeyInSecret() GenerateOption {
+	return func(_ Interface, _ secretsutils.ConfigInterface, options *GenerateOptions) error {
+		options.KeepPrivateKeyInSecret = true
+		return nil
+	}
+}
+
 // WithLabels returns a function which sets the 'Labels' field.
 func WithLabels(labels map[string]string) GenerateOption {
 	return func(_ Interface, _ secretsutils.ConfigInterface, options *GenerateOptions) error {
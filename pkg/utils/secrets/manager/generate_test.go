@@ -6,6 +6,7 @@ package manager
 
 import (
 	"context"
+	"crypto/rsa"
 	"fmt"
 	"sort"
 	"strconv"
@@ -23,7 +24,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/utils"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/secrets/manager/keystore"
 	"github.com/gardener/gardener/pkg/utils/test"
 )
 
@@ -1029,6 +1032,158 @@ var _ = Describe("Generate", func() {
 			})
 		})
 
+		Context("with key store", func() {
+			var (
+				keyStore                   *keystore.InMemory
+				newCAConfig                func() *secretsutils.CertificateSecretConfig
+				serverConfig               *secretsutils.CertificateSecretConfig
+				caName, serverName         = "ca", "server"
+				expectSignedByCA           func(*corev1.Secret, *corev1.Secret)
+				expectPrivateKeyInKeyStore func(*corev1.Secret) string
+			)
+
+			BeforeEach(func() {
+				keyStore = keystore.NewInMemory()
+
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, WithNamespaces(namespace), WithKeyStore(keyStore))
+				Expect(err).NotTo(HaveOccurred())
+				m = mgr.(*manager)
+
+				// Generate sets the common name of CA configs, hence, use fresh configs for identifying the same CA secret.
+				newCAConfig = func() *secretsutils.CertificateSecretConfig {
+					return &secretsutils.CertificateSecretConfig{
+						Name:       caName,
+						CommonName: caName,
+						CertType:   secretsutils.CACert,
+					}
+				}
+				serverConfig = &secretsutils.CertificateSecretConfig{
+					Name:       serverName,
+					CommonName: serverName,
+					CertType:   secretsutils.ServerCert,
+				}
+
+				expectSignedByCA = func(caSecret, secret *corev1.Secret) {
+					caCertificate, err := utils.DecodeCertificate(caSecret.Data["ca.crt"])
+					ExpectWithOffset(1, err).NotTo(HaveOccurred())
+					certificate, err := utils.DecodeCertificate(secret.Data["tls.crt"])
+					ExpectWithOffset(1, err).NotTo(HaveOccurred())
+					ExpectWithOffset(1, certificate.Issuer.CommonName).To(Equal(caCertificate.Subject.CommonName))
+					ExpectWithOffset(1, certificate.CheckSignatureFrom(caCertificate)).To(Succeed())
+				}
+
+				expectPrivateKeyInKeyStore = func(caSecret *corev1.Secret) string {
+					ExpectWithOffset(1, caSecret.Data).To(HaveKey("ca.crt"))
+					ExpectWithOffset(1, caSecret.Data).NotTo(HaveKey("ca.key"))
+					ExpectWithOffset(1, caSecret.Data).To(HaveKey("ca.key.ref"))
+
+					ref := string(caSecret.Data["ca.key.ref"])
+					ExpectWithOffset(1, keyStore.Has(ref)).To(BeTrue())
+					return ref
+				}
+			})
+
+			It("should create the private key of a CA in the key store and sign certificates with it", func() {
+				By("Generate CA secret")
+				caSecret, err := m.Generate(ctx, newCAConfig())
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, caSecret)
+				expectPrivateKeyInKeyStore(caSecret)
+
+				caCertificate, err := utils.DecodeCertificate(caSecret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(caCertificate.CheckSignatureFrom(caCertificate)).To(Succeed())
+
+				By("Generate server secret")
+				serverSecret, err := m.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serverSecret.Data).To(HaveKey("tls.key"))
+				expectSignedByCA(caSecret, serverSecret)
+			})
+
+			It("should keep the private key in the key store when the CA is rotated", func() {
+				caSecret, err := m.Generate(ctx, newCAConfig(), Rotate(KeepOld))
+				Expect(err).NotTo(HaveOccurred())
+				oldRef := expectPrivateKeyInKeyStore(caSecret)
+
+				By("Rotate CA")
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, WithNamespaces(namespace), WithKeyStore(keyStore), WithSecretNamesToTimes(map[string]time.Time{caName: time.Now()}))
+				Expect(err).NotTo(HaveOccurred())
+				m = mgr.(*manager)
+
+				newCASecret, err := m.Generate(ctx, newCAConfig(), Rotate(KeepOld))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(expectPrivateKeyInKeyStore(newCASecret)).NotTo(Equal(oldRef))
+
+				By("Sign server certificate with old CA")
+				serverSecret, err := m.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).NotTo(HaveOccurred())
+				expectSignedByCA(caSecret, serverSecret)
+			})
+
+			It("should store the private key in the secret if requested", func() {
+				caSecret, err := m.Generate(ctx, newCAConfig(), KeepPrivateKeyInSecret())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(caSecret.Data).To(HaveKey("ca.key"))
+				Expect(caSecret.Data).NotTo(HaveKey("ca.key.ref"))
+
+				serverSecret, err := m.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).NotTo(HaveOccurred())
+				expectSignedByCA(caSecret, serverSecret)
+			})
+
+			It("should sign certificates with CAs whose private key is stored in the secret", func() {
+				By("Generate CA secret without key store")
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, WithNamespaces(namespace))
+				Expect(err).NotTo(HaveOccurred())
+				caSecret, err := mgr.Generate(ctx, newCAConfig())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(caSecret.Data).To(HaveKey("ca.key"))
+
+				By("Generate server secret with key store")
+				caSecretWithKeyStore, err := m.Generate(ctx, newCAConfig())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(caSecretWithKeyStore.Name).To(Equal(caSecret.Name))
+				serverSecret, err := m.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).NotTo(HaveOccurred())
+				expectSignedByCA(caSecret, serverSecret)
+			})
+
+			It("should fail signing certificates if the key store is not configured", func() {
+				_, err := m.Generate(ctx, newCAConfig())
+				Expect(err).NotTo(HaveOccurred())
+
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, WithNamespaces(namespace))
+				Expect(err).NotTo(HaveOccurred())
+				_, err = mgr.Generate(ctx, newCAConfig())
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = mgr.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).To(MatchError(ContainSubstring(`private key of CA "ca" is kept in an external key store, but no key store is configured`)))
+			})
+
+			It("should fail signing certificates if the private key does not match the CA certificate", func() {
+				caSecret, err := m.Generate(ctx, newCAConfig())
+				Expect(err).NotTo(HaveOccurred())
+				ref := expectPrivateKeyInKeyStore(caSecret)
+
+				DeferCleanup(test.WithVar(&secretsutils.GenerateKey, rsa.GenerateKey))
+				Expect(keyStore.DeleteKey(ctx, ref)).To(Succeed())
+				otherKeyStore := keystore.NewInMemory()
+				otherRef, err := otherKeyStore.CreateKey(ctx, caSecret.Name)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(otherRef).To(Equal(ref))
+
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, WithNamespaces(namespace), WithKeyStore(otherKeyStore))
+				Expect(err).NotTo(HaveOccurred())
+				_, err = mgr.Generate(ctx, newCAConfig())
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = mgr.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).To(MatchError(ContainSubstring(`does not match the certificate of CA "ca"`)))
+			})
+		})
+
 		Context("adoption of existing secret data", func() {
 			var (
 				oldData = map[string][]byte{"id_rsa": []byte("some-old-data")}
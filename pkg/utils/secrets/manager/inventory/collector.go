@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MetricNameCertificateExpirationTimestampSeconds is the name of the metric exposing the expiration timestamps of
+// certificates managed by secrets managers (without metric namespace).
+const MetricNameCertificateExpirationTimestampSeconds = "secrets_manager_certificate_expiration_timestamp_seconds"
+
+// collectTimeout is the timeout for listing the secrets when the metrics are collected.
+const collectTimeout = 10 * time.Second
+
+type collector struct {
+	log      logr.Logger
+	reader   client.Reader
+	listOpts []client.ListOption
+
+	expiration *prometheus.Desc
+}
+
+// NewCollector returns a new prometheus collector exposing the expiration timestamps of all certificates managed by
+// secrets managers (see List). The given list options can be used to restrict the listed secrets, e.g. to a namespace.
+// The reader should be backed by a cache since the secrets are listed whenever the metrics are collected.
+func NewCollector(log logr.Logger, reader client.Reader, metricNamespace string, listOpts ...client.ListOption) prometheus.Collector {
+	return &collector{
+		log:      log,
+		reader:   reader,
+		listOpts: listOpts,
+
+		expiration: prometheus.NewDesc(
+			prometheus.BuildFQName(metricNamespace, "", MetricNameCertificateExpirationTimestampSeconds),
+			"Expiration timestamp of certificates managed by secrets managers in seconds since epoch.",
+			[]string{
+				"namespace",
+				"secret",
+				"name",
+				"manager_identity",
+				"subject",
+				"issuer",
+				"ca",
+				"rotation",
+			},
+			nil,
+		),
+	}
+}
+
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.expiration
+}
+
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	certificates, err := List(ctx, c.reader, c.listOpts...)
+	if err != nil {
+		c.log.Error(err, "Failed listing certificates managed by secrets managers")
+	}
+
+	for _, certificate := range certificates {
+		ch <- prometheus.MustNewConstMetric(
+			c.expiration,
+			prometheus.GaugeValue,
+			float64(certificate.NotAfter.Unix()),
+			certificate.Namespace,
+			certificate.SecretName,
+			certificate.Name,
+			certificate.ManagerIdentity,
+			certificate.Subject,
+			certificate.Issuer,
+			strconv.FormatBool(certificate.IsCA),
+			certificate.RotationState,
+		)
+	}
+}
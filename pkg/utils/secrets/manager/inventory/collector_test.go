@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory_test
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	. "github.com/gardener/gardener/pkg/utils/secrets/manager/inventory"
+)
+
+var _ = Describe("Collector", func() {
+	var (
+		ctx        = context.TODO()
+		namespace  = "garden"
+		fakeClient client.Client
+		caSecret   *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+
+		mgr, err := secretsmanager.New(ctx, logr.Discard(), clock.RealClock{}, fakeClient, "test", secretsmanager.WithNamespaces(namespace))
+		Expect(err).NotTo(HaveOccurred())
+
+		caSecret, err = mgr.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should collect the expiration timestamps of all certificates", func() {
+		certificates, err := List(ctx, fakeClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(certificates).To(HaveLen(1))
+
+		Expect(testutil.CollectAndCompare(NewCollector(logr.Discard(), fakeClient, "test"), strings.NewReader(`
+# HELP test_secrets_manager_certificate_expiration_timestamp_seconds Expiration timestamp of certificates managed by secrets managers in seconds since epoch.
+# TYPE test_secrets_manager_certificate_expiration_timestamp_seconds gauge
+test_secrets_manager_certificate_expiration_timestamp_seconds{ca="true",issuer="`+caSecret.Name+`",manager_identity="test",name="ca",namespace="garden",rotation="current",secret="`+caSecret.Name+`",subject="`+caSecret.Name+`"} `+strconv.FormatInt(certificates[0].NotAfter.Unix(), 10)+`
+`))).To(Succeed())
+	})
+
+	It("should still collect the decodable certificates if some secrets are broken", func() {
+		Expect(fakeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "broken",
+				Namespace: namespace,
+				Labels:    map[string]string{secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager},
+			},
+			Data: map[string][]byte{"ca.crt": []byte("foo")},
+		})).To(Succeed())
+
+		collector := NewCollector(logr.Discard(), fakeClient, "test")
+		Expect(testutil.CollectAndCount(collector)).To(Equal(1))
+		Expect(testutil.CollectAndLint(collector)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// RotationStateCurrent is the rotation state of the newest certificate for a name, i.e., the one currently used
+	// for signing or serving.
+	RotationStateCurrent = "current"
+	// RotationStateOld is the rotation state of older certificates for a name. Old CA certificates exist while a CA
+	// rotation is in progress, old non-CA certificates only until the next cleanup of the secrets manager.
+	RotationStateOld = "old"
+)
+
+// Certificate describes a certificate generated by a secrets manager.
+type Certificate struct {
+	// Namespace is the namespace of the secret.
+	Namespace string
+	// SecretName is the name of the secret.
+	SecretName string
+	// Name is the name of the secret config used by the secrets manager (the name of the secret without suffix).
+	Name string
+	// ManagerIdentity is the identity of the secrets manager instance managing the secret.
+	ManagerIdentity string
+	// Subject is the common name of the certificate subject.
+	Subject string
+	// Issuer is the common name of the certificate issuer.
+	Issuer string
+	// IsCA is true if the certificate is a certificate authority.
+	IsCA bool
+	// NotBefore is the time from which on the certificate is valid.
+	NotBefore time.Time
+	// NotAfter is the time when the certificate expires.
+	NotAfter time.Time
+	// LastRotationInitiationTime is the unix timestamp of the last rotation initiation of the certificate (if any).
+	LastRotationInitiationTime string
+	// RotationState is either RotationStateCurrent or RotationStateOld.
+	RotationState string
+}
+
+// List lists all certificates in secrets managed by secrets managers. Bundle secrets are skipped since they only
+// contain certificates which are already part of other secrets. The result is sorted by namespace and secret name.
+// Secrets whose certificates cannot be decoded are skipped as well, the returned error contains the reasons. Hence,
+// callers should consider the returned certificates even if an error is returned.
+func List(ctx context.Context, reader client.Reader, opts ...client.ListOption) ([]Certificate, error) {
+	secretList := &corev1.SecretList{}
+	if err := reader.List(ctx, secretList, append([]client.ListOption{client.MatchingLabels{
+		secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager,
+	}}, opts...)...); err != nil {
+		return nil, fmt.Errorf("failed listing secrets: %w", err)
+	}
+
+	var (
+		certificates []Certificate
+		newest       = make(map[string]int)
+		errs         []error
+	)
+
+	for _, secret := range secretList.Items {
+		if _, ok := secret.Labels[secretsmanager.LabelKeyBundleFor]; ok {
+			continue
+		}
+
+		certificate, err := certificateFromSecret(&secret)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if certificate == nil {
+			continue
+		}
+
+		key := certificate.Namespace + "/" + certificate.ManagerIdentity + "/" + certificate.Name
+		if i, ok := newest[key]; !ok || certificates[i].NotBefore.Before(certificate.NotBefore) {
+			newest[key] = len(certificates)
+		}
+
+		certificates = append(certificates, *certificate)
+	}
+
+	for i := range certificates {
+		certificates[i].RotationState = RotationStateOld
+	}
+	for _, i := range newest {
+		certificates[i].RotationState = RotationStateCurrent
+	}
+
+	sort.Slice(certificates, func(i, j int) bool {
+		if certificates[i].Namespace != certificates[j].Namespace {
+			return certificates[i].Namespace < certificates[j].Namespace
+		}
+		return certificates[i].SecretName < certificates[j].SecretName
+	})
+
+	return certificates, errors.Join(errs...)
+}
+
+func certificateFromSecret(secret *corev1.Secret) (*Certificate, error) {
+	var (
+		data []byte
+		isCA bool
+	)
+
+	switch {
+	case secret.Data[secretsutils.DataKeyCertificate] != nil:
+		data = secret.Data[secretsutils.DataKeyCertificate]
+	case secret.Data[secretsutils.DataKeyCertificateCA] != nil:
+		data, isCA = secret.Data[secretsutils.DataKeyCertificateCA], true
+	default:
+		return nil, nil
+	}
+
+	x509Certificate, err := utils.DecodeCertificate(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding certificate of secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	name := secret.Labels[secretsmanager.LabelKeyName]
+	if name == "" {
+		name = secret.Name
+	}
+
+	return &Certificate{
+		Namespace:                  secret.Namespace,
+		SecretName:                 secret.Name,
+		Name:                       name,
+		ManagerIdentity:            secret.Labels[secretsmanager.LabelKeyManagerIdentity],
+		Subject:                    x509Certificate.Subject.CommonName,
+		Issuer:                     x509Certificate.Issuer.CommonName,
+		IsCA:                       isCA || isCACertificate(x509Certificate),
+		NotBefore:                  x509Certificate.NotBefore,
+		NotAfter:                   x509Certificate.NotAfter,
+		LastRotationInitiationTime: secret.Labels[secretsmanager.LabelKeyLastRotationInitiationTime],
+	}, nil
+}
+
+func isCACertificate(certificate *x509.Certificate) bool {
+	return certificate.BasicConstraintsValid && certificate.IsCA
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory_test
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	. "github.com/gardener/gardener/pkg/utils/secrets/manager/inventory"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Inventory", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+		identity  = "test"
+
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+
+		caSecretOld, caSecretCurrent, serverSecret *corev1.Secret
+	)
+
+	newCAConfig := func() *secretsutils.CertificateSecretConfig {
+		return &secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert}
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+		DeferCleanup(test.WithVar(&secretsutils.Clock, fakeClock))
+
+		mgr, err := secretsmanager.New(ctx, logr.Discard(), fakeClock, fakeClient, identity, secretsmanager.WithNamespaces(namespace))
+		Expect(err).NotTo(HaveOccurred())
+
+		caSecretOld, err = mgr.Generate(ctx, newCAConfig(), secretsmanager.Rotate(secretsmanager.KeepOld))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = mgr.Generate(ctx, &secretsutils.BasicAuthSecretConfig{Name: "basic-auth", Format: secretsutils.BasicAuthFormatNormal, Username: "foo", PasswordLength: 32})
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeClock.Step(time.Hour)
+
+		mgr, err = secretsmanager.New(ctx, logr.Discard(), fakeClock, fakeClient, identity,
+			secretsmanager.WithNamespaces(namespace),
+			secretsmanager.WithSecretNamesToTimes(map[string]time.Time{"ca": fakeClock.Now()}),
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		caSecretCurrent, err = mgr.Generate(ctx, newCAConfig(), secretsmanager.Rotate(secretsmanager.KeepOld))
+		Expect(err).NotTo(HaveOccurred())
+
+		serverSecret, err = mgr.Generate(ctx, &secretsutils.CertificateSecretConfig{
+			Name:                        "server",
+			CommonName:                  "server",
+			CertType:                    secretsutils.ServerCert,
+			Validity:                    ptr.To(24 * time.Hour),
+			SkipPublishingCACertificate: true,
+		}, secretsmanager.SignedByCA("ca"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "unmanaged", Namespace: namespace},
+			Data:       caSecretOld.Data,
+		})).To(Succeed())
+	})
+
+	Describe("#List", func() {
+		It("should list all certificates managed by secrets managers", func() {
+			certificates, err := List(ctx, fakeClient)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(certificates).To(HaveExactElements(
+				MatchFields(IgnoreExtras, Fields{
+					"Namespace":                  Equal(namespace),
+					"SecretName":                 Equal(caSecretOld.Name),
+					"Name":                       Equal("ca"),
+					"ManagerIdentity":            Equal(identity),
+					"Subject":                    Equal(caSecretOld.Name),
+					"Issuer":                     Equal(caSecretOld.Name),
+					"IsCA":                       BeTrue(),
+					"NotAfter":                   BeTemporally("~", fakeClock.Now().Add(-time.Hour).AddDate(10, 0, 0), time.Minute),
+					"LastRotationInitiationTime": BeEmpty(),
+					"RotationState":              Equal(RotationStateOld),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"SecretName":                 Equal(caSecretCurrent.Name),
+					"Name":                       Equal("ca"),
+					"Subject":                    Equal(caSecretCurrent.Name),
+					"IsCA":                       BeTrue(),
+					"LastRotationInitiationTime": Equal(strconv.FormatInt(fakeClock.Now().Unix(), 10)),
+					"RotationState":              Equal(RotationStateCurrent),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"SecretName":    Equal(serverSecret.Name),
+					"Name":          Equal("server"),
+					"Subject":       Equal("server"),
+					"Issuer":        Equal(caSecretOld.Name),
+					"IsCA":          BeFalse(),
+					"NotAfter":      BeTemporally("~", fakeClock.Now().Add(24*time.Hour), time.Minute),
+					"RotationState": Equal(RotationStateCurrent),
+				}),
+			))
+		})
+
+		It("should respect the list options", func() {
+			Expect(List(ctx, fakeClient, client.InNamespace("other"))).To(BeEmpty())
+		})
+
+		It("should skip certificates which cannot be decoded", func() {
+			serverSecret.Data["tls.crt"] = []byte("foo")
+			Expect(fakeClient.Update(ctx, serverSecret)).To(Succeed())
+
+			certificates, err := List(ctx, fakeClient)
+			Expect(err).To(MatchError(ContainSubstring("failed decoding certificate of secret " + namespace + "/" + serverSecret.Name)))
+			Expect(certificates).To(HaveLen(2))
+		})
+	})
+})
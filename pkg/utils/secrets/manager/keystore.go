@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// DataKeyPrivateKeyCAReference is the key in the data of a CA secret holding the reference to the private key in the
+// external key store. Such secrets do not contain the private key itself.
+const DataKeyPrivateKeyCAReference = "ca.key.ref"
+
+// KeyStore is an external storage and signing backend for the private keys of certificate authorities, e.g. a KMS or
+// an HSM. The private keys are created in the key store and never leave it, i.e., certificates are signed via the key
+// store and the secrets only contain the CA certificates and the references to the private keys.
+type KeyStore interface {
+	// CreateKey creates a new private key for the certificate authority with the given name and returns an opaque
+	// reference to it.
+	CreateKey(ctx context.Context, name string) (string, error)
+	// Signer returns a signer for the private key with the given reference. Implementations should not contact the
+	// backend before actually signing something as signers are also requested for unchanged certificates.
+	Signer(ref string) (crypto.Signer, error)
+	// DeleteKey deletes the private key with the given reference. It must not return an error if the key does not exist.
+	DeleteKey(ctx context.Context, ref string) error
+}
+
+func (m *manager) generateCAWithKeyStore(ctx context.Context, config *secretsutils.CertificateSecretConfig, name string) (map[string][]byte, error) {
+	ref, err := m.opts.KeyStore.CreateKey(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating private key in key store: %w", err)
+	}
+
+	signer, err := m.opts.KeyStore.Signer(ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting signer for private key %q: %w", ref, err)
+	}
+
+	certificate, err := config.GenerateCertificateWithSigner(signer)
+	if err != nil {
+		return nil, fmt.Errorf("failed generating certificate with private key %q: %w", ref, err)
+	}
+
+	data := certificate.SecretData()
+	data[DataKeyPrivateKeyCAReference] = []byte(ref)
+	return data, nil
+}
+
+func (m *manager) loadCA(name string, data map[string][]byte) (*secretsutils.Certificate, error) {
+	ref, ok := data[DataKeyPrivateKeyCAReference]
+	if !ok {
+		return secretsutils.LoadCertificate(name, data[secretsutils.DataKeyPrivateKeyCA], data[secretsutils.DataKeyCertificateCA])
+	}
+
+	if m.opts.KeyStore == nil {
+		return nil, fmt.Errorf("private key of CA %q is kept in an external key store, but no key store is configured", name)
+	}
+
+	certificate, err := utils.DecodeCertificate(data[secretsutils.DataKeyCertificateCA])
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := m.opts.KeyStore.Signer(string(ref))
+	if err != nil {
+		return nil, fmt.Errorf("failed getting signer for private key %q of CA %q: %w", ref, name, err)
+	}
+
+	if publicKey, ok := certificate.PublicKey.(interface{ Equal(crypto.PublicKey) bool }); !ok || !publicKey.Equal(signer.Public()) {
+		return nil, fmt.Errorf("private key %q does not match the certificate of CA %q", ref, name)
+	}
+
+	return &secretsutils.Certificate{
+		Name: name,
+
+		Signer: signer,
+
+		Certificate:    certificate,
+		CertificatePEM: data[secretsutils.DataKeyCertificateCA],
+	}, nil
+}
+
+func (m *manager) deleteKey(ctx context.Context, secret *corev1.Secret) error {
+	ref, ok := secret.Data[DataKeyPrivateKeyCAReference]
+	if !ok {
+		return nil
+	}
+
+	if m.opts.KeyStore == nil {
+		m.logger.Info("Cannot delete private key referenced by secret since no key store is configured", "secret", client.ObjectKeyFromObject(secret), "privateKeyReference", string(ref))
+		return nil
+	}
+
+	m.logger.Info("Deleting private key referenced by secret from key store", "secret", client.ObjectKeyFromObject(secret), "privateKeyReference", string(ref))
+	return m.opts.KeyStore.DeleteKey(ctx, string(ref))
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keystore
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// Directory is an implementation of the secrets manager's key store which keeps the private keys as unencrypted PEM
+// files in a directory. It does not protect the private keys by itself, it only keeps them out of the secrets of the
+// cluster. Hence, the directory should be a volume which is only mounted into the component using the secrets manager
+// and whose disk is encrypted. Since keys are created and deleted when CAs are rotated, the volume must be writable and
+// persistent, and it must be shared by all replicas of the component (e.g., a ReadWriteMany PersistentVolume).
+// Read-only volumes, e.g., provided by the CSI driver of a secret store, are not suitable.
+type Directory struct {
+	path string
+}
+
+// NewDirectory returns a new key store which keeps the private keys in the directory with the given path.
+func NewDirectory(path string) *Directory {
+	return &Directory{path: path}
+}
+
+// CreateKey creates a new 3072-bit RSA private key, writes it to a new file in the directory and returns the name of
+// the file as reference to it.
+func (d *Directory) CreateKey(_ context.Context, name string) (string, error) {
+	privateKey, err := secretsutils.GenerateKey(rand.Reader, 3072)
+	if err != nil {
+		return "", err
+	}
+
+	suffix, err := utils.GenerateRandomStringFromCharset(8, "0123456789abcdefghijklmnopqrstuvwxyz")
+	if err != nil {
+		return "", err
+	}
+	ref := name + "-" + suffix + ".key"
+
+	// The key is written to a hidden file first, so that an interrupted write does not leave a corrupt key behind.
+	tmpPath := filepath.Join(d.path, "."+ref)
+	if err := os.WriteFile(tmpPath, utils.EncodePrivateKey(privateKey), 0600); err != nil {
+		return "", fmt.Errorf("failed writing private key: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, filepath.Join(d.path, ref)); err != nil {
+		return "", errors.Join(fmt.Errorf("failed writing private key: %w", err), os.Remove(tmpPath))
+	}
+
+	return ref, nil
+}
+
+// Signer returns a signer for the private key with the given reference.
+func (d *Directory) Signer(ref string) (crypto.Signer, error) {
+	path, err := d.pathFor(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading private key %q: %w", ref, err)
+	}
+
+	privateKey, err := utils.DecodePrivateKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding private key %q: %w", ref, err)
+	}
+
+	return &signer{privateKey: privateKey}, nil
+}
+
+// DeleteKey deletes the file of the private key with the given reference.
+func (d *Directory) DeleteKey(_ context.Context, ref string) error {
+	path, err := d.pathFor(ref)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed deleting private key %q: %w", ref, err)
+	}
+	return nil
+}
+
+// pathFor returns the path of the file of the private key with the given reference. References are file names, i.e.,
+// they must not point to files outside the directory.
+func (d *Directory) pathFor(ref string) (string, error) {
+	if ref == "" || ref != filepath.Base(ref) || strings.HasPrefix(ref, ".") {
+		return "", fmt.Errorf("invalid private key reference %q", ref)
+	}
+	return filepath.Join(d.path, ref), nil
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keystore_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/secrets/manager/keystore"
+)
+
+var _ = Describe("Directory", func() {
+	var (
+		ctx      = context.Background()
+		dir      string
+		keyStore *Directory
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		keyStore = NewDirectory(dir)
+	})
+
+	It("should create keys, sign with them and delete them", func() {
+		ref, err := keyStore.CreateKey(ctx, "ca")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ref).To(MatchRegexp(`^ca-[0-9a-z]{8}\.key$`))
+
+		info, err := os.Stat(filepath.Join(dir, ref))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		entries, err := os.ReadDir(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+
+		signer, err := keyStore.Signer(ref)
+		Expect(err).NotTo(HaveOccurred())
+
+		digest := sha256.Sum256([]byte("foo"))
+		signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rsa.VerifyPKCS1v15(signer.Public().(*rsa.PublicKey), crypto.SHA256, digest[:], signature)).To(Succeed())
+
+		Expect(keyStore.DeleteKey(ctx, ref)).To(Succeed())
+		Expect(filepath.Join(dir, ref)).NotTo(BeAnExistingFile())
+
+		_, err = keyStore.Signer(ref)
+		Expect(err).To(MatchError(ContainSubstring("failed reading private key")))
+	})
+
+	It("should create different keys for the same name", func() {
+		ref1, err := keyStore.CreateKey(ctx, "ca")
+		Expect(err).NotTo(HaveOccurred())
+		ref2, err := keyStore.CreateKey(ctx, "ca")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(ref1).NotTo(Equal(ref2))
+	})
+
+	It("should not fail deleting keys which do not exist", func() {
+		Expect(keyStore.DeleteKey(ctx, "ca-foo.key")).To(Succeed())
+	})
+
+	DescribeTable("should reject references to files outside the directory",
+		func(ref string) {
+			_, err := keyStore.Signer(ref)
+			Expect(err).To(MatchError(ContainSubstring("invalid private key reference")))
+			Expect(keyStore.DeleteKey(ctx, ref)).To(MatchError(ContainSubstring("invalid private key reference")))
+		},
+
+		Entry("empty", ""),
+		Entry("parent directory", "../ca.key"),
+		Entry("absolute path", "/etc/ca.key"),
+		Entry("hidden file", ".ca.key"),
+	)
+})
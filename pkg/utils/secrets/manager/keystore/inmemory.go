@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keystore
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// InMemory is a software implementation of the secrets manager's key store which keeps the private keys in memory. It
+// is a stand-in for external key stores in tests and local setups, i.e., the private keys are lost when the process
+// terminates.
+type InMemory struct {
+	lock    sync.RWMutex
+	counter int
+	keys    map[string]*rsa.PrivateKey
+}
+
+// NewInMemory returns a new key store which keeps the private keys in memory.
+func NewInMemory() *InMemory {
+	return &InMemory{keys: make(map[string]*rsa.PrivateKey)}
+}
+
+// CreateKey creates a new 3072-bit RSA private key and returns a reference to it.
+func (s *InMemory) CreateKey(_ context.Context, name string) (string, error) {
+	privateKey, err := secretsutils.GenerateKey(rand.Reader, 3072)
+	if err != nil {
+		return "", err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.counter++
+	ref := name + "/" + strconv.Itoa(s.counter)
+	s.keys[ref] = privateKey
+	return ref, nil
+}
+
+// Signer returns a signer for the private key with the given reference.
+func (s *InMemory) Signer(ref string) (crypto.Signer, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	privateKey, ok := s.keys[ref]
+	if !ok {
+		return nil, fmt.Errorf("private key %q not found", ref)
+	}
+
+	return &signer{privateKey: privateKey}, nil
+}
+
+// DeleteKey deletes the private key with the given reference.
+func (s *InMemory) DeleteKey(_ context.Context, ref string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.keys, ref)
+	return nil
+}
+
+// Has returns true if the private key with the given reference exists.
+func (s *InMemory) Has(ref string) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	_, ok := s.keys[ref]
+	return ok
+}
+
+// signer only exposes the public key and signing operations, i.e., the private key cannot be extracted like in case of
+// external key stores.
+type signer struct {
+	privateKey *rsa.PrivateKey
+}
+
+func (s *signer) Public() crypto.PublicKey {
+	return s.privateKey.Public()
+}
+
+func (s *signer) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return s.privateKey.Sign(rand, digest, opts)
+}
@@ -107,6 +107,9 @@ type (
 		// DisableAutomaticSecretRenewal states whether automatic secret renewal should be disabled even if a secret's
 		// configuration would otherwise require it.
 		DisableAutomaticSecretRenewal bool
+		// KeyStore is an external key store in which the private keys of new CAs are created. If nil, the private keys
+		// are stored in the CA secrets.
+		KeyStore KeyStore
 	}
 	// NewOption is some configuration that configures a secrets manager instance when creating it with [New].
 	NewOption func(*NewOptions)
@@ -143,6 +146,14 @@ func WithoutAutomaticSecretRenewal() NewOption {
 	}
 }
 
+// WithKeyStore configures an external key store in which the private keys of new CAs are created and which is used for
+// signing certificates with them. CAs whose private keys are stored in secrets can still be used.
+func WithKeyStore(keyStore KeyStore) NewOption {
+	return func(options *NewOptions) {
+		options.KeyStore = keyStore
+	}
+}
+
 var _ Interface = &manager{}
 
 type secretClass string
@@ -441,7 +452,7 @@ func unixTime(in time.Time) string {
 }
 
 func isCASecret(data map[string][]byte) bool {
-	return data[secretsutils.DataKeyCertificateCA] != nil && data[secretsutils.DataKeyPrivateKeyCA] != nil
+	return data[secretsutils.DataKeyCertificateCA] != nil && (data[secretsutils.DataKeyPrivateKeyCA] != nil || data[DataKeyPrivateKeyCAReference] != nil)
 }
 
 func certificateSecretConfig(config secretsutils.ConfigInterface) *secretsutils.CertificateSecretConfig {
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type reconciler struct {
+	reconcile.Reconciler
+
+	controllerName string
+	kind           string
+}
+
+// Reconciler wraps the given reconciler, so that each reconciliation is recorded as a span. The span carries the
+// controller name as well as the name and namespace of the reconciled object of the given kind (e.g. `shoot`, `seed`,
+// or `garden`) as attributes.
+func Reconciler(controllerName, kind string, r reconcile.Reconciler) reconcile.Reconciler {
+	return &reconciler{Reconciler: r, controllerName: controllerName, kind: kind}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	ctx, span := Tracer().Start(ctx, r.controllerName+" reconcile", trace.WithAttributes(ObjectAttributes(r.kind, request.Namespace, request.Name)...))
+	defer span.End()
+
+	span.SetAttributes(attribute.String("gardener.controller", r.controllerName))
+
+	result, err := r.Reconciler.Reconcile(ctx, request)
+	RecordError(span, err)
+	return result, err
+}
+
+// ObjectAttributes returns the span attributes for the object of the given kind with the given namespace and name.
+func ObjectAttributes(kind, namespace, name string) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{attribute.String("gardener."+kind+".name", name)}
+	if namespace != "" {
+		attributes = append(attributes, attribute.String("gardener."+kind+".namespace", namespace))
+	}
+	return attributes
+}
+
+// RecordError records the given error in the span and sets its status accordingly. It is a no-op for nil errors.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
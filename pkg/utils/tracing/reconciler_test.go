@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener/pkg/utils/tracing"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          = context.Background()
+		spanRecorder *tracetest.SpanRecorder
+		request      = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden-foo", Name: "bar"}}
+	)
+
+	BeforeEach(func() {
+		oldTracerProvider := otel.GetTracerProvider()
+		DeferCleanup(func() { otel.SetTracerProvider(oldTracerProvider) })
+
+		spanRecorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+	})
+
+	It("should record a span for each reconciliation", func() {
+		var innerSpanContext trace.SpanContext
+
+		r := Reconciler("shoot", "shoot", reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+			innerSpanContext = trace.SpanContextFromContext(ctx)
+			return reconcile.Result{Requeue: true}, nil
+		}))
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true}))
+
+		Expect(spanRecorder.Ended()).To(HaveLen(1))
+		span := spanRecorder.Ended()[0]
+		Expect(span.Name()).To(Equal("shoot reconcile"))
+		Expect(span.SpanContext()).To(Equal(innerSpanContext))
+		Expect(span.Status().Code).To(Equal(codes.Unset))
+		Expect(span.Attributes()).To(ConsistOf(
+			attribute.String("gardener.shoot.name", "bar"),
+			attribute.String("gardener.shoot.namespace", "garden-foo"),
+			attribute.String("gardener.controller", "shoot"),
+		))
+	})
+
+	It("should record the error of the reconciliation", func() {
+		r := Reconciler("seed", "seed", reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+			return reconcile.Result{}, errors.New("fake")
+		}))
+
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "bar"}})
+		Expect(err).To(MatchError("fake"))
+
+		Expect(spanRecorder.Ended()).To(HaveLen(1))
+		span := spanRecorder.Ended()[0]
+		Expect(span.Status().Code).To(Equal(codes.Error))
+		Expect(span.Status().Description).To(Equal("fake"))
+		Expect(span.Events()).To(ConsistOf(HaveField("Name", "exception")))
+		Expect(span.Attributes()).To(ContainElement(attribute.String("gardener.seed.name", "bar")))
+		Expect(span.Attributes()).NotTo(ContainElement(HaveField("Key", attribute.Key("gardener.seed.namespace"))))
+	})
+})
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// instrumentationName is the name of the tracer used by all Gardener components.
+	instrumentationName = "github.com/gardener/gardener"
+	// AnnotationKeyPrefix is the prefix of the annotations which carry the span context of the operation which last
+	// modified an object, e.g. `tracing.gardener.cloud/traceparent`.
+	AnnotationKeyPrefix = "tracing.gardener.cloud/"
+)
+
+// propagator is used for injecting span contexts into and extracting them from annotations. It is used independently
+// of the global propagator, so that extensions can continue traces even if they don't export spans themselves.
+var propagator = propagation.TraceContext{}
+
+// Tracer returns the tracer used for creating spans. Spans are only recorded and exported if a tracer provider was
+// configured via Setup, otherwise they are no-ops.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Setup configures the global tracer provider to export spans to the OTLP gRPC endpoint with the given address. The
+// returned function must be called for flushing the remaining spans on shutdown.
+func Setup(ctx context.Context, serviceName, endpoint string, insecure bool) (func(context.Context) error, error) {
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating OTLP trace exporter: %w", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagator)
+
+	return tracerProvider.Shutdown, nil
+}
+
+// InjectIntoAnnotations stores the span context of the given context in the annotations of the given object, so that
+// the controller reconciling the object can continue the trace. It is a no-op if the context does not carry a valid
+// span context.
+func InjectIntoAnnotations(ctx context.Context, obj metav1.Object) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+
+	propagator.Inject(ctx, annotationCarrier{obj: obj})
+}
+
+// ContextFromAnnotations returns a context carrying the span context stored in the annotations of the given object,
+// see InjectIntoAnnotations.
+func ContextFromAnnotations(ctx context.Context, obj metav1.Object) context.Context {
+	return propagator.Extract(ctx, annotationCarrier{obj: obj})
+}
+
+// RemoveFromAnnotations removes the span context stored in the annotations of the given object, see
+// InjectIntoAnnotations.
+func RemoveFromAnnotations(obj metav1.Object) {
+	annotations := obj.GetAnnotations()
+	for _, key := range propagator.Fields() {
+		delete(annotations, AnnotationKeyPrefix+key)
+	}
+	obj.SetAnnotations(annotations)
+}
+
+// annotationCarrier is a propagation.TextMapCarrier which stores the values in the annotations of an object.
+type annotationCarrier struct {
+	obj metav1.Object
+}
+
+func (a annotationCarrier) Get(key string) string {
+	return a.obj.GetAnnotations()[AnnotationKeyPrefix+key]
+}
+
+func (a annotationCarrier) Set(key, value string) {
+	annotations := a.obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[AnnotationKeyPrefix+key] = value
+	a.obj.SetAnnotations(annotations)
+}
+
+func (a annotationCarrier) Keys() []string {
+	var keys []string
+	for _, key := range propagator.Fields() {
+		if _, ok := a.obj.GetAnnotations()[AnnotationKeyPrefix+key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/utils/tracing"
+)
+
+var _ = Describe("Tracing", func() {
+	var (
+		ctx          = context.Background()
+		spanRecorder *tracetest.SpanRecorder
+	)
+
+	BeforeEach(func() {
+		oldTracerProvider := otel.GetTracerProvider()
+		DeferCleanup(func() { otel.SetTracerProvider(oldTracerProvider) })
+
+		spanRecorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+	})
+
+	Describe("#InjectIntoAnnotations", func() {
+		var obj *corev1.ConfigMap
+
+		BeforeEach(func() {
+			obj = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo", Annotations: map[string]string{"foo": "bar"}}}
+		})
+
+		It("should store the span context in the annotations", func() {
+			spanCtx, span := Tracer().Start(ctx, "deploy")
+			defer span.End()
+
+			InjectIntoAnnotations(spanCtx, obj)
+
+			Expect(obj.Annotations).To(HaveKeyWithValue("foo", "bar"))
+			Expect(obj.Annotations).To(HaveKeyWithValue(AnnotationKeyPrefix+"traceparent", ContainSubstring(span.SpanContext().TraceID().String())))
+		})
+
+		It("should not modify the annotations without span context", func() {
+			InjectIntoAnnotations(ctx, obj)
+
+			Expect(obj.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+		})
+
+		It("should allow continuing the trace from the annotations", func() {
+			spanCtx, span := Tracer().Start(ctx, "deploy")
+			InjectIntoAnnotations(spanCtx, obj)
+			span.End()
+
+			_, childSpan := Tracer().Start(ContextFromAnnotations(ctx, obj), "reconcile")
+			childSpan.End()
+
+			Expect(childSpan.SpanContext().TraceID()).To(Equal(span.SpanContext().TraceID()))
+			Expect(spanRecorder.Ended()).To(HaveLen(2))
+			Expect(spanRecorder.Ended()[1].Parent().SpanID()).To(Equal(span.SpanContext().SpanID()))
+		})
+	})
+
+	Describe("#RemoveFromAnnotations", func() {
+		It("should remove the span context from the annotations", func() {
+			obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo", Annotations: map[string]string{"foo": "bar"}}}
+
+			spanCtx, span := Tracer().Start(ctx, "deploy")
+			InjectIntoAnnotations(spanCtx, obj)
+			span.End()
+
+			RemoveFromAnnotations(obj)
+
+			Expect(obj.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(trace.SpanContextFromContext(ContextFromAnnotations(ctx, obj)).IsValid()).To(BeFalse())
+		})
+	})
+
+	Describe("#ContextFromAnnotations", func() {
+		It("should return a context without span context if the annotations are missing", func() {
+			Expect(trace.SpanContextFromContext(ContextFromAnnotations(ctx, &corev1.ConfigMap{})).IsValid()).To(BeFalse())
+		})
+	})
+})
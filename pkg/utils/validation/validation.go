@@ -6,6 +6,8 @@ package validation
 
 import (
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -61,3 +63,22 @@ func ValidateFreeFormText(text string, fldPath *field.Path) field.ErrorList {
 
 	return allErrs
 }
+
+// ValidateTracingEndpoint validates the address of an OTLP endpoint, which must be given in the form `host:port`.
+func ValidateTracingEndpoint(endpoint string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(endpoint) == 0 {
+		return append(allErrs, field.Required(fldPath, "endpoint must be provided"))
+	}
+
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil || len(host) == 0 {
+		return append(allErrs, field.Invalid(fldPath, endpoint, "must be in the form 'host:port'"))
+	}
+	if p, err := strconv.Atoi(port); err != nil || p < 1 || p > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath, endpoint, "port must be a number between 1 and 65535"))
+	}
+
+	return allErrs
+}
@@ -30,4 +30,17 @@ var _ = Describe("Validation", func() {
 		Entry("should reject emojis", "Hello😊World", Not(BeEmpty())),
 		Entry("should reject symbols", "Hello©®™✓World", Not(BeEmpty())),
 	)
+
+	DescribeTable("#ValidateTracingEndpoint",
+		func(endpoint string, matcher gomegatypes.GomegaMatcher) {
+			Expect(ValidateTracingEndpoint(endpoint, field.NewPath("endpoint"))).To(matcher)
+		},
+		Entry("should allow host and port", "otel-collector.garden.svc:4317", BeEmpty()),
+		Entry("should allow IPv6 addresses", "[::1]:4317", BeEmpty()),
+		Entry("should reject empty endpoints", "", ConsistOf(HaveField("Type", field.ErrorTypeRequired))),
+		Entry("should reject endpoints without port", "otel-collector", ConsistOf(HaveField("Type", field.ErrorTypeInvalid))),
+		Entry("should reject endpoints without host", ":4317", ConsistOf(HaveField("Type", field.ErrorTypeInvalid))),
+		Entry("should reject invalid ports", "otel-collector:foo", ConsistOf(HaveField("Type", field.ErrorTypeInvalid))),
+		Entry("should reject out of range ports", "otel-collector:65536", ConsistOf(HaveField("Type", field.ErrorTypeInvalid))),
+	)
 })
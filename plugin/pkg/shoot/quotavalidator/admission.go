@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -18,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/warning"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 
@@ -32,16 +34,7 @@ import (
 	securityv1alpha1listers "github.com/gardener/gardener/pkg/client/security/listers/security/v1alpha1"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	plugin "github.com/gardener/gardener/plugin/pkg"
-)
-
-var (
-	quotaMetricNames = [6]corev1.ResourceName{
-		core.QuotaMetricCPU,
-		core.QuotaMetricGPU,
-		core.QuotaMetricMemory,
-		core.QuotaMetricStorageStandard,
-		core.QuotaMetricStoragePremium,
-		core.QuotaMetricLoadbalancer}
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
 )
 
 // Register registers a plugin.
@@ -61,6 +54,7 @@ type QuotaValidator struct {
 	secretBindingLister          gardencorev1beta1listers.SecretBindingLister
 	credentialsBindingLister     securityv1alpha1listers.CredentialsBindingLister
 	quotaLister                  gardencorev1beta1listers.QuotaLister
+	projectLister                gardencorev1beta1listers.ProjectLister
 	readyFunc                    admission.ReadyFunc
 	clock                        clock.Clock
 }
@@ -103,7 +97,10 @@ func (q *QuotaValidator) SetCoreInformerFactory(f gardencoreinformers.SharedInfo
 	quotaInformer := f.Core().V1beta1().Quotas()
 	q.quotaLister = quotaInformer.Lister()
 
-	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, cloudProfileInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced, quotaInformer.Informer().HasSynced)
+	projectInformer := f.Core().V1beta1().Projects()
+	q.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, cloudProfileInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced, quotaInformer.Informer().HasSynced, projectInformer.Informer().HasSynced)
 }
 
 // SetSecurityInformerFactory gets Lister from SharedInformerFactory.
@@ -131,6 +128,9 @@ func (q *QuotaValidator) ValidateInitialization() error {
 	if q.quotaLister == nil {
 		return errors.New("missing quota lister")
 	}
+	if q.projectLister == nil {
+		return errors.New("missing project lister")
+	}
 	if q.credentialsBindingLister == nil {
 		return errors.New("missing credentials binding lister")
 	}
@@ -139,8 +139,9 @@ func (q *QuotaValidator) ValidateInitialization() error {
 
 var _ admission.ValidationInterface = (*QuotaValidator)(nil)
 
-// Validate checks that the requested Shoot resources do not exceed the quota limits.
-func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+// Validate checks that the requested Shoot resources do not exceed the quota limits. Requests exceeding the soft limits
+// of a quota are admitted with a warning unless the soft limits have been exceeded for longer than the grace period.
+func (q *QuotaValidator) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
 	// Wait until the caches have been synced
 	if q.readyFunc == nil {
 		q.AssignReadyFunc(func() bool {
@@ -229,16 +230,22 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 		}
 
 		if checkQuota {
-			exceededMetrics, err := q.isQuotaExceeded(*shoot, *quota)
+			exceededMetrics, exceededSoftMetrics, err := q.isQuotaExceeded(*shoot, *quota)
 			if err != nil {
 				return apierrors.NewInternalError(err)
 			}
-			if exceededMetrics != nil {
-				message := ""
-				for _, metric := range *exceededMetrics {
-					message = message + metric.String() + " "
+			if len(exceededMetrics) > 0 {
+				return admission.NewForbidden(a, fmt.Errorf("quota limits exceeded. Unable to allocate further %s", metricsMessage(exceededMetrics)))
+			}
+			if len(exceededSoftMetrics) > 0 {
+				expired, err := q.softLimitGracePeriodExpired(shoot.Namespace, quota, exceededSoftMetrics)
+				if err != nil {
+					return apierrors.NewInternalError(err)
+				}
+				if expired {
+					return admission.NewForbidden(a, fmt.Errorf("soft limits of quota %s/%s exceeded for longer than the grace period of %s. Unable to allocate further %s", quota.Namespace, quota.Name, quota.Spec.SoftLimitGracePeriod.Duration, metricsMessage(exceededSoftMetrics)))
 				}
-				return admission.NewForbidden(a, fmt.Errorf("quota limits exceeded. Unable to allocate further %s", message))
+				warning.AddWarning(ctx, "", fmt.Sprintf("soft limits of quota %s/%s exceeded by allocating further %s", quota.Namespace, quota.Name, metricsMessage(exceededSoftMetrics)))
 			}
 		}
 	}
@@ -264,29 +271,69 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 	return nil
 }
 
-func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1beta1.Quota) (*[]corev1.ResourceName, error) {
+// isQuotaExceeded returns the metrics whose hard and soft limits would be exceeded if the given shoot was admitted.
+func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1beta1.Quota) ([]corev1.ResourceName, []corev1.ResourceName, error) {
 	allocatedResources, err := q.determineAllocatedResources(quota, shoot)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	requiredResources, err := q.determineRequiredResources(allocatedResources, shoot)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	exceededMetrics := make([]corev1.ResourceName, 0)
-	for _, metric := range quotaMetricNames {
-		if _, ok := quota.Spec.Metrics[metric]; !ok {
+	return exceededQuotaMetrics(quota.Spec.Metrics, requiredResources), exceededQuotaMetrics(quota.Spec.SoftMetrics, requiredResources), nil
+}
+
+func exceededQuotaMetrics(limits, requiredResources corev1.ResourceList) []corev1.ResourceName {
+	var exceededMetrics []corev1.ResourceName
+	for _, metric := range gardenerutils.QuotaMetricNames {
+		if _, ok := limits[metric]; !ok {
 			continue
 		}
-		if !hasSufficientQuota(quota.Spec.Metrics[metric], requiredResources[metric]) {
+		if !hasSufficientQuota(limits[metric], requiredResources[metric]) {
 			exceededMetrics = append(exceededMetrics, metric)
 		}
 	}
-	if len(exceededMetrics) != 0 {
-		return &exceededMetrics, nil
+	return exceededMetrics
+}
+
+// softLimitGracePeriodExpired checks whether the project of the given namespace has exceeded the soft limit of the given
+// quota for one of the given metrics for longer than the grace period of the quota. The start of the grace period is the
+// time of the first breach of the soft limit recorded in the project's status. Breaches which have not been recorded yet
+// are within the grace period.
+func (q *QuotaValidator) softLimitGracePeriodExpired(namespace string, quota *gardencorev1beta1.Quota, exceededMetrics []corev1.ResourceName) (bool, error) {
+	if quota.Spec.SoftLimitGracePeriod == nil {
+		return false, nil
 	}
-	return nil, nil
+
+	project, err := admissionutils.ProjectForNamespaceFromLister(q.projectLister, namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, breach := range project.Status.QuotaSoftLimitBreaches {
+		if breach.Quota.Namespace != quota.Namespace || breach.Quota.Name != quota.Name || !slices.Contains(exceededMetrics, breach.Resource) {
+			continue
+		}
+
+		if q.clock.Now().After(breach.Since.Add(quota.Spec.SoftLimitGracePeriod.Duration)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func metricsMessage(metrics []corev1.ResourceName) string {
+	message := ""
+	for _, metric := range metrics {
+		message = message + metric.String() + " "
+	}
+	return message
 }
 
 func (q *QuotaValidator) determineAllocatedResources(quota gardencorev1beta1.Quota, shoot core.Shoot) (corev1.ResourceList, error) {
@@ -303,7 +350,7 @@ func (q *QuotaValidator) determineAllocatedResources(quota gardencorev1beta1.Quo
 			return nil, err
 		}
 
-		for _, metric := range quotaMetricNames {
+		for _, metric := range gardenerutils.QuotaMetricNames {
 			allocatedResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
 		}
 	}
@@ -399,7 +446,7 @@ func (q *QuotaValidator) determineRequiredResources(allocatedResources corev1.Re
 	}
 
 	requiredResources := make(corev1.ResourceList)
-	for _, metric := range quotaMetricNames {
+	for _, metric := range gardenerutils.QuotaMetricNames {
 		requiredResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
 	}
 	return requiredResources, nil
@@ -414,104 +461,7 @@ func (q *QuotaValidator) getShootResources(shoot core.Shoot) (corev1.ResourceLis
 		return nil, fmt.Errorf("no cloudprofile reference has been provided")
 	}
 
-	var (
-		countLB      int64 = 1
-		resources          = make(corev1.ResourceList)
-		workers            = getShootWorkerResources(&shoot, cloudProfileSpec)
-		machineTypes       = cloudProfileSpec.MachineTypes
-		volumeTypes        = cloudProfileSpec.VolumeTypes
-	)
-
-	for _, worker := range workers {
-		var (
-			machineType *gardencorev1beta1.MachineType
-			volumeType  *gardencorev1beta1.VolumeType
-		)
-
-		// Get the proper machineType
-		for _, e := range machineTypes {
-			element := e
-			if element.Name == worker.Machine.Type {
-				machineType = &element
-				break
-			}
-		}
-		if machineType == nil {
-			return nil, fmt.Errorf("machineType %s not found in CloudProfile", worker.Machine.Type)
-		}
-
-		if worker.Volume != nil {
-			if machineType.Storage != nil {
-				volumeType = &gardencorev1beta1.VolumeType{
-					Class: machineType.Storage.Class,
-				}
-			} else {
-				// Get the proper VolumeType
-				for _, e := range volumeTypes {
-					element := e
-					if worker.Volume.Type != nil && element.Name == *worker.Volume.Type {
-						volumeType = &element
-						break
-					}
-				}
-			}
-		}
-		if volumeType == nil {
-			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile", worker.Machine.Type)
-		}
-
-		// For now we always use the max. amount of resources for quota calculation
-		resources[core.QuotaMetricCPU] = sumQuantity(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
-		resources[core.QuotaMetricGPU] = sumQuantity(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
-		resources[core.QuotaMetricMemory] = sumQuantity(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
-
-		size, _ := resource.ParseQuantity("0Gi")
-		if worker.Volume != nil {
-			size, err = resource.ParseQuantity(worker.Volume.VolumeSize)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		switch volumeType.Class {
-		case core.VolumeClassStandard:
-			resources[core.QuotaMetricStorageStandard] = sumQuantity(resources[core.QuotaMetricStorageStandard], multiplyQuantity(size, worker.Maximum))
-		case core.VolumeClassPremium:
-			resources[core.QuotaMetricStoragePremium] = sumQuantity(resources[core.QuotaMetricStoragePremium], multiplyQuantity(size, worker.Maximum))
-		default:
-			return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
-		}
-	}
-
-	if helper.NginxIngressEnabled(shoot.Spec.Addons) {
-		countLB++
-	}
-	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
-
-	return resources, nil
-}
-
-func getShootWorkerResources(shoot *core.Shoot, cloudProfile *gardencorev1beta1.CloudProfileSpec) []core.Worker {
-	workers := make([]core.Worker, 0, len(shoot.Spec.Provider.Workers))
-
-	for _, worker := range shoot.Spec.Provider.Workers {
-		workerCopy := worker.DeepCopy()
-
-		if worker.Volume == nil {
-			for _, machineType := range cloudProfile.MachineTypes {
-				if worker.Machine.Type == machineType.Name && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
-					workerCopy.Volume = &core.Volume{
-						Type:       &machineType.Storage.Type,
-						VolumeSize: machineType.Storage.StorageSize.String(),
-					}
-				}
-			}
-		}
-
-		workers = append(workers, *workerCopy)
-	}
-
-	return workers
+	return gardenerutils.QuotaResourcesForShoot(&shoot, cloudProfileSpec)
 }
 
 func lifetimeVerificationNeeded(new, old core.Shoot) bool {
@@ -562,11 +512,3 @@ func sumQuantity(values ...resource.Quantity) resource.Quantity {
 	}
 	return res
 }
-
-func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
-	res := resource.Quantity{}
-	for i := 0; i < int(multiplier); i++ {
-		res.Add(quantity)
-	}
-	return res
-}
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/warning"
 	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 
@@ -23,6 +24,7 @@ import (
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	securityinformers "github.com/gardener/gardener/pkg/client/security/informers/externalversions"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	. "github.com/gardener/gardener/plugin/pkg/shoot/quotavalidator"
 )
 
@@ -379,6 +381,105 @@ var _ = Describe("quotavalidator", func() {
 			})
 		})
 
+		Context("tests for Shoots exceeding the soft limits of a quota", func() {
+			var (
+				warningRecorder *fakeWarningRecorder
+				ctx             context.Context
+				project         *gardencorev1beta1.Project
+				attrs           admission.Attributes
+			)
+
+			BeforeEach(func() {
+				warningRecorder = &fakeWarningRecorder{}
+				ctx = warning.WithWarningRecorder(context.TODO(), warningRecorder)
+
+				quotaProject.Spec.SoftMetrics = corev1.ResourceList{core.QuotaMetricCPU: resource.MustParse("1")}
+				quotaProject.Spec.SoftLimitGracePeriod = &metav1.Duration{Duration: 24 * time.Hour}
+
+				project = &gardencorev1beta1.Project{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec:       gardencorev1beta1.ProjectSpec{Namespace: &namespace},
+				}
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+
+				attrs = admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+			})
+
+			It("should pass with a warning because only the soft limits are exceeded", func() {
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(warningRecorder.warnings).To(ConsistOf("soft limits of quota trial/project-quota exceeded by allocating further cpu "))
+			})
+
+			It("should pass without a warning because the soft limits are not exceeded", func() {
+				quotaProject.Spec.SoftMetrics[core.QuotaMetricCPU] = resource.MustParse("2")
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(warningRecorder.warnings).To(BeEmpty())
+			})
+
+			It("should pass with a warning because the soft limit breach was not recorded yet", func() {
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(warningRecorder.warnings).To(HaveLen(1))
+			})
+
+			It("should pass with a warning because the grace period is not yet expired", func() {
+				project.Status.QuotaSoftLimitBreaches = []gardencorev1beta1.QuotaSoftLimitBreach{{
+					Quota:    corev1.ObjectReference{Namespace: "trial", Name: "project-quota"},
+					Resource: core.QuotaMetricCPU,
+					Since:    metav1.NewTime(fakeClock.Now().Add(-23 * time.Hour)),
+				}}
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(warningRecorder.warnings).To(HaveLen(1))
+			})
+
+			It("should fail because the soft limits are exceeded for longer than the grace period", func() {
+				project.Status.QuotaSoftLimitBreaches = []gardencorev1beta1.QuotaSoftLimitBreach{{
+					Quota:    corev1.ObjectReference{Namespace: "trial", Name: "project-quota"},
+					Resource: core.QuotaMetricCPU,
+					Since:    metav1.NewTime(fakeClock.Now().Add(-25 * time.Hour)),
+				}}
+
+				err := admissionHandler.Validate(ctx, attrs, nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("soft limits of quota trial/project-quota exceeded for longer than the grace period of 24h0m0s"))
+			})
+
+			It("should pass with a warning because only the grace period of another quota is expired", func() {
+				project.Status.QuotaSoftLimitBreaches = []gardencorev1beta1.QuotaSoftLimitBreach{{
+					Quota:    corev1.ObjectReference{Namespace: "trial", Name: "other-quota"},
+					Resource: core.QuotaMetricCPU,
+					Since:    metav1.NewTime(fakeClock.Now().Add(-25 * time.Hour)),
+				}}
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(warningRecorder.warnings).To(HaveLen(1))
+			})
+
+			It("should pass with a warning because only the grace period of another resource is expired", func() {
+				project.Status.QuotaSoftLimitBreaches = []gardencorev1beta1.QuotaSoftLimitBreach{{
+					Quota:    corev1.ObjectReference{Namespace: "trial", Name: "project-quota"},
+					Resource: core.QuotaMetricMemory,
+					Since:    metav1.NewTime(fakeClock.Now().Add(-25 * time.Hour)),
+				}}
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(warningRecorder.warnings).To(HaveLen(1))
+			})
+
+			It("should pass with a warning because the quota has no grace period", func() {
+				quotaProject.Spec.SoftLimitGracePeriod = nil
+				project.Status.QuotaSoftLimitBreaches = []gardencorev1beta1.QuotaSoftLimitBreach{{
+					Quota:    corev1.ObjectReference{Namespace: "trial", Name: "project-quota"},
+					Resource: core.QuotaMetricCPU,
+					Since:    metav1.NewTime(fakeClock.Now().Add(-25 * time.Hour)),
+				}}
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(warningRecorder.warnings).To(HaveLen(1))
+			})
+		})
+
 		Context("tests for Quota validation corner cases", func() {
 			It("should pass because shoot is intended to get deleted", func() {
 				var now metav1.Time
@@ -475,3 +576,11 @@ var _ = Describe("quotavalidator", func() {
 		})
 	})
 })
+
+type fakeWarningRecorder struct {
+	warnings []string
+}
+
+func (f *fakeWarningRecorder) AddWarning(_, text string) {
+	f.warnings = append(f.warnings, text)
+}
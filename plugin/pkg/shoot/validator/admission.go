@@ -460,6 +460,10 @@ func (c *validationContext) validateScheduling(ctx context.Context, a admission.
 			return admission.NewForbidden(a, fmt.Errorf("cannot schedule shoot '%s' on seed '%s' that is already marked for deletion", c.shoot.Name, c.seed.Name))
 		}
 
+		if v1beta1helper.TaintsHave(c.seed.Spec.Taints, gardencorev1beta1.SeedTaintDraining) {
+			return admission.NewForbidden(a, fmt.Errorf("cannot schedule shoot '%s' on seed '%s' that is being drained", c.shoot.Name, c.seed.Name))
+		}
+
 		var seedTaints []core.SeedTaint
 		if c.seed.Spec.Taints != nil {
 			for _, taint := range c.seed.Spec.Taints {
@@ -1735,6 +1735,17 @@ var _ = Describe("validator", func() {
 					Expect(err).ToNot(HaveOccurred())
 				})
 
+				It("create should fail because the Seed specified in shoot manifest is being drained", func() {
+					seed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: gardencorev1beta1.SeedTaintDraining}}
+					shoot.Spec.Tolerations = []core.Toleration{{Key: core.SeedTaintDraining}}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Validate(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err.Error()).To(ContainSubstring("cannot schedule shoot '%s' on seed '%s' that is being drained", shoot.Name, seed.Name))
+				})
+
 				It("delete should pass even if the Seed specified in shoot manifest has non-tolerated taints", func() {
 					seed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: gardencorev1beta1.SeedTaintProtected}}
 
@@ -73,9 +73,10 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		registryConfig1, registryConfig2                                                                                               extensionsv1alpha1.RegistryConfig
 		pluginConfig1, pluginConfig2, pluginConfig3                                                                                    extensionsv1alpha1.PluginConfig
 
-		operatingSystemConfig *extensionsv1alpha1.OperatingSystemConfig
-		oscRaw                []byte
-		oscSecret             *corev1.Secret
+		operatingSystemConfig  *extensionsv1alpha1.OperatingSystemConfig
+		oscRaw                 []byte
+		oscSecret              *corev1.Secret
+		readyForUpdateRequired bool
 
 		imageMountDirectory                string
 		cancelFunc                         cancelFuncEnsurer
@@ -94,6 +95,7 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		DeferCleanup(func() { Expect(fakeFS.RemoveAll(imageMountDirectory)).To(Succeed()) })
 
 		cancelFunc = cancelFuncEnsurer{}
+		readyForUpdateRequired = false
 		DeferCleanup(test.WithVar(&operatingsystemconfig.RequeueAfterRestart, time.Second))
 
 		By("Setup manager")
@@ -446,6 +448,9 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 			},
 			Data: map[string][]byte{"osc.yaml": oscRaw},
 		}
+		if readyForUpdateRequired {
+			metav1.SetMetaDataAnnotation(&oscSecret.ObjectMeta, "reconciliation.osc.node-agent.gardener.cloud/require-ready-for-update", "true")
+		}
 
 		By("Create Secret containing the operating system config")
 		Expect(testClient.Create(ctx, oscSecret)).To(Succeed())
@@ -1109,6 +1114,35 @@ metadata:
 		})
 	})
 
+	Context("when the node must be ready for update", func() {
+		BeforeEach(func() {
+			readyForUpdateRequired = true
+		})
+
+		It("should only apply the configuration once the node is marked as ready for update", func() {
+			By("Ensure the configuration is not applied")
+			Consistently(func(g Gomega) map[string]string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				return node.Annotations
+			}).ShouldNot(HaveKey("checksum/cloud-config-data"))
+			test.AssertNoFileOnDisk(fakeFS, file1.Path)
+
+			By("Update node with in-place ReadyForUpdate condition")
+			patch := client.MergeFrom(node.DeepCopy())
+			node.Status.Conditions = []corev1.NodeCondition{
+				{
+					Type:   machinev1alpha1.NodeInPlaceUpdate,
+					Status: corev1.ConditionTrue,
+					Reason: machinev1alpha1.ReadyForUpdate,
+				},
+			}
+			Expect(testClient.Status().Patch(ctx, node, patch)).To(Succeed())
+
+			waitForUpdatedNodeAnnotationCloudConfig(node, oscSecret, utils.ComputeSHA256Hex(oscRaw))
+			test.AssertFileOnDisk(fakeFS, file1.Path, "file1", 0777)
+		})
+	})
+
 	Context("in-place updates", func() {
 		var (
 			kubeletUnit                    extensionsv1alpha1.Unit
@@ -1090,6 +1090,131 @@ var _ = Describe("ManagedResource controller tests", func() {
 		})
 	})
 
+	Describe("Dry-run mode", func() {
+		BeforeEach(func() {
+			managedResource.Spec.DryRun = ptr.To(true)
+		})
+
+		It("should record the pending changes without applying them", func() {
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				g.Expect(managedResource.Status.Conditions).To(
+					ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionFalse), WithReason(resourcesv1alpha1.ConditionDryRun)),
+				)
+				g.Expect(managedResource.Status.PendingChanges).To(ConsistOf(And(
+					HaveField("Kind", "ConfigMap"),
+					HaveField("Name", configMap.Name),
+					HaveField("Operation", resourcesv1alpha1.ChangeOperationCreate),
+					HaveField("Diff", ContainSubstring("+  abc: xyz\n")),
+				)))
+			}).Should(Succeed())
+
+			Consistently(func() error {
+				return testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)
+			}).Should(BeNotFoundError())
+
+			By("Disable dry-run mode")
+			patch := client.MergeFrom(managedResource.DeepCopy())
+			managedResource.Spec.DryRun = nil
+			Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				g.Expect(managedResource.Status.Conditions).To(
+					ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+				)
+				g.Expect(managedResource.Status.PendingChanges).To(BeEmpty())
+			}).Should(Succeed())
+
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+		})
+
+		It("should record an update of an existing object", func() {
+			By("Create ConfigMap")
+			existingConfigMap := configMap.DeepCopy()
+			existingConfigMap.Data = map[string]string{"abc": "old"}
+			Expect(testClient.Create(ctx, existingConfigMap)).To(Succeed())
+
+			patch := client.MergeFrom(managedResource.DeepCopy())
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "gardener.cloud/operation", "reconcile")
+			Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+			Eventually(func(g Gomega) []resourcesv1alpha1.PendingChange {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				return managedResource.Status.PendingChanges
+			}).Should(ConsistOf(And(
+				HaveField("Name", configMap.Name),
+				HaveField("Operation", resourcesv1alpha1.ChangeOperationUpdate),
+				HaveField("Diff", ContainSubstring("-  abc: old\n+  abc: xyz\n")),
+			)))
+
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(existingConfigMap), existingConfigMap)).To(Succeed())
+			Expect(existingConfigMap.Data).To(Equal(map[string]string{"abc": "old"}))
+
+			Expect(testClient.Delete(ctx, existingConfigMap)).To(Succeed())
+		})
+	})
+
+	Describe("Server-side apply", func() {
+		BeforeEach(func() {
+			managedResource.Spec.ApplyMode = ptr.To(resourcesv1alpha1.ApplyModeServerSide)
+		})
+
+		JustBeforeEach(func() {
+			Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				return managedResource.Status.Conditions
+			}).Should(
+				ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+			)
+		})
+
+		It("should apply the resources with the field manager of the resource manager", func() {
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"abc": "xyz"}))
+			Expect(configMap.Annotations).To(HaveKeyWithValue(resourcesv1alpha1.OriginAnnotation, Not(BeEmpty())))
+			Expect(configMap.ManagedFields).To(ContainElement(And(
+				HaveField("Manager", "gardener-resource-manager"),
+				HaveField("Operation", metav1.ManagedFieldsOperationApply),
+			)))
+		})
+
+		It("should keep fields owned by other field managers", func() {
+			patch := client.MergeFrom(configMap.DeepCopy())
+			configMap.Data = map[string]string{"abc": "xyz", "foo": "bar"}
+			Expect(testClient.Patch(ctx, configMap, patch, client.FieldOwner("test"))).To(Succeed())
+
+			patch = client.MergeFrom(managedResource.DeepCopy())
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "gardener.cloud/operation", "reconcile")
+			Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+			Consistently(func(g Gomega) map[string]string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+				return configMap.Data
+			}).Should(Equal(map[string]string{"abc": "xyz", "foo": "bar"}))
+		})
+
+		It("should report conflicts with other field managers in the conditions", func() {
+			patch := client.MergeFrom(configMap.DeepCopy())
+			configMap.Data = map[string]string{"abc": "changed"}
+			Expect(testClient.Patch(ctx, configMap, patch, client.FieldOwner("test"))).To(Succeed())
+
+			patch = client.MergeFrom(managedResource.DeepCopy())
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "gardener.cloud/operation", "reconcile")
+			Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+			Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				return managedResource.Status.Conditions
+			}).Should(
+				ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionFalse), WithReason(resourcesv1alpha1.ConditionApplyConflict), WithMessageSubstrings(`conflict with "test"`, ".data.abc")),
+			)
+
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"abc": "changed"}))
+		})
+	})
+
 	Describe("Immutable resources", func() {
 		BeforeEach(func() {
 			configMap.Immutable = ptr.To(true)